@@ -79,6 +79,7 @@ import (
 	dymnsmodule "github.com/dymensionxyz/dymension/v3/x/dymns"
 	dymnskeeper "github.com/dymensionxyz/dymension/v3/x/dymns/keeper"
 	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
+	eibcmodule "github.com/dymensionxyz/dymension/v3/x/eibc"
 	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	eibcmoduletypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	incentiveskeeper "github.com/dymensionxyz/dymension/v3/x/incentives/keeper"
@@ -91,6 +92,7 @@ import (
 	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/genesisbridge"
 	rollappmodulekeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/ratelimit"
 	rollappmoduletypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	sequencermodule "github.com/dymensionxyz/dymension/v3/x/sequencer"
 	sequencermodulekeeper "github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
@@ -283,7 +285,7 @@ func (a *AppKeepers) InitKeepers(
 		appCodec,
 		a.keys[evmtypes.StoreKey],
 		a.tkeys[evmtypes.TransientKey],
-		authtypes.NewModuleAddress(govtypes.ModuleName),
+		sdk.MustAccAddressFromBech32(govModuleAddress),
 		a.AccountKeeper,
 		a.BankKeeper,
 		a.StakingKeeper,
@@ -357,7 +359,7 @@ func (a *AppKeepers) InitKeepers(
 		nil,
 		a.BankKeeper,
 		a.TransferKeeper,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govModuleAddress,
 		nil,
 	)
 
@@ -369,7 +371,7 @@ func (a *AppKeepers) InitKeepers(
 		a.BankKeeper,
 		a.AccountKeeper,
 		a.RollappKeeper,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govModuleAddress,
 	)
 
 	a.LightClientKeeper = *lightclientmodulekeeper.NewKeeper(
@@ -379,6 +381,7 @@ func (a *AppKeepers) InitKeepers(
 		a.IBCKeeper.ChannelKeeper,
 		a.SequencerKeeper,
 		a.RollappKeeper,
+		govModuleAddress,
 	)
 
 	a.SequencerKeeper.SetUnbondBlockers(a.RollappKeeper, a.LightClientKeeper)
@@ -416,7 +419,7 @@ func (a *AppKeepers) InitKeepers(
 	a.IROKeeper = irokeeper.NewKeeper(
 		appCodec,
 		a.keys[irotypes.StoreKey],
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govModuleAddress,
 		&a.AccountKeeper,
 		a.BankKeeper,
 		a.DenomMetadataKeeper,
@@ -434,7 +437,7 @@ func (a *AppKeepers) InitKeepers(
 		a.StakingKeeper,
 		a.IncentivesKeeper,
 		a.SequencerKeeper,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govModuleAddress,
 	)
 
 	a.StreamerKeeper = *streamermodulekeeper.NewKeeper(
@@ -457,6 +460,7 @@ func (a *AppKeepers) InitKeepers(
 		a.BankKeeper,
 		a.DelayedAckKeeper,
 		a.RollappKeeper,
+		govModuleAddress,
 	)
 
 	a.DymNSKeeper = dymnskeeper.NewKeeper(
@@ -465,7 +469,7 @@ func (a *AppKeepers) InitKeepers(
 		a.GetSubspace(dymnstypes.ModuleName),
 		a.BankKeeper,
 		a.RollappKeeper,
-		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		govModuleAddress,
 	)
 
 	// Create Transfer Keepers
@@ -474,7 +478,10 @@ func (a *AppKeepers) InitKeepers(
 		a.keys[ibctransfertypes.StoreKey],
 		a.GetSubspace(ibctransfertypes.ModuleName),
 		genesisbridge.NewICS4Wrapper(
-			denommetadatamodule.NewICS4Wrapper(a.IBCKeeper.ChannelKeeper, a.RollappKeeper, a.BankKeeper),
+			ratelimit.NewICS4Wrapper(
+				denommetadatamodule.NewICS4Wrapper(a.IBCKeeper.ChannelKeeper, a.RollappKeeper, a.BankKeeper),
+				a.RollappKeeper,
+			),
 			a.RollappKeeper,
 			a.IBCKeeper.ChannelKeeper,
 		),
@@ -485,6 +492,8 @@ func (a *AppKeepers) InitKeepers(
 		a.ScopedTransferKeeper,
 	)
 	a.RollappKeeper.SetTransferKeeper(a.TransferKeeper)
+	a.EIBCKeeper.SetTransferKeeper(a.TransferKeeper)
+	a.EIBCKeeper.SetChannelKeeper(a.IBCKeeper.ChannelKeeper)
 
 	a.DelayedAckKeeper = *delayedackkeeper.NewKeeper(
 		appCodec,
@@ -505,7 +514,7 @@ func (a *AppKeepers) InitKeepers(
 	// See: https://github.com/cosmos/cosmos-sdk/blob/release/v0.46.x/x/gov/spec/01_concepts.md#proposal-messages
 	govRouter := govv1beta1.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(a.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, eibcmodule.NewParamChangeProposalHandler(a.EIBCKeeper, params.NewParamChangeProposalHandler(a.ParamsKeeper))).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(a.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(a.IBCKeeper.ClientKeeper)).
 		AddRoute(streamermoduletypes.RouterKey, streamermodule.NewStreamerProposalHandler(a.StreamerKeeper)).
@@ -563,6 +572,7 @@ func (a *AppKeepers) InitTransferStack() {
 		delayedackmodule.WithRollappKeeper(a.RollappKeeper),
 	)
 	a.TransferStack = a.delayedAckMiddleware
+	a.TransferStack = ratelimit.NewIBCModule(a.TransferStack, a.RollappKeeper)
 	a.TransferStack = genesisbridge.NewIBCModule(a.TransferStack, a.RollappKeeper, a.TransferKeeper, a.DenomMetadataKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
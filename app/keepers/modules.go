@@ -241,6 +241,10 @@ func (*AppKeepers) ModuleAccountAddrs() map[string]bool {
 	modAccAddrs[authtypes.NewModuleAddress(streamermoduletypes.ModuleName).String()] = false
 	modAccAddrs[authtypes.NewModuleAddress(txfeestypes.ModuleName).String()] = false
 	modAccAddrs[authtypes.NewModuleAddress(irotypes.ModuleName).String()] = false
+	// eibc escrows the funds of partially filled demand orders until finalization
+	modAccAddrs[authtypes.NewModuleAddress(eibcmoduletypes.ModuleName).String()] = false
+	// eibc vaults receive the funds of the orders they fulfilled on finalization
+	modAccAddrs[authtypes.NewModuleAddress(eibcmoduletypes.VaultAccountName).String()] = false
 	return modAccAddrs
 }
 
@@ -266,6 +270,8 @@ var maccPerms = map[string][]string{
 	txfeestypes.ModuleName:                             {authtypes.Burner},
 	dymnstypes.ModuleName:                              {authtypes.Minter, authtypes.Burner},
 	irotypes.ModuleName:                                {authtypes.Minter, authtypes.Burner},
+	eibcmoduletypes.ModuleName:                         nil,
+	eibcmoduletypes.VaultAccountName:                   {authtypes.Minter, authtypes.Burner},
 }
 
 var BeginBlockers = []string{
@@ -0,0 +1,26 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
+	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func migrateEIBCParams(ctx sdk.Context, ek eibckeeper.Keeper) {
+	// overwrite params for eibc module to add the floors and caps of the rollapp fees, and the fill limits
+	params := eibctypes.DefaultParams()
+
+	// the floors, caps and fill limits are the only ones that are new
+	params.EpochIdentifier = ek.EpochIdentifier(ctx)
+	params.TimeoutFee = ek.TimeoutFee(ctx)
+	params.ErrackFee = ek.ErrAckFee(ctx)
+
+	// keep the current fees within the floors and caps
+	params.MinRollappTimeoutFee = sdk.MinDec(params.MinRollappTimeoutFee, params.TimeoutFee)
+	params.MinRollappErrackFee = sdk.MinDec(params.MinRollappErrackFee, params.ErrackFee)
+	params.MaxRollappTimeoutFee = sdk.MaxDec(params.MaxRollappTimeoutFee, params.TimeoutFee)
+	params.MaxRollappErrackFee = sdk.MaxDec(params.MaxRollappErrackFee, params.ErrackFee)
+
+	ek.SetParams(ctx, params)
+}
@@ -0,0 +1,12 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
+	lightclienttypes "github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+func migrateLightClientParams(ctx sdk.Context, lightClientKeeper lightclientkeeper.Keeper) error {
+	// the lightclient module had no params before
+	return lightClientKeeper.SetParams(ctx, lightclienttypes.DefaultParams())
+}
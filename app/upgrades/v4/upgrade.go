@@ -23,6 +23,7 @@ import (
 	delayedackkeeper "github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
 	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	dymnskeeper "github.com/dymensionxyz/dymension/v3/x/dymns/keeper"
+	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	incentiveskeeper "github.com/dymensionxyz/dymension/v3/x/incentives/keeper"
 	incentivestypes "github.com/dymensionxyz/dymension/v3/x/incentives/types"
 	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
@@ -58,6 +59,7 @@ func CreateUpgradeHandler(
 		}
 
 		migrateDelayedAckParams(ctx, keepers.DelayedAckKeeper)
+		migrateEIBCParams(ctx, keepers.EIBCKeeper)
 		migrateRollappParams(ctx, keepers.RollappKeeper)
 		if err := migrateRollapps(ctx, keepers.RollappKeeper, keepers.DymNSKeeper); err != nil {
 			return nil, err
@@ -72,6 +74,9 @@ func CreateUpgradeHandler(
 		if err := migrateRollappLightClients(ctx, keepers.RollappKeeper, keepers.LightClientKeeper, keepers.IBCKeeper.ChannelKeeper); err != nil {
 			return nil, err
 		}
+		if err := migrateLightClientParams(ctx, keepers.LightClientKeeper); err != nil {
+			return nil, err
+		}
 		if err := migrateStreamer(ctx, keepers.StreamerKeeper, keepers.EpochsKeeper); err != nil {
 			return nil, err
 		}
@@ -85,6 +90,10 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		if err := migrateEIBCDemandOrderIndexes(ctx, keepers.EIBCKeeper); err != nil {
+			return nil, err
+		}
+
 		if err := migrateRollappRegisteredDenoms(ctx, keepers.RollappKeeper); err != nil {
 			return nil, err
 		}
@@ -266,6 +275,21 @@ func migrateDelayedAckPacketIndex(ctx sdk.Context, dk delayedackkeeper.Keeper) e
 		case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 			dk.MustSetPendingPacketByAddress(ctx, pd.Sender, packet.RollappPacketKey())
 		}
+		dk.MustSetPendingPacketIndexes(ctx, packet)
+	}
+	return nil
+}
+
+// migrateEIBCDemandOrderIndexes re-sets all the demand orders, which adds them to the demand order indexes.
+func migrateEIBCDemandOrderIndexes(ctx sdk.Context, ek eibckeeper.Keeper) error {
+	orders, err := ek.ListAllDemandOrders(ctx)
+	if err != nil {
+		return err
+	}
+	for _, order := range orders {
+		if err := ek.SetDemandOrder(ctx, order); err != nil {
+			return err
+		}
 	}
 	return nil
 }
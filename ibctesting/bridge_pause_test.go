@@ -0,0 +1,113 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/suite"
+
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+type bridgePauseSuite struct {
+	utilSuite
+	path *ibctesting.Path
+}
+
+func TestBridgePauseTestSuite(t *testing.T) {
+	suite.Run(t, new(bridgePauseSuite))
+}
+
+func (s *bridgePauseSuite) SetupTest() {
+	s.utilSuite.SetupTest()
+	s.hubApp().LightClientKeeper.SetEnabled(false)
+
+	s.hubApp().BankKeeper.SetDenomMetaData(s.hubCtx(), banktypes.Metadata{
+		Base: sdk.DefaultBondDenom,
+	})
+
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(s.path)
+
+	s.createRollappWithFinishedGenesis(s.path.EndpointA.ChannelID)
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), s.path.EndpointA.ClientID)
+	s.registerSequencer()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight()))
+}
+
+func (s *bridgePauseSuite) pause(d time.Duration) {
+	err := s.hubApp().RollappKeeper.SetBridgePause(s.hubCtx(), rollapptypes.NewBridgePause(
+		rollappChainID(), s.hubChain().SenderAccount.GetAddress().String(), s.hubCtx().BlockTime().Add(d),
+	))
+	s.Require().NoError(err)
+}
+
+// Transfers from the rollapp are rejected with an error acknowledgement while the bridge is paused
+func (s *bridgePauseSuite) TestInbound() {
+	rollappEndpoint := s.path.EndpointB
+	hubIBCKeeper := s.hubChain().App.GetIBCKeeper()
+	receiver := s.hubChain().SenderAccount.GetAddress()
+
+	send := func() (ackWritten bool) {
+		msg := types.NewMsgTransfer(
+			rollappEndpoint.ChannelConfig.PortID,
+			rollappEndpoint.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1_000)),
+			s.rollappChain().SenderAccount.GetAddress().String(),
+			receiver.String(),
+			clienttypes.NewHeight(100, 110),
+			0,
+			"",
+		)
+		res, err := s.rollappChain().SendMsgs(msg)
+		s.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		s.Require().NoError(err)
+
+		_ = s.path.RelayPacket(packet)
+		return hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+
+	// paused: acknowledged with an error right away, nothing is held back
+	s.pause(time.Hour)
+	s.Require().True(send())
+	pending := s.hubApp().DelayedAckKeeper.ListRollappPackets(s.hubCtx(), delayedacktypes.ByRollappID(rollappChainID()))
+	s.Require().Empty(pending)
+
+	// unpaused: held back by delayedack as usual
+	s.Require().NoError(s.hubApp().RollappKeeper.RemoveBridgePause(s.hubCtx(), rollappChainID()))
+	s.Require().False(send())
+}
+
+// Transfers to the rollapp fail while the bridge is paused, and succeed once the pause expired
+func (s *bridgePauseSuite) TestOutbound() {
+	hubEndpoint := s.path.EndpointA
+	transfer := func() error {
+		ctx, _ := s.hubCtx().CacheContext()
+		_, err := s.hubApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), types.NewMsgTransfer(
+			hubEndpoint.ChannelConfig.PortID,
+			hubEndpoint.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1_000)),
+			s.hubChain().SenderAccount.GetAddress().String(),
+			s.rollappChain().SenderAccount.GetAddress().String(),
+			clienttypes.NewHeight(100, 110),
+			disabledTimeoutTimestamp,
+			"",
+		))
+		return err
+	}
+
+	s.pause(time.Nanosecond)
+	s.Require().ErrorIs(transfer(), rollapptypes.ErrBridgePaused)
+
+	// the pause expires with the next block
+	s.coordinator.CommitBlock(s.hubChain())
+	s.Require().NoError(transfer())
+}
@@ -188,6 +188,54 @@ func (s *delayedAckSuite) TestTransferRollappToHubFinalization() {
 	s.Require().True(found)
 }
 
+// TestTransferRollappToHubAutoFinalization tests that a packet at a finalized height is finalized
+// at the end of the block without a manual finalization.
+func (s *delayedAckSuite) TestTransferRollappToHubAutoFinalization() {
+	path := s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(path)
+
+	hubIBCKeeper := s.hubChain().App.GetIBCKeeper()
+
+	rollappEndpoint := path.EndpointB
+
+	s.createRollappWithFinishedGenesis(path.EndpointA.ChannelID)
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), path.EndpointA.ClientID)
+	s.registerSequencer()
+
+	// Update rollapp state
+	currentRollappBlockHeight := uint64(s.rollappCtx().BlockHeight())
+	s.updateRollappState(currentRollappBlockHeight)
+
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	amount, ok := sdk.NewIntFromString("10000000000000000000") // 10DYM
+	s.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	msg := types.NewMsgTransfer(rollappEndpoint.ChannelConfig.PortID, rollappEndpoint.ChannelID, coinToSendToB, s.rollappChain().SenderAccount.GetAddress().String(), s.hubChain().SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := s.rollappChain().SendMsgs(msg)
+	s.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+
+	// relay send
+	err = path.RelayPacket(packet)
+	s.Require().Error(err) // expecting error as no AcknowledgePacket expected to return
+
+	// Finalize the rollapp state
+	currentRollappBlockHeight = uint64(s.rollappCtx().BlockHeight())
+	_, err = s.finalizeRollappState(1, currentRollappBlockHeight)
+	s.Require().NoError(err)
+
+	found := hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().False(found)
+
+	// the packet is finalized at the end of the block
+	s.coordinator.CommitBlock(s.hubChain())
+
+	found = hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().True(found)
+}
+
 // TestHubToRollappTimeout tests the scenario where a packet is sent from the hub to the rollapp and the rollapp times out the packet.
 // The packet should actually get timed out and funds returned to the user only after the rollapp state is finalized.
 func (s *delayedAckSuite) TestHubToRollappTimeout() {
@@ -21,6 +21,7 @@ import (
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	delayedacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	eibctypes "github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
@@ -133,11 +134,11 @@ func (s *eibcSuite) TestEIBCDemandOrderCreation() {
 			false,
 		},
 		{
-			"invalid demand order - PFM and EIBC are not supported together",
+			"valid demand order - PFM and EIBC",
 			"1000000000",
 			"150",
-			0,
-			true,
+			1,
+			false,
 			map[string]map[string]string{"forward": {
 				"receiver": s.hubChain().SenderAccount.GetAddress().String(),
 				"port":     "transfer",
@@ -516,6 +517,126 @@ func (s *eibcSuite) TestTimeoutEIBCDemandOrderFulfillment() {
 	}
 }
 
+// TestEIBCDemandOrderFulfillmentWithForward tests that the price paid for the demand order of a packet with
+// a PFM memo is forwarded, that the fulfiller gets the packet funds on finalization, and that the funds of a
+// failed forward are refunded to the recipient on the hub.
+func (s *eibcSuite) TestEIBCDemandOrderFulfillmentWithForward() {
+	cosmosPath := s.newTransferPath(s.hubChain(), s.cosmosChain())
+	s.coordinator.Setup(cosmosPath)
+
+	IBCSenderAccount := s.rollappChain().SenderAccount.GetAddress().String()
+	fulfiller := s.hubChain().SenderAccount.GetAddress()
+	rollappStateIndex := uint64(0)
+
+	cases := []struct {
+		name            string
+		forwardReceiver string
+		forwardSuccess  bool
+	}{
+		{
+			"forward succeeds",
+			s.cosmosChain().SenderAccount.GetAddress().String(),
+			true,
+		},
+		{
+			"forward fails - refund to recipient",
+			"invalid",
+			false,
+		},
+	}
+	for idx, tc := range cases {
+		s.Run(tc.name, func() {
+			recipient := s.hubChain().SenderAccounts[idx+1].SenderAccount.GetAddress()
+
+			// Give the fulfiller some funds to fulfill with
+			s.rollappChain().NextBlock()
+			currentRollappBlockHeight := uint64(s.rollappCtx().BlockHeight())
+			rollappStateIndex = rollappStateIndex + 1
+			s.updateRollappState(currentRollappBlockHeight)
+			packet := s.transferRollappToHub(s.path, IBCSenderAccount, fulfiller.String(), "1000", "", false)
+			currentRollappBlockHeight = uint64(s.rollappCtx().BlockHeight())
+			_, err := s.finalizeRollappState(rollappStateIndex, currentRollappBlockHeight)
+			s.Require().NoError(err)
+			s.finalizeRollappPacketsByAddress(fulfiller.String())
+			IBCDenom := s.getRollappToHubIBCDenomFromPacket(packet)
+
+			// Send an eIBC packet to be forwarded to the cosmos chain
+			s.rollappChain().NextBlock()
+			currentRollappBlockHeight = uint64(s.rollappCtx().BlockHeight())
+			rollappStateIndex = rollappStateIndex + 1
+			s.updateRollappState(currentRollappBlockHeight)
+			memo, err := json.Marshal(map[string]map[string]string{
+				"eibc": {"fee": "100"},
+				"forward": {
+					"receiver": tc.forwardReceiver,
+					"port":     cosmosPath.EndpointA.ChannelConfig.PortID,
+					"channel":  cosmosPath.EndpointA.ChannelID,
+				},
+			})
+			s.Require().NoError(err)
+			packet = s.transferRollappToHub(s.path, IBCSenderAccount, recipient.String(), "500", string(memo), false)
+
+			demandOrders, err := s.hubApp().EIBCKeeper.ListDemandOrdersByStatus(s.hubCtx(), commontypes.Status_PENDING, 0)
+			s.Require().NoError(err)
+			var demandOrder *eibctypes.DemandOrder
+			for _, order := range demandOrders {
+				if order.Recipient == recipient.String() {
+					demandOrder = order
+				}
+			}
+			s.Require().NotNil(demandOrder)
+
+			// Fulfill the order, the price is forwarded on behalf of the recipient
+			recipientBalance := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom)
+			fulfillerBalance := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom)
+			res, err := s.hubChain().SendMsgs(eibctypes.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id, "100"))
+			s.Require().NoError(err)
+			forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			s.Require().NoError(err)
+			s.Require().Equal(recipientBalance, s.hubApp().BankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom))
+
+			// The fulfiller gets the packet funds on finalization, the forward is dropped from the packet
+			rollappPacket, err := s.hubApp().DelayedAckKeeper.GetRollappPacket(s.hubCtx(), demandOrder.TrackingPacketKey)
+			s.Require().NoError(err)
+			data, err := rollappPacket.GetTransferPacketData()
+			s.Require().NoError(err)
+			s.Require().Equal(fulfiller.String(), data.Receiver)
+			s.Require().False(delayedacktypes.MemoHasForward(data.Memo))
+
+			// Relay the forward to the cosmos chain and its ack back to the hub
+			err = cosmosPath.RelayPacket(forwardPacket)
+			s.Require().NoError(err)
+			if tc.forwardSuccess {
+				trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+					cosmosPath.EndpointB.ChannelConfig.PortID,
+					cosmosPath.EndpointB.ChannelID,
+					transfertypes.GetPrefixedDenom(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.DefaultBondDenom),
+				))
+				forwarded := convertToApp(s.cosmosChain()).BankKeeper.GetBalance(s.cosmosCtx(), sdk.MustAccAddressFromBech32(tc.forwardReceiver), trace.IBCDenom())
+				s.Require().Equal(sdk.NewInt(400), forwarded.Amount)
+				s.Require().Equal(recipientBalance, s.hubApp().BankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom))
+			} else {
+				// the price is refunded to the recipient
+				s.Require().Equal(recipientBalance.AddAmount(sdk.NewInt(400)), s.hubApp().BankKeeper.GetBalance(s.hubCtx(), recipient, IBCDenom))
+			}
+
+			// Finalize the packet, the fulfiller gets the price and the fee
+			currentRollappBlockHeight = uint64(s.rollappCtx().BlockHeight())
+			_, err = s.finalizeRollappState(rollappStateIndex, currentRollappBlockHeight)
+			s.Require().NoError(err)
+			evts := s.finalizeRollappPacketsByAddress(fulfiller.String())
+			ack, err := ibctesting.ParseAckFromEvents(evts)
+			s.Require().NoError(err)
+			s.Require().Equal(fulfillerBalance.AddAmount(sdk.NewInt(100)), s.hubApp().BankKeeper.GetBalance(s.hubCtx(), fulfiller, IBCDenom))
+
+			s.path.EndpointA.Chain.NextBlock()
+			_ = s.path.EndpointB.UpdateClient()
+			err = s.path.EndpointB.AcknowledgePacket(packet, ack)
+			s.Require().NoError(err)
+		})
+	}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Utils                                   */
 /* -------------------------------------------------------------------------- */
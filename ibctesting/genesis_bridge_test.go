@@ -138,7 +138,7 @@ func (s *transferGenesisSuite) TestIRO() {
 	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
 
 	// create IRO plan
-	_, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), amt, time.Now(), time.Now().Add(time.Hour), rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams())
+	_, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), amt, time.Now(), time.Now().Add(time.Hour), rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), appparams.BaseDenom, irotypes.VestingPlan{}, irotypes.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 
 	// create the expected genesis bridge packet
@@ -169,6 +169,62 @@ func (s *transferGenesisSuite) TestIRO() {
 	s.Require().Equal(plan.SettledDenom, expectedIBCdenom)
 }
 
+// TestIROCancelled tests a genesis bridge of a rollapp with a cancelled IRO plan
+// We expect the bridge to open, and the RA tokens allocated to the plan to be returned to the rollapp owner
+func (s *transferGenesisSuite) TestIROCancelled() {
+	amt := math.NewIntFromUint64(1_000_000).MulRaw(1e18)
+
+	// Add the iro module to the genesis accounts
+	gAddr := s.hubApp().IROKeeper.GetModuleAccountAddress()
+	gAccounts := []rollapptypes.GenesisAccount{
+		{
+			Address: gAddr,
+			Amount:  amt,
+		},
+	}
+	s.addGenesisAccounts(gAccounts)
+
+	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+
+	// create IRO plan and cancel it, as it was not settled in time
+	planId, err := s.hubApp().IROKeeper.CreatePlan(s.hubCtx(), amt, time.Now(), time.Now().Add(time.Hour), rollapp, irotypes.DefaultBondingCurve(), irotypes.DefaultIncentivePlanParams(), appparams.BaseDenom, irotypes.VestingPlan{}, irotypes.AllowlistPhase{}, math.ZeroInt())
+	s.Require().NoError(err)
+	err = s.hubApp().IROKeeper.CancelPlan(s.hubCtx(), s.hubApp().IROKeeper.MustGetPlan(s.hubCtx(), planId))
+	s.Require().NoError(err)
+
+	rollapp = s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	s.Require().True(rollapp.IroFailed)
+
+	// create the expected genesis bridge packet
+	packet := s.genesisBridgePacket(rollapp.GenesisInfo)
+
+	// send the packet on the rollapp chain
+	seq, err := s.path.EndpointB.SendPacket(packet.TimeoutHeight, packet.TimeoutTimestamp, packet.Data)
+	s.Require().NoError(err)
+	packet.Sequence = seq
+
+	_, err = s.path.EndpointA.RecvPacketWithResult(packet)
+	s.Require().NoError(err)
+
+	// assert the ack succeeded
+	ack, found := s.hubApp().IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	s.Require().True(found)
+	s.Require().Equal(successAck, ack)
+
+	// assert the transfers are enabled
+	rollapp = s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	s.Require().True(rollapp.GenesisState.IsTransferEnabled())
+
+	// the iro plan should not be settled, and the allocation is returned to the owner
+	plan := s.hubApp().IROKeeper.MustGetPlan(s.hubCtx(), planId)
+	s.Require().False(plan.IsSettled())
+	expectedIBCdenom := types.ParseDenomTrace(types.GetPrefixedDenom(s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, rollapp.GenesisInfo.NativeDenom.Base)).IBCDenom()
+	balance := s.hubApp().BankKeeper.GetBalance(s.hubCtx(), sdk.MustAccAddressFromBech32(rollapp.Owner), expectedIBCdenom)
+	s.Require().Equal(amt, balance.Amount)
+	balance = s.hubApp().BankKeeper.GetBalance(s.hubCtx(), s.hubApp().AccountKeeper.GetModuleAddress(irotypes.ModuleName), expectedIBCdenom)
+	s.Require().True(balance.IsZero())
+}
+
 // TestInvalidGenesisInfo tests an invalid genesis info
 func (s *transferGenesisSuite) TestInvalidGenesisInfo() {
 	rollapp := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
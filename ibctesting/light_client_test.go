@@ -3,15 +3,19 @@ package ibctesting_test
 import (
 	"slices"
 	"testing"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/cosmos/ibc-go/v7/testing/simapp"
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	lightclientkeeper "github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/utest"
 
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
@@ -227,6 +231,74 @@ func (s *lightClientSuite) TestSetCanonicalClient_Succeeds() {
 	s.Equal(endpointA.ClientID, canonClientID)
 }
 
+func (s *lightClientSuite) TestSetCanonicalClient_Incremental() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	s.path = s.newCanonicalClientPath()
+
+	bds := make(map[uint64]rollapptypes.BlockDescriptor)
+	recordHeader := func() {
+		h := s.rollappChain().CurrentHeader
+		bds[uint64(h.Height)] = rollapptypes.BlockDescriptor{Height: uint64(h.Height), StateRoot: h.AppHash, Timestamp: h.Time}
+	}
+
+	startHeight := uint64(s.rollappChain().CurrentHeader.Height)
+	recordHeader()
+	s.NoError(s.path.EndpointA.CreateClient())
+	recordHeader()
+
+	// more consensus states than can be validated in one go
+	for range types.ValidationChunkSize {
+		s.coordinator.CommitBlock(s.rollappChain())
+		recordHeader()
+		s.NoError(s.path.EndpointA.UpdateClient())
+		recordHeader()
+	}
+
+	endHeight := uint64(s.rollappChain().CurrentHeader.Height)
+	blockDescriptors := &rollapptypes.BlockDescriptors{}
+	for h := startHeight; h <= endHeight; h++ {
+		blockDescriptors.BD = append(blockDescriptors.BD, bds[h])
+	}
+	msgUpdateState := rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		endHeight-startHeight+1,
+		blockDescriptors,
+	)
+	_, err := s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
+	s.Require().NoError(err)
+
+	setCanonMsg := &types.MsgSetCanonicalClient{
+		Signer: s.hubChain().SenderAccount.GetAddress().String(), ClientId: s.path.EndpointA.ClientID,
+	}
+	res, err := s.lightclientMsgServer().SetCanonicalClient(s.hubCtx(), setCanonMsg)
+	s.Require().NoError(err)
+	s.True(res.Pending)
+
+	_, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.False(found)
+
+	progress, err := s.hubApp().LightClientKeeper.ClientValidation(s.hubCtx(), &types.QueryClientValidationRequest{ClientId: s.path.EndpointA.ClientID})
+	s.Require().NoError(err)
+	s.True(progress.Progress.Matched)
+	s.Positive(progress.Progress.ValidatedHeight)
+	s.Less(progress.Progress.ValidatedHeight, progress.LatestClientHeight)
+	s.Equal(endHeight, progress.LatestRollappHeight)
+
+	// validation completes in end block
+	s.hubApp().LightClientKeeper.ContinueClientValidations(s.hubCtx())
+
+	canonClientID, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Equal(s.path.EndpointA.ClientID, canonClientID)
+
+	_, err = s.hubApp().LightClientKeeper.ClientValidation(s.hubCtx(), &types.QueryClientValidationRequest{ClientId: s.path.EndpointA.ClientID})
+	utest.IsErr(s.Require(), err, gerrc.ErrNotFound)
+}
+
 func (s *lightClientSuite) TestMsgUpdateClient_StateUpdateDoesntExist() {
 	s.createRollapp(false, nil)
 	s.registerSequencer()
@@ -612,3 +684,160 @@ func (s *lightClientSuite) TestAfterUpdateState_Rollback() {
 
 	s.NoError(s.path.EndpointA.UpdateClient())
 }
+
+func (s *lightClientSuite) TestSubmitMisbehaviour_CanonicalClient() {
+	s.createRollappWithFinishedGenesis("channel-0")
+	s.registerSequencer()
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.SetupClients(s.path)
+	s.updateRollappState(uint64(s.rollappChain().LastHeader.Header.Height))
+	s.hubApp().LightClientKeeper.SetCanonicalClient(s.hubCtx(), s.rollappChain().ChainID, s.path.EndpointA.ClientID)
+
+	// two conflicting headers for the same height, signed by the sequencer
+	trustedHeight := s.path.EndpointA.GetClientState().GetLatestHeight().(clienttypes.Height)
+	trustedVals, ok := s.rollappChain().GetValsAtHeight(int64(trustedHeight.RevisionHeight))
+	s.Require().True(ok)
+	h := int64(trustedHeight.RevisionHeight) + 1
+	t := s.rollappChain().CurrentHeader.Time
+	header1 := s.rollappChain().CreateTMClientHeader(s.rollappChain().ChainID, h, trustedHeight, t.Add(time.Minute), s.rollappChain().Vals, s.rollappChain().NextVals, trustedVals, s.rollappChain().Signers)
+	header2 := s.rollappChain().CreateTMClientHeader(s.rollappChain().ChainID, h, trustedHeight, t, s.rollappChain().Vals, s.rollappChain().NextVals, trustedVals, s.rollappChain().Signers)
+	misbehaviour := ibctm.NewMisbehaviour(s.path.EndpointA.ClientID, header1, header2)
+
+	rewardee := apptesting.CreateRandomAccounts(1)[0]
+	msg, err := types.NewMsgSubmitMisbehaviour(rewardee.String(), s.path.EndpointA.ClientID, misbehaviour)
+	s.Require().NoError(err)
+	_, err = s.lightclientMsgServer().SubmitMisbehaviour(s.hubCtx(), msg)
+	s.Require().NoError(err)
+
+	// the sequencer is slashed and the rewardee gets a share
+	seq, err := s.hubApp().SequencerKeeper.RealSequencer(s.hubCtx(), s.hubChain().SenderAccount.GetAddress().String())
+	s.Require().NoError(err)
+	s.True(seq.TokensCoin().IsZero())
+	s.False(s.hubApp().BankKeeper.GetAllBalances(s.hubCtx(), rewardee).IsZero())
+
+	// the rollapp is forked from the contested height, but the client is not frozen by ibc
+	ra := s.hubApp().RollappKeeper.MustGetRollapp(s.hubCtx(), rollappChainID())
+	s.Equal(uint64(1), ra.LatestRevision().Number)
+	s.Equal(uint64(h), ra.LatestRevision().StartHeight)
+	canonClientID, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.True(found)
+	s.Equal(s.path.EndpointA.ClientID, canonClientID)
+
+	// the same evidence cannot be used twice
+	_, err = s.lightclientMsgServer().SubmitMisbehaviour(s.hubCtx(), msg)
+	s.Require().Error(err)
+}
+
+func (s *lightClientSuite) TestReplaceCanonicalClient() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	s.path = s.newCanonicalClientPath()
+	s.NoError(s.path.EndpointA.CreateClient())
+	s.hubApp().LightClientKeeper.SetCanonicalClient(s.hubCtx(), s.rollappChain().ChainID, s.path.EndpointA.ClientID)
+	canonClientID := s.path.EndpointA.ClientID
+
+	// a prospective replacement client
+	substitute := s.newCanonicalClientPath()
+
+	replaceMsg := types.NewMsgReplaceCanonicalClient(s.hubChain().SenderAccount.GetAddress().String(), "")
+
+	// expire the canonical client
+	s.coordinator.IncrementTimeBy(types.DefaultExpectedCanonicalClientParams().TrustingPeriod)
+	s.coordinator.CommitBlock(s.hubChain(), s.rollappChain())
+
+	currentHeader := s.rollappChain().CurrentHeader
+	startHeight := uint64(currentHeader.Height)
+	bd := rollapptypes.BlockDescriptor{Height: startHeight, StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	s.NoError(substitute.EndpointA.CreateClient())
+	replaceMsg.ClientId = substitute.EndpointA.ClientID
+
+	currentHeader = s.rollappChain().CurrentHeader
+	bdNext := rollapptypes.BlockDescriptor{Height: uint64(currentHeader.Height), StateRoot: currentHeader.AppHash, Timestamp: currentHeader.Time}
+
+	// no state update yet, so the replacement cannot be verified
+	_, err := s.lightclientMsgServer().ReplaceCanonicalClient(s.hubCtx(), replaceMsg)
+	s.Require().Error(err)
+
+	msgUpdateState := rollapptypes.NewMsgUpdateState(
+		s.hubChain().SenderAccount.GetAddress().String(),
+		rollappChainID(),
+		"mock-da-path",
+		startHeight,
+		2,
+		&rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{bd, bdNext}},
+	)
+	_, err = s.rollappMsgServer().UpdateState(s.hubCtx(), msgUpdateState)
+	s.Require().NoError(err)
+
+	_, err = s.lightclientMsgServer().ReplaceCanonicalClient(s.hubCtx(), replaceMsg)
+	s.Require().NoError(err)
+
+	// the canonical client id is unchanged, but it now has the state of the substitute
+	got, found := s.hubApp().LightClientKeeper.GetCanonicalClient(s.hubCtx(), rollappChainID())
+	s.Require().True(found)
+	s.Equal(canonClientID, got)
+	clientState := s.path.EndpointA.GetClientState()
+	s.Equal(substitute.EndpointA.GetClientState().GetLatestHeight(), clientState.GetLatestHeight())
+	s.Equal(exported.Active, s.hubApp().IBCKeeper.ClientKeeper.GetClientStatus(s.hubCtx(), clientState, canonClientID))
+
+	// the canonical client is active, so it cannot be replaced again
+	_, err = s.lightclientMsgServer().ReplaceCanonicalClient(s.hubCtx(), replaceMsg)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+}
+
+func (s *lightClientSuite) TestClientExpiryWarning() {
+	s.createRollapp(false, nil)
+	s.registerSequencer()
+	s.path = s.newCanonicalClientPath()
+	s.NoError(s.path.EndpointA.CreateClient())
+	k := s.hubApp().LightClientKeeper
+	k.SetCanonicalClient(s.hubCtx(), rollappChainID(), s.path.EndpointA.ClientID)
+	s.Require().NoError(k.SetParams(s.hubCtx(), types.Params{
+		ExpiryWarningThresholds: []time.Duration{time.Hour * 24, time.Hour},
+	}))
+
+	health, ok := k.GetClientHealth(s.hubCtx(), rollappChainID())
+	s.Require().True(ok)
+	s.Equal(s.path.EndpointA.ClientID, health.ClientId)
+	s.Equal(string(exported.Active), health.Status)
+
+	// checks expiry at the given time, returns the warning events
+	checkExpiry := func(t time.Time) []sdk.Event {
+		ctx := s.hubCtx().WithBlockTime(t)
+		k.CheckClientExpiry(ctx)
+		var events []sdk.Event
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == "dymensionxyz.dymension.lightclient.EventClientExpiryWarning" {
+				events = append(events, e)
+			}
+		}
+		return events
+	}
+
+	s.Empty(checkExpiry(health.Expiry.Add(-time.Hour * 25)))
+	s.Len(checkExpiry(health.Expiry.Add(-time.Hour*23)), 1)
+	// warned only once per threshold
+	s.Empty(checkExpiry(health.Expiry.Add(-time.Hour * 22)))
+	s.Len(checkExpiry(health.Expiry.Add(-time.Minute)), 1)
+	s.Len(checkExpiry(health.Expiry.Add(-time.Hour*48)), 0)
+	// after the client gets more time, the warnings are emitted again
+	s.Len(checkExpiry(health.Expiry.Add(-time.Hour*23)), 1)
+
+	// the rollapp summary shows the expired client
+	ctx := s.hubCtx().WithBlockTime(health.Expiry.Add(time.Minute))
+	res, err := s.hubApp().RollappKeeper.Rollapp(ctx, &rollapptypes.QueryGetRollappRequest{RollappId: rollappChainID()})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Summary.ClientHealth)
+	s.Equal(string(exported.Expired), res.Summary.ClientHealth.Status)
+	s.Zero(res.Summary.ClientHealth.TimeLeft)
+}
+
+// a path whose hub side client matches the trust requirements of a canonical client
+func (s *lightClientSuite) newCanonicalClientPath() *ibctesting.Path {
+	endpointA := ibctesting.NewEndpoint(s.hubChain(), &canonicalClientConfig, ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
+	endpointB := ibctesting.NewEndpoint(s.rollappChain(), ibctesting.NewTendermintConfig(), ibctesting.NewConnectionConfig(), ibctesting.NewChannelConfig())
+	endpointA.Counterparty = endpointB
+	endpointB.Counterparty = endpointA
+	return &ibctesting.Path{EndpointA: endpointA, EndpointB: endpointB}
+}
@@ -0,0 +1,141 @@
+package ibctesting_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/suite"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+type rateLimitSuite struct {
+	utilSuite
+	path *ibctesting.Path
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(rateLimitSuite))
+}
+
+func (s *rateLimitSuite) SetupTest() {
+	s.utilSuite.SetupTest()
+	s.hubApp().LightClientKeeper.SetEnabled(false)
+
+	s.hubApp().BankKeeper.SetDenomMetaData(s.hubCtx(), banktypes.Metadata{
+		Base: sdk.DefaultBondDenom,
+	})
+
+	s.path = s.newTransferPath(s.hubChain(), s.rollappChain())
+	s.coordinator.Setup(s.path)
+
+	s.createRollappWithFinishedGenesis(s.path.EndpointA.ChannelID)
+	s.setRollappLightClientID(s.rollappCtx().ChainID(), s.path.EndpointA.ClientID)
+	s.registerSequencer()
+	s.updateRollappState(uint64(s.rollappCtx().BlockHeight()))
+}
+
+func (s *rateLimitSuite) setRateLimit(denom string, maxInflow, maxOutflow math.Int) {
+	err := s.hubApp().RollappKeeper.SetRateLimit(s.hubCtx(), rollapptypes.NewRateLimit(
+		rollappChainID(), denom, maxInflow, maxOutflow, time.Hour,
+	))
+	s.Require().NoError(err)
+}
+
+func (s *rateLimitSuite) rateLimitFlow() rollapptypes.RateLimitFlow {
+	limits, err := s.hubApp().RollappKeeper.GetRateLimits(s.hubCtx(), rollappChainID())
+	s.Require().NoError(err)
+	s.Require().Len(limits, 1)
+	return limits[0].Flow
+}
+
+// Transfers from the rollapp over the quota are rejected with an error acknowledgement
+func (s *rateLimitSuite) TestInflow() {
+	rollappEndpoint := s.path.EndpointB
+	hubIBCKeeper := s.hubChain().App.GetIBCKeeper()
+	receiver := s.hubChain().SenderAccount.GetAddress()
+
+	amount := math.NewInt(1_000)
+	hubDenom := types.ParseDenomTrace(types.GetPrefixedDenom(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+	s.setRateLimit(hubDenom, amount.SubRaw(1), math.ZeroInt())
+
+	send := func() (ackWritten bool) {
+		msg := types.NewMsgTransfer(
+			rollappEndpoint.ChannelConfig.PortID,
+			rollappEndpoint.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, amount),
+			s.rollappChain().SenderAccount.GetAddress().String(),
+			receiver.String(),
+			clienttypes.NewHeight(100, 110),
+			0,
+			"",
+		)
+		res, err := s.rollappChain().SendMsgs(msg)
+		s.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		s.Require().NoError(err)
+
+		_ = s.path.RelayPacket(packet)
+		return hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(s.hubCtx(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+
+	// over the quota: acknowledged with an error right away, nothing is counted
+	s.Require().True(send())
+	s.Require().True(s.rateLimitFlow().Inflow.IsZero())
+	s.Require().True(s.hubApp().BankKeeper.GetBalance(s.hubCtx(), receiver, hubDenom).IsZero())
+
+	// within the quota: held back by delayedack as usual
+	s.setRateLimit(hubDenom, amount, math.ZeroInt())
+	s.Require().False(send())
+	s.Require().Equal(amount, s.rateLimitFlow().Inflow)
+}
+
+// Transfers to the rollapp over the quota are rejected, refunded transfers give back their quota
+func (s *rateLimitSuite) TestOutflow() {
+	hubEndpoint := s.path.EndpointA
+	sender := s.hubChain().SenderAccount.GetAddress()
+	amount := math.NewInt(1_000)
+	s.setRateLimit(sdk.DefaultBondDenom, math.ZeroInt(), amount)
+
+	newMsg := func(timeoutHeight clienttypes.Height) *types.MsgTransfer {
+		return types.NewMsgTransfer(
+			hubEndpoint.ChannelConfig.PortID,
+			hubEndpoint.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, amount),
+			sender.String(),
+			s.rollappChain().SenderAccount.GetAddress().String(),
+			timeoutHeight,
+			disabledTimeoutTimestamp,
+			"",
+		)
+	}
+	// sends in a cached context, the over quota transfer fails the tx
+	transfer := func() error {
+		ctx, _ := s.hubCtx().CacheContext()
+		_, err := s.hubApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), newMsg(clienttypes.NewHeight(100, 110)))
+		return err
+	}
+
+	res, err := s.hubChain().SendMsgs(newMsg(clienttypes.GetSelfHeight(s.rollappCtx())))
+	s.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	s.Require().NoError(err)
+	s.Require().Equal(amount, s.rateLimitFlow().Outflow)
+
+	s.Require().ErrorIs(transfer(), rollapptypes.ErrRateLimitExceeded)
+
+	// the timeout gives back the quota
+	s.Require().NoError(hubEndpoint.UpdateClient())
+	s.Require().NoError(hubEndpoint.TimeoutPacket(packet))
+	s.Require().True(s.rateLimitFlow().Outflow.IsZero())
+
+	s.Require().NoError(transfer())
+}
@@ -28,6 +28,15 @@ func WithCollectionPaginationTriplePrefix[K1, K2, K3 any](prefix K1) func(o *Col
 	}
 }
 
+// WithCollectionPaginationTripleSuperPrefix applies a super prefix to a collection, whose key is a collection.Triple,
+// being paginated that needs prefixing.
+func WithCollectionPaginationTripleSuperPrefix[K1, K2, K3 any](prefix1 K1, prefix2 K2) func(o *CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+	return func(o *CollectionsPaginateOptions[collections.Triple[K1, K2, K3]]) {
+		prefix := collections.TripleSuperPrefix[K1, K2, K3](prefix1, prefix2)
+		o.Prefix = &prefix
+	}
+}
+
 // CollectionsPaginateOptions provides extra options for pagination in collections.
 type CollectionsPaginateOptions[K any] struct {
 	// Prefix allows to optionally set a prefix for the pagination.
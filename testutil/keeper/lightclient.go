@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"bytes"
-	"context"
 	"testing"
 	"time"
 
@@ -13,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
@@ -86,10 +87,15 @@ func LightClientKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		mockSequencerKeeper,
 		mockRollappKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, cometbftproto.Header{}, false, log.NewNopLogger())
 
+	// Initialize params
+	err := k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, err)
+
 	return k, ctx
 }
 
@@ -103,6 +109,11 @@ func (m *MockIBCCLientKeeper) ClientStore(ctx sdk.Context, clientID string) stor
 	panic("unimplemented")
 }
 
+// ClientUpdateProposal implements types.IBCClientKeeperExpected.
+func (m *MockIBCCLientKeeper) ClientUpdateProposal(ctx sdk.Context, p *ibcclienttypes.ClientUpdateProposal) error {
+	panic("unimplemented")
+}
+
 func NewMockIBCClientKeeper(
 	clientCS map[string]map[uint64]exported.ConsensusState,
 	genesisClients map[string]exported.ClientState,
@@ -131,15 +142,6 @@ func (m *MockIBCCLientKeeper) IterateClientStates(ctx sdk.Context, prefix []byte
 	}
 }
 
-func (m *MockIBCCLientKeeper) ConsensusStateHeights(c context.Context, req *ibcclienttypes.QueryConsensusStateHeightsRequest) (*ibcclienttypes.QueryConsensusStateHeightsResponse, error) {
-	heights := []ibcclienttypes.Height{
-		ibcclienttypes.NewHeight(1, 2),
-	}
-	return &ibcclienttypes.QueryConsensusStateHeightsResponse{
-		ConsensusStateHeights: heights,
-	}, nil
-}
-
 type MockSequencerKeeper struct {
 	sequencers map[string]*sequencertypes.Sequencer
 }
@@ -170,6 +172,10 @@ func (m *MockSequencerKeeper) RollappSequencers(ctx sdk.Context, rollappId strin
 	return seqs
 }
 
+func (m *MockSequencerKeeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.AccAddress) error {
+	return nil
+}
+
 // GetProposer implements types.SequencerKeeperExpected.
 func (m *MockSequencerKeeper) GetProposer(ctx sdk.Context, rollappId string) (val sequencertypes.Sequencer) {
 	panic("unimplemented")
@@ -121,7 +121,10 @@ func (r RollappPacket) RestoreOriginalTransferTarget() RollappPacket {
 		case RollappPacket_ON_ACK, RollappPacket_ON_TIMEOUT:
 			transferPacketData.Sender = r.OriginalTransferTarget
 		}
-		r.Packet.Data = transferPacketData.GetBytes()
+		// copy the packet, so that the stored rollapp packet is not modified
+		packet := *r.Packet
+		packet.Data = transferPacketData.GetBytes()
+		r.Packet = &packet
 	}
 	return r
 }
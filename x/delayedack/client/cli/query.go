@@ -30,6 +30,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPacketsByStatus())
 	cmd.AddCommand(CmdGetPacketsByType())
 	cmd.AddCommand(CmdGetPendingPacketsByAddress())
+	cmd.AddCommand(CmdGetPendingPacketsBySender())
+	cmd.AddCommand(CmdGetPendingPacketsByDenom())
+	cmd.AddCommand(CmdGetPendingPacketsByChannel())
+	cmd.AddCommand(CmdGetPendingPacketsByType())
+	cmd.AddCommand(CmdPendingPacketsSummary())
 
 	return cmd
 }
@@ -254,3 +259,179 @@ func CmdGetPendingPacketsByAddress() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetPendingPacketsBySender() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets-by-sender [sender]",
+		Short: "Get pending packets by the original transfer sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetPendingPacketsBySender(cmd.Context(), &types.QueryPendingPacketsBySenderRequest{
+				Sender:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-packets-by-sender")
+
+	return cmd
+}
+
+func CmdGetPendingPacketsByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets-by-denom [denom]",
+		Short: "Get pending packets by the denom in the transfer data",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetPendingPacketsByDenom(cmd.Context(), &types.QueryPendingPacketsByDenomRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-packets-by-denom")
+
+	return cmd
+}
+
+func CmdGetPendingPacketsByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets-by-channel [channel]",
+		Short: "Get pending packets by the Hub side channel",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetPendingPacketsByChannel(cmd.Context(), &types.QueryPendingPacketsByChannelRequest{
+				Channel:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-packets-by-channel")
+
+	return cmd
+}
+
+func CmdGetPendingPacketsByType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets-by-type [type]",
+		Short: "Get pending packets by type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			packetType, err := parsePacketType(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetPendingPacketsByType(cmd.Context(), &types.QueryPendingPacketsByTypeRequest{
+				Type:       packetType,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-packets-by-type")
+
+	return cmd
+}
+
+func CmdPendingPacketsSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets-summary [rollapp-id]",
+		Short: "Get the number and the summed amounts of pending packets per rollapp",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingPacketsSummaryRequest{}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
+
+			res, err := queryClient.PendingPacketsSummary(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
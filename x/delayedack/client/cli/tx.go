@@ -24,6 +24,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdFinalizePacket())
+	cmd.AddCommand(CmdFinalizeRollappPackets())
 
 	return cmd
 }
@@ -72,6 +73,50 @@ func CmdFinalizePacket() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagMaxPackets = "max-packets"
+	FlagMaxGas     = "max-gas"
+)
+
+func CmdFinalizeRollappPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-rollapp-packets [rollapp-id] --from <sender>",
+		Short: "Finalize pending packets of a rollapp up to its latest finalized height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxPackets, err := cmd.Flags().GetUint64(FlagMaxPackets)
+			if err != nil {
+				return err
+			}
+
+			maxGas, err := cmd.Flags().GetUint64(FlagMaxGas)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizeRollappPackets{
+				Sender:     clientCtx.GetFromAddress().String(),
+				RollappId:  args[0],
+				MaxPackets: maxPackets,
+				MaxGas:     maxGas,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxPackets, 0, fmt.Sprintf("Maximum number of packets to finalize (default %d)", types.DefaultFinalizeRollappPacketsLimit))
+	cmd.Flags().Uint64(FlagMaxGas, 0, "Gas budget for finalization; no budget if zero")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parsePacketType(packetType string) (commontypes.RollappPacket_Type, error) {
 	switch packetType {
 	case commontypes.RollappPacket_ON_RECV.String():
@@ -22,6 +22,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic("invalid rollapp packet type")
 		}
 		k.SetRollappPacket(ctx, packet)
+		if packet.Status == commontypes.Status_PENDING {
+			k.MustSetPendingPacketIndexes(ctx, packet)
+			// packets at finalized heights are picked up by automatic finalization
+			if err := k.EnqueueRollappFinalization(ctx, packet.RollappId); err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -94,6 +94,12 @@ func (w IBCMiddleware) OnRecvPacket(
 		return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "delayed ack: get valid transfer with finalization info"))
 	}
 
+	if transfer.IsRollapp() {
+		if err := w.raKeeper.ValidateBridgeNotPaused(ctx, transfer.Rollapp.RollappId); err != nil {
+			return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "delayed ack"))
+		}
+	}
+
 	if !transfer.IsRollapp() || transfer.Finalized {
 		return w.IBCModule.OnRecvPacket(ctx, packet, relayer)
 	}
@@ -104,9 +110,11 @@ func (w IBCMiddleware) OnRecvPacket(
 	cacheCtx, _ := ctx.CacheContext()
 	ack := w.IBCModule.OnRecvPacket(cacheCtx, packet, relayer)
 	if ack == nil {
-		return uevent.NewErrorAcknowledgement(ctx, errors.New("delayed ack is not supported by the underlying IBC module"))
-	}
-	if !ack.Success() {
+		// the forward middleware acknowledges once the forwarded packet is acknowledged
+		if !types.MemoHasForward(transfer.Memo) {
+			return uevent.NewErrorAcknowledgement(ctx, errors.New("delayed ack is not supported by the underlying IBC module"))
+		}
+	} else if !ack.Success() {
 		return ack
 	}
 
@@ -218,6 +226,7 @@ func (w IBCMiddleware) savePacket(ctx sdk.Context, packet channeltypes.Packet, t
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		w.MustSetPendingPacketByAddress(ctx, transfer.FungibleTokenPacketData.Sender, p.RollappPacketKey())
 	}
+	w.MustSetPendingPacketIndexes(ctx, p)
 
 	// Save the rollapp packet
 	w.Keeper.SetRollappPacket(ctx, p)
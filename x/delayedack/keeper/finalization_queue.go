@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"errors"
+	"slices"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// AfterStateFinalized queues the rollapp for automatic finalization if it has pending packets
+// at the newly finalized heights.
+func (k Keeper) AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *rollapptypes.StateInfo) error {
+	pending := k.ListRollappPackets(ctx, types.PendingByRollappIDByMaxHeight(rollappID, stateInfo.GetLatestHeight()).Take(1))
+	if len(pending) == 0 {
+		return nil
+	}
+	return k.EnqueueRollappFinalization(ctx, rollappID)
+}
+
+// EnqueueRollappFinalization queues the rollapp for automatic finalization of its pending packets.
+func (k Keeper) EnqueueRollappFinalization(ctx sdk.Context, rollappID string) error {
+	return k.finalizationQueue.Set(ctx, rollappID)
+}
+
+// IsRollappFinalizationQueued returns true if the rollapp is queued for automatic finalization.
+func (k Keeper) IsRollappFinalizationQueued(ctx sdk.Context, rollappID string) (bool, error) {
+	return k.finalizationQueue.Has(ctx, rollappID)
+}
+
+// FinalizeQueuedPackets finalizes pending packets of the queued rollapps up to their latest finalized
+// heights, within the per-block packet and gas budgets. Rollapps are served one packet at a time in
+// round-robin order, starting after the rollapp served last in the previous block, so a rollapp with
+// many packets cannot starve the others. A rollapp leaves the queue once it has no finalizable packets.
+// Each packet runs under a gas meter limited to the remaining budget. A packet which runs out of gas is
+// reverted and retried in a later block.
+func (k Keeper) FinalizeQueuedPackets(ctx sdk.Context, ibc porttypes.IBCModule) {
+	limit := int(k.FinalizePacketsBlockLimit(ctx))
+	gasLimit := k.FinalizePacketsBlockGasLimit(ctx)
+	if limit == 0 || gasLimit == 0 {
+		return
+	}
+
+	queue, err := k.queuedRollapps(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Get finalization queue.", "error", err)
+		return
+	}
+
+	// the budget is measured on separate meters, the block gas meter is not affected
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	var (
+		finalized int
+		gasUsed   uint64
+		last      string
+	)
+	for len(queue) > 0 {
+		remaining := queue[:0]
+		for _, rollappID := range queue {
+			if finalized == limit || gasLimit <= gasUsed {
+				k.saveFinalizationCursor(ctx, last)
+				return
+			}
+
+			var (
+				packets []commontypes.RollappPacket
+				hasMore bool
+			)
+			gas, err := applyFuncWithGasLimit(ctx, gasLimit-gasUsed, func(ctx sdk.Context) error {
+				var err error
+				packets, hasMore, err = k.FinalizeRollappPackets(ctx, ibc, rollappID, 1, 0)
+				if err != nil {
+					return err
+				}
+				for _, packet := range packets {
+					err = uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+						RollappId:         packet.RollappId,
+						PacketProofHeight: packet.ProofHeight,
+						PacketType:        packet.Type,
+						PacketSrcChannel:  packet.Packet.SourceChannel,
+						PacketSequence:    packet.Packet.Sequence,
+						Error:             packet.Error,
+					})
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			gasUsed += gas
+			last = rollappID
+			if errors.Is(err, errFinalizationOutOfGas) {
+				// the packet is retried in a later block, with the full budget if needed
+				k.Logger(ctx).Info("Finalize queued rollapp packets: out of gas.", "rollappID", rollappID, "gas", gas)
+				k.saveFinalizationCursor(ctx, last)
+				return
+			}
+			if err != nil {
+				// the rollapp is queued again on its next state finalization
+				k.Logger(ctx).Error("Finalize queued rollapp packets.", "rollappID", rollappID, "error", err)
+				hasMore = false
+			}
+
+			finalized += len(packets)
+
+			if hasMore {
+				remaining = append(remaining, rollappID)
+				continue
+			}
+			if err := k.finalizationQueue.Remove(ctx, rollappID); err != nil {
+				k.Logger(ctx).Error("Remove rollapp from finalization queue.", "rollappID", rollappID, "error", err)
+			}
+		}
+		queue = remaining
+	}
+
+	k.saveFinalizationCursor(ctx, last)
+}
+
+var errFinalizationOutOfGas = errors.New("finalization out of gas")
+
+// applyFuncWithGasLimit runs f under a gas meter with the limit, and returns the gas consumed up to the
+// limit. The state changes of f are dropped if it fails or runs out of gas.
+func applyFuncWithGasLimit(ctx sdk.Context, limit uint64, f func(ctx sdk.Context) error) (gas uint64, err error) {
+	meter := sdk.NewGasMeter(limit)
+	defer func() {
+		if r := recover(); r != nil {
+			if isOutOfGas, _ := osmoutils.IsOutOfGasError(r); !isOutOfGas {
+				panic(r)
+			}
+			gas, err = meter.GasConsumedToLimit(), errFinalizationOutOfGas
+		}
+	}()
+	err = osmoutils.ApplyFuncIfNoError(ctx.WithGasMeter(meter), f)
+	return meter.GasConsumedToLimit(), err
+}
+
+// queuedRollapps returns the queued rollapps in the order they are served, starting after the cursor.
+func (k Keeper) queuedRollapps(ctx sdk.Context) ([]string, error) {
+	cursor, err := k.finalizationCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	iter, err := k.finalizationQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+
+	rollapps, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	i := sort.SearchStrings(rollapps, cursor)
+	if i < len(rollapps) && rollapps[i] == cursor {
+		i++
+	}
+	return slices.Concat(rollapps[i:], rollapps[:i]), nil
+}
+
+func (k Keeper) saveFinalizationCursor(ctx sdk.Context, rollappID string) {
+	if rollappID == "" {
+		return
+	}
+	if err := k.finalizationCursor.Set(ctx, rollappID); err != nil {
+		k.Logger(ctx).Error("Set finalization cursor.", "error", err)
+	}
+}
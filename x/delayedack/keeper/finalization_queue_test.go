@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *DelayedAckTestSuite) finalizeRollappState(rollappID string, height uint64) *rollapptypes.StateInfo {
+	s.T().Helper()
+
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollappID)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{
+			RollappId: rollappID,
+			Index:     1,
+		},
+		StartHeight: 1,
+		NumBlocks:   height,
+		Status:      commontypes.Status_FINALIZED,
+		Sequencer:   proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+	return &stateInfo
+}
+
+func (s *DelayedAckTestSuite) TestFinalizeQueuedPackets() {
+	const (
+		rollappA = "rollappa_1234-1"
+		rollappB = "rollappb_2345-1"
+		rollappC = "rollappc_3456-1"
+	)
+
+	k := s.App.DelayedAckKeeper
+	ibc := transfer.NewIBCModule(s.App.TransferKeeper)
+
+	pendingCount := func(rollappID string) int {
+		return len(k.ListRollappPackets(s.Ctx, types.ByRollappIDByStatus(rollappID, commontypes.Status_PENDING)))
+	}
+	isQueued := func(rollappID string) bool {
+		queued, err := k.IsRollappFinalizationQueued(s.Ctx, rollappID)
+		s.Require().NoError(err)
+		return queued
+	}
+
+	// rollapp A has three finalizable packets, rollapp B has one, rollapp C has none
+	packets := map[string]uint64{rollappA: 3, rollappB: 1, rollappC: 0}
+	for _, rollappID := range []string{rollappA, rollappB, rollappC} {
+		s.CreateRollappByName(rollappID)
+		stateInfo := s.finalizeRollappState(rollappID, 10)
+		for _, p := range apptesting.GenerateRollappPackets(s.T(), rollappID, packets[rollappID]) {
+			k.SetRollappPacket(s.Ctx, p)
+		}
+		s.Require().NoError(k.AfterStateFinalized(s.Ctx, rollappID, stateInfo))
+	}
+
+	s.Require().True(isQueued(rollappA))
+	s.Require().True(isQueued(rollappB))
+	s.Require().False(isQueued(rollappC))
+
+	params := k.GetParams(s.Ctx)
+	params.FinalizePacketsBlockLimit = 2
+	k.SetParams(s.Ctx, params)
+
+	// rollapps are served in turn: one packet of each
+	k.FinalizeQueuedPackets(s.Ctx, ibc)
+	s.Require().Equal(2, pendingCount(rollappA))
+	s.Require().Equal(0, pendingCount(rollappB))
+	s.Require().True(isQueued(rollappA))
+	s.Require().False(isQueued(rollappB))
+
+	// a packet which costs more than the gas budget is reverted, and retried in a later block
+	params.FinalizePacketsBlockGasLimit = 1
+	k.SetParams(s.Ctx, params)
+
+	k.FinalizeQueuedPackets(s.Ctx, ibc)
+	s.Require().Equal(2, pendingCount(rollappA))
+	s.Require().True(isQueued(rollappA))
+
+	// zero budget disables automatic finalization
+	params.FinalizePacketsBlockGasLimit = 0
+	k.SetParams(s.Ctx, params)
+
+	k.FinalizeQueuedPackets(s.Ctx, ibc)
+	s.Require().Equal(2, pendingCount(rollappA))
+
+	// the queue is drained
+	params.FinalizePacketsBlockGasLimit = types.DefaultParams().FinalizePacketsBlockGasLimit
+	k.SetParams(s.Ctx, params)
+
+	k.FinalizeQueuedPackets(s.Ctx, ibc)
+	s.Require().Equal(0, pendingCount(rollappA))
+	s.Require().False(isQueued(rollappA))
+}
+
+func (s *DelayedAckTestSuite) TestFinalizeQueuedPacketsRoundRobin() {
+	const (
+		rollappA = "rollappa_1234-1"
+		rollappB = "rollappb_2345-1"
+	)
+
+	k := s.App.DelayedAckKeeper
+	ibc := transfer.NewIBCModule(s.App.TransferKeeper)
+
+	for _, rollappID := range []string{rollappA, rollappB} {
+		s.CreateRollappByName(rollappID)
+		stateInfo := s.finalizeRollappState(rollappID, 10)
+		for _, p := range apptesting.GenerateRollappPackets(s.T(), rollappID, 2) {
+			k.SetRollappPacket(s.Ctx, p)
+		}
+		s.Require().NoError(k.AfterStateFinalized(s.Ctx, rollappID, stateInfo))
+	}
+
+	params := k.GetParams(s.Ctx)
+	params.FinalizePacketsBlockLimit = 1
+	k.SetParams(s.Ctx, params)
+
+	// each block resumes after the rollapp served last
+	expected := []string{rollappA, rollappB, rollappA, rollappB}
+	for _, rollappID := range expected {
+		before := len(k.ListRollappPackets(s.Ctx, types.ByRollappIDByStatus(rollappID, commontypes.Status_PENDING)))
+		k.FinalizeQueuedPackets(s.Ctx, ibc)
+		after := len(k.ListRollappPackets(s.Ctx, types.ByRollappIDByStatus(rollappID, commontypes.Status_PENDING)))
+		s.Require().Equal(before-1, after, rollappID)
+	}
+}
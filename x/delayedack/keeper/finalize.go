@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
 func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule, rollappPacketKey string) (*commontypes.RollappPacket, error) {
@@ -24,12 +25,51 @@ func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule,
 		return packet, fmt.Errorf("verify height: rollapp '%s': %w", packet.RollappId, err)
 	}
 
-	err = k.finalizeRollappPacket(ctx, ibc, packet.RollappId, *packet)
+	finalized, err := k.finalizeRollappPacket(ctx, ibc, packet.RollappId, *packet)
 	if err != nil {
 		return packet, fmt.Errorf("finalize rollapp packet: %w", err)
 	}
 
-	return packet, nil
+	return &finalized, nil
+}
+
+// FinalizeRollappPackets finalizes pending packets of the rollapp up to its latest finalized height.
+// At most maxPackets packets are finalized, and no further packet is finalized once maxGas (if non-zero)
+// is consumed. Packets are processed in key order and leave the pending set once finalized, so repeated
+// calls resume where the previous one stopped. Returns the finalized packets and whether more remain.
+func (k Keeper) FinalizeRollappPackets(
+	ctx sdk.Context,
+	ibc porttypes.IBCModule,
+	rollappID string,
+	maxPackets int,
+	maxGas uint64,
+) ([]commontypes.RollappPacket, bool, error) {
+	latestFinalizedHeight, err := k.getRollappLatestFinalizedHeight(ctx, rollappID)
+	if err != nil {
+		return nil, false, fmt.Errorf("get latest finalized height: rollapp '%s': %w", rollappID, err)
+	}
+
+	// take one extra to find out if there are more packets left
+	pending := k.ListRollappPackets(ctx, types.PendingByRollappIDByMaxHeight(rollappID, latestFinalizedHeight).Take(maxPackets+1))
+
+	startGas := ctx.GasMeter().GasConsumed()
+	finalized := make([]commontypes.RollappPacket, 0, min(len(pending), maxPackets))
+	for _, packet := range pending {
+		if len(finalized) == maxPackets {
+			return finalized, true, nil
+		}
+		if maxGas != 0 && ctx.GasMeter().GasConsumed()-startGas >= maxGas {
+			return finalized, true, nil
+		}
+
+		p, err := k.finalizeRollappPacket(ctx, ibc, rollappID, packet)
+		if err != nil {
+			return nil, false, fmt.Errorf("finalize rollapp packet: %w", err)
+		}
+		finalized = append(finalized, p)
+	}
+
+	return finalized, false, nil
 }
 
 // used with osmo helper
@@ -41,7 +81,7 @@ func (k Keeper) finalizeRollappPacket(
 	ibc porttypes.IBCModule,
 	rollappID string,
 	rollappPacket commontypes.RollappPacket,
-) error {
+) (commontypes.RollappPacket, error) {
 	logger := k.Logger(ctx).With(
 		"rollappID", rollappID,
 		"sequence", rollappPacket.Packet.Sequence,
@@ -86,14 +126,14 @@ func (k Keeper) finalizeRollappPacket(
 	}
 
 	// Update status to finalized
-	_, err := k.UpdateRollappPacketAfterFinalization(ctx, rollappPacket)
+	finalized, err := k.UpdateRollappPacketAfterFinalization(ctx, rollappPacket)
 	if err != nil {
-		return fmt.Errorf("update rollapp packet: %w", err)
+		return commontypes.RollappPacket{}, fmt.Errorf("update rollapp packet: %w", err)
 	}
 
 	logger.Debug("finalized IBC rollapp packet")
 
-	return nil
+	return finalized, nil
 }
 
 func (k Keeper) writeRecvAck(rollappPacket commontypes.RollappPacket, ack exported.Acknowledgement) wrappedFunc {
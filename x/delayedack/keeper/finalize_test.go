@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -194,3 +196,84 @@ func (s *DelayedAckTestSuite) TestFinalizePacketByPacketKey() {
 		})
 	}
 }
+
+func (s *DelayedAckTestSuite) TestFinalizeRollappPackets() {
+	rollapp := "rollapp_1234-1"
+
+	s.CreateRollappByName(rollapp)
+	s.finalizeRollappState(rollapp, 10)
+
+	// five packets below the finalized height and one above it
+	var packets []commontypes.RollappPacket
+	for i := range 6 {
+		height := uint64(i + 1)
+		if i == 5 {
+			height = 15
+		}
+		p := commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: height,
+			Packet:      apptesting.GenerateTestPacket(s.T(), uint64(i+1)),
+		}
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, p)
+		packets = append(packets, p)
+	}
+
+	handler := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizeRollappPackets))
+	sender := apptesting.CreateRandomAccounts(1)[0].String()
+
+	finalize := func(maxPackets, maxGas uint64) *types.MsgFinalizeRollappPacketsResponse {
+		res, err := handler(s.Ctx, &types.MsgFinalizeRollappPackets{
+			Sender:     sender,
+			RollappId:  rollapp,
+			MaxPackets: maxPackets,
+			MaxGas:     maxGas,
+		})
+		s.Require().NoError(err)
+
+		var resp types.MsgFinalizeRollappPacketsResponse
+		s.Require().NoError(resp.Unmarshal(res.Data))
+		events := 0
+		for _, e := range res.GetEvents() {
+			if e.Type == proto.MessageName(new(types.EventFinalizePacket)) {
+				events++
+			}
+		}
+		s.Require().Equal(len(resp.Finalized), events)
+		return &resp
+	}
+
+	// the count limit is respected
+	resp := finalize(2, 0)
+	s.Require().True(resp.HasMore)
+	s.Require().Len(resp.Finalized, 2)
+	s.Require().Equal(packets[0].Packet.Sequence, resp.Finalized[0].PacketSequence)
+	s.Require().Equal(packets[1].Packet.Sequence, resp.Finalized[1].PacketSequence)
+
+	// the gas budget is respected: the budget is spent after the first packet
+	resp = finalize(0, 1)
+	s.Require().True(resp.HasMore)
+	s.Require().Len(resp.Finalized, 1)
+	s.Require().Equal(packets[2].Packet.Sequence, resp.Finalized[0].PacketSequence)
+
+	// the rest is finalized, the packet above the finalized height is left pending
+	resp = finalize(0, 0)
+	s.Require().False(resp.HasMore)
+	s.Require().Len(resp.Finalized, 2)
+	s.Require().Equal(packets[3].Packet.Sequence, resp.Finalized[0].PacketSequence)
+	s.Require().Equal(packets[4].Packet.Sequence, resp.Finalized[1].PacketSequence)
+
+	for _, p := range packets[:5] {
+		p.Status = commontypes.Status_FINALIZED
+		_, err := s.App.DelayedAckKeeper.GetRollappPacket(s.Ctx, string(p.RollappPacketKey()))
+		s.Require().NoError(err)
+	}
+	_, err := s.App.DelayedAckKeeper.GetRollappPacket(s.Ctx, string(packets[5].RollappPacketKey()))
+	s.Require().NoError(err)
+
+	// nothing is left to finalize
+	resp = finalize(0, 0)
+	s.Require().False(resp.HasMore)
+	s.Require().Empty(resp.Finalized)
+}
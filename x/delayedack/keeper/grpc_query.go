@@ -72,3 +72,92 @@ func (q Querier) GetPendingPacketsByAddress(goCtx context.Context, req *types.Qu
 		Pagination:     pageResp,
 	}, nil
 }
+
+func (q Querier) GetPendingPacketsBySender(goCtx context.Context, req *types.QueryPendingPacketsBySenderRequest) (*types.QueryPendingPacketListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, pageResp, err := q.Keeper.GetPendingPacketsBySenderPaginated(ctx, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get pending packets by sender %s: %w", req.Sender, err)
+	}
+
+	return &types.QueryPendingPacketListResponse{
+		RollappPackets: p,
+		Pagination:     pageResp,
+	}, nil
+}
+
+func (q Querier) GetPendingPacketsByDenom(goCtx context.Context, req *types.QueryPendingPacketsByDenomRequest) (*types.QueryPendingPacketListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, pageResp, err := q.Keeper.GetPendingPacketsByDenomPaginated(ctx, req.Denom, req.Pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get pending packets by denom %s: %w", req.Denom, err)
+	}
+
+	return &types.QueryPendingPacketListResponse{
+		RollappPackets: p,
+		Pagination:     pageResp,
+	}, nil
+}
+
+func (q Querier) GetPendingPacketsByChannel(goCtx context.Context, req *types.QueryPendingPacketsByChannelRequest) (*types.QueryPendingPacketListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, pageResp, err := q.Keeper.GetPendingPacketsByChannelPaginated(ctx, req.Channel, req.Pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get pending packets by channel %s: %w", req.Channel, err)
+	}
+
+	return &types.QueryPendingPacketListResponse{
+		RollappPackets: p,
+		Pagination:     pageResp,
+	}, nil
+}
+
+func (q Querier) GetPendingPacketsByType(goCtx context.Context, req *types.QueryPendingPacketsByTypeRequest) (*types.QueryPendingPacketListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, pageResp, err := q.Keeper.GetPendingPacketsByTypePaginated(ctx, req.Type, req.Pagination)
+	if err != nil {
+		return nil, fmt.Errorf("get pending packets by type %s: %w", req.Type, err)
+	}
+
+	return &types.QueryPendingPacketListResponse{
+		RollappPackets: p,
+		Pagination:     pageResp,
+	}, nil
+}
+
+func (q Querier) PendingPacketsSummary(goCtx context.Context, req *types.QueryPendingPacketsSummaryRequest) (*types.QueryPendingPacketsSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	summaries, err := q.GetPendingPacketsSummary(ctx, req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingPacketsSummaryResponse{
+		Summaries: summaries,
+	}, nil
+}
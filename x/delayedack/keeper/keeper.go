@@ -32,6 +32,31 @@ type Keeper struct {
 	// In case of ON_ACK/ON_TIMEOUT packet (Hub -> Rollapp), the address is the packet sender.
 	// Index key: receiver address + packet key.
 	pendingPacketsByAddress collections.KeySet[collections.Pair[string, []byte]]
+	// pendingPacketsBySender is an index of all pending packets by the original transfer sender.
+	// Index key: sender address + packet key.
+	pendingPacketsBySender collections.KeySet[collections.Pair[string, []byte]]
+	// pendingPacketsByDenom is an index of all pending packets by the denom in the transfer data.
+	// Index key: denom + packet key.
+	pendingPacketsByDenom collections.KeySet[collections.Pair[string, []byte]]
+	// pendingPacketsByChannel is an index of all pending packets by the Hub side channel.
+	// In case of ON_RECV packet, the channel is the packet destination channel.
+	// In case of ON_ACK/ON_TIMEOUT packet, the channel is the packet source channel.
+	// Index key: channel + packet key.
+	pendingPacketsByChannel collections.KeySet[collections.Pair[string, []byte]]
+	// pendingPacketsByType is an index of all pending packets by the rollapp packet type.
+	// Index key: type + packet key.
+	pendingPacketsByType collections.KeySet[collections.Pair[int32, []byte]]
+	// pendingPacketsSummaries are the running aggregates of the pending packets of each rollapp,
+	// updated with the pending packet indexes.
+	// Key: rollapp ID.
+	pendingPacketsSummaries collections.Map[string, types.RollappPendingPacketsSummary]
+
+	// finalizationQueue is the set of rollapps which may have pending packets at finalized heights.
+	// The packets are finalized automatically at the end of the block.
+	finalizationQueue collections.KeySet[string]
+	// finalizationCursor is the last rollapp served by automatic finalization, to resume round-robin
+	// from the next one in the following block.
+	finalizationCursor collections.Item[string]
 
 	rollappKeeper types.RollappKeeper
 	porttypes.ICS4Wrapper
@@ -53,17 +78,61 @@ func NewKeeper(
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
+	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
 	return &Keeper{
 		cdc:                   cdc,
 		storeKey:              storeKey,
 		channelKeeperStoreKey: channelKeeperStoreKey,
 		paramstore:            ps,
 		pendingPacketsByAddress: collections.NewKeySet(
-			collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey)),
+			sb,
 			collections.NewPrefix(types.PendingPacketsByAddressKeyPrefix),
 			"pending_packets_by_receiver",
 			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
 		),
+		pendingPacketsBySender: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.PendingPacketsBySenderKeyPrefix),
+			"pending_packets_by_sender",
+			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
+		),
+		pendingPacketsByDenom: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.PendingPacketsByDenomKeyPrefix),
+			"pending_packets_by_denom",
+			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
+		),
+		pendingPacketsByChannel: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.PendingPacketsByChannelKeyPrefix),
+			"pending_packets_by_channel",
+			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
+		),
+		pendingPacketsByType: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.PendingPacketsByTypeKeyPrefix),
+			"pending_packets_by_type",
+			collections.PairKeyCodec(collections.Int32Key, collcodec.NewBytesKey[[]byte]()),
+		),
+		pendingPacketsSummaries: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PendingPacketsSummaryKeyPrefix),
+			"pending_packets_summaries",
+			collections.StringKey,
+			collcompat.ProtoValue[types.RollappPendingPacketsSummary](cdc),
+		),
+		finalizationQueue: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.FinalizationQueueKeyPrefix),
+			"finalization_queue",
+			collections.StringKey,
+		),
+		finalizationCursor: collections.NewItem(
+			sb,
+			collections.NewPrefix(types.FinalizationCursorKey),
+			"finalization_cursor",
+			collections.StringValue,
+		),
 		rollappKeeper: rollappKeeper,
 		ICS4Wrapper:   ics4Wrapper,
 		channelKeeper: channelKeeper,
@@ -8,6 +8,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
@@ -37,7 +38,7 @@ func (m MsgServer) FinalizePacket(goCtx context.Context, msg *types.MsgFinalizeP
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// next middleware is denommetadata, see transfer stack setup
-	_, err = m.k.FinalizeRollappPacket(ctx, m.ibc.NextIBCMiddleware(), string(msg.PendingPacketKey()))
+	packet, err := m.k.FinalizeRollappPacket(ctx, m.ibc.NextIBCMiddleware(), string(msg.PendingPacketKey()))
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +50,7 @@ func (m MsgServer) FinalizePacket(goCtx context.Context, msg *types.MsgFinalizeP
 		PacketType:        msg.PacketType,
 		PacketSrcChannel:  msg.PacketSrcChannel,
 		PacketSequence:    msg.PacketSequence,
+		Error:             packet.Error,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("emit event: %w", err)
@@ -88,6 +90,7 @@ func (m MsgServer) FinalizePacketByPacketKey(goCtx context.Context, msg *types.M
 		PacketType:        packet.Type,
 		PacketSrcChannel:  sourceChannel,
 		PacketSequence:    sequence,
+		Error:             packet.Error,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("emit event: %w", err)
@@ -95,3 +98,48 @@ func (m MsgServer) FinalizePacketByPacketKey(goCtx context.Context, msg *types.M
 
 	return &types.MsgFinalizePacketByPacketKeyResponse{}, nil
 }
+
+func (m MsgServer) FinalizeRollappPackets(goCtx context.Context, msg *types.MsgFinalizeRollappPackets) (*types.MsgFinalizeRollappPacketsResponse, error) {
+	err := msg.ValidateBasic() // TODO: remove, called by sdk
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// next middleware is denommetadata, see transfer stack setup
+	packets, hasMore, err := m.k.FinalizeRollappPackets(ctx, m.ibc.NextIBCMiddleware(), msg.RollappId, msg.PacketLimit(), msg.MaxGas)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.MsgFinalizeRollappPacketsResponse{
+		Finalized: make([]types.FinalizedPacket, 0, len(packets)),
+		HasMore:   hasMore,
+	}
+	for _, packet := range packets {
+		resp.Finalized = append(resp.Finalized, types.FinalizedPacket{
+			PacketKey:         commontypes.EncodePacketKey(packet.RollappPacketKey()),
+			PacketProofHeight: packet.ProofHeight,
+			PacketType:        packet.Type,
+			PacketSrcChannel:  packet.Packet.SourceChannel,
+			PacketSequence:    packet.Packet.Sequence,
+			Error:             packet.Error,
+		})
+
+		err = uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+			Sender:            msg.Sender,
+			RollappId:         packet.RollappId,
+			PacketProofHeight: packet.ProofHeight,
+			PacketType:        packet.Type,
+			PacketSrcChannel:  packet.Packet.SourceChannel,
+			PacketSequence:    packet.Packet.Sequence,
+			Error:             packet.Error,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("emit event: %w", err)
+		}
+	}
+
+	return resp, nil
+}
@@ -35,3 +35,13 @@ func (k Keeper) DeletePacketsEpochLimit(ctx sdk.Context) (res int64) {
 	k.paramstore.Get(ctx, types.KeyDeletePacketsEpochLimit, &res)
 	return
 }
+
+func (k Keeper) FinalizePacketsBlockLimit(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyFinalizePacketsBlockLimit, &res)
+	return
+}
+
+func (k Keeper) FinalizePacketsBlockGasLimit(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyFinalizePacketsBlockGasLimit, &res)
+	return
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+// pendingPacketIndexKeys returns the sender, denom and channel a pending packet is indexed by.
+// The sender is the original transfer sender, before any eIBC fulfillment.
+func pendingPacketIndexKeys(rollappPacket commontypes.RollappPacket) (sender, denom, channel string, err error) {
+	transfer, err := rollappPacket.GetTransferPacketData()
+	if err != nil {
+		return "", "", "", err
+	}
+
+	sender = transfer.Sender
+	switch rollappPacket.Type {
+	case commontypes.RollappPacket_ON_RECV:
+		channel = rollappPacket.Packet.DestinationChannel
+	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
+		channel = rollappPacket.Packet.SourceChannel
+		if rollappPacket.OriginalTransferTarget != "" {
+			sender = rollappPacket.OriginalTransferTarget
+		}
+	}
+	return sender, transfer.Denom, channel, nil
+}
+
+// SetPendingPacketIndexes adds a pending rollapp packet to the sender, denom, channel and type indexes,
+// and to the pending packets summary of its rollapp.
+func (k Keeper) SetPendingPacketIndexes(ctx sdk.Context, rollappPacket commontypes.RollappPacket) error {
+	sender, denom, channel, err := pendingPacketIndexKeys(rollappPacket)
+	if err != nil {
+		return err
+	}
+
+	key := rollappPacket.RollappPacketKey()
+	indexed, err := k.pendingPacketsByType.Has(ctx, collections.Join(int32(rollappPacket.Type), key))
+	if err != nil {
+		return err
+	}
+	if !indexed {
+		if err := k.updatePendingPacketsSummary(ctx, rollappPacket, true); err != nil {
+			return err
+		}
+	}
+
+	if err := k.pendingPacketsBySender.Set(ctx, collections.Join(sender, key)); err != nil {
+		return err
+	}
+	if err := k.pendingPacketsByDenom.Set(ctx, collections.Join(denom, key)); err != nil {
+		return err
+	}
+	if err := k.pendingPacketsByChannel.Set(ctx, collections.Join(channel, key)); err != nil {
+		return err
+	}
+	return k.pendingPacketsByType.Set(ctx, collections.Join(int32(rollappPacket.Type), key))
+}
+
+// MustSetPendingPacketIndexes adds a pending rollapp packet to the sender, denom, channel and type indexes.
+// Panics on encoding errors.
+func (k Keeper) MustSetPendingPacketIndexes(ctx sdk.Context, rollappPacket commontypes.RollappPacket) {
+	err := k.SetPendingPacketIndexes(ctx, rollappPacket)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// DeletePendingPacketIndexes removes a pending rollapp packet from the sender, denom, channel and type indexes,
+// and from the pending packets summary of its rollapp.
+func (k Keeper) DeletePendingPacketIndexes(ctx sdk.Context, rollappPacket commontypes.RollappPacket) error {
+	sender, denom, channel, err := pendingPacketIndexKeys(rollappPacket)
+	if err != nil {
+		return err
+	}
+
+	key := rollappPacket.RollappPacketKey()
+	indexed, err := k.pendingPacketsByType.Has(ctx, collections.Join(int32(rollappPacket.Type), key))
+	if err != nil {
+		return err
+	}
+	if indexed {
+		// a broken summary must not prevent the packet from leaving the indexes
+		if err := k.updatePendingPacketsSummary(ctx, rollappPacket, false); err != nil {
+			k.Logger(ctx).Error("Remove packet from pending packets summary.", "rollapp", rollappPacket.RollappId, "err", err)
+		}
+	}
+
+	if err := k.pendingPacketsBySender.Remove(ctx, collections.Join(sender, key)); err != nil {
+		return err
+	}
+	if err := k.pendingPacketsByDenom.Remove(ctx, collections.Join(denom, key)); err != nil {
+		return err
+	}
+	if err := k.pendingPacketsByChannel.Remove(ctx, collections.Join(channel, key)); err != nil {
+		return err
+	}
+	return k.pendingPacketsByType.Remove(ctx, collections.Join(int32(rollappPacket.Type), key))
+}
+
+// MustDeletePendingPacketIndexes removes a pending rollapp packet from the sender, denom, channel and type indexes.
+// Panics on encoding error. Do not panic if the key is not found.
+func (k Keeper) MustDeletePendingPacketIndexes(ctx sdk.Context, rollappPacket commontypes.RollappPacket) {
+	err := k.DeletePendingPacketIndexes(ctx, rollappPacket)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetPendingPacketsBySenderPaginated retrieves pending rollapp packets by their original sender with pagination.
+func (k Keeper) GetPendingPacketsBySenderPaginated(ctx sdk.Context, sender string, pageReq *query.PageRequest) ([]commontypes.RollappPacket, *query.PageResponse, error) {
+	return paginatePendingPackets(ctx, k, k.pendingPacketsBySender, sender, pageReq)
+}
+
+// GetPendingPacketsByDenomPaginated retrieves pending rollapp packets by their transfer denom with pagination.
+func (k Keeper) GetPendingPacketsByDenomPaginated(ctx sdk.Context, denom string, pageReq *query.PageRequest) ([]commontypes.RollappPacket, *query.PageResponse, error) {
+	return paginatePendingPackets(ctx, k, k.pendingPacketsByDenom, denom, pageReq)
+}
+
+// GetPendingPacketsByChannelPaginated retrieves pending rollapp packets by their Hub side channel with pagination.
+func (k Keeper) GetPendingPacketsByChannelPaginated(ctx sdk.Context, channel string, pageReq *query.PageRequest) ([]commontypes.RollappPacket, *query.PageResponse, error) {
+	return paginatePendingPackets(ctx, k, k.pendingPacketsByChannel, channel, pageReq)
+}
+
+// GetPendingPacketsByTypePaginated retrieves pending rollapp packets by their type with pagination.
+func (k Keeper) GetPendingPacketsByTypePaginated(ctx sdk.Context, packetType commontypes.RollappPacket_Type, pageReq *query.PageRequest) ([]commontypes.RollappPacket, *query.PageResponse, error) {
+	return paginatePendingPackets(ctx, k, k.pendingPacketsByType, int32(packetType), pageReq)
+}
+
+func paginatePendingPackets[K any](
+	ctx sdk.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[K, []byte]],
+	prefix K,
+	pageReq *query.PageRequest,
+) ([]commontypes.RollappPacket, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, index, pageReq,
+		func(key collections.Pair[K, []byte], _ collections.NoValue) (commontypes.RollappPacket, error) {
+			packet, err := k.GetRollappPacket(ctx, string(key.K2()))
+			if err != nil {
+				return commontypes.RollappPacket{}, err
+			}
+			return *packet, err
+		}, collcompat.WithCollectionPaginationPairPrefix[K, []byte](prefix),
+	)
+}
+
+// updatePendingPacketsSummary adds a pending packet to, or removes it from, the pending packets summary
+// of its rollapp. The summary is deleted when the rollapp has no more pending packets.
+func (k Keeper) updatePendingPacketsSummary(ctx sdk.Context, rollappPacket commontypes.RollappPacket, add bool) error {
+	s, err := k.pendingPacketsSummaries.Get(ctx, rollappPacket.RollappId)
+	if errors.Is(err, collections.ErrNotFound) {
+		s = types.RollappPendingPacketsSummary{RollappId: rollappPacket.RollappId}
+	} else if err != nil {
+		return err
+	}
+
+	var count *uint64
+	switch rollappPacket.Type {
+	case commontypes.RollappPacket_ON_RECV:
+		count = &s.RecvCount
+	case commontypes.RollappPacket_ON_ACK:
+		count = &s.AckCount
+	case commontypes.RollappPacket_ON_TIMEOUT:
+		count = &s.TimeoutCount
+	}
+
+	// amounts of denoms which are not valid coin denoms are not summed
+	var amount sdk.Coins
+	if transfer, err := rollappPacket.GetTransferPacketData(); err == nil && sdk.ValidateDenom(transfer.Denom) == nil {
+		if amt, ok := sdk.NewIntFromString(transfer.Amount); ok && amt.IsPositive() {
+			amount = sdk.Coins{sdk.Coin{Denom: transfer.Denom, Amount: amt}}
+		}
+	}
+
+	if add {
+		s.Count++
+		if count != nil {
+			*count++
+		}
+		s.Amounts = s.Amounts.Add(amount...)
+	} else {
+		if s.Count == 0 || (count != nil && *count == 0) {
+			return errorsmod.Wrapf(gerrc.ErrInternal, "pending packets summary count underflow: rollapp: %s", rollappPacket.RollappId)
+		}
+		s.Count--
+		if count != nil {
+			*count--
+		}
+		amounts, neg := s.Amounts.SafeSub(amount...)
+		if neg {
+			return errorsmod.Wrapf(gerrc.ErrInternal, "pending packets summary amount underflow: rollapp: %s: amount: %s", rollappPacket.RollappId, amount)
+		}
+		s.Amounts = amounts
+	}
+
+	if s.Count == 0 {
+		return k.pendingPacketsSummaries.Remove(ctx, rollappPacket.RollappId)
+	}
+	return k.pendingPacketsSummaries.Set(ctx, rollappPacket.RollappId, s)
+}
+
+// GetPendingPacketsSummary returns the pending packets summary of the rollapp. If rollappID is empty,
+// the summaries of all rollapps with pending packets are returned. Summaries are sorted by rollapp ID.
+func (k Keeper) GetPendingPacketsSummary(ctx sdk.Context, rollappID string) ([]types.RollappPendingPacketsSummary, error) {
+	if rollappID != "" {
+		s, err := k.pendingPacketsSummaries.Get(ctx, rollappID)
+		if errors.Is(err, collections.ErrNotFound) {
+			return []types.RollappPendingPacketsSummary{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []types.RollappPendingPacketsSummary{s}, nil
+	}
+
+	iter, err := k.pendingPacketsSummaries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+)
+
+func (suite *DelayedAckTestSuite) TestPendingPacketIndexes() {
+	k, ctx := suite.App.DelayedAckKeeper, suite.Ctx
+	querier := keeper.NewQuerier(k)
+
+	newPacket := func(rollappID string, packetType commontypes.RollappPacket_Type, seq uint64, sender, denom, amount string) commontypes.RollappPacket {
+		data := transfertypes.NewFungibleTokenPacketData(denom, amount, sender, "receiver", "")
+		return commontypes.RollappPacket{
+			RollappId: rollappID,
+			Packet: &channeltypes.Packet{
+				SourcePort:         "transfer",
+				SourceChannel:      "channel-src",
+				DestinationPort:    "transfer",
+				DestinationChannel: "channel-dst",
+				Data:               data.GetBytes(),
+				Sequence:           seq,
+			},
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: seq,
+			Type:        packetType,
+		}
+	}
+
+	packets := []commontypes.RollappPacket{
+		newPacket("rollappa_1234-1", commontypes.RollappPacket_ON_RECV, 1, "alice", "arax", "100"),
+		newPacket("rollappa_1234-1", commontypes.RollappPacket_ON_RECV, 2, "bob", "arax", "50"),
+		newPacket("rollappa_1234-1", commontypes.RollappPacket_ON_ACK, 3, "alice", "adym", "10"),
+		newPacket("rollappb_2345-1", commontypes.RollappPacket_ON_TIMEOUT, 4, "alice", "adym", "5"),
+	}
+	for _, p := range packets {
+		k.SetRollappPacket(ctx, p)
+		k.MustSetPendingPacketIndexes(ctx, p)
+	}
+
+	bySender := func(sender string) int {
+		res, err := querier.GetPendingPacketsBySender(ctx, &types.QueryPendingPacketsBySenderRequest{Sender: sender})
+		suite.Require().NoError(err)
+		return len(res.RollappPackets)
+	}
+	byDenom := func(denom string) int {
+		res, err := querier.GetPendingPacketsByDenom(ctx, &types.QueryPendingPacketsByDenomRequest{Denom: denom})
+		suite.Require().NoError(err)
+		return len(res.RollappPackets)
+	}
+	byChannel := func(channel string) int {
+		res, err := querier.GetPendingPacketsByChannel(ctx, &types.QueryPendingPacketsByChannelRequest{Channel: channel})
+		suite.Require().NoError(err)
+		return len(res.RollappPackets)
+	}
+	byType := func(packetType commontypes.RollappPacket_Type) int {
+		res, err := querier.GetPendingPacketsByType(ctx, &types.QueryPendingPacketsByTypeRequest{Type: packetType})
+		suite.Require().NoError(err)
+		return len(res.RollappPackets)
+	}
+
+	suite.Require().Equal(3, bySender("alice"))
+	suite.Require().Equal(1, bySender("bob"))
+	suite.Require().Equal(2, byDenom("arax"))
+	suite.Require().Equal(2, byDenom("adym"))
+	// received packets are indexed by the destination channel, sent ones by the source channel
+	suite.Require().Equal(2, byChannel("channel-dst"))
+	suite.Require().Equal(2, byChannel("channel-src"))
+	suite.Require().Equal(2, byType(commontypes.RollappPacket_ON_RECV))
+	suite.Require().Equal(1, byType(commontypes.RollappPacket_ON_ACK))
+	suite.Require().Equal(1, byType(commontypes.RollappPacket_ON_TIMEOUT))
+
+	// pagination
+	res, err := querier.GetPendingPacketsBySender(ctx, &types.QueryPendingPacketsBySenderRequest{
+		Sender:     "alice",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RollappPackets, 2)
+	suite.Require().EqualValues(3, res.Pagination.Total)
+
+	// the sender index keeps the original sender after an eIBC fulfillment
+	err = k.UpdateRollappPacketTransferAddress(ctx, string(packets[2].RollappPacketKey()), "fulfiller")
+	suite.Require().NoError(err)
+	suite.Require().Equal(3, bySender("alice"))
+	suite.Require().Equal(0, bySender("fulfiller"))
+
+	// summary
+	summary, err := querier.PendingPacketsSummary(ctx, &types.QueryPendingPacketsSummaryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RollappPendingPacketsSummary{
+		{
+			RollappId: "rollappa_1234-1",
+			Count:     3,
+			RecvCount: 2,
+			AckCount:  1,
+			Amounts:   sdk.NewCoins(sdk.NewInt64Coin("adym", 10), sdk.NewInt64Coin("arax", 150)),
+		},
+		{
+			RollappId:    "rollappb_2345-1",
+			Count:        1,
+			TimeoutCount: 1,
+			Amounts:      sdk.NewCoins(sdk.NewInt64Coin("adym", 5)),
+		},
+	}, summary.Summaries)
+
+	summary, err = querier.PendingPacketsSummary(ctx, &types.QueryPendingPacketsSummaryRequest{RollappId: "rollappb_2345-1"})
+	suite.Require().NoError(err)
+	suite.Require().Len(summary.Summaries, 1)
+
+	// finalized and deleted packets leave the indexes
+	ack, err := k.GetRollappPacket(ctx, string(packets[2].RollappPacketKey()))
+	suite.Require().NoError(err)
+	_, err = k.UpdateRollappPacketAfterFinalization(ctx, *ack)
+	suite.Require().NoError(err)
+	k.DeleteRollappPacket(ctx, &packets[3])
+
+	suite.Require().Equal(1, bySender("alice"))
+	suite.Require().Equal(0, byDenom("adym"))
+	suite.Require().Equal(0, byChannel("channel-src"))
+	suite.Require().Equal(0, byType(commontypes.RollappPacket_ON_ACK))
+	suite.Require().Equal(0, byType(commontypes.RollappPacket_ON_TIMEOUT))
+
+	// and the summaries
+	summary, err = querier.PendingPacketsSummary(ctx, &types.QueryPendingPacketsSummaryRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RollappPendingPacketsSummary{
+		{
+			RollappId: "rollappa_1234-1",
+			Count:     2,
+			RecvCount: 2,
+			Amounts:   sdk.NewCoins(sdk.NewInt64Coin("arax", 150)),
+		},
+	}, summary.Summaries)
+	summary, err = querier.PendingPacketsSummary(ctx, &types.QueryPendingPacketsSummaryRequest{RollappId: "rollappb_2345-1"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(summary.Summaries)
+}
@@ -10,7 +10,6 @@ import (
 
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
-	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
@@ -81,15 +80,7 @@ func (k Keeper) GetPendingPacketsByAddress(ctx sdk.Context, receiver string) ([]
 
 // GetPendingPacketsByAddressPaginated retrieves rollapp packets from the KVStore by their receiver with pagination.
 func (k Keeper) GetPendingPacketsByAddressPaginated(ctx sdk.Context, receiver string, pageReq *query.PageRequest) ([]commontypes.RollappPacket, *query.PageResponse, error) {
-	return collcompat.CollectionPaginate(ctx, k.pendingPacketsByAddress, pageReq,
-		func(key collections.Pair[string, []byte], _ collections.NoValue) (commontypes.RollappPacket, error) {
-			packet, err := k.GetRollappPacket(ctx, string(key.K2()))
-			if err != nil {
-				return commontypes.RollappPacket{}, err
-			}
-			return *packet, err
-		}, collcompat.WithCollectionPaginationPairPrefix[string, []byte](receiver),
-	)
+	return paginatePendingPackets(ctx, k, k.pendingPacketsByAddress, receiver, pageReq)
 }
 
 // GetRollappPacket retrieves a rollapp packet from the KVStore.
@@ -131,12 +122,18 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 	var (
 		recipient              = transferPacketData.Receiver
 		sender                 = transferPacketData.Sender
+		memo                   = transferPacketData.Memo
 		originalTransferTarget string
 	)
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		originalTransferTarget = recipient
 		recipient = address
+		// the forward was already done on fulfillment, the new recipient gets the funds
+		memo, err = types.MemoWithoutForward(memo)
+		if err != nil {
+			return err
+		}
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		originalTransferTarget = sender
 		sender = address
@@ -148,7 +145,7 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 		transferPacketData.Amount,
 		sender,
 		recipient,
-		transferPacketData.Memo,
+		memo,
 	)
 
 	// Marshall to binary and update the packet with this data
@@ -190,6 +187,7 @@ func (k *Keeper) UpdateRollappPacketAfterFinalization(ctx sdk.Context, rollappPa
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		k.MustDeletePendingPacketByAddress(ctx, transferPacketData.Sender, oldKey)
 	}
+	k.MustDeletePendingPacketIndexes(ctx, rollappPacket)
 
 	// Delete the old rollapp packet
 	store := ctx.KVStore(k.storeKey)
@@ -276,6 +274,7 @@ func (k Keeper) DeleteRollappPacket(ctx sdk.Context, rollappPacket *commontypes.
 		pendingAddr = transfer.Sender
 	}
 	k.MustDeletePendingPacketByAddress(ctx, pendingAddr, rollappPacket.RollappPacketKey())
+	k.MustDeletePendingPacketIndexes(ctx, *rollappPacket)
 
 	keeperHooks := k.GetHooks()
 	// TODO: can call eIBC directly
@@ -139,6 +139,8 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// next middleware is denommetadata, see transfer stack setup
+	am.keeper.FinalizeQueuedPackets(ctx, am.ibc.NextIBCMiddleware())
 	return []abci.ValidatorUpdate{}
 }
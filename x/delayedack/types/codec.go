@@ -13,6 +13,7 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFinalizePacket{}, "delayedack/FinalizePacket", nil)
 	cdc.RegisterConcrete(&MsgFinalizePacketByPacketKey{}, "delayedack/MsgFinalizePacketByPacketKey", nil)
+	cdc.RegisterConcrete(&MsgFinalizeRollappPackets{}, "delayedack/FinalizeRollappPackets", nil)
 }
 
 // RegisterInterfaces registers interfaces types with the interface registry.
@@ -21,6 +22,7 @@ func RegisterInterfaces(reg types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgFinalizePacket{},
 		&MsgFinalizePacketByPacketKey{},
+		&MsgFinalizeRollappPackets{},
 	)
 	msgservice.RegisterMsgServiceDesc(reg, &_Msg_serviceDesc)
 }
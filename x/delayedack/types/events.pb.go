@@ -24,7 +24,8 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type EventFinalizePacket struct {
-	// Sender is the signer of the message.
+	// Sender is the signer of the message. Empty if the packet was finalized
+	// automatically.
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// RollappID is the ID of the rollapp.
 	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
@@ -36,6 +37,9 @@ type EventFinalizePacket struct {
 	PacketSrcChannel string `protobuf:"bytes,5,opt,name=packet_src_channel,json=packetSrcChannel,proto3" json:"packet_src_channel,omitempty"`
 	// PacketSequence is a sequence number of the packet.
 	PacketSequence uint64 `protobuf:"varint,6,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// Error is the error returned by the packet handler, if any. The packet is
+	// finalized regardless.
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *EventFinalizePacket) Reset()         { *m = EventFinalizePacket{} }
@@ -113,6 +117,13 @@ func (m *EventFinalizePacket) GetPacketSequence() uint64 {
 	return 0
 }
 
+func (m *EventFinalizePacket) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.EventFinalizePacket")
 }
@@ -122,28 +133,29 @@ func init() {
 }
 
 var fileDescriptor_de2c6b6165d75670 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xc2, 0x30,
-	0x14, 0xc7, 0x29, 0x22, 0x09, 0x35, 0x41, 0x2d, 0x89, 0x59, 0x4c, 0x5c, 0xd0, 0x8b, 0x1c, 0x4c,
-	0x17, 0xe1, 0xe0, 0x5d, 0xa3, 0xd1, 0x1b, 0x4e, 0x4f, 0x5e, 0x96, 0xd1, 0x3d, 0xd9, 0xc2, 0x68,
-	0xeb, 0x56, 0x08, 0xe3, 0x53, 0xf8, 0x2d, 0xfc, 0x2a, 0x1e, 0x39, 0x7a, 0x34, 0xf0, 0x45, 0xcc,
-	0xda, 0x22, 0x5c, 0xb8, 0xf5, 0xbd, 0xff, 0xbf, 0xbf, 0xf7, 0x7f, 0x79, 0x98, 0x46, 0xc5, 0x18,
-	0x78, 0x9e, 0x08, 0x3e, 0x2b, 0xe6, 0xde, 0x7f, 0xe1, 0x45, 0x90, 0x86, 0x05, 0x44, 0x21, 0x1b,
-	0x79, 0x30, 0x05, 0xae, 0x72, 0x2a, 0x33, 0xa1, 0x04, 0x39, 0xdf, 0xf6, 0x6f, 0x3e, 0xd3, 0x8d,
-	0xff, 0xb4, 0xbb, 0x03, 0xc9, 0xc4, 0x78, 0x2c, 0xb8, 0x97, 0x89, 0x34, 0x0d, 0xa5, 0x0c, 0x64,
-	0xc8, 0x46, 0xa0, 0x0c, 0xf6, 0xe2, 0xab, 0x8a, 0x5b, 0xf7, 0xe5, 0x9c, 0x87, 0x84, 0x87, 0x69,
-	0x32, 0x87, 0xbe, 0x56, 0xc9, 0x09, 0xae, 0xe7, 0xc0, 0x23, 0xc8, 0x1c, 0xd4, 0x46, 0x9d, 0x86,
-	0x6f, 0x2b, 0x72, 0x86, 0xf1, 0x9a, 0x93, 0x44, 0x4e, 0x55, 0x6b, 0x0d, 0xdb, 0x79, 0x8a, 0x08,
-	0xc5, 0x2d, 0x83, 0x0f, 0x64, 0x26, 0xc4, 0x7b, 0x10, 0x43, 0x32, 0x8c, 0x95, 0xb3, 0xd7, 0x46,
-	0x9d, 0x9a, 0x7f, 0x6c, 0xa4, 0x7e, 0xa9, 0x3c, 0x6a, 0x81, 0xf8, 0xf8, 0xc0, 0xfa, 0x55, 0x21,
-	0xc1, 0xa9, 0xb5, 0x51, 0xa7, 0xd9, 0xbd, 0xa6, 0x3b, 0x76, 0x35, 0x8b, 0x50, 0xdf, 0x8c, 0x33,
-	0x49, 0xe9, 0x6b, 0x21, 0xc1, 0xc7, 0x86, 0x52, 0xbe, 0xc9, 0x15, 0x26, 0x96, 0x99, 0x67, 0x2c,
-	0x60, 0x71, 0xc8, 0x39, 0xa4, 0xce, 0xbe, 0x8e, 0x7a, 0x64, 0x94, 0x97, 0x8c, 0xdd, 0x99, 0x3e,
-	0xb9, 0xc4, 0x87, 0x6b, 0x37, 0x7c, 0x4c, 0x80, 0x33, 0x70, 0xea, 0x3a, 0x6d, 0xd3, 0x5a, 0x6d,
-	0xf7, 0xf6, 0xf9, 0x7b, 0xe9, 0xa2, 0xc5, 0xd2, 0x45, 0xbf, 0x4b, 0x17, 0x7d, 0xae, 0xdc, 0xca,
-	0x62, 0xe5, 0x56, 0x7e, 0x56, 0x6e, 0xe5, 0xed, 0x66, 0x98, 0xa8, 0x78, 0x32, 0x28, 0xe3, 0x79,
-	0x3b, 0x4e, 0x30, 0xed, 0x79, 0xb3, 0xed, 0xd3, 0x96, 0xdb, 0xe6, 0x83, 0xba, 0xbe, 0x41, 0xef,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xa4, 0x49, 0x5e, 0x0c, 0x02, 0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x19, 0x2e, 0x70, 0xc3, 0xdc, 0x84, 0x7b, 0xef, 0x60, 0xcc, 0xc4, 0xc4, 0x06, 0xdd,
+	0xc8, 0xc2, 0x4c, 0x23, 0x2c, 0xdc, 0x6b, 0x34, 0xba, 0xc3, 0xea, 0xca, 0x4d, 0x53, 0xa6, 0x47,
+	0xda, 0x50, 0x66, 0xc6, 0x69, 0x21, 0x94, 0xa7, 0xf0, 0x85, 0xdc, 0xbb, 0x64, 0xe9, 0xd2, 0xc0,
+	0x8b, 0x98, 0xce, 0x14, 0x61, 0xc3, 0xae, 0xe7, 0xfc, 0x7f, 0xbf, 0xf3, 0x9f, 0x39, 0x98, 0x85,
+	0xf9, 0x04, 0x44, 0x1a, 0x4b, 0x31, 0xcf, 0x17, 0xee, 0x4f, 0xe1, 0x86, 0x90, 0x04, 0x39, 0x84,
+	0x01, 0x1f, 0xbb, 0x30, 0x03, 0x91, 0xa5, 0x4c, 0x69, 0x99, 0x49, 0x72, 0xb2, 0xeb, 0xdf, 0xfe,
+	0xcc, 0xb6, 0xfe, 0xa3, 0xde, 0x1e, 0x24, 0x97, 0x93, 0x89, 0x14, 0xae, 0x96, 0x49, 0x12, 0x28,
+	0xe5, 0xab, 0x80, 0x8f, 0x21, 0xb3, 0xd8, 0xd3, 0xf7, 0x2a, 0x6e, 0xdf, 0x14, 0x73, 0x6e, 0x63,
+	0x11, 0x24, 0xf1, 0x02, 0x06, 0x46, 0x25, 0x87, 0xb8, 0x91, 0x82, 0x08, 0x41, 0x53, 0xd4, 0x41,
+	0xdd, 0xa6, 0x57, 0x56, 0xe4, 0x18, 0xe3, 0x0d, 0x27, 0x0e, 0x69, 0xd5, 0x68, 0xcd, 0xb2, 0x73,
+	0x1f, 0x12, 0x86, 0xdb, 0x16, 0xef, 0x2b, 0x2d, 0xe5, 0x8b, 0x1f, 0x41, 0x3c, 0x8a, 0x32, 0xfa,
+	0xab, 0x83, 0xba, 0x35, 0xef, 0xbf, 0x95, 0x06, 0x85, 0x72, 0x67, 0x04, 0xe2, 0xe1, 0x3f, 0xa5,
+	0x3f, 0xcb, 0x15, 0xd0, 0x5a, 0x07, 0x75, 0x5b, 0xbd, 0x0b, 0xb6, 0x67, 0x57, 0xbb, 0x08, 0xf3,
+	0xec, 0x38, 0x9b, 0x94, 0x3d, 0xe5, 0x0a, 0x3c, 0x6c, 0x29, 0xc5, 0x37, 0x39, 0xc7, 0xa4, 0x64,
+	0xa6, 0x9a, 0xfb, 0x3c, 0x0a, 0x84, 0x80, 0x84, 0xd6, 0x4d, 0xd4, 0x7f, 0x56, 0x79, 0xd4, 0xfc,
+	0xda, 0xf6, 0xc9, 0x19, 0xfe, 0xbb, 0x71, 0xc3, 0xeb, 0x14, 0x04, 0x07, 0xda, 0x30, 0x69, 0x5b,
+	0xa5, 0xb5, 0xec, 0x92, 0x03, 0x5c, 0x07, 0xad, 0xa5, 0xa6, 0xbf, 0x0d, 0xc9, 0x16, 0x57, 0x0f,
+	0x1f, 0x2b, 0x07, 0x2d, 0x57, 0x0e, 0xfa, 0x5a, 0x39, 0xe8, 0x6d, 0xed, 0x54, 0x96, 0x6b, 0xa7,
+	0xf2, 0xb9, 0x76, 0x2a, 0xcf, 0x97, 0xa3, 0x38, 0x8b, 0xa6, 0xc3, 0x22, 0xb4, 0xbb, 0xe7, 0x30,
+	0xb3, 0xbe, 0x3b, 0xdf, 0x3d, 0x78, 0xf1, 0x06, 0xe9, 0xb0, 0x61, 0x2e, 0xd3, 0xff, 0x1e, 0x00,
+	0x90, 0xfa, 0xde, 0x21, 0x22, 0x02, 0x00, 0x00,
 }
 
 func (m *EventFinalizePacket) Marshal() (dAtA []byte, err error) {
@@ -166,6 +178,13 @@ func (m *EventFinalizePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PacketSequence != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.PacketSequence))
 		i--
@@ -243,6 +262,10 @@ func (m *EventFinalizePacket) Size() (n int) {
 	if m.PacketSequence != 0 {
 		n += 1 + sovEvents(uint64(m.PacketSequence))
 	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -434,6 +457,38 @@ func (m *EventFinalizePacket) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
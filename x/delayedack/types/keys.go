@@ -14,4 +14,13 @@ const (
 	MemStoreKey = "mem_delayedack"
 )
 
-var PendingPacketsByAddressKeyPrefix = []byte{0x01}
+var (
+	PendingPacketsByAddressKeyPrefix = []byte{0x01}
+	FinalizationQueueKeyPrefix       = []byte{0x02}
+	FinalizationCursorKey            = []byte{0x03}
+	PendingPacketsBySenderKeyPrefix  = []byte{0x04}
+	PendingPacketsByDenomKeyPrefix   = []byte{0x05}
+	PendingPacketsByChannelKeyPrefix = []byte{0x06}
+	PendingPacketsByTypeKeyPrefix    = []byte{0x07}
+	PendingPacketsSummaryKeyPrefix   = []byte{0x08}
+)
@@ -17,11 +17,22 @@ import (
 const (
 	TypeMsgFinalizedPacket            = "finalized_packet"
 	TypeMsgFinalizedPacketByPacketKey = "finalized_packet_by_packet_key"
+	TypeMsgFinalizeRollappPackets     = "finalize_rollapp_packets"
+)
+
+const (
+	// DefaultFinalizeRollappPacketsLimit is the number of packets finalized by MsgFinalizeRollappPackets
+	// when no limit is given.
+	DefaultFinalizeRollappPacketsLimit = 100
+	// MaxFinalizeRollappPacketsLimit is the maximum number of packets a single MsgFinalizeRollappPackets
+	// may finalize.
+	MaxFinalizeRollappPacketsLimit = 1000
 )
 
 var (
 	_ legacytx.LegacyMsg = &MsgFinalizePacket{}
 	_ legacytx.LegacyMsg = &MsgFinalizePacketByPacketKey{}
+	_ legacytx.LegacyMsg = &MsgFinalizeRollappPackets{}
 )
 
 func (m MsgFinalizePacket) ValidateBasic() error {
@@ -113,3 +124,46 @@ func (m *MsgFinalizePacketByPacketKey) Route() string {
 func (m *MsgFinalizePacketByPacketKey) Type() string {
 	return TypeMsgFinalizedPacketByPacketKey
 }
+
+func (m MsgFinalizeRollappPackets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if len(m.RollappId) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty")
+	}
+	if MaxFinalizeRollappPacketsLimit < m.MaxPackets {
+		return gerrc.ErrInvalidArgument.Wrapf("max packets must not exceed %d", MaxFinalizeRollappPacketsLimit)
+	}
+	return nil
+}
+
+func (m MsgFinalizeRollappPackets) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// PacketLimit returns the number of packets to finalize, applying the default if none is given.
+func (m MsgFinalizeRollappPackets) PacketLimit() int {
+	if m.MaxPackets == 0 {
+		return DefaultFinalizeRollappPacketsLimit
+	}
+	return int(m.MaxPackets)
+}
+
+func (m *MsgFinalizeRollappPackets) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgFinalizeRollappPackets) Route() string {
+	return RouterKey
+}
+
+func (m *MsgFinalizeRollappPackets) Type() string {
+	return TypeMsgFinalizeRollappPackets
+}
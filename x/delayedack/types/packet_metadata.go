@@ -7,28 +7,71 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 )
 
 type PacketMetadata struct {
 	EIBC *EIBCMetadata `json:"eibc"`
+	// Forward is the packet-forward-middleware metadata. Once an order is fulfilled, the fulfiller funds
+	// are forwarded on behalf of the recipient, and the forward is dropped from the packet.
+	// The recipient on the hub is the sender of the forward, so if the forward fails the funds are
+	// refunded to the hub recipient, not to the original sender on the rollapp.
+	Forward *pfmtypes.ForwardMetadata `json:"forward"`
 }
 
 type EIBCMetadata struct {
 	Fee string `json:"fee"`
+	// FeeCurve optionally raises the fee linearly from Fee to a max fee over a number of hub blocks.
+	FeeCurve *FeeCurveMetadata `json:"fee_curve,omitempty"`
+}
+
+type FeeCurveMetadata struct {
+	MaxFee string `json:"max_fee"`
+	Blocks uint64 `json:"blocks"`
 }
 
 func (p PacketMetadata) ValidateBasic() error {
-	return p.EIBC.ValidateBasic()
+	if p.EIBC != nil {
+		if err := p.EIBC.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	if p.Forward != nil {
+		if err := p.Forward.Validate(); err != nil {
+			return fmt.Errorf("forward: %w", err)
+		}
+	}
+	return nil
 }
 
 func (e EIBCMetadata) ValidateBasic() error {
-	_, err := e.FeeInt()
+	fee, err := e.FeeInt()
 	if err != nil {
 		return fmt.Errorf("fee: %w", err)
 	}
+	if e.FeeCurve != nil {
+		maxFee, err := e.FeeCurve.MaxFeeInt()
+		if err != nil {
+			return fmt.Errorf("fee curve: %w", err)
+		}
+		if maxFee.LT(fee) {
+			return fmt.Errorf("fee curve: max fee is less than fee: %w", ErrBadEIBCFee)
+		}
+		if e.FeeCurve.Blocks == 0 {
+			return fmt.Errorf("fee curve: zero blocks: %w", ErrBadEIBCFee)
+		}
+	}
 	return nil
 }
 
+func (f FeeCurveMetadata) MaxFeeInt() (math.Int, error) {
+	i, ok := sdk.NewIntFromString(f.MaxFee)
+	if !ok || i.IsNegative() {
+		return math.Int{}, ErrBadEIBCFee
+	}
+	return i, nil
+}
+
 func (e EIBCMetadata) FeeInt() (math.Int, error) {
 	i, ok := sdk.NewIntFromString(e.Fee)
 	if !ok || i.IsNegative() {
@@ -45,10 +88,11 @@ const (
 var (
 	ErrMemoUnmarshal         = fmt.Errorf("unmarshal memo")
 	ErrEIBCMetadataUnmarshal = fmt.Errorf("unmarshal eibc metadata")
-	ErrMemoHashPFMandEIBC    = fmt.Errorf("EIBC packet with PFM is currently not supported")
 	ErrMemoEibcEmpty         = fmt.Errorf("memo eIBC field is missing")
 )
 
+// ParsePacketMetadata parses the eIBC and forward metadata of the memo. ErrMemoEibcEmpty is returned
+// if the memo has neither of them.
 func ParsePacketMetadata(input string) (*PacketMetadata, error) {
 	bz := []byte(input)
 
@@ -57,11 +101,7 @@ func ParsePacketMetadata(input string) (*PacketMetadata, error) {
 	if err != nil {
 		return nil, ErrMemoUnmarshal
 	}
-	if memo[memoObjectKeyPFM] != nil {
-		// Currently not supporting eibc with PFM: https://github.com/dymensionxyz/dymension/issues/599
-		return nil, ErrMemoHashPFMandEIBC
-	}
-	if memo[memoObjectKeyEIBC] == nil {
+	if memo[memoObjectKeyEIBC] == nil && memo[memoObjectKeyPFM] == nil {
 		return nil, ErrMemoEibcEmpty
 	}
 	var metadata PacketMetadata
@@ -71,3 +111,33 @@ func ParsePacketMetadata(input string) (*PacketMetadata, error) {
 	}
 	return &metadata, nil
 }
+
+// MemoHasForward returns true if the memo has packet-forward-middleware metadata.
+func MemoHasForward(input string) bool {
+	memo := make(map[string]any)
+	if err := json.Unmarshal([]byte(input), &memo); err != nil {
+		return false
+	}
+	return memo[memoObjectKeyPFM] != nil
+}
+
+// MemoWithoutForward returns the memo without the packet-forward-middleware metadata.
+// The memo is returned as is if it has no forward.
+func MemoWithoutForward(input string) (string, error) {
+	if !MemoHasForward(input) {
+		return input, nil
+	}
+	memo := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(input), &memo); err != nil {
+		return "", ErrMemoUnmarshal
+	}
+	delete(memo, memoObjectKeyPFM)
+	if len(memo) == 0 {
+		return "", nil
+	}
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
@@ -3,6 +3,9 @@ package types
 import (
 	"reflect"
 	"testing"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_parsePacketMetadata(t *testing.T) {
@@ -27,6 +30,22 @@ func Test_parsePacketMetadata(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid - fee curve",
+			args{
+				`{"eibc":{"fee":"100","fee_curve":{"max_fee":"300","blocks":50}}}`,
+			},
+			&PacketMetadata{
+				EIBC: &EIBCMetadata{
+					Fee: "100",
+					FeeCurve: &FeeCurveMetadata{
+						MaxFee: "300",
+						Blocks: 50,
+					},
+				},
+			},
+			false,
+		},
 		{
 			"invalid - misquoted fee",
 			args{
@@ -36,12 +55,35 @@ func Test_parsePacketMetadata(t *testing.T) {
 			true,
 		},
 		{
-			"invalid - pfm",
+			"valid - pfm",
 			args{
-				`{"forward":{}}`,
+				`{"forward":{"receiver":"dym1","port":"transfer","channel":"channel-1"}}`,
 			},
-			nil,
-			true,
+			&PacketMetadata{
+				Forward: &pfmtypes.ForwardMetadata{
+					Receiver: "dym1",
+					Port:     "transfer",
+					Channel:  "channel-1",
+				},
+			},
+			false,
+		},
+		{
+			"valid - eibc and pfm",
+			args{
+				`{"eibc":{"fee":"100"},"forward":{"receiver":"dym1","port":"transfer","channel":"channel-1"}}`,
+			},
+			&PacketMetadata{
+				EIBC: &EIBCMetadata{
+					Fee: "100",
+				},
+				Forward: &pfmtypes.ForwardMetadata{
+					Receiver: "dym1",
+					Port:     "transfer",
+					Channel:  "channel-1",
+				},
+			},
+			false,
 		},
 		{
 			"invalid - empty",
@@ -65,3 +107,26 @@ func Test_parsePacketMetadata(t *testing.T) {
 		})
 	}
 }
+
+func TestMemoWithoutForward(t *testing.T) {
+	memo, err := MemoWithoutForward(`{"eibc":{"fee":"100"},"forward":{"receiver":"dym1","port":"transfer","channel":"channel-1"}}`)
+	require.NoError(t, err)
+	require.Equal(t, `{"eibc":{"fee":"100"}}`, memo)
+	require.False(t, MemoHasForward(memo))
+
+	memo, err = MemoWithoutForward(`{"forward":{"receiver":"dym1","port":"transfer","channel":"channel-1"}}`)
+	require.NoError(t, err)
+	require.Empty(t, memo)
+
+	memo, err = MemoWithoutForward("not a json")
+	require.NoError(t, err)
+	require.Equal(t, "not a json", memo)
+}
+
+func TestEIBCMetadata_ValidateBasic(t *testing.T) {
+	require.NoError(t, EIBCMetadata{Fee: "100"}.ValidateBasic())
+	require.NoError(t, EIBCMetadata{Fee: "100", FeeCurve: &FeeCurveMetadata{MaxFee: "300", Blocks: 50}}.ValidateBasic())
+	require.Error(t, EIBCMetadata{Fee: "100", FeeCurve: &FeeCurveMetadata{MaxFee: "50", Blocks: 50}}.ValidateBasic())
+	require.Error(t, EIBCMetadata{Fee: "100", FeeCurve: &FeeCurveMetadata{MaxFee: "300"}}.ValidateBasic())
+	require.Error(t, EIBCMetadata{Fee: "100", FeeCurve: &FeeCurveMetadata{MaxFee: "x", Blocks: 50}}.ValidateBasic())
+}
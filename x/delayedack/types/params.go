@@ -19,11 +19,19 @@ var (
 
 	// KeyDeletePacketsEpochLimit is the key for the delete packets epoch limit
 	KeyDeletePacketsEpochLimit = []byte("DeletePacketsEpochLimit")
+
+	// KeyFinalizePacketsBlockLimit is the key for the finalize packets block limit
+	KeyFinalizePacketsBlockLimit = []byte("FinalizePacketsBlockLimit")
+
+	// KeyFinalizePacketsBlockGasLimit is the key for the finalize packets block gas limit
+	KeyFinalizePacketsBlockGasLimit = []byte("FinalizePacketsBlockGasLimit")
 )
 
 const (
-	defaultEpochIdentifier         = "hour"
-	defaultDeletePacketsEpochLimit = 1000_000
+	defaultEpochIdentifier              = "hour"
+	defaultDeletePacketsEpochLimit      = 1000_000
+	defaultFinalizePacketsBlockLimit    = 100
+	defaultFinalizePacketsBlockGasLimit = 20_000_000
 )
 
 // ParamKeyTable the param key table for launch module
@@ -32,11 +40,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, bridgingFee sdk.Dec, deletePacketsEpochLimit int) Params {
+func NewParams(
+	epochIdentifier string,
+	bridgingFee sdk.Dec,
+	deletePacketsEpochLimit int,
+	finalizePacketsBlockLimit uint32,
+	finalizePacketsBlockGasLimit uint64,
+) Params {
 	return Params{
-		EpochIdentifier:         epochIdentifier,
-		BridgingFee:             bridgingFee,
-		DeletePacketsEpochLimit: int32(deletePacketsEpochLimit),
+		EpochIdentifier:              epochIdentifier,
+		BridgingFee:                  bridgingFee,
+		DeletePacketsEpochLimit:      int32(deletePacketsEpochLimit),
+		FinalizePacketsBlockLimit:    finalizePacketsBlockLimit,
+		FinalizePacketsBlockGasLimit: finalizePacketsBlockGasLimit,
 	}
 }
 
@@ -46,6 +62,8 @@ func DefaultParams() Params {
 		defaultEpochIdentifier,
 		sdk.NewDecWithPrec(1, 3), // 0.1%
 		defaultDeletePacketsEpochLimit,
+		defaultFinalizePacketsBlockLimit,
+		defaultFinalizePacketsBlockGasLimit,
 	)
 }
 
@@ -55,6 +73,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateEpochIdentifier),
 		paramtypes.NewParamSetPair(KeyBridgeFee, &p.BridgingFee, validateBridgingFee),
 		paramtypes.NewParamSetPair(KeyDeletePacketsEpochLimit, &p.DeletePacketsEpochLimit, validateDeletePacketsEpochLimit),
+		paramtypes.NewParamSetPair(KeyFinalizePacketsBlockLimit, &p.FinalizePacketsBlockLimit, validateFinalizePacketsBlockLimit),
+		paramtypes.NewParamSetPair(KeyFinalizePacketsBlockGasLimit, &p.FinalizePacketsBlockGasLimit, validateFinalizePacketsBlockGasLimit),
 	}
 }
 
@@ -99,6 +119,22 @@ func validateDeletePacketsEpochLimit(i interface{}) error {
 	return nil
 }
 
+func validateFinalizePacketsBlockLimit(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFinalizePacketsBlockGasLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validateBridgingFee(p.BridgingFee); err != nil {
@@ -110,6 +146,12 @@ func (p Params) Validate() error {
 	if err := validateDeletePacketsEpochLimit(p.DeletePacketsEpochLimit); err != nil {
 		return err
 	}
+	if err := validateFinalizePacketsBlockLimit(p.FinalizePacketsBlockLimit); err != nil {
+		return err
+	}
+	if err := validateFinalizePacketsBlockGasLimit(p.FinalizePacketsBlockGasLimit); err != nil {
+		return err
+	}
 	return nil
 }
 
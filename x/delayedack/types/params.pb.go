@@ -36,6 +36,14 @@ type Params struct {
 	// even if it means potentially causing the store to temporarily grow by piling up packets
 	// that weren't deleted but rather "postponed", to subsequent epochs.
 	DeletePacketsEpochLimit int32 `protobuf:"varint,3,opt,name=delete_packets_epoch_limit,json=deletePacketsEpochLimit,proto3" json:"delete_packets_epoch_limit,omitempty" yaml:"delete_packets_epoch_limit"`
+	// `finalize_packets_block_limit` is the maximum number of packets finalized
+	// automatically at the end of every block. Zero disables automatic
+	// finalization.
+	FinalizePacketsBlockLimit uint32 `protobuf:"varint,4,opt,name=finalize_packets_block_limit,json=finalizePacketsBlockLimit,proto3" json:"finalize_packets_block_limit,omitempty" yaml:"finalize_packets_block_limit"`
+	// `finalize_packets_block_gas_limit` is the gas budget for automatic
+	// finalization at the end of every block. No further packet is finalized
+	// once the budget is spent. Zero disables automatic finalization.
+	FinalizePacketsBlockGasLimit uint64 `protobuf:"varint,5,opt,name=finalize_packets_block_gas_limit,json=finalizePacketsBlockGasLimit,proto3" json:"finalize_packets_block_gas_limit,omitempty" yaml:"finalize_packets_block_gas_limit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -84,6 +92,20 @@ func (m *Params) GetDeletePacketsEpochLimit() int32 {
 	return 0
 }
 
+func (m *Params) GetFinalizePacketsBlockLimit() uint32 {
+	if m != nil {
+		return m.FinalizePacketsBlockLimit
+	}
+	return 0
+}
+
+func (m *Params) GetFinalizePacketsBlockGasLimit() uint64 {
+	if m != nil {
+		return m.FinalizePacketsBlockGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.delayedack.Params")
 }
@@ -93,28 +115,33 @@ func init() {
 }
 
 var fileDescriptor_9516cc08de197609 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x52, 0x73, 0x12,
-	0x2b, 0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x21, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x55, 0xeb, 0x83, 0x58, 0x10, 0x8d, 0x4a, 0x4b, 0x98, 0xb8, 0xd8, 0x02, 0xc0,
-	0x26, 0x09, 0xb9, 0x71, 0x09, 0xa4, 0x16, 0xe4, 0x27, 0x67, 0xc4, 0x67, 0xa6, 0xa4, 0xe6, 0x95,
-	0x64, 0xa6, 0x65, 0xa6, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x7f, 0xba, 0x27,
-	0x2f, 0x5e, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0xae, 0x42, 0x29, 0x88, 0x1f, 0x2c, 0xe4, 0x09,
-	0x17, 0x11, 0xca, 0xe0, 0xe2, 0x49, 0x2a, 0xca, 0x4c, 0x49, 0xcf, 0xcc, 0x4b, 0x8f, 0x4f, 0x4b,
-	0x4d, 0x95, 0x60, 0x02, 0x9b, 0xe1, 0x7a, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0x6a, 0xe9,
-	0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5,
-	0x50, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xcf, 0x25, 0x35, 0xf9,
-	0xd3, 0x3d, 0x79, 0x61, 0x88, 0x8d, 0xc8, 0x66, 0x29, 0x05, 0x71, 0xc3, 0xb8, 0x6e, 0xa9, 0xa9,
-	0x42, 0x49, 0x5c, 0x52, 0x29, 0xa9, 0x39, 0xa9, 0x25, 0xa9, 0xf1, 0x05, 0x89, 0xc9, 0xd9, 0xa9,
-	0x25, 0xc5, 0xf1, 0x10, 0xe7, 0xe5, 0x64, 0xe6, 0x66, 0x96, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0xb0,
-	0x3a, 0xa9, 0x7e, 0xba, 0x27, 0xaf, 0x08, 0x31, 0x09, 0xb7, 0x5a, 0xa5, 0x20, 0x71, 0x88, 0x64,
-	0x00, 0x44, 0xce, 0x15, 0x24, 0xe5, 0x03, 0x92, 0xb1, 0x62, 0x99, 0xb1, 0x40, 0x9e, 0xc1, 0x29,
-	0xf0, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0x91, 0xfc, 0x83, 0x23,
-	0xd2, 0xca, 0x8c, 0xf5, 0x2b, 0x90, 0x63, 0x0e, 0xec, 0xc9, 0x24, 0x36, 0x70, 0x04, 0x18, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x0c, 0xec, 0x8e, 0xeb, 0x01, 0x00, 0x00,
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3d, 0x8f, 0x94, 0x40,
+	0x18, 0xc7, 0x19, 0xe5, 0x2e, 0x11, 0x35, 0x1a, 0x34, 0xb9, 0xf5, 0xbc, 0x30, 0x1c, 0x46, 0x8f,
+	0xc4, 0x08, 0xc5, 0x15, 0x26, 0x57, 0x12, 0xef, 0x8c, 0x89, 0xc5, 0x49, 0x69, 0x43, 0x06, 0x78,
+	0x16, 0x26, 0xbc, 0x0c, 0x61, 0xd0, 0x2c, 0xdb, 0xfa, 0x05, 0x2c, 0x2d, 0xfd, 0x38, 0x5b, 0x6e,
+	0x69, 0x2c, 0x88, 0xd9, 0xfd, 0x06, 0x7c, 0x02, 0x03, 0xc3, 0xee, 0x12, 0xe3, 0x6e, 0x05, 0x33,
+	0xff, 0x97, 0xdf, 0x24, 0xcf, 0xa3, 0x58, 0x61, 0x9d, 0x41, 0xce, 0x29, 0xcb, 0x67, 0xf5, 0xdc,
+	0xde, 0x1e, 0xec, 0x10, 0x52, 0x52, 0x43, 0x48, 0x82, 0xc4, 0x2e, 0x48, 0x49, 0x32, 0x6e, 0x15,
+	0x25, 0xab, 0x98, 0x7a, 0x3e, 0xf6, 0xef, 0xc2, 0xd6, 0xce, 0x7f, 0xfa, 0x34, 0x62, 0x11, 0xeb,
+	0xdd, 0x76, 0xf7, 0x27, 0x82, 0xc6, 0x37, 0x59, 0x39, 0xbe, 0xed, 0x9b, 0xd4, 0x1b, 0xe5, 0x31,
+	0x14, 0x2c, 0x88, 0x3d, 0x1a, 0x42, 0x5e, 0xd1, 0x29, 0x85, 0x72, 0x82, 0x74, 0x64, 0xde, 0x73,
+	0x9e, 0xb7, 0x0d, 0x3e, 0xa9, 0x49, 0x96, 0x5e, 0x19, 0xff, 0x3a, 0x0c, 0xf7, 0x51, 0x7f, 0xf5,
+	0x61, 0x7b, 0xa3, 0xc6, 0xca, 0x03, 0xbf, 0xa4, 0x61, 0x44, 0xf3, 0xc8, 0x9b, 0x02, 0x4c, 0xee,
+	0xf4, 0x1d, 0xd7, 0x8b, 0x06, 0x4b, 0xbf, 0x1b, 0xfc, 0x2a, 0xa2, 0x55, 0xfc, 0xc5, 0xb7, 0x02,
+	0x96, 0xd9, 0x01, 0xe3, 0x19, 0xe3, 0xc3, 0xe7, 0x0d, 0x0f, 0x13, 0xbb, 0xaa, 0x0b, 0xe0, 0xd6,
+	0x3b, 0x08, 0xda, 0x06, 0x3f, 0x11, 0xc4, 0x71, 0x97, 0xe1, 0xde, 0xdf, 0x1c, 0x6f, 0x00, 0x54,
+	0x5f, 0x39, 0x0d, 0x21, 0x85, 0x0a, 0xbc, 0x82, 0x04, 0x09, 0x54, 0xdc, 0x13, 0xcf, 0x4b, 0x69,
+	0x46, 0xab, 0xc9, 0x5d, 0x1d, 0x99, 0x47, 0xce, 0xcb, 0xb6, 0xc1, 0xe7, 0xa2, 0x69, 0xbf, 0xd7,
+	0x70, 0x4f, 0x84, 0x78, 0x2b, 0xb4, 0xeb, 0x4e, 0xfa, 0xd8, 0x29, 0x6a, 0xac, 0x9c, 0x4d, 0x69,
+	0x4e, 0x52, 0x3a, 0xdf, 0x25, 0xfd, 0x94, 0x05, 0xc9, 0x40, 0x91, 0x75, 0x64, 0x3e, 0x74, 0x2e,
+	0xda, 0x06, 0xbf, 0x10, 0x94, 0x43, 0x6e, 0xc3, 0x7d, 0xb6, 0x91, 0x07, 0x92, 0xd3, 0x89, 0x82,
+	0xc4, 0x15, 0x7d, 0x4f, 0x36, 0x22, 0x7c, 0xa0, 0x1d, 0xe9, 0xc8, 0x94, 0x9d, 0xd7, 0x6d, 0x83,
+	0x2f, 0x0e, 0xd2, 0xb6, 0x09, 0xc3, 0x3d, 0xfb, 0x1f, 0xf1, 0x3d, 0xe1, 0x3d, 0xf4, 0x4a, 0xfe,
+	0xf1, 0x13, 0x4b, 0xce, 0xa7, 0xc5, 0x4a, 0x43, 0xcb, 0x95, 0x86, 0xfe, 0xac, 0x34, 0xf4, 0x7d,
+	0xad, 0x49, 0xcb, 0xb5, 0x26, 0xfd, 0x5a, 0x6b, 0xd2, 0xe7, 0xb7, 0xa3, 0x71, 0xed, 0xd9, 0xc9,
+	0xaf, 0x97, 0xf6, 0x6c, 0xbc, 0x98, 0xfd, 0x0c, 0xfd, 0xe3, 0x7e, 0xbf, 0x2e, 0xff, 0x0e, 0x00,
+	0x5d, 0x70, 0x0f, 0x3c, 0xca, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -137,6 +164,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalizePacketsBlockGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalizePacketsBlockGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FinalizePacketsBlockLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalizePacketsBlockLimit))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DeletePacketsEpochLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeletePacketsEpochLimit))
 		i--
@@ -188,6 +225,12 @@ func (m *Params) Size() (n int) {
 	if m.DeletePacketsEpochLimit != 0 {
 		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
 	}
+	if m.FinalizePacketsBlockLimit != 0 {
+		n += 1 + sovParams(uint64(m.FinalizePacketsBlockLimit))
+	}
+	if m.FinalizePacketsBlockGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.FinalizePacketsBlockGasLimit))
+	}
 	return n
 }
 
@@ -311,6 +354,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizePacketsBlockLimit", wireType)
+			}
+			m.FinalizePacketsBlockLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizePacketsBlockLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizePacketsBlockGasLimit", wireType)
+			}
+			m.FinalizePacketsBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizePacketsBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
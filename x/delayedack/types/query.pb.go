@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -340,586 +342,2510 @@ func (m *QueryPendingPacketByAddressListResponse) GetPagination() *query.PageRes
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryParamsResponse")
-	proto.RegisterType((*QueryRollappPacketsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketsRequest")
-	proto.RegisterType((*QueryRollappPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketListResponse")
-	proto.RegisterType((*QueryPendingPacketsByAddressRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByAddressRequest")
-	proto.RegisterType((*QueryPendingPacketByAddressListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketByAddressListResponse")
+type QueryPendingPacketsBySenderRequest struct {
+	// Sender is the original sender of the transfer.
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/delayedack/query.proto", fileDescriptor_0d5f080aa12bfc36)
+func (m *QueryPendingPacketsBySenderRequest) Reset()         { *m = QueryPendingPacketsBySenderRequest{} }
+func (m *QueryPendingPacketsBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsBySenderRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{6}
 }
-
-var fileDescriptor_0d5f080aa12bfc36 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x4e, 0x13, 0x41,
-	0x18, 0xef, 0x14, 0xa8, 0x61, 0x48, 0x38, 0x8c, 0x1c, 0x36, 0x1b, 0xb2, 0xe2, 0x1a, 0x05, 0x54,
-	0x66, 0xd2, 0x12, 0xf5, 0x44, 0x0c, 0x24, 0xd0, 0x68, 0x34, 0x81, 0xd5, 0x13, 0x07, 0xcd, 0xb4,
-	0x3b, 0x59, 0x37, 0xb4, 0x3b, 0xcb, 0xce, 0x94, 0xb0, 0x12, 0x2e, 0x5e, 0xf4, 0x48, 0xe2, 0x5b,
-	0xf8, 0x1e, 0x26, 0x9c, 0x0c, 0x89, 0x07, 0x3d, 0x19, 0x03, 0xbe, 0x87, 0x66, 0x67, 0xb6, 0xdb,
-	0x96, 0xb6, 0x74, 0xe9, 0xcd, 0x5b, 0x67, 0xfa, 0xfd, 0xe6, 0xf7, 0x67, 0xbe, 0x6f, 0x16, 0xae,
-	0xb8, 0x71, 0x93, 0x05, 0xc2, 0xe7, 0xc1, 0x61, 0xfc, 0x9e, 0x64, 0x0b, 0xe2, 0xb2, 0x06, 0x8d,
-	0x99, 0x4b, 0xeb, 0x7b, 0x64, 0xbf, 0xc5, 0xa2, 0x18, 0x87, 0x11, 0x97, 0x1c, 0xdd, 0xee, 0x2e,
-	0xc7, 0xd9, 0x02, 0x77, 0xca, 0xcd, 0x39, 0x8f, 0x7b, 0x5c, 0x55, 0x93, 0xe4, 0x97, 0x06, 0x9a,
-	0xf3, 0x1e, 0xe7, 0x5e, 0x83, 0x11, 0x1a, 0xfa, 0x84, 0x06, 0x01, 0x97, 0x54, 0xfa, 0x3c, 0x10,
-	0xe9, 0xbf, 0xf7, 0xeb, 0x5c, 0x34, 0xb9, 0x20, 0x35, 0x2a, 0x98, 0xe6, 0x23, 0x07, 0xe5, 0x1a,
-	0x93, 0xb4, 0x4c, 0x42, 0xea, 0xf9, 0x81, 0x2a, 0x4e, 0x6b, 0xf1, 0x68, 0xc5, 0x21, 0x8d, 0x68,
-	0x33, 0x3b, 0x7b, 0x48, 0x7d, 0x9d, 0x37, 0x9b, 0x3c, 0x20, 0x42, 0x52, 0xd9, 0x6a, 0xd7, 0x56,
-	0xae, 0xae, 0x8d, 0x78, 0xa3, 0x41, 0xc3, 0xf0, 0x6d, 0x48, 0xeb, 0x7b, 0x4c, 0x6a, 0x8c, 0x3d,
-	0x07, 0xd1, 0x4e, 0xa2, 0x78, 0x5b, 0x91, 0x3a, 0x6c, 0xbf, 0xc5, 0x84, 0xb4, 0xdf, 0xc0, 0x9b,
-	0x3d, 0xbb, 0x22, 0xe4, 0x81, 0x60, 0xa8, 0x0a, 0x4b, 0x5a, 0x9c, 0x01, 0x16, 0xc0, 0xd2, 0x4c,
-	0x65, 0x19, 0x8f, 0x0c, 0x14, 0xeb, 0x23, 0x36, 0x26, 0x4f, 0x7f, 0xdd, 0x2a, 0x38, 0x29, 0xdc,
-	0xfe, 0x54, 0x84, 0xa6, 0x22, 0x70, 0xb4, 0xa6, 0x6d, 0x25, 0xa9, 0x4d, 0x8f, 0xe6, 0xe1, 0x74,
-	0x2a, 0xf6, 0x99, 0xab, 0xa8, 0xa6, 0x9d, 0xce, 0x06, 0x5a, 0x83, 0x25, 0x6d, 0xdb, 0x28, 0x2e,
-	0x80, 0xa5, 0xd9, 0xca, 0xdd, 0x61, 0x2a, 0xb4, 0x6f, 0xfc, 0x4a, 0x15, 0x3b, 0x29, 0x08, 0x6d,
-	0xc2, 0x49, 0x19, 0x87, 0xcc, 0x98, 0x50, 0xe0, 0xf2, 0x08, 0x70, 0x8f, 0x40, 0xfc, 0x3a, 0x0e,
-	0x99, 0xa3, 0xe0, 0x68, 0x0b, 0xc2, 0xce, 0xe5, 0x1a, 0x93, 0x2a, 0x8f, 0x7b, 0x58, 0x77, 0x02,
-	0x4e, 0x3a, 0x01, 0xeb, 0xce, 0x4b, 0x3b, 0x01, 0x6f, 0x53, 0x8f, 0xa5, 0xfe, 0x9c, 0x2e, 0xa4,
-	0xfd, 0x15, 0x40, 0xab, 0x3f, 0x8a, 0x17, 0xbe, 0x90, 0x59, 0xec, 0xbb, 0x70, 0x36, 0xea, 0xc9,
-	0xc9, 0x00, 0x0b, 0x13, 0x4b, 0x33, 0x95, 0x87, 0xd7, 0xd1, 0x9e, 0xde, 0xc0, 0xa5, 0x93, 0x50,
-	0xb5, 0xc7, 0x46, 0x51, 0xd9, 0x58, 0x1c, 0x69, 0x43, 0x0b, 0xeb, 0xf1, 0xf1, 0x11, 0xc0, 0x3b,
-	0xba, 0x67, 0x58, 0xe0, 0xfa, 0x81, 0x97, 0x12, 0x6c, 0xc4, 0xeb, 0xae, 0x1b, 0x31, 0x91, 0xdd,
-	0xad, 0x01, 0x6f, 0x50, 0xbd, 0x93, 0xde, 0x6c, 0x7b, 0x79, 0x29, 0xd1, 0xe2, 0xd8, 0x89, 0x7e,
-	0x03, 0x70, 0xb1, 0x5f, 0x49, 0x26, 0xe4, 0xbf, 0x8b, 0xb6, 0x72, 0x32, 0x05, 0xa7, 0x94, 0x21,
-	0xf4, 0x05, 0xc0, 0x92, 0x1e, 0x28, 0xf4, 0x28, 0xc7, 0xec, 0xf5, 0x4f, 0xb6, 0xf9, 0xf8, 0xba,
-	0x30, 0xad, 0xc7, 0x2e, 0x7f, 0xf8, 0xfe, 0xe7, 0x73, 0xf1, 0x01, 0x5a, 0x26, 0x79, 0x1f, 0x30,
-	0xf4, 0x03, 0x40, 0x58, 0x65, 0xb2, 0x1d, 0xc7, 0x5a, 0x5e, 0xe6, 0x81, 0x6f, 0x82, 0xb9, 0x3e,
-	0x16, 0xbc, 0xfb, 0xb2, 0xed, 0xaa, 0xf2, 0xb0, 0x8e, 0x9e, 0xe6, 0xf2, 0xa0, 0xd8, 0xc9, 0x51,
-	0xf6, 0xee, 0x1c, 0x93, 0x23, 0xfd, 0x82, 0x1c, 0xa3, 0xbf, 0x00, 0x9a, 0x89, 0xb3, 0xc1, 0x9d,
-	0x8e, 0xb6, 0x72, 0x67, 0x7c, 0xe5, 0xa8, 0x98, 0xcf, 0xc7, 0x3a, 0x67, 0x60, 0xa3, 0xdb, 0x2f,
-	0x95, 0xf7, 0x2a, 0xda, 0xcc, 0xe3, 0x5d, 0x1f, 0xb7, 0x12, 0xb1, 0x3a, 0xf3, 0x0f, 0x58, 0xb4,
-	0x92, 0x85, 0x91, 0x8e, 0xea, 0xf1, 0xc6, 0xce, 0xe9, 0xb9, 0x05, 0xce, 0xce, 0x2d, 0xf0, 0xfb,
-	0xdc, 0x02, 0x27, 0x17, 0x56, 0xe1, 0xec, 0xc2, 0x2a, 0xfc, 0xbc, 0xb0, 0x0a, 0xbb, 0x4f, 0x3c,
-	0x5f, 0xbe, 0x6b, 0xd5, 0x92, 0x41, 0x19, 0x46, 0x75, 0xb0, 0x4a, 0x0e, 0xbb, 0xf9, 0x92, 0xf7,
-	0x54, 0xd4, 0x4a, 0xea, 0x83, 0xb4, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x19, 0xae, 0x61, 0xeb,
-	0xd4, 0x07, 0x00, 0x00,
+func (m *QueryPendingPacketsBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsBySenderRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsBySenderRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingPacketsBySenderRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of RollappPacket items by rollappID.
-	GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error)
-	// Queries a list of pending RollappPacket items by rollappID and receiver.
-	GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error)
+func (m *QueryPendingPacketsBySenderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingPacketsBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryPendingPacketsByDenomRequest struct {
+	// Denom is the denom as it appears in the transfer data.
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingPacketsByDenomRequest) Reset()         { *m = QueryPendingPacketsByDenomRequest{} }
+func (m *QueryPendingPacketsByDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsByDenomRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsByDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{7}
+}
+func (m *QueryPendingPacketsByDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsByDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsByDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingPacketsByDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsByDenomRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsByDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsByDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsByDenomRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error) {
-	out := new(QueryRollappPacketListResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPackets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingPacketsByDenomRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsByDenomRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error) {
-	out := new(QueryPendingPacketByAddressListResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingPacketsByDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of RollappPacket items by rollappID.
-	GetPackets(context.Context, *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error)
-	// Queries a list of pending RollappPacket items by rollappID and receiver.
-	GetPendingPacketsByAddress(context.Context, *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error)
+type QueryPendingPacketsByChannelRequest struct {
+	// Channel is the Hub side channel of the packet.
+	Channel    string             `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPendingPacketsByChannelRequest) Reset()         { *m = QueryPendingPacketsByChannelRequest{} }
+func (m *QueryPendingPacketsByChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsByChannelRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsByChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPendingPacketsByChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPackets(ctx context.Context, req *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPackets not implemented")
+func (m *QueryPendingPacketsByChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsByChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetPendingPacketsByAddress(ctx context.Context, req *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByAddress not implemented")
+func (m *QueryPendingPacketsByChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsByChannelRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPendingPacketsByChannelRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.delayedack.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPendingPacketsByChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsByChannelRequest.DiscardUnknown(m)
 }
 
-func _Query_GetPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRollappPacketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPackets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPackets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPackets(ctx, req.(*QueryRollappPacketsRequest))
+var xxx_messageInfo_QueryPendingPacketsByChannelRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsByChannelRequest) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetPendingPacketsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingPacketsByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPendingPacketsByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPendingPacketsByAddress(ctx, req.(*QueryPendingPacketsByAddressRequest))
+func (m *QueryPendingPacketsByChannelRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.delayedack.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetPackets",
-			Handler:    _Query_GetPackets_Handler,
-		},
-		{
-			MethodName: "GetPendingPacketsByAddress",
-			Handler:    _Query_GetPendingPacketsByAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/delayedack/query.proto",
+type QueryPendingPacketsByTypeRequest struct {
+	Type       types.RollappPacket_Type `protobuf:"varint,1,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
+	Pagination *query.PageRequest       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingPacketsByTypeRequest) Reset()         { *m = QueryPendingPacketsByTypeRequest{} }
+func (m *QueryPendingPacketsByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsByTypeRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{9}
+}
+func (m *QueryPendingPacketsByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingPacketsByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsByTypeRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsByTypeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPendingPacketsByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsByTypeRequest) GetType() types.RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.RollappPacket_ON_RECV
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingPacketsByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingPacketListResponse struct {
+	RollappPackets []types.RollappPacket `protobuf:"bytes,1,rep,name=rollappPackets,proto3" json:"rollappPackets"`
+	Pagination     *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingPacketListResponse) Reset()         { *m = QueryPendingPacketListResponse{} }
+func (m *QueryPendingPacketListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketListResponse) ProtoMessage()    {}
+func (*QueryPendingPacketListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{10}
+}
+func (m *QueryPendingPacketListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRollappPacketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingPacketListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketListResponse.Merge(m, src)
 }
-
-func (m *QueryRollappPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingPacketListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketListResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRollappPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPendingPacketListResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketListResponse) GetRollappPackets() []types.RollappPacket {
+	if m != nil {
+		return m.RollappPackets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRollappPacketListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingPacketListResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRollappPacketListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingPacketsSummaryRequest struct {
+	// RollappId limits the summary to a single rollapp. If empty, all rollapps
+	// are summarized.
+	RollappId string `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
 }
 
-func (m *QueryRollappPacketListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.RollappPackets) > 0 {
-		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RollappPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingPacketsSummaryRequest) Reset()         { *m = QueryPendingPacketsSummaryRequest{} }
+func (m *QueryPendingPacketsSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsSummaryRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{11}
+}
+func (m *QueryPendingPacketsSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingPacketsSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsSummaryRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsSummaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPendingPacketsByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingPacketsSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsSummaryRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPendingPacketsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingPacketsSummaryResponse struct {
+	Summaries []RollappPendingPacketsSummary `protobuf:"bytes,1,rep,name=summaries,proto3" json:"summaries"`
 }
 
-func (m *QueryPendingPacketsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPendingPacketsSummaryResponse) Reset()         { *m = QueryPendingPacketsSummaryResponse{} }
+func (m *QueryPendingPacketsSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsSummaryResponse) ProtoMessage()    {}
+func (*QueryPendingPacketsSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{12}
+}
+func (m *QueryPendingPacketsSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingPacketsSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsSummaryResponse.Merge(m, src)
+}
+func (m *QueryPendingPacketsSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsSummaryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPendingPacketByAddressListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingPacketsSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsSummaryResponse) GetSummaries() []RollappPendingPacketsSummary {
+	if m != nil {
+		return m.Summaries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPendingPacketByAddressListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RollappPendingPacketsSummary aggregates the pending packets of a rollapp.
+type RollappPendingPacketsSummary struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// Count is the total number of pending packets.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// RecvCount is the number of pending ON_RECV packets.
+	RecvCount uint64 `protobuf:"varint,3,opt,name=recvCount,proto3" json:"recvCount,omitempty"`
+	// AckCount is the number of pending ON_ACK packets.
+	AckCount uint64 `protobuf:"varint,4,opt,name=ackCount,proto3" json:"ackCount,omitempty"`
+	// TimeoutCount is the number of pending ON_TIMEOUT packets.
+	TimeoutCount uint64 `protobuf:"varint,5,opt,name=timeoutCount,proto3" json:"timeoutCount,omitempty"`
+	// Amounts are the summed transfer amounts by the denom as it appears in
+	// the transfer data.
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *RollappPendingPacketsSummary) Reset()         { *m = RollappPendingPacketsSummary{} }
+func (m *RollappPendingPacketsSummary) String() string { return proto.CompactTextString(m) }
+func (*RollappPendingPacketsSummary) ProtoMessage()    {}
+func (*RollappPendingPacketsSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{13}
+}
+func (m *RollappPendingPacketsSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryPendingPacketByAddressListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *RollappPendingPacketsSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappPendingPacketsSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.RollappPackets) > 0 {
-		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RollappPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *RollappPendingPacketsSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappPendingPacketsSummary.Merge(m, src)
+}
+func (m *RollappPendingPacketsSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappPendingPacketsSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappPendingPacketsSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappPendingPacketsSummary proto.InternalMessageInfo
+
+func (m *RollappPendingPacketsSummary) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RollappPendingPacketsSummary) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RollappPendingPacketsSummary) GetRecvCount() uint64 {
+	if m != nil {
+		return m.RecvCount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RollappPendingPacketsSummary) GetAckCount() uint64 {
+	if m != nil {
+		return m.AckCount
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryRollappPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RollappPendingPacketsSummary) GetTimeoutCount() uint64 {
+	if m != nil {
+		return m.TimeoutCount
 	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *RollappPendingPacketsSummary) GetAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amounts
 	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryParamsResponse")
+	proto.RegisterType((*QueryRollappPacketsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketsRequest")
+	proto.RegisterType((*QueryRollappPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketListResponse")
+	proto.RegisterType((*QueryPendingPacketsByAddressRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByAddressRequest")
+	proto.RegisterType((*QueryPendingPacketByAddressListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketByAddressListResponse")
+	proto.RegisterType((*QueryPendingPacketsBySenderRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsBySenderRequest")
+	proto.RegisterType((*QueryPendingPacketsByDenomRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByDenomRequest")
+	proto.RegisterType((*QueryPendingPacketsByChannelRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByChannelRequest")
+	proto.RegisterType((*QueryPendingPacketsByTypeRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByTypeRequest")
+	proto.RegisterType((*QueryPendingPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketListResponse")
+	proto.RegisterType((*QueryPendingPacketsSummaryRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsSummaryRequest")
+	proto.RegisterType((*QueryPendingPacketsSummaryResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsSummaryResponse")
+	proto.RegisterType((*RollappPendingPacketsSummary)(nil), "dymensionxyz.dymension.delayedack.RollappPendingPacketsSummary")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/delayedack/query.proto", fileDescriptor_0d5f080aa12bfc36)
+}
+
+var fileDescriptor_0d5f080aa12bfc36 = []byte{
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x38, 0x8e, 0x4b, 0xa6, 0x28, 0x87, 0x21, 0x80, 0xbb, 0x8a, 0xdc, 0x74, 0x11, 0x34,
+	0x05, 0xbc, 0x4b, 0x5c, 0xf1, 0xa1, 0x8a, 0xd2, 0xda, 0x4e, 0x6c, 0x51, 0x15, 0x29, 0x75, 0x38,
+	0xf5, 0x00, 0x9a, 0xec, 0x8e, 0xdc, 0x55, 0xbc, 0x3b, 0xdb, 0x9d, 0x75, 0x54, 0x53, 0xf9, 0x00,
+	0x42, 0x02, 0x6e, 0x48, 0x1c, 0xf8, 0x1f, 0x7a, 0xe3, 0xcc, 0x19, 0xa9, 0x27, 0x54, 0x89, 0x03,
+	0x9c, 0x00, 0x25, 0x20, 0xc1, 0x91, 0x03, 0x67, 0xd0, 0xbe, 0x99, 0x5d, 0xef, 0x36, 0x9b, 0xf8,
+	0xa3, 0xbe, 0xf4, 0x14, 0xbf, 0x99, 0xf7, 0xf5, 0xfb, 0xbd, 0xb7, 0xf3, 0x9e, 0x82, 0xab, 0xf6,
+	0xc0, 0x65, 0x9e, 0x70, 0xb8, 0x77, 0x6f, 0xf0, 0x89, 0x99, 0x08, 0xa6, 0xcd, 0x7a, 0x74, 0xc0,
+	0x6c, 0x6a, 0xed, 0x9b, 0x77, 0xfb, 0x2c, 0x18, 0x18, 0x7e, 0xc0, 0x43, 0x4e, 0x2e, 0xa4, 0xd5,
+	0x8d, 0x44, 0x30, 0x46, 0xea, 0xda, 0x6a, 0x97, 0x77, 0x39, 0x68, 0x9b, 0xd1, 0x2f, 0x69, 0xa8,
+	0xad, 0x75, 0x39, 0xef, 0xf6, 0x98, 0x49, 0x7d, 0xc7, 0xa4, 0x9e, 0xc7, 0x43, 0x1a, 0x3a, 0xdc,
+	0x13, 0xea, 0xf6, 0x55, 0x8b, 0x0b, 0x97, 0x0b, 0x73, 0x8f, 0x0a, 0x26, 0xe3, 0x99, 0x07, 0x9b,
+	0x7b, 0x2c, 0xa4, 0x9b, 0xa6, 0x4f, 0xbb, 0x8e, 0x07, 0xca, 0x4a, 0xb7, 0x92, 0xd6, 0x8d, 0xb5,
+	0x2c, 0xee, 0xc4, 0xf7, 0xc6, 0x78, 0x44, 0x3e, 0x0d, 0xa8, 0x9b, 0xc4, 0x3e, 0x41, 0xdf, 0xe2,
+	0xae, 0xcb, 0x3d, 0x53, 0x84, 0x34, 0xec, 0xc7, 0xba, 0xb5, 0xd3, 0x75, 0x03, 0xde, 0xeb, 0x51,
+	0xdf, 0xff, 0xd8, 0xa7, 0xd6, 0x3e, 0x0b, 0xa5, 0x8d, 0xbe, 0x8a, 0xc9, 0xad, 0x08, 0xd1, 0x0e,
+	0x04, 0xed, 0xb0, 0xbb, 0x7d, 0x26, 0x42, 0xfd, 0x23, 0xfc, 0x5c, 0xe6, 0x54, 0xf8, 0xdc, 0x13,
+	0x8c, 0xb4, 0x71, 0x49, 0x26, 0x57, 0x46, 0xeb, 0x68, 0xe3, 0x6c, 0xed, 0x92, 0x31, 0x96, 0x70,
+	0x43, 0xba, 0x68, 0x14, 0x1f, 0xfe, 0x7a, 0x7e, 0xa1, 0xa3, 0xcc, 0xf5, 0x2f, 0x0b, 0x58, 0x83,
+	0x00, 0x1d, 0x99, 0xd3, 0x0e, 0xa4, 0x14, 0x87, 0x27, 0x6b, 0x78, 0x59, 0x25, 0xfb, 0xbe, 0x0d,
+	0xa1, 0x96, 0x3b, 0xa3, 0x03, 0x72, 0x15, 0x97, 0x24, 0xec, 0x72, 0x61, 0x1d, 0x6d, 0xac, 0xd4,
+	0x5e, 0x3e, 0x29, 0x0b, 0x89, 0xdb, 0xd8, 0x05, 0xe5, 0x8e, 0x32, 0x22, 0xdb, 0xb8, 0x18, 0x0e,
+	0x7c, 0x56, 0x5e, 0x04, 0xe3, 0xcd, 0x31, 0xc6, 0x99, 0x04, 0x8d, 0x0f, 0x07, 0x3e, 0xeb, 0x80,
+	0x39, 0x69, 0x61, 0x3c, 0x2a, 0x7e, 0xb9, 0x08, 0x7c, 0xbc, 0x62, 0xc8, 0xea, 0x1b, 0x51, 0xf5,
+	0x0d, 0xd9, 0x99, 0xaa, 0x07, 0x8c, 0x1d, 0xda, 0x65, 0x0a, 0x5f, 0x27, 0x65, 0xa9, 0xff, 0x80,
+	0x70, 0xe5, 0x38, 0x15, 0x37, 0x1d, 0x11, 0x26, 0xb4, 0xdf, 0xc6, 0x2b, 0x41, 0xfa, 0x32, 0xa2,
+	0x7f, 0x71, 0xe3, 0x6c, 0xed, 0xf5, 0x69, 0x72, 0x57, 0x15, 0x78, 0xcc, 0x13, 0x69, 0x67, 0x60,
+	0x14, 0x00, 0xc6, 0xc5, 0xb1, 0x30, 0x64, 0x62, 0x19, 0x1c, 0x5f, 0x20, 0xfc, 0x92, 0xec, 0x19,
+	0xe6, 0xd9, 0x8e, 0xd7, 0x55, 0x01, 0x1a, 0x83, 0xba, 0x6d, 0x07, 0x4c, 0x24, 0xb5, 0x2d, 0xe3,
+	0x33, 0x54, 0x9e, 0xa8, 0xca, 0xc6, 0x22, 0x69, 0xe5, 0xa4, 0x32, 0x0b, 0xa3, 0x3f, 0x22, 0x7c,
+	0xf1, 0x78, 0x26, 0x49, 0x22, 0x4f, 0x1f, 0xb5, 0x9f, 0x23, 0xac, 0xe7, 0x52, 0xbb, 0xcb, 0x3c,
+	0x9b, 0x05, 0x31, 0xb3, 0x2f, 0xe0, 0x92, 0x80, 0x03, 0x45, 0xac, 0x92, 0xe6, 0xc6, 0xeb, 0xa7,
+	0x08, 0x5f, 0xc8, 0x4d, 0x63, 0x8b, 0x79, 0xdc, 0x8d, 0xb3, 0x58, 0xc5, 0x4b, 0x76, 0x24, 0xab,
+	0x24, 0xa4, 0x30, 0xb7, 0x1c, 0x4e, 0xec, 0xb2, 0xe6, 0x1d, 0xea, 0x79, 0xac, 0x97, 0xea, 0x32,
+	0x4b, 0x9e, 0xc4, 0x5d, 0xa6, 0xc4, 0xb9, 0x65, 0xf2, 0x1d, 0xc2, 0xeb, 0xb9, 0x99, 0xc0, 0x1b,
+	0xa1, 0xd2, 0x88, 0xdf, 0x1a, 0x34, 0xcf, 0xb7, 0xa6, 0xf0, 0xe4, 0x6f, 0x4d, 0x26, 0xe7, 0xa7,
+	0xef, 0x83, 0xa8, 0xe7, 0x36, 0xe2, 0x6e, 0xdf, 0x75, 0x69, 0x30, 0x98, 0x68, 0x88, 0xe8, 0x5f,
+	0xe5, 0x7f, 0x53, 0x89, 0x0f, 0x45, 0x87, 0x85, 0x97, 0x05, 0x1c, 0x39, 0x2c, 0x66, 0xe2, 0xda,
+	0x04, 0x43, 0x2f, 0x66, 0x23, 0xcf, 0xb7, 0x22, 0x67, 0xe4, 0x57, 0xff, 0xb6, 0x80, 0xd7, 0x4e,
+	0xb3, 0x18, 0x33, 0x0f, 0x57, 0xf1, 0x92, 0xc5, 0xfb, 0x5e, 0x08, 0x8c, 0x16, 0x3b, 0x52, 0x00,
+	0x1b, 0x66, 0x1d, 0x34, 0xe1, 0x66, 0x11, 0x6e, 0x46, 0x07, 0x44, 0xc3, 0xcf, 0x50, 0x6b, 0x5f,
+	0x5e, 0x16, 0xe1, 0x32, 0x91, 0x89, 0x8e, 0x9f, 0x0d, 0x1d, 0x97, 0xf1, 0x7e, 0x28, 0xef, 0x97,
+	0xe0, 0x3e, 0x73, 0x46, 0x18, 0x3e, 0x43, 0xdd, 0xe8, 0x97, 0x28, 0x97, 0x80, 0x95, 0x73, 0x99,
+	0x3a, 0xc6, 0x15, 0x6c, 0x72, 0xc7, 0x6b, 0xbc, 0x11, 0xe1, 0x7d, 0xf0, 0xdb, 0xf9, 0x8d, 0xae,
+	0x13, 0xde, 0xe9, 0xef, 0x45, 0x1d, 0x63, 0x4a, 0x65, 0xf5, 0xa7, 0x2a, 0xec, 0x7d, 0x33, 0x6a,
+	0x74, 0x01, 0x06, 0xa2, 0x13, 0xfb, 0xae, 0x7d, 0xbf, 0x82, 0x97, 0xa0, 0x4a, 0xe4, 0x01, 0xc2,
+	0x25, 0xb9, 0x4a, 0x90, 0x37, 0x27, 0x28, 0xc0, 0xf1, 0x9d, 0x46, 0x7b, 0x6b, 0x5a, 0x33, 0xd9,
+	0x02, 0xfa, 0xe6, 0x67, 0x3f, 0xfd, 0xf1, 0x4d, 0xe1, 0x35, 0x72, 0xc9, 0x9c, 0x74, 0x75, 0x23,
+	0x3f, 0x23, 0x8c, 0xdb, 0x2c, 0x8c, 0xfb, 0xfe, 0xea, 0xa4, 0x91, 0x73, 0xb7, 0x21, 0xad, 0x3e,
+	0x93, 0x79, 0xfa, 0xab, 0xd6, 0xdb, 0x80, 0xa1, 0x4e, 0xae, 0x4d, 0x84, 0x01, 0xa2, 0x9b, 0xf7,
+	0x93, 0x0e, 0x1b, 0x9a, 0xf7, 0xe5, 0xee, 0x34, 0x24, 0xff, 0x21, 0xac, 0x45, 0xc8, 0xf2, 0x67,
+	0x3c, 0x69, 0x4d, 0xcc, 0xf1, 0xa9, 0x4b, 0x82, 0x76, 0x63, 0x26, 0x3f, 0xb9, 0x23, 0x5e, 0xff,
+	0x00, 0xb0, 0xb7, 0xc9, 0xf6, 0x24, 0xd8, 0xa5, 0xbb, 0x6a, 0xc0, 0x2c, 0xe6, 0x1c, 0xb0, 0xa0,
+	0x9a, 0x90, 0xa1, 0x96, 0x94, 0x21, 0xf9, 0x07, 0xe1, 0x73, 0x39, 0x0c, 0xc8, 0x51, 0x4c, 0xb6,
+	0x67, 0x25, 0x20, 0x33, 0xca, 0xb5, 0xfa, 0x4c, 0x6e, 0x32, 0xb0, 0x6f, 0x00, 0xec, 0x2d, 0xd2,
+	0x98, 0x02, 0xb6, 0x5c, 0x18, 0x46, 0xa0, 0xa5, 0x3c, 0x24, 0x7f, 0x22, 0x5c, 0xce, 0xc1, 0x0c,
+	0x73, 0x9f, 0x6c, 0xcd, 0x0a, 0x39, 0xbd, 0x36, 0xcc, 0x03, 0xf1, 0x75, 0x40, 0x7c, 0x85, 0xbc,
+	0x33, 0x05, 0x62, 0xd8, 0x4e, 0x62, 0xc0, 0xe4, 0xdf, 0xfc, 0xee, 0x6e, 0xc6, 0xab, 0xc3, 0xac,
+	0x48, 0xb3, 0xcb, 0xc9, 0x3c, 0xb0, 0xde, 0x04, 0xac, 0x2d, 0xb2, 0x35, 0x05, 0x56, 0xb5, 0x01,
+	0x8d, 0xca, 0xab, 0x0e, 0x86, 0xe4, 0x6f, 0x84, 0x5f, 0xcc, 0xc1, 0x1d, 0x6d, 0x20, 0xa4, 0x39,
+	0x2b, 0xe8, 0xd4, 0x1e, 0x34, 0x0f, 0xc4, 0x2d, 0x40, 0x7c, 0x9d, 0xbc, 0x37, 0x05, 0xe2, 0x68,
+	0xa6, 0x8c, 0xe0, 0x46, 0xd2, 0x90, 0xfc, 0x85, 0xf0, 0xf3, 0xf9, 0x53, 0x76, 0xc6, 0x46, 0xce,
+	0xae, 0x1d, 0xda, 0xf6, 0x13, 0x7a, 0x51, 0x70, 0x1b, 0x00, 0xf7, 0x5d, 0x72, 0x65, 0x0a, 0xb8,
+	0x0a, 0x69, 0x55, 0xa8, 0x45, 0xe3, 0xd6, 0xc3, 0xc3, 0x0a, 0x7a, 0x74, 0x58, 0x41, 0xbf, 0x1f,
+	0x56, 0xd0, 0xd7, 0x47, 0x95, 0x85, 0x47, 0x47, 0x95, 0x85, 0x5f, 0x8e, 0x2a, 0x0b, 0xb7, 0xdf,
+	0x4e, 0x8d, 0xe2, 0x13, 0xfc, 0x1f, 0x5c, 0x36, 0xef, 0xa5, 0x83, 0xc0, 0x7c, 0xde, 0x2b, 0xc1,
+	0x7f, 0x0d, 0x2e, 0xff, 0x3f, 0x00, 0xc5, 0x36, 0x29, 0x68, 0x99, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of RollappPacket items by rollappID.
+	GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by rollappID and receiver.
+	GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error)
+	// Queries a list of pending RollappPacket items by the original transfer sender.
+	GetPendingPacketsBySender(ctx context.Context, in *QueryPendingPacketsBySenderRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by the transfer denom.
+	GetPendingPacketsByDenom(ctx context.Context, in *QueryPendingPacketsByDenomRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by the Hub side channel.
+	GetPendingPacketsByChannel(ctx context.Context, in *QueryPendingPacketsByChannelRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by the packet type.
+	GetPendingPacketsByType(ctx context.Context, in *QueryPendingPacketsByTypeRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error)
+	// Queries the number and the summed amounts of pending packets per rollapp.
+	PendingPacketsSummary(ctx context.Context, in *QueryPendingPacketsSummaryRequest, opts ...grpc.CallOption) (*QueryPendingPacketsSummaryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+	return out, nil
+}
+
+func (c *queryClient) GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error) {
+	out := new(QueryRollappPacketListResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error) {
+	out := new(QueryPendingPacketByAddressListResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRollappPacketListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetPendingPacketsBySender(ctx context.Context, in *QueryPendingPacketsBySenderRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error) {
+	out := new(QueryPendingPacketListResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RollappPackets) > 0 {
-		for _, e := range m.RollappPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetPendingPacketsByDenom(ctx context.Context, in *QueryPendingPacketsByDenomRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error) {
+	out := new(QueryPendingPacketListResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPendingPacketsByChannel(ctx context.Context, in *QueryPendingPacketsByChannelRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error) {
+	out := new(QueryPendingPacketListResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPendingPacketsByType(ctx context.Context, in *QueryPendingPacketsByTypeRequest, opts ...grpc.CallOption) (*QueryPendingPacketListResponse, error) {
+	out := new(QueryPendingPacketListResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPacketsSummary(ctx context.Context, in *QueryPendingPacketsSummaryRequest, opts ...grpc.CallOption) (*QueryPendingPacketsSummaryResponse, error) {
+	out := new(QueryPendingPacketsSummaryResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/PendingPacketsSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of RollappPacket items by rollappID.
+	GetPackets(context.Context, *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by rollappID and receiver.
+	GetPendingPacketsByAddress(context.Context, *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error)
+	// Queries a list of pending RollappPacket items by the original transfer sender.
+	GetPendingPacketsBySender(context.Context, *QueryPendingPacketsBySenderRequest) (*QueryPendingPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by the transfer denom.
+	GetPendingPacketsByDenom(context.Context, *QueryPendingPacketsByDenomRequest) (*QueryPendingPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by the Hub side channel.
+	GetPendingPacketsByChannel(context.Context, *QueryPendingPacketsByChannelRequest) (*QueryPendingPacketListResponse, error)
+	// Queries a list of pending RollappPacket items by the packet type.
+	GetPendingPacketsByType(context.Context, *QueryPendingPacketsByTypeRequest) (*QueryPendingPacketListResponse, error)
+	// Queries the number and the summed amounts of pending packets per rollapp.
+	PendingPacketsSummary(context.Context, *QueryPendingPacketsSummaryRequest) (*QueryPendingPacketsSummaryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetPackets(ctx context.Context, req *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPackets not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingPacketsByAddress(ctx context.Context, req *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingPacketsBySender(ctx context.Context, req *QueryPendingPacketsBySenderRequest) (*QueryPendingPacketListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsBySender not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingPacketsByDenom(ctx context.Context, req *QueryPendingPacketsByDenomRequest) (*QueryPendingPacketListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingPacketsByChannel(ctx context.Context, req *QueryPendingPacketsByChannelRequest) (*QueryPendingPacketListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByChannel not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingPacketsByType(ctx context.Context, req *QueryPendingPacketsByTypeRequest) (*QueryPendingPacketListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByType not implemented")
+}
+func (*UnimplementedQueryServer) PendingPacketsSummary(ctx context.Context, req *QueryPendingPacketsSummaryRequest) (*QueryPendingPacketsSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPacketsSummary not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRollappPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPackets(ctx, req.(*QueryRollappPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingPacketsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingPacketsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingPacketsByAddress(ctx, req.(*QueryPendingPacketsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingPacketsBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingPacketsBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingPacketsBySender(ctx, req.(*QueryPendingPacketsBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingPacketsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingPacketsByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingPacketsByDenom(ctx, req.(*QueryPendingPacketsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingPacketsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsByChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingPacketsByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingPacketsByChannel(ctx, req.(*QueryPendingPacketsByChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingPacketsByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingPacketsByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetPendingPacketsByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingPacketsByType(ctx, req.(*QueryPendingPacketsByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPacketsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPacketsSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/PendingPacketsSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPacketsSummary(ctx, req.(*QueryPendingPacketsSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.delayedack.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetPackets",
+			Handler:    _Query_GetPackets_Handler,
+		},
+		{
+			MethodName: "GetPendingPacketsByAddress",
+			Handler:    _Query_GetPendingPacketsByAddress_Handler,
+		},
+		{
+			MethodName: "GetPendingPacketsBySender",
+			Handler:    _Query_GetPendingPacketsBySender_Handler,
+		},
+		{
+			MethodName: "GetPendingPacketsByDenom",
+			Handler:    _Query_GetPendingPacketsByDenom_Handler,
+		},
+		{
+			MethodName: "GetPendingPacketsByChannel",
+			Handler:    _Query_GetPendingPacketsByChannel_Handler,
+		},
+		{
+			MethodName: "GetPendingPacketsByType",
+			Handler:    _Query_GetPendingPacketsByType_Handler,
+		},
+		{
+			MethodName: "PendingPacketsSummary",
+			Handler:    _Query_PendingPacketsSummary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/delayedack/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappPacketListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappPacketListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappPacketListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappPackets) > 0 {
+		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketByAddressListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketByAddressListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketByAddressListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappPackets) > 0 {
+		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsByChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsByChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsByChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappPackets) > 0 {
+		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for iNdEx := len(m.Summaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Summaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollappPendingPacketsSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappPendingPacketsSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappPendingPacketsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TimeoutCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AckCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AckCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecvCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecvCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRollappPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappPacketListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RollappPackets) > 0 {
+		for _, e := range m.RollappPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketByAddressListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RollappPackets) > 0 {
+		for _, e := range m.RollappPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsByChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RollappPackets) > 0 {
+		for _, e := range m.RollappPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Summaries) > 0 {
+		for _, e := range m.Summaries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollappPendingPacketsSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.RecvCount != 0 {
+		n += 1 + sovQuery(uint64(m.RecvCount))
+	}
+	if m.AckCount != 0 {
+		n += 1 + sovQuery(uint64(m.AckCount))
+	}
+	if m.TimeoutCount != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutCount))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappPacketListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappPacketListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappPackets = append(m.RollappPackets, types.RollappPacket{})
+			if err := m.RollappPackets[len(m.RollappPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketByAddressListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketByAddressListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappPackets = append(m.RollappPackets, types.RollappPacket{})
+			if err := m.RollappPackets[len(m.RollappPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryPendingPacketsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryPendingPacketByAddressListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RollappPackets) > 0 {
-		for _, e := range m.RollappPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingPacketsBySenderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsBySenderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketsByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -942,12 +2868,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketsByDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -969,7 +2963,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketsByChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -992,15 +2986,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketsByChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketsByChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1027,7 +3053,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1052,7 +3081,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketsByTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1075,64 +3104,13 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappPacketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketsByTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketsByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
@@ -1151,7 +3129,7 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1208,7 +3186,7 @@ func (m *QueryRollappPacketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1231,10 +3209,10 @@ func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappPacketListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappPacketListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1328,7 +3306,7 @@ func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingPacketsByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketsSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1351,15 +3329,15 @@ func (m *QueryPendingPacketsByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingPacketsByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketsSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingPacketsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketsSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1387,11 +3365,61 @@ func (m *QueryPendingPacketsByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summaries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1418,10 +3446,8 @@ func (m *QueryPendingPacketsByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Summaries = append(m.Summaries, RollappPendingPacketsSummary{})
+			if err := m.Summaries[len(m.Summaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1446,7 +3472,7 @@ func (m *QueryPendingPacketsByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
+func (m *RollappPendingPacketsSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,17 +3495,17 @@ func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingPacketByAddressListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollappPendingPacketsSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingPacketByAddressListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollappPendingPacketsSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappPackets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1489,29 +3515,103 @@ func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappPackets = append(m.RollappPackets, types.RollappPacket{})
-			if err := m.RollappPackets[len(m.RollappPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvCount", wireType)
+			}
+			m.RecvCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckCount", wireType)
+			}
+			m.AckCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutCount", wireType)
+			}
+			m.TimeoutCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1538,10 +3638,8 @@ func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
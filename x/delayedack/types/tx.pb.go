@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	types "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -247,11 +248,238 @@ func (m *MsgFinalizePacketByPacketKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFinalizePacketByPacketKeyResponse proto.InternalMessageInfo
 
+// MsgFinalizeRollappPackets finalizes pending packets of a rollapp up to its
+// latest finalized height. Packets are processed in key order, so repeated
+// calls resume where the previous one stopped.
+type MsgFinalizeRollappPackets struct {
+	// Sender is the signer of the message.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// MaxPackets is the maximum number of packets to finalize. Zero means the
+	// default limit.
+	MaxPackets uint64 `protobuf:"varint,3,opt,name=max_packets,json=maxPackets,proto3" json:"max_packets,omitempty"`
+	// MaxGas is the gas budget for finalization. No further packet is
+	// finalized once the budget is spent. Zero means no budget.
+	MaxGas uint64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+}
+
+func (m *MsgFinalizeRollappPackets) Reset()         { *m = MsgFinalizeRollappPackets{} }
+func (m *MsgFinalizeRollappPackets) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeRollappPackets) ProtoMessage()    {}
+func (*MsgFinalizeRollappPackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{4}
+}
+func (m *MsgFinalizeRollappPackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeRollappPackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeRollappPackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeRollappPackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeRollappPackets.Merge(m, src)
+}
+func (m *MsgFinalizeRollappPackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeRollappPackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeRollappPackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeRollappPackets proto.InternalMessageInfo
+
+func (m *MsgFinalizeRollappPackets) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFinalizeRollappPackets) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgFinalizeRollappPackets) GetMaxPackets() uint64 {
+	if m != nil {
+		return m.MaxPackets
+	}
+	return 0
+}
+
+func (m *MsgFinalizeRollappPackets) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+type MsgFinalizeRollappPacketsResponse struct {
+	// Finalized are the outcomes of the finalized packets, in order.
+	Finalized []FinalizedPacket `protobuf:"bytes,1,rep,name=finalized,proto3" json:"finalized"`
+	// HasMore is true if pending packets up to the latest finalized height
+	// remain.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (m *MsgFinalizeRollappPacketsResponse) Reset()         { *m = MsgFinalizeRollappPacketsResponse{} }
+func (m *MsgFinalizeRollappPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizeRollappPacketsResponse) ProtoMessage()    {}
+func (*MsgFinalizeRollappPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{5}
+}
+func (m *MsgFinalizeRollappPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizeRollappPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizeRollappPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizeRollappPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizeRollappPacketsResponse.Merge(m, src)
+}
+func (m *MsgFinalizeRollappPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizeRollappPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizeRollappPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizeRollappPacketsResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizeRollappPacketsResponse) GetFinalized() []FinalizedPacket {
+	if m != nil {
+		return m.Finalized
+	}
+	return nil
+}
+
+func (m *MsgFinalizeRollappPacketsResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+// FinalizedPacket is the outcome of a single packet finalization.
+type FinalizedPacket struct {
+	// PacketKey is the base64 encoded key of the finalized packet.
+	PacketKey string `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	// PacketProofHeight is a height at which the proof was retrieved.
+	PacketProofHeight uint64 `protobuf:"varint,2,opt,name=packet_proof_height,json=packetProofHeight,proto3" json:"packet_proof_height,omitempty"`
+	// PacketType is a type of the packet. Eg, RECV, ACK, TIMEOUT.
+	PacketType types.RollappPacket_Type `protobuf:"varint,3,opt,name=packet_type,json=packetType,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"packet_type,omitempty"`
+	// PacketSrcChannel identifies the channel end on the sending chain.
+	PacketSrcChannel string `protobuf:"bytes,4,opt,name=packet_src_channel,json=packetSrcChannel,proto3" json:"packet_src_channel,omitempty"`
+	// PacketSequence is a sequence number of the packet.
+	PacketSequence uint64 `protobuf:"varint,5,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// Error is the error returned by the packet handler, if any. The packet is
+	// finalized regardless.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FinalizedPacket) Reset()         { *m = FinalizedPacket{} }
+func (m *FinalizedPacket) String() string { return proto.CompactTextString(m) }
+func (*FinalizedPacket) ProtoMessage()    {}
+func (*FinalizedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{6}
+}
+func (m *FinalizedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizedPacket.Merge(m, src)
+}
+func (m *FinalizedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizedPacket proto.InternalMessageInfo
+
+func (m *FinalizedPacket) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *FinalizedPacket) GetPacketProofHeight() uint64 {
+	if m != nil {
+		return m.PacketProofHeight
+	}
+	return 0
+}
+
+func (m *FinalizedPacket) GetPacketType() types.RollappPacket_Type {
+	if m != nil {
+		return m.PacketType
+	}
+	return types.RollappPacket_ON_RECV
+}
+
+func (m *FinalizedPacket) GetPacketSrcChannel() string {
+	if m != nil {
+		return m.PacketSrcChannel
+	}
+	return ""
+}
+
+func (m *FinalizedPacket) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *FinalizedPacket) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacket")
 	proto.RegisterType((*MsgFinalizePacketResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketResponse")
 	proto.RegisterType((*MsgFinalizePacketByPacketKey)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKey")
 	proto.RegisterType((*MsgFinalizePacketByPacketKeyResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKeyResponse")
+	proto.RegisterType((*MsgFinalizeRollappPackets)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizeRollappPackets")
+	proto.RegisterType((*MsgFinalizeRollappPacketsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizeRollappPacketsResponse")
+	proto.RegisterType((*FinalizedPacket)(nil), "dymensionxyz.dymension.delayedack.FinalizedPacket")
 }
 
 func init() {
@@ -259,37 +487,48 @@ func init() {
 }
 
 var fileDescriptor_604a74c1ca57f5ed = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0xa4, 0x6d, 0xa0, 0xaf, 0x10, 0xed, 0x08, 0xba, 0x5d, 0x75, 0x89, 0x41, 0x34, 0x04,
-	0x99, 0xa5, 0xa9, 0x20, 0x88, 0x20, 0x54, 0xf0, 0x0f, 0x52, 0xa8, 0xab, 0x27, 0x2f, 0x61, 0xb3,
-	0xfb, 0xdc, 0x2c, 0xd9, 0x9d, 0x19, 0x77, 0xb6, 0x25, 0xd3, 0x93, 0x88, 0x1f, 0xc0, 0x2f, 0xe1,
-	0xbd, 0x78, 0xf5, 0x0b, 0x78, 0xec, 0xd1, 0xa3, 0x24, 0x87, 0x7e, 0x0d, 0xc9, 0xce, 0x6e, 0x6d,
-	0x0d, 0xa9, 0xd0, 0x9e, 0x66, 0xdf, 0xfb, 0xbd, 0xf7, 0x7b, 0xbf, 0xfd, 0xcd, 0x3c, 0xe8, 0x86,
-	0x3a, 0x45, 0xae, 0x62, 0xc1, 0xc7, 0xfa, 0xc0, 0x3d, 0x09, 0xdc, 0x10, 0x13, 0x5f, 0x63, 0xe8,
-	0x07, 0x23, 0x37, 0x1f, 0x33, 0x99, 0x89, 0x5c, 0xd0, 0x3b, 0xa7, 0x6b, 0xd9, 0x49, 0xc0, 0xfe,
-	0xd6, 0xda, 0x37, 0x02, 0xa1, 0x52, 0xa1, 0xdc, 0x54, 0x45, 0xee, 0xfe, 0xe6, 0xec, 0x30, 0xbd,
-	0x76, 0x6f, 0xc1, 0x9c, 0x40, 0xa4, 0xa9, 0xe0, 0x6e, 0x26, 0x92, 0xc4, 0x97, 0xb2, 0x2f, 0xfd,
-	0x60, 0x84, 0xb9, 0xe9, 0x69, 0x7f, 0xaf, 0xc3, 0xfa, 0x8e, 0x8a, 0x9e, 0xc7, 0xdc, 0x4f, 0xe2,
-	0x03, 0xdc, 0x2d, 0x30, 0x7a, 0x1d, 0x1a, 0x0a, 0x79, 0x88, 0x99, 0x45, 0x5a, 0xa4, 0xb3, 0xea,
-	0x95, 0x11, 0xbd, 0x0d, 0x50, 0xb1, 0xc4, 0xa1, 0x55, 0x2f, 0xb0, 0xd5, 0x32, 0xf3, 0x2a, 0xa4,
-	0x0c, 0xae, 0x19, 0xf2, 0xbe, 0xcc, 0x84, 0xf8, 0xd0, 0x1f, 0x62, 0x1c, 0x0d, 0x73, 0x6b, 0xa9,
-	0x45, 0x3a, 0xcb, 0xde, 0xba, 0x81, 0x76, 0x67, 0xc8, 0xcb, 0x02, 0xa0, 0x1e, 0xac, 0x95, 0xf5,
-	0xb9, 0x96, 0x68, 0x2d, 0xb7, 0x48, 0xa7, 0xd9, 0xdb, 0x64, 0x0b, 0x2c, 0x30, 0xbf, 0xc1, 0x3c,
-	0x33, 0xce, 0x28, 0x65, 0xef, 0xb4, 0x44, 0x0f, 0x0c, 0xcb, 0xec, 0x9b, 0x3e, 0x00, 0x5a, 0x72,
-	0xaa, 0x2c, 0xe8, 0x07, 0x43, 0x9f, 0x73, 0x4c, 0xac, 0x95, 0x42, 0xea, 0x55, 0x83, 0xbc, 0xcd,
-	0x82, 0x67, 0x26, 0x4f, 0xef, 0xc3, 0x95, 0xaa, 0x1a, 0x3f, 0xee, 0x21, 0x0f, 0xd0, 0x6a, 0x14,
-	0x6a, 0x9b, 0x65, 0x69, 0x99, 0x7d, 0xbc, 0xf6, 0xf9, 0xf8, 0xb0, 0x5b, 0xda, 0xd0, 0xbe, 0x09,
-	0x1b, 0x73, 0x9e, 0x79, 0xa8, 0xa4, 0xe0, 0x0a, 0xdb, 0x03, 0xb8, 0x35, 0x07, 0x6e, 0x6b, 0x73,
-	0xbe, 0x46, 0x7d, 0x9e, 0xb7, 0xa5, 0x94, 0x11, 0xea, 0xca, 0x5b, 0x59, 0xb5, 0x9d, 0x15, 0x70,
-	0x0f, 0xee, 0x9e, 0x37, 0xa3, 0xd2, 0xd2, 0xfb, 0x51, 0x87, 0xa5, 0x1d, 0x15, 0xd1, 0x2f, 0x04,
-	0x9a, 0xff, 0x5c, 0xf1, 0x43, 0xf6, 0xdf, 0x97, 0xc6, 0xe6, 0x66, 0xd8, 0x4f, 0x2e, 0xd2, 0x55,
-	0xc9, 0xa1, 0xdf, 0x08, 0x6c, 0x2c, 0x36, 0xe6, 0xe9, 0x45, 0xb8, 0x4f, 0x11, 0xd8, 0x2f, 0x2e,
-	0x49, 0x50, 0xe9, 0xb4, 0x57, 0x3e, 0x1d, 0x1f, 0x76, 0xc9, 0xf6, 0x9b, 0x9f, 0x13, 0x87, 0x1c,
-	0x4d, 0x1c, 0xf2, 0x7b, 0xe2, 0x90, 0xaf, 0x53, 0xa7, 0x76, 0x34, 0x75, 0x6a, 0xbf, 0xa6, 0x4e,
-	0xed, 0xfd, 0xa3, 0x28, 0xce, 0x87, 0x7b, 0x83, 0xd9, 0x93, 0x74, 0x17, 0x2c, 0xdd, 0xfe, 0x96,
-	0x3b, 0x3e, 0xb3, 0xe1, 0x5a, 0xa2, 0x1a, 0x34, 0x8a, 0xad, 0xdb, 0xfa, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x99, 0xb8, 0x5a, 0x94, 0x13, 0x04, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6b, 0xd4, 0x4e,
+	0x18, 0xde, 0xd9, 0x7f, 0xed, 0xbe, 0x0b, 0xed, 0xaf, 0xf3, 0x2b, 0x6d, 0xba, 0xea, 0x76, 0xbb,
+	0x88, 0x2e, 0x45, 0x12, 0xba, 0x15, 0x04, 0x11, 0x84, 0x2a, 0x56, 0x91, 0x42, 0x8d, 0xe2, 0xc1,
+	0xcb, 0x32, 0x4d, 0xa6, 0xd9, 0xa5, 0x9b, 0x4c, 0xcc, 0xa4, 0x25, 0xe9, 0x49, 0xc4, 0x0f, 0xe0,
+	0x49, 0x10, 0xbc, 0x78, 0xf0, 0x5e, 0xfc, 0x14, 0x3d, 0xf6, 0x28, 0x1e, 0x44, 0xda, 0x43, 0xbf,
+	0x86, 0x24, 0x33, 0xd9, 0x36, 0x5b, 0x53, 0xeb, 0x16, 0x4f, 0x99, 0x99, 0xf7, 0x7d, 0x9f, 0x79,
+	0xe6, 0x79, 0x9f, 0xc9, 0xc0, 0xa2, 0x19, 0xda, 0xd4, 0xe1, 0x3d, 0xe6, 0x04, 0xe1, 0xae, 0x36,
+	0x98, 0x68, 0x26, 0xed, 0x93, 0x90, 0x9a, 0xc4, 0xd8, 0xd2, 0xfc, 0x40, 0x75, 0x3d, 0xe6, 0x33,
+	0xbc, 0x70, 0x3a, 0x57, 0x1d, 0x4c, 0xd4, 0x93, 0xdc, 0xda, 0xb4, 0xc5, 0x2c, 0x16, 0x67, 0x6b,
+	0xd1, 0x48, 0x14, 0xd6, 0x66, 0x0d, 0xc6, 0x6d, 0xc6, 0x35, 0x9b, 0x5b, 0xda, 0xce, 0x52, 0xf4,
+	0x91, 0x81, 0x76, 0xc6, 0xee, 0x06, 0xb3, 0x6d, 0xe6, 0x68, 0x1e, 0xeb, 0xf7, 0x89, 0xeb, 0x76,
+	0x5c, 0x62, 0x6c, 0x51, 0x5f, 0xd4, 0x34, 0xbf, 0xe6, 0x61, 0x6a, 0x8d, 0x5b, 0x8f, 0x7a, 0x0e,
+	0xe9, 0xf7, 0x76, 0xe9, 0x7a, 0x1c, 0xc3, 0x33, 0x50, 0xe6, 0xd4, 0x31, 0xa9, 0xa7, 0xa0, 0x06,
+	0x6a, 0x55, 0x74, 0x39, 0xc3, 0xd7, 0x00, 0x12, 0x94, 0x9e, 0xa9, 0xe4, 0xe3, 0x58, 0x45, 0xae,
+	0x3c, 0x31, 0xb1, 0x0a, 0xff, 0x0b, 0xf0, 0x8e, 0xeb, 0x31, 0xb6, 0xd9, 0xe9, 0xd2, 0x9e, 0xd5,
+	0xf5, 0x95, 0x42, 0x03, 0xb5, 0x8a, 0xfa, 0x94, 0x08, 0xad, 0x47, 0x91, 0xc7, 0x71, 0x00, 0xeb,
+	0x50, 0x95, 0xf9, 0x7e, 0xe8, 0x52, 0xa5, 0xd8, 0x40, 0xad, 0x89, 0xf6, 0x92, 0x9a, 0x21, 0x8c,
+	0x38, 0x86, 0xaa, 0x8b, 0xed, 0x04, 0x53, 0xf5, 0x45, 0xe8, 0x52, 0x1d, 0x04, 0x4a, 0x34, 0xc6,
+	0xb7, 0x00, 0x4b, 0x4c, 0xee, 0x19, 0x1d, 0xa3, 0x4b, 0x1c, 0x87, 0xf6, 0x95, 0x52, 0x4c, 0xf5,
+	0x3f, 0x11, 0x79, 0xee, 0x19, 0x0f, 0xc4, 0x3a, 0xbe, 0x09, 0x93, 0x49, 0x36, 0x7d, 0xbd, 0x4d,
+	0x1d, 0x83, 0x2a, 0xe5, 0x98, 0xed, 0x84, 0x4c, 0x95, 0xab, 0x77, 0xab, 0x6f, 0x8f, 0xf7, 0x16,
+	0xa5, 0x0c, 0xcd, 0x2b, 0x30, 0x77, 0x46, 0x33, 0x9d, 0x72, 0x97, 0x39, 0x9c, 0x36, 0x37, 0xe0,
+	0xea, 0x99, 0xe0, 0x4a, 0x28, 0xbe, 0x4f, 0x69, 0x78, 0x9e, 0xb6, 0x92, 0xca, 0x16, 0x0d, 0x13,
+	0x6d, 0xdd, 0xa4, 0x2c, 0x4d, 0xe0, 0x06, 0x5c, 0x3f, 0x6f, 0x8f, 0x01, 0x97, 0x8f, 0x28, 0xc5,
+	0x34, 0x25, 0x1d, 0x1f, 0xb5, 0xcb, 0xf3, 0x50, 0xb5, 0x49, 0x20, 0x6d, 0xc4, 0x65, 0x77, 0xc1,
+	0x26, 0x41, 0x82, 0x3b, 0x0b, 0x63, 0x51, 0x82, 0x45, 0x78, 0xdc, 0xd2, 0xa2, 0x5e, 0xb6, 0x49,
+	0xb0, 0x4a, 0x78, 0xfa, 0x0c, 0x1f, 0x10, 0x2c, 0x64, 0x72, 0x4b, 0x4e, 0x80, 0x5f, 0x42, 0x65,
+	0x53, 0x66, 0x98, 0x0a, 0x6a, 0x14, 0x5a, 0xd5, 0x76, 0x5b, 0xfd, 0xe3, 0xcd, 0x51, 0x13, 0x54,
+	0x53, 0x6a, 0x53, 0xdc, 0xff, 0x31, 0x9f, 0xd3, 0x4f, 0xa0, 0xf0, 0x1c, 0x8c, 0x77, 0x09, 0xef,
+	0xd8, 0xcc, 0xa3, 0xf1, 0x09, 0xc7, 0xf5, 0xb1, 0x2e, 0xe1, 0x6b, 0xcc, 0xa3, 0xcd, 0xcf, 0x79,
+	0x98, 0x1c, 0xaa, 0x1f, 0x6a, 0x0e, 0x1a, 0x6a, 0x4e, 0x96, 0xf1, 0xf3, 0x17, 0x34, 0x7e, 0xe1,
+	0xdf, 0x19, 0xbf, 0x78, 0x71, 0xe3, 0x97, 0x7e, 0x67, 0x7c, 0x3c, 0x0d, 0x25, 0xea, 0x79, 0xcc,
+	0x8b, 0xef, 0x45, 0x45, 0x17, 0x93, 0xf6, 0xf7, 0x02, 0x14, 0xd6, 0xb8, 0x85, 0xdf, 0x21, 0x98,
+	0x18, 0xfa, 0x77, 0xdc, 0xbe, 0x40, 0x7b, 0xce, 0x98, 0xb7, 0x76, 0x6f, 0x94, 0xaa, 0x81, 0x4b,
+	0xbe, 0x20, 0x98, 0xcb, 0xbe, 0x71, 0xf7, 0x47, 0xc1, 0x3e, 0x05, 0x50, 0x5b, 0xbd, 0x24, 0xc0,
+	0x80, 0xe7, 0x27, 0x04, 0x33, 0x19, 0x97, 0xf1, 0x2f, 0x05, 0x48, 0x57, 0xd7, 0x1e, 0x5e, 0xa6,
+	0x3a, 0xa1, 0x57, 0x2b, 0xbd, 0x39, 0xde, 0x5b, 0x44, 0x2b, 0xcf, 0xf6, 0x0f, 0xeb, 0xe8, 0xe0,
+	0xb0, 0x8e, 0x7e, 0x1e, 0xd6, 0xd1, 0xfb, 0xa3, 0x7a, 0xee, 0xe0, 0xa8, 0x9e, 0xfb, 0x76, 0x54,
+	0xcf, 0xbd, 0xba, 0x63, 0xf5, 0xfc, 0xee, 0xf6, 0x46, 0xe4, 0x48, 0x2d, 0xe3, 0xb1, 0xd9, 0x59,
+	0xd6, 0x82, 0xd4, 0x7b, 0x17, 0xba, 0x94, 0x6f, 0x94, 0xe3, 0xd7, 0x66, 0xf9, 0xd7, 0x00, 0xba,
+	0xb1, 0x24, 0x4a, 0x21, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -307,6 +546,9 @@ type MsgClient interface {
 	// FinalizePacket finalizes a singe packet.
 	FinalizePacket(ctx context.Context, in *MsgFinalizePacket, opts ...grpc.CallOption) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(ctx context.Context, in *MsgFinalizePacketByPacketKey, opts ...grpc.CallOption) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizeRollappPackets finalizes pending packets of a rollapp up to its
+	// latest finalized height.
+	FinalizeRollappPackets(ctx context.Context, in *MsgFinalizeRollappPackets, opts ...grpc.CallOption) (*MsgFinalizeRollappPacketsResponse, error)
 }
 
 type msgClient struct {
@@ -335,11 +577,23 @@ func (c *msgClient) FinalizePacketByPacketKey(ctx context.Context, in *MsgFinali
 	return out, nil
 }
 
+func (c *msgClient) FinalizeRollappPackets(ctx context.Context, in *MsgFinalizeRollappPackets, opts ...grpc.CallOption) (*MsgFinalizeRollappPacketsResponse, error) {
+	out := new(MsgFinalizeRollappPacketsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Msg/FinalizeRollappPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// FinalizePacket finalizes a singe packet.
 	FinalizePacket(context.Context, *MsgFinalizePacket) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(context.Context, *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizeRollappPackets finalizes pending packets of a rollapp up to its
+	// latest finalized height.
+	FinalizeRollappPackets(context.Context, *MsgFinalizeRollappPackets) (*MsgFinalizeRollappPacketsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -352,6 +606,9 @@ func (*UnimplementedMsgServer) FinalizePacket(ctx context.Context, req *MsgFinal
 func (*UnimplementedMsgServer) FinalizePacketByPacketKey(ctx context.Context, req *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePacketByPacketKey not implemented")
 }
+func (*UnimplementedMsgServer) FinalizeRollappPackets(ctx context.Context, req *MsgFinalizeRollappPackets) (*MsgFinalizeRollappPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeRollappPackets not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -393,6 +650,24 @@ func _Msg_FinalizePacketByPacketKey_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FinalizeRollappPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizeRollappPackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizeRollappPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Msg/FinalizeRollappPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizeRollappPackets(ctx, req.(*MsgFinalizeRollappPackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -405,6 +680,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePacketByPacketKey",
 			Handler:    _Msg_FinalizePacketByPacketKey_Handler,
 		},
+		{
+			MethodName: "FinalizeRollappPackets",
+			Handler:    _Msg_FinalizeRollappPackets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/tx.proto",
@@ -552,71 +831,224 @@ func (m *MsgFinalizePacketByPacketKeyResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgFinalizeRollappPackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgFinalizePacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgFinalizeRollappPackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRollappPackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PacketProofHeight != 0 {
-		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	if m.MaxGas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PacketType != 0 {
-		n += 1 + sovTx(uint64(m.PacketType))
+	if m.MaxPackets != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxPackets))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PacketSrcChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PacketSequence != 0 {
-		n += 1 + sovTx(uint64(m.PacketSequence))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinalizePacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFinalizeRollappPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFinalizePacketByPacketKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFinalizeRollappPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizeRollappPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.PacketKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Finalized) > 0 {
+		for iNdEx := len(m.Finalized) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Finalized[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PacketSrcChannel) > 0 {
+		i -= len(m.PacketSrcChannel)
+		copy(dAtA[i:], m.PacketSrcChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PacketSrcChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PacketType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketProofHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFinalizePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	}
+	if m.PacketType != 0 {
+		n += 1 + sovTx(uint64(m.PacketType))
+	}
+	l = len(m.PacketSrcChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTx(uint64(m.PacketSequence))
+	}
+	return n
+}
+
+func (m *MsgFinalizePacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizePacketByPacketKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
 
 func (m *MsgFinalizePacketByPacketKeyResponse) Size() (n int) {
@@ -628,6 +1060,77 @@ func (m *MsgFinalizePacketByPacketKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFinalizeRollappPackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxPackets != 0 {
+		n += 1 + sovTx(uint64(m.MaxPackets))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovTx(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *MsgFinalizeRollappPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalized) > 0 {
+		for _, e := range m.Finalized {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *FinalizedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	}
+	if m.PacketType != 0 {
+		n += 1 + sovTx(uint64(m.PacketType))
+	}
+	l = len(m.PacketSrcChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTx(uint64(m.PacketSequence))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1051,6 +1554,465 @@ func (m *MsgFinalizePacketByPacketKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFinalizeRollappPackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRollappPackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRollappPackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPackets", wireType)
+			}
+			m.MaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizeRollappPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizeRollappPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizeRollappPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalized = append(m.Finalized, FinalizedPacket{})
+			if err := m.Finalized[len(m.Finalized)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+			}
+			m.PacketProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			m.PacketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,6 +26,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
+	cmd.AddCommand(CmdQueryVault())
+	cmd.AddCommand(CmdQueryVaults())
+	cmd.AddCommand(CmdQueryFulfillerStats())
+	cmd.AddCommand(CmdQuoteDemandOrder())
+	cmd.AddCommand(CmdQueryRollappFees())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
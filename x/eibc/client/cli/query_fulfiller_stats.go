@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryFulfillerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfiller-stats [fulfiller]",
+		Short:   "Query the stats of a fulfiller per rollapp and denom",
+		Example: "dymd query eibc fulfiller-stats <fulfiller> --rollapp <rollapp-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rollappID, err := cmd.Flags().GetString("rollapp")
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FulfillerStats(cmd.Context(), &types.QueryFulfillerStatsRequest{
+				Fulfiller: args[0],
+				RollappId: rollappID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringP("rollapp", "r", "", "Rollapp ID")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
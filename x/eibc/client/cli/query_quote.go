@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQuoteDemandOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-demand-order [rollapp-id] [amount] [denom] [sender] [receiver]",
+		Short: "Quote the demand order that would be created for a transfer",
+		Example: `dymd query eibc quote-demand-order rollappx_1234-1 1000 arax <sender> <receiver> --memo '{"eibc":{"fee":"10"}}'
+dymd query eibc quote-demand-order rollappx_1234-1 1000 adym <sender> <receiver> --type timeout`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			packetType, err := cmd.Flags().GetString("type")
+			if err != nil {
+				return err
+			}
+			packetType = strings.ToUpper(packetType)
+			if !strings.HasPrefix(packetType, "ON_") {
+				packetType = "ON_" + packetType
+			}
+			ptype, ok := commontypes.RollappPacket_Type_value[packetType]
+			if !ok {
+				return fmt.Errorf("invalid packet type: %s", packetType)
+			}
+
+			memo, err := cmd.Flags().GetString("memo")
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QuoteDemandOrder(cmd.Context(), &types.QueryQuoteDemandOrderRequest{
+				RollappId: args[0],
+				Type:      commontypes.RollappPacket_Type(ptype),
+				Amount:    args[1],
+				Denom:     args[2],
+				Sender:    args[3],
+				Receiver:  args[4],
+				Memo:      memo,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringP("type", "t", "recv", "Packet type: recv for a transfer from the rollapp, timeout or ack for a failed transfer to the rollapp")
+	cmd.Flags().String("memo", "", "Memo of the transfer")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
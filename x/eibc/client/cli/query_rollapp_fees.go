@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryRollappFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rollapp-fees [rollapp-id]",
+		Short:   "Query the effective eibc timeout and errack fees of a rollapp",
+		Example: "dymd query eibc rollapp-fees <rollapp-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RollappFees(cmd.Context(), &types.QueryRollappFeesRequest{
+				RollappId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewFulfillOrderAuthorizedTxCmd())
 	cmd.AddCommand(NewUpdateDemandOrderTxCmd())
 	cmd.AddCommand(NewCmdGrantAuthorization())
+	cmd.AddCommand(NewDepositToVaultTxCmd())
+	cmd.AddCommand(NewWithdrawFromVaultTxCmd())
+	cmd.AddCommand(NewSetRollappFeesTxCmd())
+	cmd.AddCommand(NewPayFillsTxCmd())
 
 	return cmd
 }
@@ -38,6 +42,7 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 		Example: "dymd tx eibc fulfill-order <order-id> <expected-fee-amount>",
 		Long: `Fulfill a new eibc order by providing the order ID and the expected fee amount.
 		The expected fee amount is the amount of fee that the user expects to pay for fulfilling the order.
+		Use --fill-price to fulfill only a part of the order price; the fee is then earned pro-rata.
 		`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -48,10 +53,16 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 			orderId := args[0]
 			fee := args[1]
 
-			msg := types.NewMsgFulfillOrder(
+			fillPrice, err := cmd.Flags().GetString(FlagFillPrice)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFulfillOrderPartially(
 				clientCtx.GetFromAddress().String(),
 				orderId,
 				fee,
+				fillPrice,
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -61,12 +72,14 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagFillPrice, "", "Part of the order price to fulfill (defaults to the whole order)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
 const (
+	FlagFillPrice          = "fill-price"
 	FlagOperatorFeeAddress = "operator-fee-address"
 	FlagRollappId          = "rollapp-id"
 	FlagPrice              = "price"
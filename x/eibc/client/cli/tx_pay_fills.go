@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func NewPayFillsTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pay-fills [order-id]",
+		Short:   "Pay the fills of a partially filled demand order whose payment failed on finalization",
+		Example: "dymd tx eibc pay-fills <order-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPayFills(clientCtx.GetFromAddress().String(), args[0])
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
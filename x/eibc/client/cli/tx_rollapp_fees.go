@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func NewSetRollappFeesTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rollapp-fees [rollapp-id] [timeout-fee] [errack-fee]",
+		Short:   "Set the eibc timeout and errack fees of a rollapp",
+		Example: "dymd tx eibc set-rollapp-fees rollapp_1234-1 0.002 0.003",
+		Long: `Set the eibc fees charged for the timeout and errack packets of a rollapp, overriding the params fees.
+		Only the rollapp owner can set the fees, which are bounded by the caps set by governance.
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			timeoutFee, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid timeout fee: %w", err)
+			}
+			errAckFee, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid errack fee: %w", err)
+			}
+
+			msg := types.NewMsgSetRollappFees(clientCtx.GetFromAddress().String(), args[0], timeoutFee, errAckFee)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
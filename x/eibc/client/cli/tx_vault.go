@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func NewDepositToVaultTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deposit-to-vault [amount]",
+		Short:   "Deposit liquidity into the eibc vault of its denom",
+		Example: "dymd tx eibc deposit-to-vault 1000ibc/ABC",
+		Long: `Deposit liquidity into the eibc vault of its denom in exchange for vault shares.
+		The vault fulfills the demand orders meeting its criteria, and the fees grow the value of the shares.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := types.NewMsgDepositToVault(clientCtx.GetFromAddress().String(), amount)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewWithdrawFromVaultTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-from-vault [shares]",
+		Short:   "Withdraw liquidity from an eibc vault",
+		Example: "dymd tx eibc withdraw-from-vault 1000eibc/vault/ibc/ABC",
+		Long: `Burn eibc vault shares in exchange for their value in the vault denom.
+		The liquidity locked in pending demand orders can't be withdrawn until they are finalized.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			shares, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid shares: %w", err)
+			}
+
+			msg := types.NewMsgWithdrawFromVault(clientCtx.GetFromAddress().String(), shares)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,19 +18,48 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		demandOrderCopy := demandOrder
 
 		// Decode base64 tracking_packet_key if it exists
-		if demandOrderCopy.TrackingPacketKey != "" {
-			decodedKey, err := base64.StdEncoding.DecodeString(demandOrderCopy.TrackingPacketKey)
-			if err != nil {
-				panic(fmt.Errorf("failed to decode tracking_packet_key: %w", err))
-			}
-			demandOrderCopy.TrackingPacketKey = string(decodedKey)
-		}
+		demandOrderCopy.TrackingPacketKey = decodeTrackingPacketKey(demandOrderCopy.TrackingPacketKey)
 
 		err := k.SetDemandOrder(ctx, &demandOrderCopy)
 		if err != nil {
 			panic(err)
 		}
 	}
+
+	for _, vault := range genState.Vaults {
+		k.SetVault(ctx, vault)
+	}
+
+	for _, stats := range genState.FulfillerStats {
+		if err := k.SetFulfillerStats(ctx, stats); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, fees := range genState.RollappFees {
+		if err := k.SetRollappFees(ctx, fees); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, order := range genState.UnpaidFills {
+		order.TrackingPacketKey = decodeTrackingPacketKey(order.TrackingPacketKey)
+		if err := k.SetUnpaidFills(ctx, order); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// decodeTrackingPacketKey decodes the base64 tracking packet key of an exported demand order
+func decodeTrackingPacketKey(key string) string {
+	if key == "" {
+		return key
+	}
+	decodedKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		panic(fmt.Errorf("failed to decode tracking_packet_key: %w", err))
+	}
+	return string(decodedKey)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -58,5 +87,27 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.DemandOrders[i] = orderCopy
 	}
 
+	genesis.Vaults = k.ListVaults(ctx)
+
+	genesis.FulfillerStats, err = k.ListAllFulfillerStats(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.RollappFees, err = k.ListRollappFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.UnpaidFills, err = k.ListUnpaidFills(ctx)
+	if err != nil {
+		panic(err)
+	}
+	for i, order := range genesis.UnpaidFills {
+		if order.TrackingPacketKey != "" {
+			genesis.UnpaidFills[i].TrackingPacketKey = base64.StdEncoding.EncodeToString([]byte(order.TrackingPacketKey))
+		}
+	}
+
 	return genesis
 }
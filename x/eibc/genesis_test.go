@@ -54,9 +54,15 @@ func TestInitGenesis(t *testing.T) {
 func TestExportGenesis(t *testing.T) {
 	k, ctx := keepertest.EibcKeeper(t)
 	params := types.Params{
-		EpochIdentifier: "week",
-		TimeoutFee:      sdk.NewDecWithPrec(4, 1),
-		ErrackFee:       sdk.NewDecWithPrec(4, 1),
+		EpochIdentifier:      "week",
+		TimeoutFee:           sdk.NewDecWithPrec(4, 1),
+		ErrackFee:            sdk.NewDecWithPrec(4, 1),
+		MaxRollappTimeoutFee: sdk.NewDecWithPrec(5, 1),
+		MaxRollappErrackFee:  sdk.NewDecWithPrec(5, 1),
+		MinRollappTimeoutFee: sdk.NewDecWithPrec(1, 1),
+		MinRollappErrackFee:  sdk.NewDecWithPrec(1, 1),
+		MinFillFraction:      sdk.NewDecWithPrec(1, 1),
+		MaxFillsPerOrder:     10,
 	}
 	// Set some demand orders
 	demandOrders := []types.DemandOrder{
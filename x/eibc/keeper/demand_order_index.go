@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// demandOrderIndex is an index of the demand orders by status and a field of the order.
+// Index key: status + field + order ID.
+type demandOrderIndex = collections.KeySet[collections.Triple[int32, string, string]]
+
+func newDemandOrderIndex(sb *collections.SchemaBuilder, prefix []byte, name string) demandOrderIndex {
+	return collections.NewKeySet(
+		sb,
+		collections.NewPrefix(prefix),
+		name,
+		collections.TripleKeyCodec(collections.Int32Key, collections.StringKey, collections.StringKey),
+	)
+}
+
+// indexedField is an index of the demand orders with the values of an order it is indexed by.
+type indexedField struct {
+	index  demandOrderIndex
+	values []string
+}
+
+func (k Keeper) demandOrderIndexedFields(order *types.DemandOrder) []indexedField {
+	return []indexedField{
+		{index: k.ordersByRecipient, values: []string{order.Recipient}},
+		{index: k.ordersByFulfiller, values: order.Fulfillers()},
+		{index: k.ordersByRollapp, values: []string{order.RollappId}},
+		{index: k.ordersByDenom, values: order.Price.Denoms()},
+	}
+}
+
+// setDemandOrderIndexes adds the demand order to the recipient, fulfiller, rollapp and denom indexes.
+func (k Keeper) setDemandOrderIndexes(ctx sdk.Context, order *types.DemandOrder) error {
+	for _, field := range k.demandOrderIndexedFields(order) {
+		for _, value := range field.values {
+			if value == "" {
+				continue
+			}
+			if err := field.index.Set(ctx, collections.Join3(int32(order.TrackingPacketStatus), value, order.Id)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeDemandOrderIndexes removes the demand order from the recipient, fulfiller, rollapp and denom indexes.
+func (k Keeper) removeDemandOrderIndexes(ctx sdk.Context, order *types.DemandOrder) error {
+	for _, field := range k.demandOrderIndexedFields(order) {
+		for _, value := range field.values {
+			if err := field.index.Remove(ctx, collections.Join3(int32(order.TrackingPacketStatus), value, order.Id)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListDemandOrdersByRecipientPaginated lists the demand orders with the given status and recipient with pagination.
+func (k Keeper) ListDemandOrdersByRecipientPaginated(ctx sdk.Context, status commontypes.Status, recipient string, pageReq *query.PageRequest, opts ...filterOption) ([]*types.DemandOrder, *query.PageResponse, error) {
+	return k.listDemandOrdersByIndexPaginated(ctx, k.ordersByRecipient, status, recipient, pageReq, opts...)
+}
+
+// ListDemandOrdersByFulfillerPaginated lists the demand orders with the given status and fulfiller with pagination.
+func (k Keeper) ListDemandOrdersByFulfillerPaginated(ctx sdk.Context, status commontypes.Status, fulfiller string, pageReq *query.PageRequest, opts ...filterOption) ([]*types.DemandOrder, *query.PageResponse, error) {
+	return k.listDemandOrdersByIndexPaginated(ctx, k.ordersByFulfiller, status, fulfiller, pageReq, opts...)
+}
+
+// ListDemandOrdersByRollappPaginated lists the demand orders with the given status and rollapp with pagination.
+func (k Keeper) ListDemandOrdersByRollappPaginated(ctx sdk.Context, status commontypes.Status, rollappID string, pageReq *query.PageRequest, opts ...filterOption) ([]*types.DemandOrder, *query.PageResponse, error) {
+	return k.listDemandOrdersByIndexPaginated(ctx, k.ordersByRollapp, status, rollappID, pageReq, opts...)
+}
+
+// ListDemandOrdersByDenomPaginated lists the demand orders with the given status and denom with pagination.
+func (k Keeper) ListDemandOrdersByDenomPaginated(ctx sdk.Context, status commontypes.Status, denom string, pageReq *query.PageRequest, opts ...filterOption) ([]*types.DemandOrder, *query.PageResponse, error) {
+	return k.listDemandOrdersByIndexPaginated(ctx, k.ordersByDenom, status, denom, pageReq, opts...)
+}
+
+// listDemandOrdersByIndexPaginated paginates the demand orders of an index with the given status and value.
+// The orders not matching the filter options are skipped, so the pages are full.
+func (k Keeper) listDemandOrdersByIndexPaginated(
+	ctx sdk.Context,
+	index demandOrderIndex,
+	status commontypes.Status,
+	value string,
+	pageReq *query.PageRequest,
+	opts ...filterOption,
+) ([]*types.DemandOrder, *query.PageResponse, error) {
+	if err := validateDemandOrderStatus(status); err != nil {
+		return nil, nil, err
+	}
+	return collcompat.CollectionFilteredPaginate(ctx, index, pageReq,
+		func(key collections.Triple[int32, string, string], _ collections.NoValue) (bool, error) {
+			order, err := k.GetDemandOrder(ctx, status, key.K3())
+			if err != nil {
+				return false, err
+			}
+			return matchesFilters(*order, opts...), nil
+		},
+		func(key collections.Triple[int32, string, string], _ collections.NoValue) (*types.DemandOrder, error) {
+			return k.GetDemandOrder(ctx, status, key.K3())
+		},
+		collcompat.WithCollectionPaginationTripleSuperPrefix[int32, string, string](int32(status), value),
+	)
+}
+
+// listDemandOrdersByIndex lists the demand orders of an index with the given status and value, matching
+// the filter options. Zero limit lists all the orders.
+func (k Keeper) listDemandOrdersByIndex(
+	ctx sdk.Context,
+	index demandOrderIndex,
+	status commontypes.Status,
+	value string,
+	limit int,
+	opts ...filterOption,
+) ([]*types.DemandOrder, error) {
+	if err := validateDemandOrderStatus(status); err != nil {
+		return nil, err
+	}
+	rng := collections.NewSuperPrefixedTripleRange[int32, string, string](int32(status), value)
+	iter, err := index.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+
+	var list []*types.DemandOrder
+	for ; iter.Valid(); iter.Next() {
+		if limit > 0 && len(list) >= limit {
+			break
+		}
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		order, err := k.GetDemandOrder(ctx, status, key.K3())
+		if err != nil {
+			return nil, fmt.Errorf("get indexed demand order: %s: %w", key.K3(), err)
+		}
+		if matchesFilters(*order, opts...) {
+			list = append(list, order)
+		}
+	}
+	return list, nil
+}
+
+func validateDemandOrderStatus(status commontypes.Status) error {
+	switch status {
+	case commontypes.Status_PENDING, commontypes.Status_FINALIZED:
+		return nil
+	default:
+		return fmt.Errorf("invalid demand order status: %s", status)
+	}
+}
+
+func matchesFilters(order types.DemandOrder, opts ...filterOption) bool {
+	for _, opt := range opts {
+		if !opt(order) {
+			return false
+		}
+	}
+	return true
+}
+
+// demandOrdersAfter ranges the demand orders of an index with the given status and value, after the given
+// order ID. An empty order ID ranges all the orders.
+type demandOrdersAfter struct {
+	status int32
+	value  string
+	after  string
+}
+
+func (r demandOrdersAfter) RangeValues() (start, end *collections.RangeKey[collections.Triple[int32, string, string]], order collections.Order, err error) {
+	start = collections.RangeKeyNext(collections.Join3(r.status, r.value, r.after))
+	end = collections.RangeKeyPrefixEnd(collections.TripleSuperPrefix[int32, string, string](r.status, r.value))
+	return start, end, collections.OrderAscending, nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	pfmkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// GetOrderForward returns the packet-forward-middleware metadata of the packet underlying the order,
+// or nil if the packet is not forwarded.
+func (k Keeper) GetOrderForward(ctx sdk.Context, order *types.DemandOrder) (*pfmtypes.ForwardMetadata, error) {
+	if order.Type != commontypes.RollappPacket_ON_RECV {
+		return nil, nil
+	}
+	rollappPacket, err := k.dack.GetRollappPacket(ctx, order.TrackingPacketKey)
+	if err != nil {
+		return nil, fmt.Errorf("get rollapp packet: %w", err)
+	}
+	data, err := rollappPacket.GetTransferPacketData()
+	if err != nil {
+		return nil, fmt.Errorf("get transfer packet data: %w", err)
+	}
+	if !dacktypes.MemoHasForward(data.Memo) {
+		return nil, nil
+	}
+	metadata, err := dacktypes.ParsePacketMetadata(data.Memo)
+	if err != nil {
+		return nil, fmt.Errorf("parse packet metadata: %w", err)
+	}
+	return metadata.Forward, nil
+}
+
+// forwardFulfilledOrder forwards the price paid to the recipient of a fulfilled order, as the
+// packet-forward-middleware would have done with the packet funds. The recipient is the sender of the
+// forward, so the funds of a failed forward are refunded to the recipient on the hub. Retries of the
+// metadata are not supported. Must be called before the packet is updated, which drops the forward.
+func (k Keeper) forwardFulfilledOrder(ctx sdk.Context, order *types.DemandOrder) error {
+	forward, err := k.GetOrderForward(ctx, order)
+	if err != nil {
+		return err
+	}
+	if forward == nil {
+		return nil
+	}
+
+	timeout := time.Duration(forward.Timeout)
+	if timeout <= 0 {
+		timeout = pfmkeeper.DefaultForwardTransferPacketTimeoutTimestamp
+	}
+
+	memo := ""
+	if forward.Next != nil {
+		bz, err := json.Marshal(forward.Next)
+		if err != nil {
+			return fmt.Errorf("marshal next: %w", err)
+		}
+		memo = string(bz)
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		forward.Port,
+		forward.Channel,
+		order.Price[0],
+		order.Recipient,
+		forward.Receiver,
+		pfmkeeper.DefaultTransferPacketTimeoutHeight,
+		uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		memo,
+	)
+	res, err := k.tk.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return fmt.Errorf("transfer: %w", err)
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventDemandOrderForwarded{
+		OrderId:  order.Id,
+		Port:     forward.Port,
+		Channel:  forward.Channel,
+		Receiver: forward.Receiver,
+		Sequence: res.Sequence,
+	})
+}
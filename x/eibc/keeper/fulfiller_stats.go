@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// GetFulfillerStats returns the stats of a fulfiller for a rollapp in a denom, empty if none.
+func (k Keeper) GetFulfillerStats(ctx sdk.Context, fulfiller, rollappID, denom string) (types.FulfillerStats, error) {
+	stats, err := k.fulfillerStats.Get(ctx, collections.Join3(fulfiller, rollappID, denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewFulfillerStats(fulfiller, rollappID, denom), nil
+	}
+	return stats, err
+}
+
+func (k Keeper) SetFulfillerStats(ctx sdk.Context, stats types.FulfillerStats) error {
+	return k.fulfillerStats.Set(ctx, collections.Join3(stats.Fulfiller, stats.RollappId, stats.Denom), stats)
+}
+
+// ListFulfillerStats lists the stats of a fulfiller per rollapp and denom. Empty rollapp ID lists the
+// stats of all the rollapps.
+func (k Keeper) ListFulfillerStats(ctx sdk.Context, fulfiller, rollappID string) ([]types.FulfillerStats, error) {
+	var rng collections.Ranger[collections.Triple[string, string, string]]
+	if rollappID == "" {
+		rng = collections.NewPrefixedTripleRange[string, string, string](fulfiller)
+	} else {
+		rng = collections.NewSuperPrefixedTripleRange[string, string, string](fulfiller, rollappID)
+	}
+	iter, err := k.fulfillerStats.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// ListAllFulfillerStats lists the stats of all the fulfillers.
+func (k Keeper) ListAllFulfillerStats(ctx sdk.Context) ([]types.FulfillerStats, error) {
+	iter, err := k.fulfillerStats.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// updateFulfillerStats applies the update of an order to the stats of a fulfiller and emits the updated stats.
+func (k Keeper) updateFulfillerStats(ctx sdk.Context, order *types.DemandOrder, fulfiller string, update func(*types.FulfillerStats)) error {
+	stats, err := k.GetFulfillerStats(ctx, fulfiller, order.RollappId, order.Price[0].Denom)
+	if err != nil {
+		return fmt.Errorf("get fulfiller stats: %w", err)
+	}
+	update(&stats)
+	if err := k.SetFulfillerStats(ctx, stats); err != nil {
+		return fmt.Errorf("set fulfiller stats: %w", err)
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventFulfillerStatsUpdated{
+		OrderId: order.Id,
+		Stats:   stats,
+	})
+}
+
+// recordOrderFronted records the part of the order price paid by a fulfiller, while the packet is pending.
+func (k Keeper) recordOrderFronted(ctx sdk.Context, order *types.DemandOrder, front types.Front) error {
+	return k.updateFulfillerStats(ctx, order, front.Fulfiller, func(stats *types.FulfillerStats) {
+		stats.AddFronted(front.Price)
+	})
+}
+
+// recordOperatorFeePaid records the fee paid to the operator by the liquidity provider of an authorized fulfillment.
+func (k Keeper) recordOperatorFeePaid(ctx sdk.Context, order *types.DemandOrder, lp string, fee math.Int) error {
+	return k.updateFulfillerStats(ctx, order, lp, func(stats *types.FulfillerStats) {
+		stats.OperatorFeesPaid = stats.OperatorFeesPaid.Add(fee)
+	})
+}
+
+// recordOrderSettled records the order of a finalized packet as settled for its fulfillers.
+func (k Keeper) recordOrderSettled(ctx sdk.Context, order *types.DemandOrder) error {
+	for _, front := range order.Fronts() {
+		err := k.updateFulfillerStats(ctx, order, front.Fulfiller, func(stats *types.FulfillerStats) {
+			stats.AddSettled(front.Price, front.Fee)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordOrderLost records the order of a reverted or failed packet as lost for its fulfillers.
+func (k Keeper) recordOrderLost(ctx sdk.Context, order *types.DemandOrder) error {
+	for _, front := range order.Fronts() {
+		err := k.updateFulfillerStats(ctx, order, front.Fulfiller, func(stats *types.FulfillerStats) {
+			stats.AddLost(front.Price)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
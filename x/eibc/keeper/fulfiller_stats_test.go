@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestFulfillerStats() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 4, math.NewInt(2000))
+	recipient := testAddresses[0].String()
+	lp := testAddresses[1].String()
+	operator := testAddresses[2].String()
+	fulfiller := testAddresses[3].String()
+	denom := sdk.DefaultBondDenom
+
+	// two pending orders of the same rollapp
+	newOrder := func(sequence uint64) *types.DemandOrder {
+		p := packet
+		p.Sequence = sequence
+		rollappPacket := commontypes.RollappPacket{
+			RollappId: rollappPacket.RollappId,
+			Status:    commontypes.Status_PENDING,
+			Type:      commontypes.RollappPacket_ON_RECV,
+			Packet:    &p,
+		}
+		suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, rollappPacket)
+		order := types.NewDemandOrder(rollappPacket, math.NewInt(900), math.NewInt(100), denom, recipient, 1)
+		suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, order))
+		return order
+	}
+	authorizedOrder := newOrder(1)
+	filledOrder := newOrder(2)
+
+	queryStats := func(addr string) types.FulfillerStats {
+		res, err := suite.queryClient.FulfillerStats(sdk.WrapSDKContext(suite.Ctx), &types.QueryFulfillerStatsRequest{
+			Fulfiller: addr,
+			RollappId: rollappPacket.RollappId,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Stats, 1)
+		return res.Stats[0]
+	}
+
+	// the liquidity provider fronts the price of an authorized fulfillment and pays the operator fee
+	_, err := suite.msgServer.FulfillOrderAuthorized(suite.Ctx, types.NewMsgFulfillOrderAuthorized(
+		authorizedOrder.Id,
+		rollappPacket.RollappId,
+		lp,
+		operator,
+		"100",
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 900)),
+		sdk.IntProto{Int: math.NewInt(1000)},
+		sdk.DecProto{Dec: sdk.NewDecWithPrec(2, 1)}, // 0.2
+		false,
+	))
+	suite.Require().NoError(err)
+	stats := queryStats(lp)
+	suite.Require().Equal(denom, stats.Denom)
+	suite.Require().Equal(math.NewInt(900), stats.Active)
+	suite.Require().Equal(uint64(1), stats.ActiveOrders)
+	suite.Require().Equal(math.NewInt(20), stats.OperatorFeesPaid)
+
+	// the fulfiller fronts a slice of the other order
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrderPartially(fulfiller, filledOrder.Id, "100", "450"))
+	suite.Require().NoError(err)
+	stats = queryStats(fulfiller)
+	suite.Require().Equal(math.NewInt(450), stats.Active)
+	suite.Require().Equal(uint64(1), stats.ActiveOrders)
+
+	// the finalized order is settled with its fee
+	authorizedOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, authorizedOrder.Id)
+	suite.Require().NoError(err)
+	authorizedPacket, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, authorizedOrder.TrackingPacketKey)
+	suite.Require().NoError(err)
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *authorizedPacket)
+	suite.Require().NoError(err)
+	stats = queryStats(lp)
+	suite.Require().True(stats.Active.IsZero())
+	suite.Require().Zero(stats.ActiveOrders)
+	suite.Require().Equal(math.NewInt(900), stats.Settled)
+	suite.Require().Equal(uint64(1), stats.SettledOrders)
+	suite.Require().Equal(math.NewInt(100), stats.FeesEarned)
+
+	// the order whose packet is reverted by a hard fork is lost
+	filledOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, filledOrder.Id)
+	suite.Require().NoError(err)
+	filledPacket, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, filledOrder.TrackingPacketKey)
+	suite.Require().NoError(err)
+	suite.App.DelayedAckKeeper.DeleteRollappPacket(suite.Ctx, filledPacket)
+	stats = queryStats(fulfiller)
+	suite.Require().True(stats.Active.IsZero())
+	suite.Require().Equal(math.NewInt(450), stats.Lost)
+	suite.Require().Equal(uint64(1), stats.LostOrders)
+	suite.Require().True(stats.Settled.IsZero())
+
+	// the stats are exported
+	all, err := suite.App.EIBCKeeper.ListAllFulfillerStats(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(all, 2)
+}
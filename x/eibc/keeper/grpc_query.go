@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
@@ -45,6 +48,7 @@ func (q Querier) DemandOrderById(goCtx context.Context, req *types.QueryGetDeman
 	for _, status := range statuses {
 		demandOrder, err = q.GetDemandOrder(ctx, status, req.Id)
 		if err == nil && demandOrder != nil {
+			demandOrder.ApplyFeeCurve(uint64(ctx.BlockHeight()))
 			return &types.QueryGetDemandOrderResponse{DemandOrder: demandOrder}, nil
 		}
 	}
@@ -55,11 +59,32 @@ func (q Querier) DemandOrdersByStatus(goCtx context.Context, req *types.QueryDem
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
-	// Get the demand orders by status, with optional filters
-	demandOrders, pageResp, err := q.ListDemandOrdersByStatusPaginated(sdk.UnwrapSDKContext(goCtx), req.Status, req.Pagination, filterOpts(req)...)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Get the demand orders by status, with optional filters. The most selective index matching
+	// the filters is walked, if any.
+	var (
+		demandOrders []*types.DemandOrder
+		pageResp     *query.PageResponse
+		err          error
+	)
+	switch opts := filterOpts(req); {
+	case req.Fulfiller != "":
+		demandOrders, pageResp, err = q.ListDemandOrdersByFulfillerPaginated(ctx, req.Status, req.Fulfiller, req.Pagination, opts...)
+	case req.Recipient != "":
+		demandOrders, pageResp, err = q.ListDemandOrdersByRecipientPaginated(ctx, req.Status, req.Recipient, req.Pagination, opts...)
+	case req.RollappId != "":
+		demandOrders, pageResp, err = q.ListDemandOrdersByRollappPaginated(ctx, req.Status, req.RollappId, req.Pagination, opts...)
+	case req.Denom != "":
+		demandOrders, pageResp, err = q.ListDemandOrdersByDenomPaginated(ctx, req.Status, req.Denom, req.Pagination, opts...)
+	default:
+		demandOrders, pageResp, err = q.ListDemandOrdersByStatusPaginated(ctx, req.Status, req.Pagination, opts...)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	for _, demandOrder := range demandOrders {
+		demandOrder.ApplyFeeCurve(uint64(ctx.BlockHeight()))
+	}
 	// Construct the response
 	return &types.QueryDemandOrdersByStatusResponse{
 		DemandOrders: demandOrders,
@@ -90,6 +115,32 @@ func filterOpts(req *types.QueryDemandOrdersByStatusRequest) []filterOption {
 	return opts
 }
 
+func (q Querier) Vault(goCtx context.Context, req *types.QueryVaultRequest) (*types.QueryVaultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vault, ok := q.GetVault(ctx, req.Denom)
+	if !ok {
+		return nil, status.Error(codes.NotFound, types.ErrVaultNotFound.Error())
+	}
+	return &types.QueryVaultResponse{
+		Vault:     vault,
+		Liquidity: q.VaultLiquidity(ctx, vault),
+		Shares:    q.VaultShares(ctx, vault),
+	}, nil
+}
+
+func (q Querier) Vaults(goCtx context.Context, req *types.QueryVaultsRequest) (*types.QueryVaultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryVaultsResponse{Vaults: q.ListVaults(ctx)}, nil
+}
+
 type filterOption func(order types.DemandOrder) bool
 
 func isRollappId(rollappId string) filterOption {
@@ -98,6 +149,12 @@ func isRollappId(rollappId string) filterOption {
 	}
 }
 
+func isRollappIdIn(rollappIds []string) filterOption {
+	return func(order types.DemandOrder) bool {
+		return slices.Contains(rollappIds, order.RollappId)
+	}
+}
+
 func isOrderType(orderType ...commontypes.RollappPacket_Type) filterOption {
 	return func(order types.DemandOrder) bool {
 		for _, ot := range orderType {
@@ -111,7 +168,7 @@ func isOrderType(orderType ...commontypes.RollappPacket_Type) filterOption {
 
 func isFulfiller(fulfiller string) filterOption {
 	return func(order types.DemandOrder) bool {
-		return order.Recipient == fulfiller
+		return slices.Contains(order.Fulfillers(), fulfiller)
 	}
 }
 
@@ -132,3 +189,71 @@ func isRecipient(recipient string) filterOption {
 		return order.Recipient == recipient
 	}
 }
+
+func (q Querier) FulfillerStats(goCtx context.Context, req *types.QueryFulfillerStatsRequest) (*types.QueryFulfillerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Fulfiller); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stats, err := q.ListFulfillerStats(ctx, req.Fulfiller, req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryFulfillerStatsResponse{Stats: stats}, nil
+}
+
+func (q Querier) QuoteDemandOrder(goCtx context.Context, req *types.QueryQuoteDemandOrderRequest) (*types.QueryQuoteDemandOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	data := transfertypes.NewFungibleTokenPacketData(req.Denom, req.Amount, req.Sender, req.Receiver, req.Memo)
+	order, err := q.Keeper.QuoteDemandOrder(ctx, req.RollappId, req.Type, data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if order == nil {
+		return &types.QueryQuoteDemandOrderResponse{}, nil
+	}
+
+	// the bridging fee is what is left of the transfer amount after the price and the fee
+	amt, _ := sdk.NewIntFromString(req.Amount) // guaranteed ok by the order creation
+	denom := order.Price[0].Denom
+	fee := order.GetFeeAmount()
+	return &types.QueryQuoteDemandOrderResponse{
+		CreatesOrder: true,
+		Price:        order.Price[0],
+		Fee:          sdk.NewCoin(denom, fee),
+		BridgingFee:  sdk.NewCoin(denom, amt.Sub(order.GetPriceAmount()).Sub(fee)),
+		FeeCurve:     order.FeeCurve,
+	}, nil
+}
+
+func (q Querier) RollappFees(goCtx context.Context, req *types.QueryRollappFeesRequest) (*types.QueryRollappFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	effective, err := q.EffectiveRollappFees(ctx, req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryRollappFeesResponse{
+		TimeoutFee: effective.TimeoutFee,
+		ErrackFee:  effective.ErrackFee,
+	}
+	fees, ok, err := q.GetRollappFees(ctx, req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if ok {
+		res.RollappFees = &fees
+	}
+	return res, nil
+}
@@ -6,9 +6,13 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	eibckeeper "github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -100,3 +104,196 @@ func (suite *KeeperTestSuite) TestQueryDemandOrdersByStatus() {
 	suite.Require().NotNil(res.DemandOrders)
 	suite.Require().Equal(false, res.DemandOrders[0].IsFulfilled(), "Expected 0 demand orders with fulfillment state unfulfilled")
 }
+
+func (suite *KeeperTestSuite) TestQueryDemandOrdersByIndexes() {
+	keeper := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipients := []string{addrs[0].String(), addrs[1].String()}
+	fulfiller := addrs[2].String()
+
+	// 5 pending orders: recipients alternate, the last order is of another rollapp
+	// and the last two orders are of another denom
+	var orders []*types.DemandOrder
+	for i := 0; i < 5; i++ {
+		rollappId := "rollapp_1234-1"
+		if i == 4 {
+			rollappId = "other_5678-1"
+		}
+		denom := "stake"
+		if i >= 3 {
+			denom = "adym"
+		}
+		p := packet
+		p.Sequence = uint64(i + 1)
+		rollappPacket := commontypes.RollappPacket{
+			RollappId:   rollappId,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: 2,
+			Packet:      &p,
+		}
+		order := types.NewDemandOrder(rollappPacket, math.NewIntFromUint64(150), math.NewIntFromUint64(50), denom, recipients[i%2], 1)
+		suite.Require().NoError(keeper.SetDemandOrder(suite.Ctx, order))
+		orders = append(orders, order)
+	}
+
+	queryAll := func(req *types.QueryDemandOrdersByStatusRequest) []*types.DemandOrder {
+		var all []*types.DemandOrder
+		req.Pagination = &query.PageRequest{Limit: 2}
+		for {
+			res, err := suite.queryClient.DemandOrdersByStatus(sdk.WrapSDKContext(suite.Ctx), req)
+			suite.Require().NoError(err)
+			suite.Require().LessOrEqual(len(res.DemandOrders), 2)
+			all = append(all, res.DemandOrders...)
+			if res.Pagination.NextKey == nil {
+				return all
+			}
+			req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+		}
+	}
+
+	pending := commontypes.Status_PENDING
+	suite.Require().Len(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Recipient: recipients[0]}), 3)
+	suite.Require().Len(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Recipient: recipients[1]}), 2)
+	suite.Require().Len(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, RollappId: "rollapp_1234-1"}), 4)
+	suite.Require().Len(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Denom: "adym"}), 2)
+	suite.Require().Empty(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Fulfiller: fulfiller}))
+
+	// filters not served by the index are applied without leaving partial pages
+	res := queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Recipient: recipients[0], Denom: "stake"})
+	suite.Require().Len(res, 2)
+	for _, order := range res {
+		suite.Require().Equal(recipients[0], order.Recipient)
+		suite.Require().Equal("stake", order.Price[0].Denom)
+	}
+
+	// the fulfiller index follows the fulfillment
+	orders[0].FulfillerAddress = fulfiller
+	suite.Require().NoError(keeper.SetDemandOrder(suite.Ctx, orders[0]))
+	res = queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Fulfiller: fulfiller})
+	suite.Require().Len(res, 1)
+	suite.Require().Equal(orders[0].Id, res[0].Id)
+
+	// and the status update
+	_, err := keeper.UpdateDemandOrderWithStatus(suite.Ctx, orders[0], commontypes.Status_FINALIZED)
+	suite.Require().NoError(err)
+	suite.Require().Empty(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Fulfiller: fulfiller}))
+	suite.Require().Len(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: commontypes.Status_FINALIZED, Fulfiller: fulfiller}), 1)
+	suite.Require().Len(queryAll(&types.QueryDemandOrdersByStatusRequest{Status: pending, Recipient: recipients[0]}), 2)
+
+	_, broken := eibckeeper.DemandOrderIndexesInvariant(keeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestQueryQuoteDemandOrder() {
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	rollappID := suite.CreateDefaultRollapp()
+	rollapp, _ := suite.App.RollappKeeper.GetRollapp(suite.Ctx, rollappID)
+	rollapp.ChannelId = "channel-0"
+	suite.App.RollappKeeper.SetRollapp(suite.Ctx, rollapp)
+	suite.App.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, transfertypes.PortID, "channel-0", channeltypes.Channel{
+		Counterparty: channeltypes.NewCounterparty(transfertypes.PortID, "channel-5"),
+	})
+
+	params := suite.App.EIBCKeeper.GetParams(suite.Ctx)
+	params.TimeoutFee = sdk.NewDecWithPrec(1, 2)
+	params.ErrackFee = sdk.ZeroDec()
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+	bridgingFee := suite.App.DelayedAckKeeper.BridgingFee(suite.Ctx).MulInt(math.NewInt(1000)).TruncateInt()
+
+	sender := apptesting.CreateRandomAccounts(1)[0].String()
+	receiver := apptesting.CreateRandomAccounts(1)[0].String()
+	recvDenom := transfertypes.ParseDenomTrace("transfer/channel-0/arax").IBCDenom()
+
+	tests := []struct {
+		name                string
+		req                 types.QueryQuoteDemandOrderRequest
+		expectErr           bool
+		expectCreatesOrder  bool
+		expectPrice         sdk.Coin
+		expectFee           sdk.Coin
+		expectBridgingFee   sdk.Coin
+		expectFeeCurveIsSet bool
+	}{
+		{
+			name:               "recv without memo",
+			req:                types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_ON_RECV},
+			expectCreatesOrder: true,
+			expectPrice:        sdk.NewCoin(recvDenom, math.NewInt(1000).Sub(bridgingFee)),
+			expectFee:          sdk.NewCoin(recvDenom, math.ZeroInt()),
+			expectBridgingFee:  sdk.NewCoin(recvDenom, bridgingFee),
+		},
+		{
+			name:               "recv with eibc fee",
+			req:                types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_ON_RECV, Memo: `{"eibc":{"fee":"100"}}`},
+			expectCreatesOrder: true,
+			expectPrice:        sdk.NewCoin(recvDenom, math.NewInt(900).Sub(bridgingFee)),
+			expectFee:          sdk.NewCoin(recvDenom, math.NewInt(100)),
+			expectBridgingFee:  sdk.NewCoin(recvDenom, bridgingFee),
+		},
+		{
+			name:                "recv with fee curve",
+			req:                 types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_ON_RECV, Memo: `{"eibc":{"fee":"100","fee_curve":{"max_fee":"200","blocks":10}}}`},
+			expectCreatesOrder:  true,
+			expectPrice:         sdk.NewCoin(recvDenom, math.NewInt(900).Sub(bridgingFee)),
+			expectFee:           sdk.NewCoin(recvDenom, math.NewInt(100)),
+			expectBridgingFee:   sdk.NewCoin(recvDenom, bridgingFee),
+			expectFeeCurveIsSet: true,
+		},
+		{
+			name:      "recv with fee above amount",
+			req:       types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_ON_RECV, Memo: `{"eibc":{"fee":"1000"}}`},
+			expectErr: true,
+		},
+		{
+			name:               "timeout",
+			req:                types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_ON_TIMEOUT, Denom: "adym"},
+			expectCreatesOrder: true,
+			expectPrice:        sdk.NewCoin("adym", math.NewInt(990)),
+			expectFee:          sdk.NewCoin("adym", math.NewInt(10)),
+			expectBridgingFee:  sdk.NewCoin("adym", math.ZeroInt()),
+		},
+		{
+			name: "errack with zero fee",
+			req:  types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_ON_ACK, Denom: "adym"},
+		},
+		{
+			name:      "unknown rollapp",
+			req:       types.QueryQuoteDemandOrderRequest{RollappId: "unknown_1-1", Type: commontypes.RollappPacket_ON_RECV},
+			expectErr: true,
+		},
+		{
+			name:      "undefined packet type",
+			req:       types.QueryQuoteDemandOrderRequest{Type: commontypes.RollappPacket_UNDEFINED},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			req := tc.req
+			if req.RollappId == "" {
+				req.RollappId = rollappID
+			}
+			if req.Denom == "" {
+				req.Denom = "arax"
+			}
+			req.Amount = "1000"
+			req.Sender = sender
+			req.Receiver = receiver
+
+			res, err := eibckeeper.NewQuerier(suite.App.EIBCKeeper).QuoteDemandOrder(sdk.WrapSDKContext(suite.Ctx), &req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectCreatesOrder, res.CreatesOrder)
+			if !tc.expectCreatesOrder {
+				return
+			}
+			suite.Require().Equal(tc.expectPrice.String(), res.Price.String())
+			suite.Require().Equal(tc.expectFee.String(), res.Fee.String())
+			suite.Require().Equal(tc.expectBridgingFee.String(), res.BridgingFee.String())
+			suite.Require().Equal(tc.expectFeeCurveIsSet, res.FeeCurve != nil)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
@@ -19,17 +20,39 @@ import (
 // If the rollapp packet is of type ON_RECV, the function will validate the memo and create a demand order from the packet data.
 // If the rollapp packet is of type ON_TIMEOUT/ON_ACK, the function will calculate the fee and create a demand order from the packet data.
 func (k Keeper) EIBCDemandOrderHandler(ctx sdk.Context, rollappPacket commontypes.RollappPacket, data transfertypes.FungibleTokenPacketData) error {
+	eibcDemandOrder, err := k.newDemandOrder(ctx, rollappPacket, data)
+	if err != nil {
+		return err
+	}
+	if eibcDemandOrder == nil {
+		return nil
+	}
+	err = k.SetDemandOrder(ctx, eibcDemandOrder)
+	if err != nil {
+		return fmt.Errorf("set eibc demand order: %w", err)
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, eibcDemandOrder.GetCreatedEvent(rollappPacket.ProofHeight, data.Amount)); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// newDemandOrder validates the packet data and creates the demand order of the rollapp packet, without saving it.
+// It returns nil if no demand order is created for the packet.
+func (k Keeper) newDemandOrder(ctx sdk.Context, rollappPacket commontypes.RollappPacket, data transfertypes.FungibleTokenPacketData) (*types.DemandOrder, error) {
 	var (
 		eibcDemandOrder *types.DemandOrder
 		err             error
 	)
 	// Validate the fungible token packet data as we're going to use it to create the demand order
 	if err := data.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 	// Verify the original recipient is not a blocked sender otherwise could potentially use eibc to bypass it
 	if k.BlockedAddr(data.Receiver) {
-		return types.ErrBlockedAddress
+		return nil, types.ErrBlockedAddress
 	}
 
 	switch t := rollappPacket.Type; t {
@@ -39,28 +62,19 @@ func (k Keeper) EIBCDemandOrderHandler(ctx sdk.Context, rollappPacket commontype
 		eibcDemandOrder, err = k.CreateDemandOrderOnErrAckOrTimeout(ctx, data, &rollappPacket)
 	}
 	if err != nil {
-		return fmt.Errorf("create eibc demand order: %w", err)
+		return nil, fmt.Errorf("create eibc demand order: %w", err)
 	}
 	if eibcDemandOrder == nil {
-		return nil
+		return nil, nil
 	}
 	if err := eibcDemandOrder.Validate(); err != nil {
-		return fmt.Errorf("validate eibc data: %w", err)
-	}
-	err = k.SetDemandOrder(ctx, eibcDemandOrder)
-	if err != nil {
-		return fmt.Errorf("set eibc demand order: %w", err)
-	}
-
-	if err = uevent.EmitTypedEvent(ctx, eibcDemandOrder.GetCreatedEvent(rollappPacket.ProofHeight, data.Amount)); err != nil {
-		return fmt.Errorf("emit event: %w", err)
+		return nil, fmt.Errorf("validate eibc data: %w", err)
 	}
-
-	return nil
+	return eibcDemandOrder, nil
 }
 
 // CreateDemandOrderOnRecv creates a demand order from an IBC packet.
-// It extracts the fee from the memo,calculates the demand order price, and creates a new demand order.
+// It extracts the fee and the optional fee curve from the memo, calculates the demand order price, and creates a new demand order.
 // price calculated with the fee and the bridging fee. (price = amount - fee - bridging fee)
 // It returns the created demand order or an error if there is any.
 func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketData transfertypes.FungibleTokenPacketData,
@@ -72,7 +86,22 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	if fungibleTokenPacketData.Memo != "" {
 		packetMetaData, err := dacktypes.ParsePacketMetadata(fungibleTokenPacketData.Memo)
 		if err == nil {
-			eibcMetaData = *packetMetaData.EIBC
+			if packetMetaData.Forward != nil {
+				if err := packetMetaData.Forward.Validate(); err != nil {
+					return nil, fmt.Errorf("validate forward metadata: %w", err)
+				}
+				// The funds of a failed forward are refunded to the recipient, so it has to be a hub account.
+				if _, err := sdk.AccAddressFromBech32(fungibleTokenPacketData.Receiver); err != nil {
+					if packetMetaData.EIBC != nil {
+						return nil, errorsmod.Wrap(types.ErrInvalidRecipientAddress, "forward recipient must be a hub address")
+					}
+					// not an eIBC transfer, the packet is forwarded on finalization
+					return nil, nil
+				}
+			}
+			if packetMetaData.EIBC != nil {
+				eibcMetaData = *packetMetaData.EIBC
+			}
 		} else if !errors.Is(err, dacktypes.ErrMemoEibcEmpty) {
 			return nil, fmt.Errorf("parse packet metadata: %w", err)
 		}
@@ -94,11 +123,22 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	creationHeight := uint64(ctx.BlockHeight())
 
 	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient, creationHeight)
+	if eibcMetaData.FeeCurve != nil {
+		maxFee, _ := eibcMetaData.FeeCurve.MaxFeeInt() // guaranteed ok by above validation
+		// the same bound as the demand order validation, which needs the price
+		if err := types.ValidateFeeCurve(fee, demandOrderPrice, maxFee, eibcMetaData.FeeCurve.Blocks); err != nil {
+			return nil, fmt.Errorf("validate eibc metadata: %w", err)
+		}
+		order.FeeCurve = &types.FeeCurve{
+			MaxFee: maxFee,
+			Blocks: eibcMetaData.FeeCurve.Blocks,
+		}
+	}
 	return order, nil
 }
 
 // CreateDemandOrderOnErrAckOrTimeout creates a demand order for a timeout or errack packet.
-// The fee multiplier is read from params and used to calculate the fee.
+// The fee multiplier is the effective fee of the rollapp and used to calculate the fee.
 func (k Keeper) CreateDemandOrderOnErrAckOrTimeout(ctx sdk.Context, fungibleTokenPacketData transfertypes.FungibleTokenPacketData,
 	rollappPacket *commontypes.RollappPacket,
 ) (*types.DemandOrder, error) {
@@ -106,12 +146,16 @@ func (k Keeper) CreateDemandOrderOnErrAckOrTimeout(ctx sdk.Context, fungibleToke
 	amt, _ := sdk.NewIntFromString(fungibleTokenPacketData.Amount) // guaranteed ok and positive by above validation
 
 	// Calculate the fee by multiplying the fee by the price
+	fees, err := k.EffectiveRollappFees(ctx, rollappPacket.RollappId)
+	if err != nil {
+		return nil, fmt.Errorf("effective rollapp fees: %w", err)
+	}
 	var feeMultiplier sdk.Dec
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_TIMEOUT:
-		feeMultiplier = k.TimeoutFee(ctx)
+		feeMultiplier = fees.TimeoutFee
 	case commontypes.RollappPacket_ON_ACK:
-		feeMultiplier = k.ErrAckFee(ctx)
+		feeMultiplier = fees.ErrackFee
 	}
 	fee := feeMultiplier.MulInt(amt).TruncateInt()
 	if !fee.IsPositive() {
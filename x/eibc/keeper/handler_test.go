@@ -13,6 +13,7 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 		expectedErr   bool
 		expectedFee   string
 		expectedPrice string // considering bridging fee of 1%
+		expectedCurve bool
 	}{
 		{
 			name:          "fee by memo - create demand order",
@@ -39,20 +40,47 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 			expectedErr: true,
 		},
 		{
-			name:        "PFM memo - fail",
+			name:        "invalid PFM memo - fail",
 			memo:        `{"forward":{}}`,
 			expectedErr: true,
 		},
+		{
+			name:          "eibc and PFM memo - create demand order",
+			memo:          `{"eibc":{"fee":"100"},"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`,
+			expectedErr:   false,
+			expectedFee:   "100",
+			expectedPrice: "890",
+		},
+		{
+			name:          "fee curve by memo - create demand order",
+			memo:          `{"eibc":{"fee":"100","fee_curve":{"max_fee":"300","blocks":10}}}`,
+			expectedErr:   false,
+			expectedFee:   "100",
+			expectedPrice: "890",
+			expectedCurve: true,
+		},
+		{
+			name:        "fee curve max fee not below the price and fee - fail",
+			memo:        `{"eibc":{"fee":"100","fee_curve":{"max_fee":"990","blocks":10}}}`,
+			expectedErr: true,
+		},
 	}
 
 	// set 1% bridging fee
-	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0, 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	amt, _ := sdk.NewIntFromString(transferPacketData.Amount)
 	bridgeFee := suite.App.DelayedAckKeeper.BridgingFeeFromAmt(suite.Ctx, amt)
 	suite.Require().True(bridgeFee.IsPositive())
 
+	// restore the shared packet for the other tests
+	defer func() {
+		transferPacketData.Memo = ""
+		packet = channeltypes.NewPacket(transferPacketData.GetBytes(), 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+		rollappPacket.Packet = &packet
+	}()
+
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
 			// modify the memo and set rollapp packet
@@ -77,6 +105,7 @@ func (suite *KeeperTestSuite) TestCreateDemandOrderOnRecv() {
 				suite.Require().Len(order.Fee, 0)
 				suite.Require().Equal(tt.expectedPrice, order.Price[0].Amount.String())
 			}
+			suite.Require().Equal(tt.expectedCurve, order.FeeCurve != nil)
 		})
 	}
 }
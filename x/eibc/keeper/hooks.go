@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	delayeacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -50,6 +51,37 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 		return err
 	}
 
+	// the packet is no longer pending, the price paid by a vault is no longer locked
+	d.unlockVaultOrder(ctx, demandOrder, packet.Status == commontypes.Status_FINALIZED && packet.Error == "")
+
+	// The fulfillers are paid for a successfully finalized packet only.
+	record := d.recordOrderLost
+	if packet.Status == commontypes.Status_FINALIZED && packet.Error == "" {
+		record = d.recordOrderSettled
+	}
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		return record(ctx, demandOrder)
+	})
+	if err != nil {
+		// do not fail the finalization of the packet
+		d.Logger(ctx).Error("Record demand order in fulfiller stats.", "order", demandOrder.Id, "err", err)
+	}
+
+	// The funds of a partially filled order were received by the module account, unless the packet
+	// failed on finalization, in which case the fulfillers are not paid, like a regular fulfiller.
+	if demandOrder.IsPartiallyFilled() && packet.Status == commontypes.Status_FINALIZED && packet.Error == "" {
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return d.PayFills(ctx, demandOrder)
+		})
+		if err != nil {
+			// do not fail the finalization of the packet, the fills can be paid later with MsgPayFills
+			d.Logger(ctx).Error("Pay demand order fills.", "order", demandOrder.Id, "err", err)
+			if err := d.setUnpaidFills(ctx, *demandOrder, err); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -63,9 +95,23 @@ func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *comm
 	packetKey := rollappPacket.RollappPacketKey()
 	demandOrderID := types.BuildDemandIDFromPacketKey(string(packetKey))
 
+	// the packet of a fulfilled order is deleted while pending by a hard fork, the price is lost
+	if demandOrder, err := d.GetDemandOrder(ctx, commontypes.Status_PENDING, demandOrderID); err == nil {
+		d.unlockVaultOrder(ctx, demandOrder, false)
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return d.recordOrderLost(ctx, demandOrder)
+		})
+		if err != nil {
+			d.Logger(ctx).Error("Record demand order in fulfiller stats.", "order", demandOrderID, "err", err)
+		}
+	}
+
 	statuses := []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED}
 	for _, status := range statuses {
-		d.deleteDemandOrder(ctx, status, demandOrderID)
+		if err := d.deleteDemandOrder(ctx, status, demandOrderID); err != nil {
+			d.Logger(ctx).Error("delete demand order", "order", demandOrderID, "error", err)
+			continue
+		}
 
 		if err := uevent.EmitTypedEvent(ctx, &types.EventDemandOrderDeleted{
 			OrderId:      demandOrderID,
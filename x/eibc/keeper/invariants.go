@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -10,7 +12,8 @@ import (
 )
 
 const (
-	demandOrderCountInvariantName = "demand-order-count"
+	demandOrderCountInvariantName   = "demand-order-count"
+	demandOrderIndexesInvariantName = "demand-order-indexes"
 )
 
 // RegisterInvariants registers the bank module invariants
@@ -18,6 +21,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "demand-order-count", DemandOrderCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "underlying-packet-exist", UnderlyingPacketExistInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "coins", CoinsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, demandOrderIndexesInvariantName, DemandOrderIndexesInvariant(k))
 }
 
 // DO NOT DELETE
@@ -27,6 +31,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			DemandOrderCountInvariant(k),
 			UnderlyingPacketExistInvariant(k),
 			CoinsInvariant(k),
+			DemandOrderIndexesInvariant(k),
 		} {
 			res, stop := inv(ctx)
 			if stop {
@@ -123,3 +128,55 @@ func CoinsInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "coins", msg), broken
 	}
 }
+
+// DemandOrderIndexesInvariant checks that the recipient, fulfiller, rollapp and denom indexes have
+// exactly one entry per indexed value of each demand order.
+func DemandOrderIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			broken bool
+			msg    string
+		)
+		allDemandOrders, err := k.ListAllDemandOrders(ctx)
+		if err != nil {
+			msg += fmt.Sprintf("list all demand orders failed: %v\n", err)
+			broken = true
+		}
+
+		// the number of entries expected in each index
+		expected := make([]int, len(k.demandOrderIndexedFields(&types.DemandOrder{})))
+		for _, order := range allDemandOrders {
+			for i, field := range k.demandOrderIndexedFields(order) {
+				for _, value := range field.values {
+					if value == "" {
+						continue
+					}
+					expected[i]++
+					key := collections.Join3(int32(order.TrackingPacketStatus), value, order.Id)
+					if ok, err := field.index.Has(ctx, key); err != nil || !ok {
+						msg += fmt.Sprintf("demand order %s not indexed by %s: %v\n", order.Id, value, err)
+						broken = true
+					}
+				}
+			}
+		}
+
+		// no stale entries
+		for i, field := range k.demandOrderIndexedFields(&types.DemandOrder{}) {
+			count := 0
+			err := field.index.Walk(ctx, nil, func(collections.Triple[int32, string, string]) (bool, error) {
+				count++
+				return false, nil
+			})
+			if err != nil {
+				msg += fmt.Sprintf("walk demand order index failed: %v\n", err)
+				broken = true
+			}
+			if count != expected[i] {
+				msg += fmt.Sprintf("demand order index entries mismatch: index(%d) != expected(%d)\n", count, expected[i])
+				broken = true
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, demandOrderIndexesInvariantName, msg), broken
+	}
+}
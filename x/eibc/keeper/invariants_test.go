@@ -42,3 +42,28 @@ func (suite *KeeperTestSuite) TestInvariants() {
 		suite.False(broken)
 	})
 }
+
+func (suite *KeeperTestSuite) TestDemandOrderIndexesInvariant() {
+	keeper := suite.App.EIBCKeeper
+	ctx := suite.Ctx
+	recipient := apptesting.AddTestAddrs(suite.App, ctx, 1, math.NewInt(1000))[0]
+	rollappPacket := commontypes.RollappPacket{
+		RollappId:   "testRollappId",
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 2,
+		Packet:      &packet,
+	}
+	demandOrder := types.NewDemandOrder(rollappPacket, math.NewIntFromUint64(150), math.NewIntFromUint64(50), "stake", recipient.String(), 1)
+	suite.Require().NoError(keeper.SetDemandOrder(ctx, demandOrder))
+
+	_, broken := eibckeeper.DemandOrderIndexesInvariant(keeper)(ctx)
+	suite.Require().False(broken)
+
+	// deleting the order bypassing the keeper leaves its indexes dangling
+	key, err := types.GetDemandOrderKey(demandOrder.TrackingPacketStatus, demandOrder.Id)
+	suite.Require().NoError(err)
+	ctx.KVStore(suite.App.GetKey(types.StoreKey)).Delete(key)
+
+	_, broken = eibckeeper.DemandOrderIndexesInvariant(keeper)(ctx)
+	suite.Require().True(broken)
+}
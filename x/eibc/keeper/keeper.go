@@ -1,19 +1,23 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
-
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -28,6 +32,35 @@ type (
 		bk         types.BankKeeper
 		dack       types.DelayedAckKeeper
 		rk         types.RollappKeeper
+		tk         types.TransferKeeper
+		ck         types.ChannelKeeper
+		authority  string // authority is the x/gov module account
+
+		// The indexes of the demand orders by status and a field of the order.
+		// Index key: status + field + order ID.
+		ordersByRecipient demandOrderIndex
+		// The fulfillers are the fulfiller of a fulfilled order, or the fulfillers of the fills of a
+		// partially filled order.
+		ordersByFulfiller demandOrderIndex
+		ordersByRollapp   demandOrderIndex
+		// The denom is the denom of the order price.
+		ordersByDenom demandOrderIndex
+
+		// fulfillerStats are the stats of the fulfillers.
+		// Key: fulfiller + rollapp ID + denom.
+		fulfillerStats collections.Map[collections.Triple[string, string, string], types.FulfillerStats]
+
+		// rollappFees are the timeout and errack fees set by the rollapp owners, overriding the params.
+		// Key: rollapp ID.
+		rollappFees collections.Map[string, types.RollappFees]
+
+		// vaultCursors are the IDs of the last pending orders scanned by the vaults at the end of a block.
+		// Key: vault denom.
+		vaultCursors collections.Map[string, string]
+
+		// unpaidFills are the partially filled orders whose fills failed to be paid on finalization.
+		// Key: order ID.
+		unpaidFills collections.Map[string, types.DemandOrder]
 	}
 )
 
@@ -40,13 +73,15 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	delayedAckKeeper types.DelayedAckKeeper,
 	rk types.RollappKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return &Keeper{
+	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
+	k := &Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		memKey:     memKey,
@@ -55,40 +90,117 @@ func NewKeeper(
 		bk:         bankKeeper,
 		dack:       delayedAckKeeper,
 		rk:         rk,
+		authority:  authority,
+		ordersByRecipient: newDemandOrderIndex(
+			sb,
+			types.DemandOrdersByRecipientKeyPrefix,
+			"demand_orders_by_recipient",
+		),
+		ordersByFulfiller: newDemandOrderIndex(
+			sb,
+			types.DemandOrdersByFulfillerKeyPrefix,
+			"demand_orders_by_fulfiller",
+		),
+		ordersByRollapp: newDemandOrderIndex(
+			sb,
+			types.DemandOrdersByRollappKeyPrefix,
+			"demand_orders_by_rollapp",
+		),
+		ordersByDenom: newDemandOrderIndex(
+			sb,
+			types.DemandOrdersByDenomKeyPrefix,
+			"demand_orders_by_denom",
+		),
+		fulfillerStats: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.FulfillerStatsKeyPrefix),
+			"fulfiller_stats",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.FulfillerStats](cdc),
+		),
+		rollappFees: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RollappFeesKeyPrefix),
+			"rollapp_fees",
+			collections.StringKey,
+			collcompat.ProtoValue[types.RollappFees](cdc),
+		),
+		vaultCursors: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.VaultCursorsKeyPrefix),
+			"vault_cursors",
+			collections.StringKey,
+			collections.StringValue,
+		),
+		unpaidFills: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.UnpaidFillsKeyPrefix),
+			"unpaid_fills",
+			collections.StringKey,
+			collcompat.ProtoValue[types.DemandOrder](cdc),
+		),
+	}
+
+	// SchemaBuilder CANNOT be used after Build is called,
+	// so we build it after all collections are initialized
+	if _, err := sb.Build(); err != nil {
+		panic(fmt.Errorf("build schema: %w", err))
 	}
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetDemandOrder stores the demand order and keeps its indexes in sync.
 func (k Keeper) SetDemandOrder(ctx sdk.Context, order *types.DemandOrder) error {
 	store := ctx.KVStore(k.storeKey)
 	demandOrderKey, err := types.GetDemandOrderKey(order.TrackingPacketStatus, order.Id)
 	if err != nil {
 		return err
 	}
+	// the fulfillers of an updated order may change
+	if old, err := k.GetDemandOrder(ctx, order.TrackingPacketStatus, order.Id); err == nil {
+		if err := k.removeDemandOrderIndexes(ctx, old); err != nil {
+			return fmt.Errorf("remove indexes: %w", err)
+		}
+	}
 	data, err := k.cdc.Marshal(order)
 	if err != nil {
 		return err
 	}
 	store.Set(demandOrderKey, data)
 
-	return nil
+	return k.setDemandOrderIndexes(ctx, order)
 }
 
-func (k Keeper) deleteDemandOrder(ctx sdk.Context, status commontypes.Status, orderID string) {
+func (k Keeper) deleteDemandOrder(ctx sdk.Context, status commontypes.Status, orderID string) error {
+	order, err := k.GetDemandOrder(ctx, status, orderID)
+	if err != nil {
+		if errors.Is(err, types.ErrDemandOrderDoesNotExist) {
+			return nil
+		}
+		return err
+	}
+	if err := k.removeDemandOrderIndexes(ctx, order); err != nil {
+		return fmt.Errorf("remove indexes: %w", err)
+	}
 	store := ctx.KVStore(k.storeKey)
 	// we can skip error check, the status is known, if key is not valid, order will not be deleted anyway
 	demandOrderKey, _ := types.GetDemandOrderKey(status, orderID)
 	store.Delete(demandOrderKey)
+	return nil
 }
 
 // UpdateDemandOrderWithStatus deletes the current demand order and creates a new one with and updated packet status under a new key.
 // Updating the status should be called only with this method as it effects the key of the packet.
 // The assumption is that the passed demand order packet status field is not updated directly.
 func (k *Keeper) UpdateDemandOrderWithStatus(ctx sdk.Context, demandOrder *types.DemandOrder, newStatus commontypes.Status) (*types.DemandOrder, error) {
-	k.deleteDemandOrder(ctx, demandOrder.TrackingPacketStatus, demandOrder.Id)
+	if err := k.deleteDemandOrder(ctx, demandOrder.TrackingPacketStatus, demandOrder.Id); err != nil {
+		return nil, err
+	}
 
 	demandOrder.TrackingPacketStatus = newStatus
 	err := k.SetDemandOrder(ctx, demandOrder)
@@ -111,14 +223,22 @@ func (k Keeper) SetOrderFulfilled(
 	collectorAddress sdk.AccAddress,
 ) error {
 	order.FulfillerAddress = fulfillerAddress.String()
-	err := k.SetDemandOrder(ctx, order)
-	if err != nil {
-		return err
-	}
 	receiverAddress := fulfillerAddress
 	if collectorAddress != nil {
 		// optional override
 		receiverAddress = collectorAddress
+		order.CollectorAddress = collectorAddress.String()
+	}
+	// the fee is fixed once the order is filled
+	order.FeeCurve = nil
+	err := k.SetDemandOrder(ctx, order)
+	if err != nil {
+		return err
+	}
+	for _, front := range order.Fronts() {
+		if err := k.recordOrderFronted(ctx, order, front); err != nil {
+			return err
+		}
 	}
 	// Call hooks if fulfilled. This hook should be called only once per fulfillment.
 	err = k.hooks.AfterDemandOrderFulfilled(ctx, order, receiverAddress.String())
@@ -129,6 +249,64 @@ func (k Keeper) SetOrderFulfilled(
 	return nil
 }
 
+// SetOrderFilled records a slice of the order price filled by the fulfiller, who already paid the
+// price of the slice to the recipient. On the first slice the underlying packet is redirected to the
+// module account, which pays each fulfiller its slice and fee share on finalization.
+func (k Keeper) SetOrderFilled(
+	ctx sdk.Context,
+	order *types.DemandOrder,
+	fill types.Fill,
+) error {
+	first := !order.IsPartiallyFilled()
+	order.Fills = append(order.Fills, fill)
+	// the fee is fixed once the order is filled
+	order.FeeCurve = nil
+	err := k.SetDemandOrder(ctx, order)
+	if err != nil {
+		return err
+	}
+	err = k.recordOrderFronted(ctx, order, types.Front{Fulfiller: fill.FulfillerAddress, Price: fill.Price, Fee: fill.Fee})
+	if err != nil {
+		return err
+	}
+	if !first {
+		return nil
+	}
+	// Call hooks on the first slice only, the packet is redirected once.
+	return k.hooks.AfterDemandOrderFulfilled(ctx, order, authtypes.NewModuleAddress(types.ModuleName).String())
+}
+
+// PayFills pays the fulfillers of a partially filled order from the module account, once the underlying
+// packet is finalized and its funds were received by the module account. The unfilled part of the
+// order goes to the original recipient.
+func (k Keeper) PayFills(ctx sdk.Context, order *types.DemandOrder) error {
+	denom := order.Price[0].Denom
+	for _, fill := range order.Fills {
+		fulfiller, err := sdk.AccAddressFromBech32(fill.FulfillerAddress)
+		if err != nil {
+			return err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(denom, fill.Price.Add(fill.Fee)))
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, fulfiller, coins); err != nil {
+			return fmt.Errorf("pay fulfiller: %s: %w", fill.FulfillerAddress, err)
+		}
+	}
+
+	remainder := order.Remainder()
+	if remainder.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(denom, remainder))
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, order.GetRecipientBech32Address(), coins); err != nil {
+			return fmt.Errorf("pay recipient: %w", err)
+		}
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventDemandOrderFillsPaid{
+		OrderId:   order.Id,
+		Fills:     uint64(len(order.Fills)),
+		Remainder: remainder.String(),
+	})
+}
+
 // GetDemandOrder returns the demand order with the given id and status.
 func (k Keeper) GetDemandOrder(ctx sdk.Context, status commontypes.Status, id string) (*types.DemandOrder, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -224,18 +402,18 @@ func (k Keeper) ListDemandOrdersByStatusPaginated(
 		pageReq = &query.PageRequest{}
 	}
 
-	pageResp, err = query.Paginate(prefixStore, pageReq, func(key []byte, value []byte) error {
+	pageResp, err = query.FilteredPaginate(prefixStore, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var val types.DemandOrder
 		if err := k.cdc.Unmarshal(value, &val); err != nil {
-			return err
+			return false, err
 		}
-		for _, opt := range opts {
-			if !opt(val) {
-				return nil
-			}
+		if !matchesFilters(val, opts...) {
+			return false, nil
 		}
-		list = append(list, &val)
-		return nil
+		if accumulate {
+			list = append(list, &val)
+		}
+		return true, nil
 	})
 
 	return
@@ -265,3 +443,11 @@ func (k *Keeper) GetHooks() types.EIBCHooks {
 func (k *Keeper) SetDelayedAckKeeper(delayedAckKeeper types.DelayedAckKeeper) {
 	k.dack = delayedAckKeeper
 }
+
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.tk = transferKeeper
+}
+
+func (k *Keeper) SetChannelKeeper(channelKeeper types.ChannelKeeper) {
+	k.ck = channelKeeper
+}
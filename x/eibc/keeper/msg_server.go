@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -40,10 +41,16 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 		return nil, err
 	}
 
-	// Check that the fulfiller expected fee is equal to the demand order fee
+	// no fulfillment while the rollapp bridge is paused by a circuit breaker
+	if err := m.rk.ValidateBridgeNotPaused(ctx, demandOrder.RollappId); err != nil {
+		return nil, err
+	}
+
+	// Check that the fulfiller gets at least the expected fee. The fee of an order with a fee curve
+	// rises with the blocks, so the fulfillment does not have to land in the block it was priced at.
 	expectedFee, _ := sdk.NewIntFromString(msg.ExpectedFee)
 	orderFee := demandOrder.GetFeeAmount()
-	if !orderFee.Equal(expectedFee) {
+	if orderFee.LT(expectedFee) {
 		return nil, types.ErrExpectedFeeNotMet
 	}
 
@@ -52,6 +59,16 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 		return nil, types.ErrFulfillerAddressDoesNotExist
 	}
 
+	fillPrice, err := msg.FillPriceInt()
+	if err != nil {
+		return nil, err
+	}
+	// A slice of the price, or the rest of a partially filled order, is filled partially.
+	// The whole price of an order is filled in one go.
+	if demandOrder.IsPartiallyFilled() || (fillPrice.IsPositive() && !fillPrice.Equal(demandOrder.GetPriceAmount())) {
+		return m.fillOrder(ctx, demandOrder, fulfillerAccount.GetAddress(), fillPrice)
+	}
+
 	// Send the funds from the fulfiller to the eibc packet original recipient
 	err = m.bk.SendCoins(ctx, fulfillerAccount.GetAddress(), demandOrder.GetRecipientBech32Address(), demandOrder.Price)
 	if err != nil {
@@ -59,6 +76,10 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 		return nil, err
 	}
 
+	if err = m.forwardFulfilledOrder(ctx, demandOrder); err != nil {
+		return nil, fmt.Errorf("forward: %w", err)
+	}
+
 	// Fulfill the order by updating the order status and underlying packet recipient
 	if err = m.Keeper.SetOrderFulfilled(ctx, demandOrder, fulfillerAccount.GetAddress(), nil); err != nil {
 		return nil, err
@@ -71,6 +92,40 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 	return &types.MsgFulfillOrderResponse{}, nil
 }
 
+// fillOrder fills a slice of the order price. Zero price fills the remaining price.
+func (m msgServer) fillOrder(ctx sdk.Context, demandOrder *types.DemandOrder, fulfiller sdk.AccAddress, price math.Int) (*types.MsgFulfillOrderResponse, error) {
+	if price.IsZero() {
+		price = demandOrder.RemainingPrice()
+	}
+	forward, err := m.GetOrderForward(ctx, demandOrder)
+	if err != nil {
+		return nil, err
+	}
+	if forward != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFillPrice, "forwarded orders are fulfilled in one go")
+	}
+	fill, err := demandOrder.NewFill(fulfiller.String(), price, m.MinFillFraction(ctx), m.MaxFillsPerOrder(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// Send the price of the slice from the fulfiller to the eibc packet original recipient
+	slice := sdk.NewCoins(sdk.NewCoin(demandOrder.Price[0].Denom, fill.Price))
+	if err = m.bk.SendCoins(ctx, fulfiller, demandOrder.GetRecipientBech32Address(), slice); err != nil {
+		return nil, err
+	}
+
+	if err = m.Keeper.SetOrderFilled(ctx, demandOrder, fill); err != nil {
+		return nil, err
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, demandOrder.GetFilledEvent(fill)); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgFulfillOrderResponse{}, nil
+}
+
 func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgFulfillOrderAuthorized) (*types.MsgFulfillOrderAuthorizedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := ctx.Logger()
@@ -85,6 +140,16 @@ func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgF
 		return nil, err
 	}
 
+	// no fulfillment while the rollapp bridge is paused by a circuit breaker
+	if err := m.rk.ValidateBridgeNotPaused(ctx, demandOrder.RollappId); err != nil {
+		return nil, err
+	}
+
+	// authorized fulfillment fills the whole order in one go
+	if demandOrder.IsPartiallyFilled() {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
+
 	// check compat between the fulfillment and current order and packet status
 	if err := m.validateOrder(demandOrder, msg, ctx); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, err.Error())
@@ -102,6 +167,10 @@ func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgF
 		return nil, err
 	}
 
+	if err = m.forwardFulfilledOrder(ctx, demandOrder); err != nil {
+		return nil, fmt.Errorf("forward: %w", err)
+	}
+
 	operatorAccount := m.ak.GetAccount(ctx, msg.GetOperatorFeeBech32Address())
 	if operatorAccount == nil {
 		return nil, types.ErrOperatorFeeAccountDoesNotExist
@@ -123,6 +192,12 @@ func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgF
 		return nil, err
 	}
 
+	if operatorFee.IsPositive() {
+		if err = m.recordOperatorFeePaid(ctx, demandOrder, msg.LpAddress, operatorFee); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = uevent.EmitTypedEvent(ctx, demandOrder.GetFulfilledAuthorizedEvent(
 		demandOrder.CreationHeight,
 		msg.LpAddress,
@@ -141,14 +216,15 @@ func (m msgServer) validateOrder(demandOrder *types.DemandOrder, msg *types.MsgF
 		return types.ErrRollappIdMismatch
 	}
 
-	if !demandOrder.Price.IsEqual(msg.Price) {
+	// The price of an order with a fee curve falls by as much as the fee rises, so the LP pays at most
+	// the price and the operator gets at least the fee the fulfillment was priced at.
+	if !msg.Price.IsAllGTE(demandOrder.Price) {
 		return types.ErrPriceMismatch
 	}
 
-	// Check that the expected fee is equal to the demand order fee
 	expectedFee, _ := sdk.NewIntFromString(msg.ExpectedFee)
 	orderFee := demandOrder.GetFeeAmount()
-	if !orderFee.Equal(expectedFee) {
+	if orderFee.LT(expectedFee) {
 		return types.ErrExpectedFeeNotMet
 	}
 
@@ -165,8 +241,8 @@ func (m msgServer) validateOrder(demandOrder *types.DemandOrder, msg *types.MsgF
 	return nil
 }
 
-func (m msgServer) checkIfSettlementValidated(ctx sdk.Context, demandOrder *types.DemandOrder) (bool, error) {
-	raPacket, err := m.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
+func (k Keeper) checkIfSettlementValidated(ctx sdk.Context, demandOrder *types.DemandOrder) (bool, error) {
+	raPacket, err := k.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
 	if err != nil {
 		return false, fmt.Errorf("get rollapp packet: %w", err)
 	}
@@ -175,7 +251,7 @@ func (m msgServer) checkIfSettlementValidated(ctx sdk.Context, demandOrder *type
 
 	// as it is not currently possible to make IBC transfers without a canonical client,
 	// we can assume that there has to exist at least one state info record for the rollapp
-	stateInfo, ok := m.rk.GetLatestStateInfo(ctx, demandOrder.RollappId)
+	stateInfo, ok := k.rk.GetLatestStateInfo(ctx, demandOrder.RollappId)
 	if !ok {
 		return false, types.ErrRollappStateInfoNotFound
 	}
@@ -211,6 +287,11 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can update the order")
 	}
 
+	// the fee shares of the filled slices are already set
+	if demandOrder.IsPartiallyFilled() {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
+
 	raPacket, err := m.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
 	if err != nil {
 		// TODO: isn't this internal error?
@@ -241,6 +322,8 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 	denom := demandOrder.Price[0].Denom
 	demandOrder.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFeeInt))
 	demandOrder.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
+	// the fee set by the recipient replaces the fee curve
+	demandOrder.FeeCurve = nil
 
 	if err = m.SetDemandOrder(ctx, demandOrder); err != nil {
 		return nil, err
@@ -253,24 +336,27 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 	return &types.MsgUpdateDemandOrderResponse{}, nil
 }
 
-func (m msgServer) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.DemandOrder, error) {
+func (k Keeper) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.DemandOrder, error) {
 	// Check that the order exists in status PENDING
-	demandOrder, err := m.GetDemandOrder(ctx, commontypes.Status_PENDING, orderId)
+	demandOrder, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, orderId)
 	if err != nil {
 		return nil, err
 	}
 
 	// TODO: would be nice if the demand order already has the proofHeight, so we don't have to fetch the packet
-	packet, err := m.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
+	packet, err := k.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
 	if err != nil {
 		return nil, err
 	}
 
 	// No error means the order is due to be finalized,
 	// in which case the order is not outstanding anymore
-	if err = m.dack.VerifyHeightFinalized(ctx, demandOrder.RollappId, packet.ProofHeight); err == nil {
+	if err = k.dack.VerifyHeightFinalized(ctx, demandOrder.RollappId, packet.ProofHeight); err == nil {
 		return nil, types.ErrDemandOrderInactive
 	}
 
+	// the fee and the price at the current height
+	demandOrder.ApplyFeeCurve(uint64(ctx.BlockHeight()))
+
 	return demandOrder, demandOrder.ValidateOrderIsOutstanding()
 }
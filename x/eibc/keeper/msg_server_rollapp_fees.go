@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetRollappFees sets the timeout and errack fees of a rollapp, bounded by the caps of the params.
+func (m msgServer) SetRollappFees(goCtx context.Context, msg *types.MsgSetRollappFees) (*types.MsgSetRollappFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	rollapp, ok := m.rk.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "rollapp: %s", msg.RollappId)
+	}
+	if rollapp.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the rollapp owner can set the rollapp fees")
+	}
+
+	fees := msg.RollappFees()
+	if err := fees.ValidateCaps(m.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+	if err := m.Keeper.SetRollappFees(ctx, fees); err != nil {
+		return nil, errorsmod.Wrap(err, "set rollapp fees")
+	}
+
+	err := uevent.EmitTypedEvent(ctx, &types.EventRollappFeesSet{
+		RollappId:  fees.RollappId,
+		TimeoutFee: fees.TimeoutFee.String(),
+		ErrackFee:  fees.ErrackFee.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetRollappFeesResponse{}, nil
+}
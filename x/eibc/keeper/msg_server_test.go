@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +15,7 @@ import (
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	dacktypes "github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -29,6 +32,7 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrder() {
 		fulfillmentExpectedFee               string
 		latestFinalizedStateIndex            uint64
 		proofHeight                          uint64
+		bridgePaused                         bool
 		expectedFulfillmentError             error
 		eIBCdemandAddrBalance                math.Int
 		expectedDemandOrdefFulfillmentStatus bool
@@ -56,7 +60,7 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrder() {
 			name:                                 "Test demand order fulfillment - wrong expected fee",
 			demandOrderPrice:                     150,
 			demandOrderFee:                       50,
-			fulfillmentExpectedFee:               "30",
+			fulfillmentExpectedFee:               "70",
 			expectedFulfillmentError:             types.ErrExpectedFeeNotMet,
 			eIBCdemandAddrBalance:                math.NewInt(1000),
 			latestFinalizedStateIndex:            10,
@@ -117,10 +121,28 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrder() {
 			expectedFulfillmentError:             types.ErrDemandOrderInactive,
 			expectedDemandOrdefFulfillmentStatus: false,
 		},
+		{
+			name:                                 "Test demand order fulfillment - failure due to paused bridge",
+			demandOrderPrice:                     150,
+			demandOrderFee:                       50,
+			eIBCdemandAddrBalance:                math.NewInt(1000),
+			latestFinalizedStateIndex:            10,
+			proofHeight:                          10,
+			bridgePaused:                         true,
+			expectedFulfillmentError:             rollapptypes.ErrBridgePaused,
+			expectedDemandOrdefFulfillmentStatus: false,
+		},
 	}
 
 	for _, tc := range tests {
 		suite.Run(tc.name, func() {
+			if tc.bridgePaused {
+				pause := rollapptypes.NewBridgePause(rollappPacket.RollappId, sample.AccAddress(), suite.Ctx.BlockTime().Add(time.Hour))
+				suite.Require().NoError(suite.App.RollappKeeper.SetBridgePause(suite.Ctx, pause))
+				defer func() {
+					suite.Require().NoError(suite.App.RollappKeeper.RemoveBridgePause(suite.Ctx, rollappPacket.RollappId))
+				}()
+			}
 			// Create and fund the account
 			testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, tc.eIBCdemandAddrBalance)
 			eibcSupplyAddr := testAddresses[0]
@@ -288,13 +310,13 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrderAuthorized() {
 			expectedLPAccountBalance:  sdk.NewCoins(sdk.NewInt64Coin("adym", 200)), // Unchanged
 		},
 		{
-			name:           "Failure due to mismatched price",
+			name:           "Failure due to a price above the authorized price",
 			orderPrice:     sdk.NewInt64Coin("adym", 100),
 			orderFee:       sdk.NewInt(10),
 			orderRecipient: sample.AccAddress(),
 			msg: &types.MsgFulfillOrderAuthorized{
 				RollappId:           rollappPacket.RollappId,
-				Price:               sdk.NewCoins(sdk.NewInt64Coin("adym", 110)), // Mismatched Price
+				Price:               sdk.NewCoins(sdk.NewInt64Coin("adym", 90)), // Mismatched Price
 				Amount:              sdk.IntProto{Int: sdk.NewInt(120)},
 				ExpectedFee:         "10",
 				OperatorFeeShare:    sdk.DecProto{Dec: sdk.NewDecWithPrec(2, 1)}, // 0.2
@@ -571,7 +593,7 @@ func (suite *KeeperTestSuite) TestFulfillOrderEvent() {
 		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
 		expectedFee := "50"
 		if tc.fulfillmentShouldFail {
-			expectedFee = "30" // wrong expected fee to fail the fulfillment msg
+			expectedFee = "70" // wrong expected fee to fail the fulfillment msg
 		}
 		msg := types.NewMsgFulfillOrder(eibcDemandAddr.String(), demandOrder.Id, expectedFee)
 		_, err = suite.msgServer.FulfillOrder(suite.Ctx, msg)
@@ -610,7 +632,7 @@ func (suite *KeeperTestSuite) TestMsgUpdateDemandOrder() {
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, sdk.NewInt(100_000))
 	eibcSupplyAddr := testAddresses[0]
 
-	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0, 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 	denom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
 
@@ -682,7 +704,7 @@ func (suite *KeeperTestSuite) TestUpdateDemandOrderOnAckOrTimeout() {
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, sdk.NewInt(100_000))
 	eibcSupplyAddr := testAddresses[0]
 
-	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", sdk.NewDecWithPrec(1, 2), 0, 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	denom := suite.App.StakingKeeper.BondDenom(suite.Ctx)
@@ -714,3 +736,172 @@ func (suite *KeeperTestSuite) TestUpdateDemandOrderOnAckOrTimeout() {
 	suite.Assert().Equal(updatedDemandOrder.Fee.AmountOf(denom), newFee)
 	suite.Assert().Equal(updatedDemandOrder.Price.AmountOf(denom), expectedNewPrice)
 }
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderPartially() {
+	// Create and fund the accounts
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipient := testAddresses[0]
+	fulfillerA := testAddresses[1]
+	fulfillerB := testAddresses[2]
+	denom := sdk.DefaultBondDenom
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(150), math.NewInt(50), denom, recipient.String(), 1)
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder))
+
+	// fill more than the price
+	msg := types.NewMsgFulfillOrderPartially(fulfillerA.String(), demandOrder.Id, "50", "151")
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFillPrice)
+
+	// fill a slice of the price, earning a pro-rata share of the fee
+	msg = types.NewMsgFulfillOrderPartially(fulfillerA.String(), demandOrder.Id, "50", "60")
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(demandOrder.IsPartiallyFilled())
+	suite.Require().False(demandOrder.IsFulfilled())
+	suite.Require().Equal(math.NewInt(20), demandOrder.Fills[0].Fee)
+	suite.Require().Equal(math.NewInt(90), demandOrder.RemainingPrice())
+	suite.Require().Equal(math.NewInt(1060), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+
+	// the fee of a partially filled order can't be updated, nor can it be fulfilled with authorization
+	_, err = suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(recipient.String(), demandOrder.Id, "10"))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderPartiallyFilled)
+	_, err = suite.msgServer.FulfillOrderAuthorized(suite.Ctx, types.NewMsgFulfillOrderAuthorized(
+		demandOrder.Id,
+		rollappPacket.RollappId,
+		fulfillerB.String(),
+		fulfillerB.String(),
+		"50",
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 150)),
+		sdk.IntProto{Int: math.NewInt(200)},
+		sdk.DecProto{Dec: sdk.ZeroDec()},
+		false,
+	))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderPartiallyFilled)
+
+	// a slice below the min fraction of the price is not filled, unless it completes the order
+	params := suite.App.EIBCKeeper.GetParams(suite.Ctx)
+	params.MinFillFraction = sdk.NewDecWithPrec(1, 1) // 15 of the price of 150
+	params.MaxFillsPerOrder = 2
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+	msg = types.NewMsgFulfillOrderPartially(fulfillerB.String(), demandOrder.Id, "50", "14")
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFillPrice)
+
+	// the last fill allowed must complete the order
+	msg = types.NewMsgFulfillOrderPartially(fulfillerB.String(), demandOrder.Id, "50", "15")
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidFillPrice)
+
+	// fill the rest of the price
+	msg = types.NewMsgFulfillOrderPartially(fulfillerB.String(), demandOrder.Id, "50", "")
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(demandOrder.IsFulfilled())
+	suite.Require().Equal(math.NewInt(30), demandOrder.Fills[1].Fee)
+	suite.Require().True(demandOrder.Remainder().IsZero())
+	suite.Require().Equal(math.NewInt(1150), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+
+	// the order is fulfilled
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrderPartially(fulfillerB.String(), demandOrder.Id, "50", "10"))
+	suite.Require().ErrorIs(err, types.ErrDemandAlreadyFulfilled)
+
+	// the module account receives the funds of the packet on finalization and pays the fulfillers
+	suite.Require().NoError(bankutil.FundModuleAccount(suite.App.BankKeeper, suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *rollappPacket)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(math.NewInt(1000-60+60+20), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000-90+90+30), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerB, denom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMsgPayFills() {
+	// Create and fund the accounts
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	recipient := testAddresses[0]
+	fulfillerA := testAddresses[1]
+	fulfillerB := testAddresses[2]
+	denom := sdk.DefaultBondDenom
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(150), math.NewInt(50), denom, recipient.String(), 1)
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder))
+
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrderPartially(fulfillerA.String(), demandOrder.Id, "50", "60"))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrderPartially(fulfillerB.String(), demandOrder.Id, "50", ""))
+	suite.Require().NoError(err)
+
+	// nothing to pay before finalization
+	_, err = suite.msgServer.PayFills(suite.Ctx, types.NewMsgPayFills(recipient.String(), demandOrder.Id))
+	suite.Require().ErrorIs(err, types.ErrNoUnpaidFills)
+
+	// the module account didn't receive the funds, the payment fails without failing the finalization
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *rollappPacket)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000-60), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+
+	unpaid, err := suite.App.EIBCKeeper.ListUnpaidFills(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(unpaid, 1)
+	suite.Require().Equal(demandOrder.Id, unpaid[0].Id)
+
+	// the fills stay unpaid until the payment succeeds
+	_, err = suite.msgServer.PayFills(suite.Ctx, types.NewMsgPayFills(recipient.String(), demandOrder.Id))
+	suite.Require().Error(err)
+
+	suite.Require().NoError(bankutil.FundModuleAccount(suite.App.BankKeeper, suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	_, err = suite.msgServer.PayFills(suite.Ctx, types.NewMsgPayFills(recipient.String(), demandOrder.Id))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(math.NewInt(1000-60+60+20), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerA, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000-90+90+30), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfillerB, denom).Amount)
+
+	// the fills are paid once only
+	_, err = suite.msgServer.PayFills(suite.Ctx, types.NewMsgPayFills(recipient.String(), demandOrder.Id))
+	suite.Require().ErrorIs(err, types.ErrNoUnpaidFills)
+}
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderFeeCurve() {
+	// Create and fund the accounts
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient := testAddresses[0]
+	fulfiller := testAddresses[1]
+	denom := sdk.DefaultBondDenom
+
+	// the fee rises from 50 to 250 over 20 blocks
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(450), math.NewInt(50), denom, recipient.String(), 10)
+	demandOrder.FeeCurve = &types.FeeCurve{MaxFee: math.NewInt(250), Blocks: 20}
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder))
+
+	ctx := suite.Ctx.WithBlockHeight(20)
+	res, err := keeper.NewQuerier(suite.App.EIBCKeeper).DemandOrderById(sdk.WrapSDKContext(ctx), &types.QueryGetDemandOrderRequest{Id: demandOrder.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(150), res.DemandOrder.GetFeeAmount())
+	suite.Require().Equal(math.NewInt(350), res.DemandOrder.GetPriceAmount())
+
+	// the fee has not reached the expected fee yet
+	_, err = suite.msgServer.FulfillOrder(ctx, types.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id, "160"))
+	suite.Require().ErrorIs(err, types.ErrExpectedFeeNotMet)
+
+	// a fulfillment priced at an earlier block gets the current fee
+	_, err = suite.msgServer.FulfillOrder(ctx, types.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id, "50"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1350), suite.App.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+
+	// the fee is fixed once the order is fulfilled
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().Nil(demandOrder.FeeCurve)
+	suite.Require().Equal(math.NewInt(150), demandOrder.GetFeeAmount())
+	suite.Require().Equal(math.NewInt(350), demandOrder.GetPriceAmount())
+}
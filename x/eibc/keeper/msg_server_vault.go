@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// SetVault creates the vault of a denom, or updates the criteria of an existing vault.
+func (m msgServer) SetVault(goCtx context.Context, msg *types.MsgSetVault) (*types.MsgSetVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != m.authority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the gov module can set vaults")
+	}
+
+	vault, ok := m.GetVault(ctx, msg.Denom)
+	if !ok {
+		vault = types.NewVault(msg.Denom, msg.Criteria)
+	}
+	vault.Criteria = msg.Criteria
+	m.Keeper.SetVault(ctx, vault)
+
+	return &types.MsgSetVaultResponse{}, nil
+}
+
+func (m msgServer) DepositToVault(goCtx context.Context, msg *types.MsgDepositToVault) (*types.MsgDepositToVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	shares, err := m.Keeper.DepositToVault(ctx, sdk.MustAccAddressFromBech32(msg.Depositor), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgDepositToVaultResponse{Shares: shares}, nil
+}
+
+func (m msgServer) WithdrawFromVault(goCtx context.Context, msg *types.MsgWithdrawFromVault) (*types.MsgWithdrawFromVaultResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	amount, err := m.Keeper.WithdrawFromVault(ctx, sdk.MustAccAddressFromBech32(msg.Withdrawer), msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawFromVaultResponse{Amount: amount}, nil
+}
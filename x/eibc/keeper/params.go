@@ -11,6 +11,12 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.EpochIdentifier(ctx),
 		k.TimeoutFee(ctx),
 		k.ErrAckFee(ctx),
+		k.MaxRollappTimeoutFee(ctx),
+		k.MaxRollappErrAckFee(ctx),
+		k.MinRollappTimeoutFee(ctx),
+		k.MinRollappErrAckFee(ctx),
+		k.MinFillFraction(ctx),
+		k.MaxFillsPerOrder(ctx),
 	)
 }
 
@@ -33,3 +39,33 @@ func (k Keeper) ErrAckFee(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyErrAckFee, &res)
 	return
 }
+
+func (k Keeper) MaxRollappTimeoutFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxRollappTimeoutFee, &res)
+	return
+}
+
+func (k Keeper) MaxRollappErrAckFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMaxRollappErrAckFee, &res)
+	return
+}
+
+func (k Keeper) MinRollappTimeoutFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinRollappTimeoutFee, &res)
+	return
+}
+
+func (k Keeper) MinRollappErrAckFee(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinRollappErrAckFee, &res)
+	return
+}
+
+func (k Keeper) MinFillFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinFillFraction, &res)
+	return
+}
+
+func (k Keeper) MaxFillsPerOrder(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxFillsPerOrder, &res)
+	return
+}
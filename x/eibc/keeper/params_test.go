@@ -3,9 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+
 	testkeeper "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/eibc"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
-	"github.com/stretchr/testify/require"
 )
 
 func TestGetParams(t *testing.T) {
@@ -16,3 +20,20 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func (suite *KeeperTestSuite) TestParamChangeProposalBounds() {
+	handler := eibc.NewParamChangeProposalHandler(suite.App.EIBCKeeper, params.NewParamChangeProposalHandler(suite.App.ParamsKeeper))
+	propose := func(key, value string) error {
+		ctx, _ := suite.Ctx.CacheContext()
+		return handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			{Subspace: types.ModuleName, Key: key, Value: value},
+		}))
+	}
+
+	// within the bounds
+	suite.Require().NoError(propose(string(types.KeyTimeoutFee), `"0.002000000000000000"`))
+	// each param is valid on its own, but not with the others
+	suite.Require().Error(propose(string(types.KeyMinRollappTimeoutFee), `"0.020000000000000000"`))
+	suite.Require().Error(propose(string(types.KeyMaxRollappErrAckFee), `"0.000100000000000000"`))
+	suite.Require().Error(propose(string(types.KeyTimeoutFee), `"0.500000000000000000"`))
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// QuoteDemandOrder returns the demand order that would be created for a transfer between the hub and the
+// rollapp over its canonical channel, without saving it. The packet type is ON_RECV for a transfer from the
+// rollapp, and ON_TIMEOUT or ON_ACK for a failed transfer to the rollapp. It returns nil if no demand order
+// would be created for the transfer, and an error if the transfer would be rejected.
+func (k Keeper) QuoteDemandOrder(
+	ctx sdk.Context,
+	rollappID string,
+	packetType commontypes.RollappPacket_Type,
+	data transfertypes.FungibleTokenPacketData,
+) (*types.DemandOrder, error) {
+	rollapp, ok := k.rk.GetRollapp(ctx, rollappID)
+	if !ok {
+		return nil, fmt.Errorf("rollapp not found: %s", rollappID)
+	}
+	if rollapp.ChannelId == "" {
+		return nil, fmt.Errorf("rollapp has no canonical channel: %s", rollappID)
+	}
+	channel, ok := k.ck.GetChannel(ctx, transfertypes.PortID, rollapp.ChannelId)
+	if !ok {
+		return nil, fmt.Errorf("canonical channel not found: %s", rollapp.ChannelId)
+	}
+
+	// the packet the transfer would be sent in, the hub end is the canonical channel
+	packet := channeltypes.Packet{Data: data.GetBytes()}
+	switch packetType {
+	case commontypes.RollappPacket_ON_RECV:
+		packet.SourcePort = channel.Counterparty.PortId
+		packet.SourceChannel = channel.Counterparty.ChannelId
+		packet.DestinationPort = transfertypes.PortID
+		packet.DestinationChannel = rollapp.ChannelId
+	case commontypes.RollappPacket_ON_TIMEOUT, commontypes.RollappPacket_ON_ACK:
+		packet.SourcePort = transfertypes.PortID
+		packet.SourceChannel = rollapp.ChannelId
+		packet.DestinationPort = channel.Counterparty.PortId
+		packet.DestinationChannel = channel.Counterparty.ChannelId
+	default:
+		return nil, fmt.Errorf("invalid packet type: %s", packetType)
+	}
+
+	rollappPacket := commontypes.RollappPacket{
+		RollappId:   rollappID,
+		Packet:      &packet,
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: uint64(ctx.BlockHeight()),
+		Type:        packetType,
+	}
+	return k.newDemandOrder(ctx, rollappPacket, data)
+}
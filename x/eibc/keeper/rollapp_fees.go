@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// GetRollappFees returns the fees set by the owner of a rollapp.
+func (k Keeper) GetRollappFees(ctx sdk.Context, rollappID string) (types.RollappFees, bool, error) {
+	fees, err := k.rollappFees.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RollappFees{}, false, nil
+	}
+	if err != nil {
+		return types.RollappFees{}, false, err
+	}
+	return fees, true, nil
+}
+
+func (k Keeper) SetRollappFees(ctx sdk.Context, fees types.RollappFees) error {
+	return k.rollappFees.Set(ctx, fees.RollappId, fees)
+}
+
+// ListRollappFees lists the fees set by the owners of all the rollapps.
+func (k Keeper) ListRollappFees(ctx sdk.Context) ([]types.RollappFees, error) {
+	iter, err := k.rollappFees.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// EffectiveRollappFees returns the timeout and errack fees charged for the packets of a rollapp.
+// The fees set by the rollapp owner are bounded by the current floors and caps, as governance can
+// change them after the fees are set. The params fees apply to rollapps without fees.
+func (k Keeper) EffectiveRollappFees(ctx sdk.Context, rollappID string) (types.RollappFees, error) {
+	fees, ok, err := k.GetRollappFees(ctx, rollappID)
+	if err != nil {
+		return types.RollappFees{}, err
+	}
+	if !ok {
+		return types.NewRollappFees(rollappID, k.TimeoutFee(ctx), k.ErrAckFee(ctx)), nil
+	}
+	return types.NewRollappFees(
+		rollappID,
+		sdk.MinDec(sdk.MaxDec(fees.TimeoutFee, k.MinRollappTimeoutFee(ctx)), k.MaxRollappTimeoutFee(ctx)),
+		sdk.MinDec(sdk.MaxDec(fees.ErrackFee, k.MinRollappErrAckFee(ctx)), k.MaxRollappErrAckFee(ctx)),
+	), nil
+}
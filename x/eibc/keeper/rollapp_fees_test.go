@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestSetRollappFees() {
+	rollappID := suite.CreateDefaultRollapp()
+	rollapp, _ := suite.App.RollappKeeper.GetRollapp(suite.Ctx, rollappID)
+	owner := rollapp.Owner
+	other := apptesting.CreateRandomAccounts(1)[0].String()
+
+	params := suite.App.EIBCKeeper.GetParams(suite.Ctx)
+	params.TimeoutFee = sdk.NewDecWithPrec(1, 3)           // 0.001
+	params.ErrackFee = sdk.NewDecWithPrec(1, 3)            // 0.001
+	params.MaxRollappTimeoutFee = sdk.NewDecWithPrec(1, 2) // 0.01
+	params.MaxRollappErrackFee = sdk.NewDecWithPrec(1, 2)  // 0.01
+	params.MinRollappTimeoutFee = sdk.NewDecWithPrec(1, 4) // 0.0001
+	params.MinRollappErrackFee = sdk.NewDecWithPrec(1, 4)  // 0.0001
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+
+	queryFees := func(rollappID string) *types.QueryRollappFeesResponse {
+		res, err := suite.queryClient.RollappFees(sdk.WrapSDKContext(suite.Ctx), &types.QueryRollappFeesRequest{RollappId: rollappID})
+		suite.Require().NoError(err)
+		return res
+	}
+
+	// without fees, the params fees apply
+	res := queryFees(rollappID)
+	suite.Require().Equal(params.TimeoutFee, res.TimeoutFee)
+	suite.Require().Equal(params.ErrackFee, res.ErrackFee)
+	suite.Require().Nil(res.RollappFees)
+
+	timeoutFee := sdk.NewDecWithPrec(5, 3) // 0.005
+	errAckFee := sdk.NewDecWithPrec(2, 3)  // 0.002
+
+	// only the owner of an existing rollapp can set the fees
+	_, err := suite.msgServer.SetRollappFees(suite.Ctx, types.NewMsgSetRollappFees(owner, "unknown_1-1", timeoutFee, errAckFee))
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+	_, err = suite.msgServer.SetRollappFees(suite.Ctx, types.NewMsgSetRollappFees(other, rollappID, timeoutFee, errAckFee))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the fees are bounded by the floors and caps
+	_, err = suite.msgServer.SetRollappFees(suite.Ctx, types.NewMsgSetRollappFees(owner, rollappID, sdk.NewDecWithPrec(2, 2), errAckFee))
+	suite.Require().ErrorIs(err, types.ErrFeeTooHigh)
+	_, err = suite.msgServer.SetRollappFees(suite.Ctx, types.NewMsgSetRollappFees(owner, rollappID, timeoutFee, sdk.ZeroDec()))
+	suite.Require().ErrorIs(err, types.ErrFeeTooLow)
+
+	_, err = suite.msgServer.SetRollappFees(suite.Ctx, types.NewMsgSetRollappFees(owner, rollappID, timeoutFee, errAckFee))
+	suite.Require().NoError(err)
+
+	res = queryFees(rollappID)
+	suite.Require().Equal(timeoutFee, res.TimeoutFee)
+	suite.Require().Equal(errAckFee, res.ErrackFee)
+	suite.Require().Equal(types.NewRollappFees(rollappID, timeoutFee, errAckFee), *res.RollappFees)
+
+	// the orders of the rollapp use its fees
+	newOrder := func(packetType commontypes.RollappPacket_Type) *types.DemandOrder {
+		rollappPacket := &commontypes.RollappPacket{
+			RollappId: rollappID,
+			Status:    commontypes.Status_PENDING,
+			Type:      packetType,
+			Packet:    &packet,
+		}
+		data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", other, owner, "")
+		order, err := suite.App.EIBCKeeper.CreateDemandOrderOnErrAckOrTimeout(suite.Ctx, data, rollappPacket)
+		suite.Require().NoError(err)
+		return order
+	}
+	suite.Require().Equal(math.NewInt(5).String(), newOrder(commontypes.RollappPacket_ON_TIMEOUT).GetFeeAmount().String())
+	suite.Require().Equal(math.NewInt(2).String(), newOrder(commontypes.RollappPacket_ON_ACK).GetFeeAmount().String())
+
+	// governance lowering a cap bounds the fees already set
+	params.MaxRollappTimeoutFee = sdk.NewDecWithPrec(3, 3) // 0.003
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+
+	res = queryFees(rollappID)
+	suite.Require().Equal(params.MaxRollappTimeoutFee, res.TimeoutFee)
+	suite.Require().Equal(errAckFee, res.ErrackFee)
+	suite.Require().Equal(timeoutFee, res.RollappFees.TimeoutFee)
+	suite.Require().Equal(math.NewInt(3).String(), newOrder(commontypes.RollappPacket_ON_TIMEOUT).GetFeeAmount().String())
+
+	// governance raising a floor bounds the fees already set
+	params.MinRollappErrackFee = sdk.NewDecWithPrec(4, 3) // 0.004
+	params.ErrackFee = params.MinRollappErrackFee
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+
+	res = queryFees(rollappID)
+	suite.Require().Equal(params.MinRollappErrackFee, res.ErrackFee)
+	suite.Require().Equal(errAckFee, res.RollappFees.ErrackFee)
+	suite.Require().Equal(math.NewInt(4).String(), newOrder(commontypes.RollappPacket_ON_ACK).GetFeeAmount().String())
+
+	// the other rollapps keep the params fees
+	res = queryFees("other_2-1")
+	suite.Require().Equal(params.TimeoutFee, res.TimeoutFee)
+	suite.Require().Nil(res.RollappFees)
+}
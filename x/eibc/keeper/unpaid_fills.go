@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// PayFills pays the fills of a partially filled order whose payment failed on finalization.
+func (m msgServer) PayFills(goCtx context.Context, msg *types.MsgPayFills) (*types.MsgPayFillsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := m.RetryPayFills(ctx, msg.OrderId); err != nil {
+		return nil, err
+	}
+	return &types.MsgPayFillsResponse{}, nil
+}
+
+// setUnpaidFills records a copy of the order whose fills failed to be paid, as the order is deleted
+// with the finalized packet.
+func (k Keeper) setUnpaidFills(ctx sdk.Context, order types.DemandOrder, payErr error) error {
+	if err := k.unpaidFills.Set(ctx, order.Id, order); err != nil {
+		return errorsmod.Wrap(err, "set unpaid fills")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventDemandOrderFillsUnpaid{
+		OrderId: order.Id,
+		Error:   payErr.Error(),
+	})
+}
+
+// RetryPayFills pays the unpaid fills of the order. They are no longer unpaid once the payment succeeds.
+func (k Keeper) RetryPayFills(ctx sdk.Context, orderID string) error {
+	order, err := k.unpaidFills.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrNoUnpaidFills, "order: %s", orderID)
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get unpaid fills")
+	}
+	if err := k.PayFills(ctx, &order); err != nil {
+		return err
+	}
+	return errorsmod.Wrap(k.unpaidFills.Remove(ctx, orderID), "remove unpaid fills")
+}
+
+// ListUnpaidFills returns the partially filled orders whose fills are unpaid.
+func (k Keeper) ListUnpaidFills(ctx sdk.Context) ([]types.DemandOrder, error) {
+	iter, err := k.unpaidFills.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetUnpaidFills records the partially filled order whose fills are unpaid.
+func (k Keeper) SetUnpaidFills(ctx sdk.Context, order types.DemandOrder) error {
+	return k.unpaidFills.Set(ctx, order.Id, order)
+}
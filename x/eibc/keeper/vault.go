@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (k Keeper) SetVault(ctx sdk.Context, vault types.Vault) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVaultKey(vault.Denom), k.cdc.MustMarshal(&vault))
+}
+
+func (k Keeper) GetVault(ctx sdk.Context, denom string) (types.Vault, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVaultKey(denom))
+	if bz == nil {
+		return types.Vault{}, false
+	}
+	var vault types.Vault
+	k.cdc.MustUnmarshal(bz, &vault)
+	return vault, true
+}
+
+func (k Keeper) ListVaults(ctx sdk.Context) []types.Vault {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VaultKeyPrefix)
+	defer iterator.Close() // nolint: errcheck
+
+	var list []types.Vault
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Vault
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return list
+}
+
+// VaultLiquidity returns the liquidity of the vault available to fulfill orders and to withdraw.
+func (k Keeper) VaultLiquidity(_ sdk.Context, vault types.Vault) sdk.Coin {
+	return sdk.NewCoin(vault.Denom, vault.Liquidity)
+}
+
+// VaultShares returns the total supply of the vault shares.
+func (k Keeper) VaultShares(ctx sdk.Context, vault types.Vault) sdk.Coin {
+	return k.bk.GetSupply(ctx, vault.ShareDenom())
+}
+
+// vaultValue is the liquidity of the vault and the price and fee due for the orders it fulfilled.
+// The fee of an order accrues to the value when the vault fulfills it, so that depositing just before
+// the finalization of the packet does not capture it.
+func (k Keeper) vaultValue(ctx sdk.Context, vault types.Vault) math.Int {
+	return k.VaultLiquidity(ctx, vault).Amount.Add(vault.Locked)
+}
+
+// DepositToVault deposits liquidity into the vault of its denom and mints the depositor shares at the
+// current share price.
+func (k Keeper) DepositToVault(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	vault, ok := k.GetVault(ctx, amount.Denom)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVaultNotFound, "denom: %s", amount.Denom)
+	}
+
+	sharesAmt, err := types.SharesForDeposit(amount.Amount, k.vaultValue(ctx, vault), k.VaultShares(ctx, vault).Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !sharesAmt.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVault, "deposit too small: %s", amount)
+	}
+	shares := sdk.NewCoin(vault.ShareDenom(), sharesAmt)
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, depositor, types.VaultAccountName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("send deposit: %w", err)
+	}
+	if err := k.bk.MintCoins(ctx, types.VaultAccountName, sdk.NewCoins(shares)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("mint shares: %w", err)
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.VaultAccountName, depositor, sdk.NewCoins(shares)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("send shares: %w", err)
+	}
+
+	vault.Liquidity = vault.Liquidity.Add(amount.Amount)
+	k.SetVault(ctx, vault)
+
+	return shares, uevent.EmitTypedEvent(ctx, &types.EventVaultDeposit{
+		Denom:     vault.Denom,
+		Depositor: depositor.String(),
+		Amount:    amount.Amount.String(),
+		Shares:    shares.Amount.String(),
+	})
+}
+
+// WithdrawFromVault burns the shares and pays the withdrawer their value. The liquidity locked in the
+// orders fulfilled by the vault can't be withdrawn until their packets are finalized.
+func (k Keeper) WithdrawFromVault(ctx sdk.Context, withdrawer sdk.AccAddress, shares sdk.Coin) (sdk.Coin, error) {
+	denom, ok := types.VaultDenomFromShareDenom(shares.Denom)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVaultNotFound, "share denom: %s", shares.Denom)
+	}
+	vault, ok := k.GetVault(ctx, denom)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVaultNotFound, "share denom: %s", shares.Denom)
+	}
+
+	amount := sdk.NewCoin(vault.Denom, types.AmountForShares(shares.Amount, k.vaultValue(ctx, vault), k.VaultShares(ctx, vault).Amount))
+	if !amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVault, "withdrawal too small: %s", shares)
+	}
+	if liquidity := k.VaultLiquidity(ctx, vault); liquidity.IsLT(amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrVaultLiquidityLocked, "liquidity: %s: withdrawal: %s", liquidity, amount)
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, withdrawer, types.VaultAccountName, sdk.NewCoins(shares)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("send shares: %w", err)
+	}
+	if err := k.bk.BurnCoins(ctx, types.VaultAccountName, sdk.NewCoins(shares)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("burn shares: %w", err)
+	}
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.VaultAccountName, withdrawer, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, fmt.Errorf("send withdrawal: %w", err)
+	}
+
+	vault.Liquidity = vault.Liquidity.Sub(amount.Amount)
+	k.SetVault(ctx, vault)
+
+	return amount, uevent.EmitTypedEvent(ctx, &types.EventVaultWithdrawal{
+		Denom:      vault.Denom,
+		Withdrawer: withdrawer.String(),
+		Amount:     amount.Amount.String(),
+		Shares:     shares.Amount.String(),
+	})
+}
+
+// FulfillOrdersFromVaults fulfills the outstanding demand orders meeting the criteria of a vault with
+// enough liquidity. It is called at the end of each block.
+func (k Keeper) FulfillOrdersFromVaults(ctx sdk.Context) {
+	for _, vault := range k.ListVaults(ctx) {
+		orders, err := k.nextVaultOrders(ctx, vault)
+		if err != nil {
+			k.Logger(ctx).Error("List vault demand orders.", "denom", vault.Denom, "err", err)
+			continue
+		}
+
+		fulfilled := 0
+		for _, order := range orders {
+			if fulfilled == types.MaxVaultFulfillmentsPerBlock {
+				break
+			}
+			order, err := k.GetOutstandingOrder(ctx, order.Id)
+			if err != nil {
+				continue
+			}
+			if !k.vaultAcceptsOrder(ctx, vault.Denom, order) {
+				continue
+			}
+			err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.fulfillOrderFromVault(ctx, vault.Denom, order)
+			})
+			if err != nil {
+				continue
+			}
+			fulfilled++
+		}
+	}
+}
+
+// nextVaultOrders returns the unfulfilled pending orders of the vault denom and rollapps among the next
+// MaxVaultScannedOrdersPerBlock pending orders of the denom after the vault cursor. The cursor is moved to
+// the last scanned order, and starts over once all the orders are scanned.
+func (k Keeper) nextVaultOrders(ctx sdk.Context, vault types.Vault) ([]*types.DemandOrder, error) {
+	cursor, err := k.vaultCursors.Get(ctx, vault.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, fmt.Errorf("get vault cursor: %w", err)
+	}
+
+	status := commontypes.Status_PENDING
+	iter, err := k.ordersByDenom.Iterate(ctx, demandOrdersAfter{status: int32(status), value: vault.Denom, after: cursor})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+
+	var list []*types.DemandOrder
+	for scanned := 0; iter.Valid() && scanned < types.MaxVaultScannedOrdersPerBlock; iter.Next() {
+		scanned++
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		cursor = key.K3()
+		order, err := k.GetDemandOrder(ctx, status, key.K3())
+		if err != nil {
+			return nil, fmt.Errorf("get indexed demand order: %s: %w", key.K3(), err)
+		}
+		if matchesFilters(*order, isFulfillmentState(types.FulfillmentState_UNFULFILLED), isRollappIdIn(vault.Criteria.Rollapps)) {
+			list = append(list, order)
+		}
+	}
+
+	if !iter.Valid() {
+		// all the orders are scanned, start over in the next block
+		return list, k.vaultCursors.Remove(ctx, vault.Denom)
+	}
+	return list, k.vaultCursors.Set(ctx, vault.Denom, cursor)
+}
+
+// vaultAcceptsOrder returns whether the outstanding order meets the criteria of the vault, and the vault
+// has the liquidity to fulfill it.
+func (k Keeper) vaultAcceptsOrder(ctx sdk.Context, denom string, order *types.DemandOrder) bool {
+	vault, ok := k.GetVault(ctx, denom)
+	if !ok || order.IsPartiallyFilled() || !slices.Contains(vault.Criteria.Rollapps, order.RollappId) {
+		return false
+	}
+	if k.VaultLiquidity(ctx, vault).Amount.LT(order.GetPriceAmount()) {
+		return false
+	}
+	if err := k.rk.ValidateBridgeNotPaused(ctx, order.RollappId); err != nil {
+		return false
+	}
+
+	rollappPacket, err := k.dack.GetRollappPacket(ctx, order.TrackingPacketKey)
+	if err != nil {
+		return false
+	}
+	data, err := rollappPacket.GetTransferPacketData()
+	if err != nil {
+		return false
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok || !vault.Criteria.AcceptsFee(order.GetFeeAmount(), amount) {
+		return false
+	}
+
+	if vault.Criteria.SettlementValidated {
+		validated, err := k.checkIfSettlementValidated(ctx, order)
+		if err != nil || !validated {
+			return false
+		}
+	}
+	return true
+}
+
+// fulfillOrderFromVault pays the price of the order to the recipient from the vault liquidity, and locks
+// the price and the fee due until the packet is finalized and the vault receives them.
+func (k Keeper) fulfillOrderFromVault(ctx sdk.Context, denom string, order *types.DemandOrder) error {
+	vault, _ := k.GetVault(ctx, denom)
+	vaultAddr := types.VaultAddress()
+
+	if err := k.bk.SendCoins(ctx, vaultAddr, order.GetRecipientBech32Address(), order.Price); err != nil {
+		return fmt.Errorf("send price: %w", err)
+	}
+	if err := k.forwardFulfilledOrder(ctx, order); err != nil {
+		return fmt.Errorf("forward: %w", err)
+	}
+	if err := k.SetOrderFulfilled(ctx, order, vaultAddr, nil); err != nil {
+		return err
+	}
+
+	vault.Liquidity = vault.Liquidity.Sub(order.GetPriceAmount())
+	vault.Locked = vault.Locked.Add(order.GetPriceAmount()).Add(order.GetFeeAmount())
+	k.SetVault(ctx, vault)
+
+	if err := uevent.EmitTypedEvent(ctx, order.GetFulfilledEvent()); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventVaultOrderFulfilled{
+		Denom:   vault.Denom,
+		OrderId: order.Id,
+		Price:   order.GetPriceAmount().String(),
+		Fee:     order.GetFeeAmount().String(),
+	})
+}
+
+// unlockVaultOrder releases the price and the fee locked in an order fulfilled by a vault once the packet
+// is no longer pending. If received, the price and the fee were received by the vault account, and are added
+// to the vault liquidity. Otherwise the packet failed, and the loss is shared by the vault depositors.
+func (k Keeper) unlockVaultOrder(ctx sdk.Context, order *types.DemandOrder, received bool) {
+	if order.FulfillerAddress != types.VaultAddress().String() {
+		return
+	}
+	vault, ok := k.GetVault(ctx, order.Price[0].Denom)
+	if !ok {
+		return
+	}
+	due := order.GetPriceAmount().Add(order.GetFeeAmount())
+	vault.Locked = math.MaxInt(vault.Locked.Sub(due), math.ZeroInt())
+	if received {
+		// the vault account balance beyond the liquidity was received but not accounted for yet
+		untracked := k.bk.GetBalance(ctx, types.VaultAddress(), vault.Denom).Amount.Sub(vault.Liquidity)
+		amount := math.MinInt(due, math.MaxInt(untracked, math.ZeroInt()))
+		vault.Liquidity = vault.Liquidity.Add(amount)
+	}
+	k.SetVault(ctx, vault)
+}
@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestVault() {
+	// Create and fund the accounts
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(2000))
+	recipient := testAddresses[0]
+	lpA := testAddresses[1]
+	lpB := testAddresses[2]
+	denom := sdk.DefaultBondDenom
+	shareDenom := types.VaultShareDenom(denom)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	criteria := types.VaultCriteria{
+		Rollapps:         []string{rollappPacket.RollappId},
+		MinFeePercentage: sdk.DecProto{Dec: sdk.NewDecWithPrec(5, 2)}, // 5%
+	}
+
+	// only the gov module sets vaults
+	_, err := suite.msgServer.SetVault(suite.Ctx, types.NewMsgSetVault(lpA.String(), denom, criteria))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lpA.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().ErrorIs(err, types.ErrVaultNotFound)
+	_, err = suite.msgServer.SetVault(suite.Ctx, types.NewMsgSetVault(authority, denom, criteria))
+	suite.Require().NoError(err)
+
+	// the first deposit mints shares one to one
+	res, err := suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lpA.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(shareDenom, 1000), res.Shares)
+
+	// the vault fulfills the order meeting its criteria only
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	cheapOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(960), math.NewInt(40), denom, recipient.String(), 1)
+	cheapOrder.Id = "cheap"
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, cheapOrder))
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(900), math.NewInt(100), denom, recipient.String(), 1)
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder))
+
+	suite.App.EIBCKeeper.FulfillOrdersFromVaults(suite.Ctx)
+
+	cheapOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, cheapOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(cheapOrder.IsFulfilled())
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.VaultAddress().String(), demandOrder.FulfillerAddress)
+	suite.Require().Equal(math.NewInt(2900), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, denom).Amount)
+
+	vaultRes, err := suite.queryClient.Vault(sdk.WrapSDKContext(suite.Ctx), &types.QueryVaultRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000), vaultRes.Vault.Locked)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 100), vaultRes.Liquidity)
+	suite.Require().Equal(sdk.NewInt64Coin(shareDenom, 1000), vaultRes.Shares)
+
+	// the liquidity locked in the pending order can't be withdrawn
+	_, err = suite.msgServer.WithdrawFromVault(suite.Ctx, types.NewMsgWithdrawFromVault(lpA.String(), sdk.NewInt64Coin(shareDenom, 1000)))
+	suite.Require().ErrorIs(err, types.ErrVaultLiquidityLocked)
+
+	// the locked price and fee are part of the share price, so that a deposit just before the finalization
+	// does not capture the fee
+	res, err = suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lpB.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(shareDenom, 100*1001/1101), res.Shares)
+
+	// the vault receives the price and the fee on finalization
+	suite.Require().NoError(bankutil.FundAccount(suite.App.BankKeeper, suite.Ctx, types.VaultAddress(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *rollappPacket)
+	suite.Require().NoError(err)
+
+	vault, ok := suite.App.EIBCKeeper.GetVault(suite.Ctx, denom)
+	suite.Require().True(ok)
+	suite.Require().True(vault.Locked.IsZero())
+
+	withdrawRes, err := suite.msgServer.WithdrawFromVault(suite.Ctx, types.NewMsgWithdrawFromVault(lpA.String(), sdk.NewInt64Coin(shareDenom, 1000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 1000*1201/1091), withdrawRes.Amount)
+	withdrawRes, err = suite.msgServer.WithdrawFromVault(suite.Ctx, types.NewMsgWithdrawFromVault(lpB.String(), res.Shares))
+	suite.Require().NoError(err)
+	// the late depositor does not earn the fee, and the virtual share and unit of value of the vault round
+	// the withdrawal down
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 90*101/91), withdrawRes.Amount)
+	suite.Require().True(suite.App.BankKeeper.GetSupply(suite.Ctx, shareDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestVaultSandwich() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(100_000))
+	recipient := testAddresses[0]
+	lp := testAddresses[1]
+	attacker := testAddresses[2]
+	denom := sdk.DefaultBondDenom
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	criteria := types.VaultCriteria{
+		Rollapps:         []string{rollappPacket.RollappId},
+		MinFeePercentage: sdk.DecProto{Dec: sdk.NewDecWithPrec(5, 2)},
+	}
+	_, err := suite.msgServer.SetVault(suite.Ctx, types.NewMsgSetVault(authority, denom, criteria))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lp.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(900), math.NewInt(100), denom, recipient.String(), 1)
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder))
+	suite.App.EIBCKeeper.FulfillOrdersFromVaults(suite.Ctx)
+
+	// deposit a lot right before the finalization, and withdraw right after it
+	res, err := suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(attacker.String(), sdk.NewInt64Coin(denom, 90_000)))
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(bankutil.FundAccount(suite.App.BankKeeper, suite.Ctx, types.VaultAddress(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *rollappPacket)
+	suite.Require().NoError(err)
+
+	withdrawRes, err := suite.msgServer.WithdrawFromVault(suite.Ctx, types.NewMsgWithdrawFromVault(attacker.String(), res.Shares))
+	suite.Require().NoError(err)
+	suite.Require().True(withdrawRes.Amount.Amount.LTE(math.NewInt(90_000)), "attacker withdrew %s", withdrawRes.Amount)
+
+	// the fee went to the depositor whose liquidity fulfilled the order
+	withdrawRes, err = suite.msgServer.WithdrawFromVault(suite.Ctx, types.NewMsgWithdrawFromVault(lp.String(), sdk.NewInt64Coin(types.VaultShareDenom(denom), 1000)))
+	suite.Require().NoError(err)
+	suite.Require().True(withdrawRes.Amount.Amount.GTE(math.NewInt(1099)), "depositor withdrew %s", withdrawRes.Amount)
+}
+
+func (suite *KeeperTestSuite) TestVaultDonation() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(20_000))
+	lpA := testAddresses[0]
+	lpB := testAddresses[1]
+	denom := sdk.DefaultBondDenom
+	shareDenom := types.VaultShareDenom(denom)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	criteria := types.VaultCriteria{
+		Rollapps:         []string{rollappPacket.RollappId},
+		MinFeePercentage: sdk.DecProto{Dec: sdk.NewDecWithPrec(5, 2)},
+	}
+	_, err := suite.msgServer.SetVault(suite.Ctx, types.NewMsgSetVault(authority, denom, criteria))
+	suite.Require().NoError(err)
+
+	// the funds sent to the vault account are not part of the liquidity, and don't inflate the share price
+	_, err = suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lpA.String(), sdk.NewInt64Coin(denom, 1)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, lpA, types.VaultAddress(), sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000))))
+
+	res, err := suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lpB.String(), sdk.NewInt64Coin(denom, 10_000)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(shareDenom, 10_000), res.Shares)
+
+	vaultRes, err := suite.queryClient.Vault(sdk.WrapSDKContext(suite.Ctx), &types.QueryVaultRequest{Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 10_001), vaultRes.Liquidity)
+
+	withdrawRes, err := suite.msgServer.WithdrawFromVault(suite.Ctx, types.NewMsgWithdrawFromVault(lpB.String(), res.Shares))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 10_000), withdrawRes.Amount)
+}
+
+func (suite *KeeperTestSuite) TestVaultScanCursor() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(2000))
+	recipient := testAddresses[0]
+	lp := testAddresses[1]
+	denom := sdk.DefaultBondDenom
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	criteria := types.VaultCriteria{
+		Rollapps:         []string{rollappPacket.RollappId},
+		MinFeePercentage: sdk.DecProto{Dec: sdk.NewDecWithPrec(5, 2)},
+	}
+	_, err := suite.msgServer.SetVault(suite.Ctx, types.NewMsgSetVault(authority, denom, criteria))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.DepositToVault(suite.Ctx, types.NewMsgDepositToVault(lp.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+
+	// the orders not meeting the criteria fill the scan budget of a block
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	for i := 0; i < types.MaxVaultScannedOrdersPerBlock; i++ {
+		cheapOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(960), math.NewInt(40), denom, recipient.String(), 1)
+		cheapOrder.Id = fmt.Sprintf("a%03d", i)
+		suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, cheapOrder))
+	}
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(900), math.NewInt(100), denom, recipient.String(), 1)
+	demandOrder.Id = "b"
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder))
+
+	// the order is scanned in the next block only
+	suite.App.EIBCKeeper.FulfillOrdersFromVaults(suite.Ctx)
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(demandOrder.IsFulfilled())
+
+	suite.App.EIBCKeeper.FulfillOrdersFromVaults(suite.Ctx)
+	demandOrder, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.VaultAddress().String(), demandOrder.FulfillerAddress)
+}
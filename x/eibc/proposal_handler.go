@@ -0,0 +1,36 @@
+package eibc
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// NewParamChangeProposalHandler wraps the handler of the param change proposals. The subspace validates
+// each changed param on its own, so the x/eibc params are validated as a whole after the changes, which
+// keeps the fees within the floors and caps of the rollapp fees.
+func NewParamChangeProposalHandler(k keeper.Keeper, handler govv1beta1.Handler) govv1beta1.Handler {
+	return func(ctx sdk.Context, content govv1beta1.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+			if err := k.GetParams(ctx).Validate(); err != nil {
+				return errorsmod.Wrap(err, "eibc params")
+			}
+			return nil
+		}
+		return nil
+	}
+}
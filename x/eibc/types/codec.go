@@ -13,6 +13,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFulfillOrder{}, "eibc/MsgFulfillOrder", nil)
 	cdc.RegisterConcrete(&MsgFulfillOrderAuthorized{}, "eibc/MsgFulfillOrderAuthorized", nil)
 	cdc.RegisterConcrete(&MsgUpdateDemandOrder{}, "eibc/MsgUpdateDemandOrder", nil)
+	cdc.RegisterConcrete(&MsgSetVault{}, "eibc/MsgSetVault", nil)
+	cdc.RegisterConcrete(&MsgDepositToVault{}, "eibc/MsgDepositToVault", nil)
+	cdc.RegisterConcrete(&MsgWithdrawFromVault{}, "eibc/MsgWithdrawFromVault", nil)
+	cdc.RegisterConcrete(&MsgSetRollappFees{}, "eibc/MsgSetRollappFees", nil)
+	cdc.RegisterConcrete(&MsgPayFills{}, "eibc/MsgPayFills", nil)
 	cdc.RegisterConcrete(&FulfillOrderAuthorization{}, "eibc/FulfillOrderAuthorization", nil)
 }
 
@@ -23,6 +28,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgFulfillOrder{},
 		&MsgFulfillOrderAuthorized{},
 		&MsgUpdateDemandOrder{},
+		&MsgSetVault{},
+		&MsgDepositToVault{},
+		&MsgWithdrawFromVault{},
+		&MsgSetRollappFees{},
+		&MsgPayFills{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
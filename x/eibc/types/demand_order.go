@@ -4,7 +4,9 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"slices"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -66,6 +68,24 @@ func (m *DemandOrder) ValidateBasic() error {
 		return ErrInvalidCreationHeight
 	}
 
+	for _, fill := range m.Fills {
+		if _, err := sdk.AccAddressFromBech32(fill.FulfillerAddress); err != nil {
+			return errorsmod.Wrap(ErrInvalidFillPrice, "fulfiller address")
+		}
+		if fill.Price.IsNil() || !fill.Price.IsPositive() || fill.Fee.IsNil() || fill.Fee.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidFillPrice, "price: %s: fee: %s", fill.Price, fill.Fee)
+		}
+	}
+	if m.IsPartiallyFilled() && (m.RemainingPrice().IsNegative() || m.Remainder().IsNegative()) {
+		return errorsmod.Wrap(ErrInvalidFillPrice, "fills exceed the order")
+	}
+
+	if m.FeeCurve != nil {
+		if err := ValidateFeeCurve(m.GetFeeAmount(), m.GetPriceAmount(), m.FeeCurve.MaxFee, m.FeeCurve.Blocks); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -170,8 +190,141 @@ func (m *DemandOrder) ValidateOrderIsOutstanding() error {
 	return nil
 }
 
+// IsFulfilled returns true if the order was fulfilled in one go, or if its whole price was filled in slices.
 func (m *DemandOrder) IsFulfilled() bool {
-	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled
+	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled || (m.IsPartiallyFilled() && m.RemainingPrice().IsZero())
+}
+
+// IsPartiallyFilled returns true if at least one slice of the order price was filled.
+func (m *DemandOrder) IsPartiallyFilled() bool {
+	return len(m.Fills) != 0
+}
+
+// Fulfillers returns the fulfiller of a fulfilled order, or the fulfillers of the fills of a partially
+// filled order, without duplicates.
+func (m *DemandOrder) Fulfillers() []string {
+	var fulfillers []string
+	if m.FulfillerAddress != "" {
+		fulfillers = append(fulfillers, m.FulfillerAddress)
+	}
+	for _, fill := range m.Fills {
+		if !slices.Contains(fulfillers, fill.FulfillerAddress) {
+			fulfillers = append(fulfillers, fill.FulfillerAddress)
+		}
+	}
+	return fulfillers
+}
+
+// GetPriceAmount returns the price amount of the demand order.
+func (m *DemandOrder) GetPriceAmount() math.Int {
+	return m.Price.AmountOf(m.Price[0].Denom)
+}
+
+// FilledPrice returns the sum of the prices of the filled slices.
+func (m *DemandOrder) FilledPrice() math.Int {
+	filled := math.ZeroInt()
+	for _, fill := range m.Fills {
+		filled = filled.Add(fill.Price)
+	}
+	return filled
+}
+
+// FilledFee returns the sum of the fee shares of the filled slices.
+func (m *DemandOrder) FilledFee() math.Int {
+	filled := math.ZeroInt()
+	for _, fill := range m.Fills {
+		filled = filled.Add(fill.Fee)
+	}
+	return filled
+}
+
+// RemainingPrice returns the part of the price not filled yet.
+func (m *DemandOrder) RemainingPrice() math.Int {
+	return m.GetPriceAmount().Sub(m.FilledPrice())
+}
+
+// NewFill returns the slice of the order filling the given price, with its pro-rata share of the fee.
+// The last slice gets the rest of the fee, so that the shares add up to the order fee.
+// A slice which does not complete the order must fill at least the min fraction of the order price,
+// and leave room for the fill completing the order within the max number of fills, as all the fills
+// of an order are iterated when it is settled.
+func (m *DemandOrder) NewFill(fulfiller string, price math.Int, minFraction sdk.Dec, maxFills uint64) (Fill, error) {
+	remaining := m.RemainingPrice()
+	if !price.IsPositive() || price.GT(remaining) {
+		return Fill{}, errorsmod.Wrapf(ErrInvalidFillPrice, "price: %s: remaining: %s", price, remaining)
+	}
+	if !price.Equal(remaining) {
+		minPrice := minFraction.MulInt(m.GetPriceAmount()).Ceil().TruncateInt()
+		if price.LT(minPrice) {
+			return Fill{}, errorsmod.Wrapf(ErrInvalidFillPrice, "price below min fill: price: %s: min: %s", price, minPrice)
+		}
+		if uint64(len(m.Fills))+1 >= maxFills {
+			return Fill{}, errorsmod.Wrapf(ErrInvalidFillPrice, "only the remaining price can be filled: max fills: %d", maxFills)
+		}
+	}
+	fee := m.GetFeeAmount().Mul(price).Quo(m.GetPriceAmount())
+	if price.Equal(remaining) {
+		fee = m.GetFeeAmount().Sub(m.FilledFee())
+	}
+	return Fill{
+		FulfillerAddress: fulfiller,
+		Price:            price,
+		Fee:              fee,
+	}, nil
+}
+
+// Remainder returns the part of the transfer which goes to the original recipient on finalization of a
+// partially filled order: the unfilled price and the unpaid fee.
+func (m *DemandOrder) Remainder() math.Int {
+	return m.GetPriceAmount().Add(m.GetFeeAmount()).Sub(m.FilledPrice()).Sub(m.FilledFee())
+}
+
+func (m *DemandOrder) GetFilledEvent(fill Fill) *EventDemandOrderFilled {
+	return &EventDemandOrderFilled{
+		OrderId:     m.Id,
+		Fulfiller:   fill.FulfillerAddress,
+		Price:       fill.Price.String(),
+		Fee:         fill.Fee.String(),
+		FilledPrice: m.FilledPrice().String(),
+		IsFulfilled: m.IsFulfilled(),
+		PacketType:  m.Type.String(),
+	}
+}
+
+// ValidateFeeCurve checks that the max fee of a fee curve is at least the initial fee, and below the
+// price and fee together, so that the price stays positive.
+func ValidateFeeCurve(fee, price, maxFee math.Int, blocks uint64) error {
+	if maxFee.IsNil() || maxFee.LT(fee) || maxFee.GTE(price.Add(fee)) {
+		return errorsmod.Wrapf(ErrInvalidFeeCurve, "max fee: %s", maxFee)
+	}
+	if blocks == 0 {
+		return errorsmod.Wrap(ErrInvalidFeeCurve, "zero blocks")
+	}
+	return nil
+}
+
+// FeeAt returns the fee of the order at the given hub height, following the fee curve if any.
+func (m *DemandOrder) FeeAt(height uint64) math.Int {
+	fee := m.GetFeeAmount()
+	if m.FeeCurve == nil || height <= m.CreationHeight {
+		return fee
+	}
+	elapsed := min(height-m.CreationHeight, m.FeeCurve.Blocks)
+	rise := m.FeeCurve.MaxFee.Sub(fee).Mul(math.NewIntFromUint64(elapsed)).Quo(math.NewIntFromUint64(m.FeeCurve.Blocks))
+	return fee.Add(rise)
+}
+
+// ApplyFeeCurve sets the fee and the price of an unfilled order to their values at the given hub height.
+// The price falls by as much as the fee rises.
+func (m *DemandOrder) ApplyFeeCurve(height uint64) {
+	if m.FeeCurve == nil || m.IsFulfilled() || m.IsPartiallyFilled() {
+		return
+	}
+	denom := m.Price[0].Denom
+	total := m.GetPriceAmount().Add(m.GetFeeAmount())
+	fee := m.FeeAt(height)
+	m.Fee = sdk.NewCoins(sdk.NewCoin(denom, fee))
+	m.Price = sdk.NewCoins(sdk.NewCoin(denom, total.Sub(fee)))
 }
 
 // BuildDemandIDFromPacketKey returns a unique demand order id from the packet key.
@@ -48,6 +48,16 @@ type DemandOrder struct {
 	FulfillerAddress string `protobuf:"bytes,11,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
 	// creation_height is the height of the block on the hub when order was created.
 	CreationHeight uint64 `protobuf:"varint,12,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// fills are the slices of the price filled by fulfillers, when the order is filled partially.
+	// An order filled in one go has no fills and sets fulfiller_address instead.
+	Fills []Fill `protobuf:"bytes,13,rep,name=fills,proto3" json:"fills"`
+	// fee_curve is the optional curve of the fee: starting from fee at creation_height, the fee rises
+	// linearly to the max fee. Fee and price are fixed once the order is filled.
+	FeeCurve *FeeCurve `protobuf:"bytes,14,opt,name=fee_curve,json=feeCurve,proto3" json:"fee_curve,omitempty"`
+	// collector_address is the bech32-encoded address of the account which paid the price of the order
+	// and collects the funds of the packet, when it is not the fulfiller: the liquidity provider of an
+	// authorized fulfillment.
+	CollectorAddress string `protobuf:"bytes,15,opt,name=collector_address,json=collectorAddress,proto3" json:"collector_address,omitempty"`
 }
 
 func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
@@ -161,8 +171,130 @@ func (m *DemandOrder) GetCreationHeight() uint64 {
 	return 0
 }
 
+func (m *DemandOrder) GetFills() []Fill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetFeeCurve() *FeeCurve {
+	if m != nil {
+		return m.FeeCurve
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetCollectorAddress() string {
+	if m != nil {
+		return m.CollectorAddress
+	}
+	return ""
+}
+
+// FeeCurve is a fee rising linearly from the order fee to max_fee over a number of hub blocks.
+// The price falls by as much as the fee rises, so that their sum is unchanged.
+type FeeCurve struct {
+	// max_fee is the fee reached at the end of the curve.
+	MaxFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_fee,json=maxFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_fee"`
+	// blocks is the number of hub blocks the fee takes to rise to max_fee.
+	Blocks uint64 `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (m *FeeCurve) Reset()         { *m = FeeCurve{} }
+func (m *FeeCurve) String() string { return proto.CompactTextString(m) }
+func (*FeeCurve) ProtoMessage()    {}
+func (*FeeCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{1}
+}
+func (m *FeeCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCurve.Merge(m, src)
+}
+func (m *FeeCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCurve proto.InternalMessageInfo
+
+func (m *FeeCurve) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+// Fill is a slice of the price of a demand order filled by one fulfiller.
+type Fill struct {
+	// fulfiller_address is the bech32-encoded address of the account which filled the slice.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// price is the amount the fulfiller sent to the recipient.
+	Price github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"price"`
+	// fee is the pro-rata share of the order fee the fulfiller gets on finalization, with the price.
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee"`
+}
+
+func (m *Fill) Reset()         { *m = Fill{} }
+func (m *Fill) String() string { return proto.CompactTextString(m) }
+func (*Fill) ProtoMessage()    {}
+func (*Fill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{2}
+}
+func (m *Fill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fill.Merge(m, src)
+}
+func (m *Fill) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fill) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fill proto.InternalMessageInfo
+
+func (m *Fill) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.eibc.DemandOrder")
+	proto.RegisterType((*FeeCurve)(nil), "dymensionxyz.dymension.eibc.FeeCurve")
+	proto.RegisterType((*Fill)(nil), "dymensionxyz.dymension.eibc.Fill")
 }
 
 func init() {
@@ -170,39 +302,49 @@ func init() {
 }
 
 var fileDescriptor_2fc99140861fbacd = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xba, 0x75, 0x5a, 0x5d, 0x54, 0x98, 0x19, 0x60, 0x06, 0x64, 0x15, 0x12, 0x22, 0x02,
-	0xe1, 0xd0, 0xee, 0xc6, 0x8d, 0xf2, 0x43, 0x4c, 0x3b, 0x80, 0x02, 0x27, 0x10, 0x8a, 0x52, 0xfb,
-	0xb5, 0xb5, 0xda, 0xc4, 0x91, 0xed, 0x4e, 0x0b, 0x47, 0xfe, 0x02, 0xfe, 0x0e, 0xfe, 0x92, 0x1d,
-	0x77, 0xe4, 0x04, 0xa8, 0xfd, 0x47, 0x50, 0xec, 0x74, 0x1d, 0x48, 0x85, 0x0b, 0xa7, 0x24, 0xdf,
-	0xfb, 0xbe, 0xf7, 0xf9, 0xf3, 0x7b, 0x41, 0x94, 0x17, 0x29, 0x64, 0x5a, 0xc8, 0xec, 0xa4, 0xf8,
-	0x14, 0x9e, 0x7f, 0x84, 0x20, 0x06, 0x2c, 0xe4, 0x90, 0x26, 0x19, 0x8f, 0xa5, 0xe2, 0xa0, 0x68,
-	0xae, 0xa4, 0x91, 0xf8, 0xd6, 0x45, 0xfe, 0x4a, 0x4c, 0x4b, 0xfe, 0xde, 0xee, 0x48, 0x8e, 0xa4,
-	0xe5, 0x85, 0xe5, 0x9b, 0x93, 0xec, 0x3d, 0x58, 0x63, 0xc1, 0x64, 0x9a, 0xca, 0x2c, 0xd4, 0x26,
-	0x31, 0x33, 0x5d, 0x71, 0x7b, 0x7f, 0xe7, 0x2a, 0x39, 0x9d, 0x26, 0x79, 0x1e, 0xe7, 0x09, 0x9b,
-	0x80, 0xa9, 0x34, 0x3e, 0x93, 0x3a, 0x95, 0x3a, 0x1c, 0x24, 0x1a, 0xc2, 0xe3, 0xee, 0x00, 0x4c,
-	0xd2, 0x0d, 0x99, 0x14, 0x99, 0xab, 0xdf, 0xfd, 0xdc, 0x40, 0xad, 0xe7, 0x36, 0xc9, 0xeb, 0x32,
-	0x08, 0x6e, 0xa3, 0xba, 0xe0, 0xc4, 0xeb, 0x78, 0x41, 0x33, 0xaa, 0x0b, 0x8e, 0x29, 0xba, 0x6a,
-	0x54, 0xc2, 0x26, 0x22, 0x1b, 0x55, 0x8d, 0xe3, 0x09, 0x14, 0xa4, 0x6e, 0x09, 0x3b, 0xcb, 0xd2,
-	0x1b, 0x5b, 0x39, 0x82, 0x02, 0x27, 0xa8, 0x91, 0x2b, 0xc1, 0x80, 0x6c, 0x74, 0x36, 0x82, 0x56,
-	0xef, 0x26, 0x75, 0xfe, 0xb4, 0xf4, 0xa7, 0x95, 0x3f, 0x7d, 0x26, 0x45, 0xd6, 0x7f, 0x7c, 0xfa,
-	0x7d, 0xbf, 0xf6, 0xf5, 0xc7, 0x7e, 0x30, 0x12, 0x66, 0x3c, 0x1b, 0x50, 0x26, 0xd3, 0xb0, 0x3a,
-	0xac, 0x7b, 0x3c, 0xd2, 0x7c, 0x12, 0x9a, 0x22, 0x07, 0x6d, 0x05, 0x3a, 0x72, 0x9d, 0xf1, 0x47,
-	0xb4, 0x31, 0x04, 0x20, 0x9b, 0xff, 0xdf, 0xa0, 0xec, 0x8b, 0x6f, 0xa3, 0xa6, 0x02, 0x26, 0x72,
-	0x01, 0x99, 0x21, 0x0d, 0x9b, 0x73, 0x05, 0xe0, 0x27, 0xe8, 0x06, 0x87, 0x5c, 0x01, 0x4b, 0x0c,
-	0xf0, 0x58, 0xe8, 0x78, 0x38, 0x9b, 0x0e, 0xc5, 0x74, 0x0a, 0x9c, 0x6c, 0x75, 0xbc, 0x60, 0xbb,
-	0x5f, 0x27, 0x5e, 0x74, 0x6d, 0x45, 0x39, 0xd4, 0x2f, 0x97, 0x04, 0xfc, 0x01, 0x5d, 0xff, 0xf3,
-	0x2e, 0xdd, 0x7c, 0xc9, 0x76, 0xc7, 0x0b, 0xda, 0xbd, 0x7b, 0x74, 0xcd, 0xfe, 0xb8, 0x01, 0xd3,
-	0xb7, 0x96, 0x1c, 0xed, 0xfe, 0x7e, 0xeb, 0x0e, 0xc5, 0x77, 0x10, 0x5a, 0x2e, 0x80, 0xe0, 0xa4,
-	0x59, 0x9d, 0xdb, 0x21, 0x87, 0x1c, 0xbf, 0x40, 0x9b, 0x65, 0x52, 0x82, 0xac, 0x53, 0xf7, 0x1f,
-	0x4e, 0x91, 0xd3, 0x39, 0x03, 0xfa, 0xae, 0xc8, 0x21, 0xb2, 0x72, 0xfc, 0x10, 0xed, 0x2c, 0x03,
-	0xab, 0x38, 0xe1, 0x5c, 0x81, 0xd6, 0xa4, 0x65, 0xcd, 0xae, 0x9c, 0x17, 0x9e, 0x3a, 0x1c, 0xdf,
-	0x47, 0x97, 0x99, 0x82, 0xc4, 0x08, 0x99, 0xc5, 0x63, 0x10, 0xa3, 0xb1, 0x21, 0x97, 0x3a, 0x5e,
-	0xb0, 0x19, 0xb5, 0x97, 0xf0, 0x2b, 0x8b, 0xf6, 0x8f, 0x4e, 0xe7, 0xbe, 0x77, 0x36, 0xf7, 0xbd,
-	0x9f, 0x73, 0xdf, 0xfb, 0xb2, 0xf0, 0x6b, 0x67, 0x0b, 0xbf, 0xf6, 0x6d, 0xe1, 0xd7, 0xde, 0x77,
-	0x2f, 0xcc, 0x6e, 0xcd, 0xf6, 0x1f, 0x1f, 0x84, 0x27, 0xee, 0x8f, 0xb4, 0xa3, 0x1c, 0x6c, 0xd9,
-	0xc5, 0x3e, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x42, 0xcd, 0xcc, 0xbd, 0x03, 0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0xda, 0xae, 0xb4, 0x2e, 0x74, 0xcc, 0x8c, 0x11, 0x06, 0x74, 0x65, 0xd2, 0xa0, 0x02,
+	0xe1, 0xd0, 0xee, 0x86, 0x84, 0x04, 0xdd, 0x18, 0x4c, 0x3b, 0x80, 0x02, 0x27, 0x10, 0x8a, 0x52,
+	0xe7, 0xb5, 0xb3, 0x9a, 0xc4, 0x51, 0xec, 0x4e, 0x2d, 0xbf, 0x82, 0xdf, 0xc1, 0x91, 0x2b, 0x7f,
+	0x60, 0xc7, 0x1d, 0x11, 0x87, 0x81, 0xb6, 0x3f, 0x82, 0x6c, 0xa7, 0xeb, 0x40, 0x74, 0xa0, 0x89,
+	0x53, 0xe2, 0xf7, 0xbe, 0xef, 0x7d, 0xfe, 0xec, 0xf7, 0x8c, 0x48, 0x30, 0x8e, 0x20, 0x16, 0x8c,
+	0xc7, 0xa3, 0xf1, 0x07, 0xe7, 0x64, 0xe1, 0x00, 0xeb, 0x52, 0x27, 0x80, 0xc8, 0x8f, 0x03, 0x8f,
+	0xa7, 0x01, 0xa4, 0x24, 0x49, 0xb9, 0xe4, 0xf8, 0xc6, 0x69, 0xfc, 0x94, 0x4c, 0x14, 0x7e, 0x79,
+	0xb1, 0xcf, 0xfb, 0x5c, 0xe3, 0x1c, 0xf5, 0x67, 0x28, 0xcb, 0xf7, 0x66, 0x48, 0x50, 0x1e, 0x45,
+	0x3c, 0x76, 0x84, 0xf4, 0xe5, 0x50, 0x64, 0xd8, 0xf6, 0xd9, 0xd8, 0x94, 0x87, 0xa1, 0x9f, 0x24,
+	0x5e, 0xe2, 0xd3, 0x01, 0xc8, 0x8c, 0x53, 0xa7, 0x5c, 0x44, 0x5c, 0x38, 0x5d, 0x5f, 0x80, 0xb3,
+	0xd7, 0xea, 0x82, 0xf4, 0x5b, 0x0e, 0xe5, 0x2c, 0x36, 0xf9, 0xd5, 0xcf, 0x25, 0x54, 0xdd, 0xd4,
+	0x4e, 0x5e, 0x2a, 0x23, 0xb8, 0x86, 0xf2, 0x2c, 0xb0, 0xad, 0x86, 0xd5, 0xac, 0xb8, 0x79, 0x16,
+	0x60, 0x82, 0xae, 0xc8, 0xd4, 0xa7, 0x03, 0x16, 0xf7, 0xb3, 0xc2, 0xde, 0x00, 0xc6, 0x76, 0x5e,
+	0x03, 0x16, 0x26, 0xa9, 0x57, 0x3a, 0xb3, 0x03, 0x63, 0xec, 0xa3, 0xb9, 0x24, 0x65, 0x14, 0xec,
+	0x42, 0xa3, 0xd0, 0xac, 0xb6, 0xaf, 0x13, 0xa3, 0x4f, 0x94, 0x3e, 0xc9, 0xf4, 0xc9, 0x06, 0x67,
+	0x71, 0xe7, 0xe1, 0xfe, 0xe1, 0x4a, 0xee, 0xd3, 0xf7, 0x95, 0x66, 0x9f, 0xc9, 0xdd, 0x61, 0x97,
+	0x50, 0x1e, 0x39, 0xd9, 0x66, 0xcd, 0xe7, 0x81, 0x08, 0x06, 0x8e, 0x1c, 0x27, 0x20, 0x34, 0x41,
+	0xb8, 0xa6, 0x32, 0x7e, 0x8f, 0x0a, 0x3d, 0x00, 0xbb, 0xf8, 0xff, 0x05, 0x54, 0x5d, 0x7c, 0x13,
+	0x55, 0x52, 0xa0, 0x2c, 0x61, 0x10, 0x4b, 0x7b, 0x4e, 0xfb, 0x9c, 0x06, 0xf0, 0x23, 0x74, 0x2d,
+	0x80, 0x24, 0x05, 0xea, 0x4b, 0x08, 0x3c, 0x26, 0xbc, 0xde, 0x30, 0xec, 0xb1, 0x30, 0x84, 0xc0,
+	0x2e, 0x35, 0xac, 0x66, 0xb9, 0x93, 0xb7, 0x2d, 0xf7, 0xea, 0x14, 0xb2, 0x2d, 0xb6, 0x26, 0x00,
+	0xfc, 0x0e, 0x2d, 0xfd, 0x7e, 0x96, 0xe6, 0x7e, 0xed, 0x72, 0xc3, 0x6a, 0xd6, 0xda, 0x6b, 0x64,
+	0x46, 0xff, 0x98, 0x0b, 0x26, 0xaf, 0x35, 0xd8, 0x5d, 0xfc, 0xf5, 0xd4, 0x4d, 0x14, 0xdf, 0x42,
+	0x68, 0xd2, 0x00, 0x2c, 0xb0, 0x2b, 0xd9, 0xbe, 0x4d, 0x64, 0x3b, 0xc0, 0xcf, 0x50, 0x51, 0x39,
+	0xb5, 0x91, 0x56, 0x6a, 0xfd, 0x45, 0xc9, 0x35, 0x3c, 0x23, 0x40, 0xde, 0x8c, 0x13, 0x70, 0x35,
+	0x1d, 0xdf, 0x47, 0x0b, 0x13, 0xc3, 0xa9, 0xe7, 0x07, 0x41, 0x0a, 0x42, 0xd8, 0x55, 0x2d, 0x76,
+	0xf9, 0x24, 0xf1, 0xd4, 0xc4, 0xf1, 0x5d, 0x34, 0x4f, 0x53, 0xf0, 0x25, 0xe3, 0xb1, 0xb7, 0x0b,
+	0xac, 0xbf, 0x2b, 0xed, 0x8b, 0x0d, 0xab, 0x59, 0x74, 0x6b, 0x93, 0xf0, 0x0b, 0x1d, 0xc5, 0x8f,
+	0xd1, 0x9c, 0x62, 0x0a, 0xfb, 0x92, 0xbe, 0xd3, 0xdb, 0xe4, 0x8c, 0x39, 0x22, 0x5b, 0x2c, 0x0c,
+	0x3b, 0x45, 0x75, 0xb7, 0xae, 0x61, 0xe1, 0x0e, 0xaa, 0xf4, 0x00, 0x3c, 0x3a, 0x4c, 0xf7, 0xc0,
+	0xae, 0x35, 0xac, 0x66, 0xb5, 0xbd, 0x76, 0x76, 0x09, 0x80, 0x0d, 0x05, 0x76, 0xcb, 0xbd, 0xec,
+	0x4f, 0x19, 0xa3, 0x3c, 0x0c, 0x81, 0x4a, 0x3e, 0x35, 0x36, 0x6f, 0x8c, 0x9d, 0x24, 0x32, 0x63,
+	0xab, 0x03, 0x54, 0x9e, 0x94, 0xc0, 0xcf, 0xd1, 0x85, 0xc8, 0x1f, 0x79, 0xaa, 0x23, 0xf5, 0xd4,
+	0x74, 0x88, 0xda, 0xda, 0xb7, 0xc3, 0x95, 0x3b, 0xff, 0xd0, 0x76, 0xdb, 0xb1, 0x74, 0x4b, 0x91,
+	0x3f, 0xda, 0x02, 0xc0, 0x4b, 0xa8, 0xd4, 0x0d, 0x39, 0x1d, 0x08, 0x3d, 0x5c, 0x45, 0x37, 0x5b,
+	0xad, 0x7e, 0xb1, 0x50, 0x51, 0x79, 0xfe, 0xf3, 0xd9, 0x5b, 0x33, 0xce, 0x7e, 0x73, 0x32, 0x87,
+	0xf9, 0x73, 0x6d, 0x2a, 0x1b, 0xb5, 0x27, 0x66, 0xd4, 0x0a, 0xe7, 0xaa, 0xa1, 0xa8, 0x9d, 0x9d,
+	0xfd, 0xa3, 0xba, 0x75, 0x70, 0x54, 0xb7, 0x7e, 0x1c, 0xd5, 0xad, 0x8f, 0xc7, 0xf5, 0xdc, 0xc1,
+	0x71, 0x3d, 0xf7, 0xf5, 0xb8, 0x9e, 0x7b, 0xdb, 0x3a, 0x55, 0x66, 0xc6, 0xc3, 0xb6, 0xb7, 0xee,
+	0x8c, 0xcc, 0x63, 0xab, 0xab, 0x76, 0x4b, 0xfa, 0xcd, 0x5a, 0xff, 0x39, 0x00, 0x46, 0x21, 0xd7,
+	0x23, 0x98, 0x05, 0x00, 0x00,
 }
 
 func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
@@ -225,6 +367,39 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CollectorAddress) > 0 {
+		i -= len(m.CollectorAddress)
+		copy(dAtA[i:], m.CollectorAddress)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.CollectorAddress)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.FeeCurve != nil {
+		{
+			size, err := m.FeeCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.CreationHeight != 0 {
 		i = encodeVarintDemandOrder(dAtA, i, uint64(m.CreationHeight))
 		i--
@@ -316,6 +491,94 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Fill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDemandOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDemandOrder(v)
 	base := offset
@@ -377,6 +640,51 @@ func (m *DemandOrder) Size() (n int) {
 	if m.CreationHeight != 0 {
 		n += 1 + sovDemandOrder(uint64(m.CreationHeight))
 	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovDemandOrder(uint64(l))
+		}
+	}
+	if m.FeeCurve != nil {
+		l = m.FeeCurve.Size()
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = len(m.CollectorAddress)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxFee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovDemandOrder(uint64(m.Blocks))
+	}
+	return n
+}
+
+func (m *Fill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
 	return n
 }
 
@@ -720,6 +1028,361 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, Fill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeCurve == nil {
+				m.FeeCurve = &FeeCurve{}
+			}
+			if err := m.FeeCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDemandOrder(dAtA[iNdEx:])
@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func TestDemandOrder_FeeCurve(t *testing.T) {
+	newOrder := func() *types.DemandOrder {
+		order := types.NewDemandOrder(commontypes.RollappPacket{Packet: &channeltypes.Packet{}}, math.NewInt(900), math.NewInt(100), sdk.DefaultBondDenom, sample.AccAddress(), 10)
+		order.FeeCurve = &types.FeeCurve{MaxFee: math.NewInt(500), Blocks: 40}
+		return order
+	}
+
+	require.NoError(t, newOrder().ValidateBasic())
+	for _, curve := range []types.FeeCurve{
+		{MaxFee: math.NewInt(99), Blocks: 40},   // below the starting fee
+		{MaxFee: math.NewInt(1000), Blocks: 40}, // the whole amount
+		{MaxFee: math.NewInt(500), Blocks: 0},
+	} {
+		order := newOrder()
+		order.FeeCurve = &curve
+		require.ErrorIs(t, order.ValidateBasic(), types.ErrInvalidFeeCurve)
+	}
+
+	for _, tc := range []struct {
+		height uint64
+		fee    int64
+	}{
+		{height: 5, fee: 100},
+		{height: 10, fee: 100},
+		{height: 11, fee: 110},
+		{height: 30, fee: 300},
+		{height: 50, fee: 500},
+		{height: 1000, fee: 500},
+	} {
+		order := newOrder()
+		require.Equal(t, math.NewInt(tc.fee), order.FeeAt(tc.height), "height: %d", tc.height)
+
+		order.ApplyFeeCurve(tc.height)
+		require.Equal(t, math.NewInt(tc.fee), order.GetFeeAmount(), "height: %d", tc.height)
+		require.Equal(t, math.NewInt(1000-tc.fee), order.GetPriceAmount(), "height: %d", tc.height)
+	}
+
+	// the fee is fixed once the order is filled
+	order := newOrder()
+	order.FulfillerAddress = "fulfiller"
+	order.ApplyFeeCurve(50)
+	require.Equal(t, math.NewInt(100), order.GetFeeAmount())
+
+	// no curve
+	order = newOrder()
+	order.FeeCurve = nil
+	order.ApplyFeeCurve(50)
+	require.Equal(t, math.NewInt(100), order.GetFeeAmount())
+}
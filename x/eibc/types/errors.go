@@ -28,4 +28,12 @@ var (
 	ErrRollappIdMismatch              = errorsmod.Register(ModuleName, 21, "Rollapp ID mismatch")
 	ErrPriceMismatch                  = errorsmod.Register(ModuleName, 22, "Price mismatch")
 	ErrInvalidCreationHeight          = errorsmod.Register(ModuleName, 23, "Invalid creation height")
+	ErrInvalidFillPrice               = errorsmod.Register(ModuleName, 24, "Invalid fill price")
+	ErrDemandOrderPartiallyFilled     = errorsmod.Register(ModuleName, 25, "Demand order partially filled")
+	ErrInvalidFeeCurve                = errorsmod.Register(ModuleName, 26, "Invalid fee curve")
+	ErrInvalidVault                   = errorsmod.Register(ModuleName, 27, "Invalid vault")
+	ErrVaultNotFound                  = errorsmod.Register(ModuleName, 28, "Vault not found")
+	ErrVaultLiquidityLocked           = errorsmod.Register(ModuleName, 29, "Vault liquidity locked in pending orders")
+	ErrNoUnpaidFills                  = errorsmod.Register(ModuleName, 30, "No unpaid fills for demand order")
+	ErrFeeTooLow                      = errorsmod.Register(ModuleName, 31, "Fee below the minimum")
 )
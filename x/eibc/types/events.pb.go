@@ -643,415 +643,796 @@ func (m *EventDemandOrderDeleted) GetPacketType() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
-	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
-	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
-	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
-	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
-	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
+// EventDemandOrderFilled is emitted when a slice of the demand order is filled.
+type EventDemandOrderFilled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fulfiller is the address of the fulfiller of the slice.
+	Fulfiller string `protobuf:"bytes,2,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// price is the price of the slice.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// fee is the share of the fee of the slice.
+	Fee string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// filled_price is the price of the order filled so far, including this slice.
+	FilledPrice string `protobuf:"bytes,5,opt,name=filled_price,json=filledPrice,proto3" json:"filled_price,omitempty"`
+	// is_fulfilled is the flag indicating whether the whole order price is filled.
+	IsFulfilled bool `protobuf:"varint,6,opt,name=is_fulfilled,json=isFulfilled,proto3" json:"is_fulfilled,omitempty"`
+	// packet_type is the type of the packet.
+	PacketType string `protobuf:"bytes,7,opt,name=packet_type,json=packetType,proto3" json:"packet_type,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/events.proto", fileDescriptor_87abe4479c806cf7)
+func (m *EventDemandOrderFilled) Reset()         { *m = EventDemandOrderFilled{} }
+func (m *EventDemandOrderFilled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFilled) ProtoMessage()    {}
+func (*EventDemandOrderFilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{6}
 }
-
-var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc6, 0xf9, 0xcf, 0x24, 0xfc, 0xd4, 0x42, 0xc5, 0xa4, 0x90, 0x42, 0x10, 0x6a, 0xda, 0x43,
-	0xac, 0x96, 0x27, 0x80, 0xd2, 0xa8, 0x88, 0x43, 0xdb, 0xb4, 0xbd, 0xf4, 0x62, 0x99, 0x78, 0x92,
-	0xac, 0x70, 0xbc, 0xab, 0xf5, 0x06, 0x08, 0xf7, 0xde, 0xfb, 0x34, 0x55, 0x1f, 0xa1, 0x47, 0x8e,
-	0x3d, 0x22, 0x10, 0xef, 0x51, 0xed, 0xae, 0x1d, 0x12, 0x07, 0x48, 0x55, 0xf5, 0xd4, 0x9b, 0xf7,
-	0x9b, 0xcf, 0xb3, 0xb3, 0xf3, 0x7d, 0xb3, 0x0b, 0x75, 0x6f, 0xd8, 0xc7, 0x20, 0x24, 0x34, 0x38,
-	0x1b, 0x9e, 0xdb, 0xa3, 0x85, 0x8d, 0xe4, 0xa8, 0x6d, 0xe3, 0x09, 0x06, 0x22, 0x6c, 0x30, 0x4e,
-	0x05, 0x35, 0x9f, 0x8c, 0x33, 0x1b, 0xa3, 0x45, 0x43, 0x32, 0x2b, 0xcb, 0x5d, 0xda, 0xa5, 0x8a,
-	0x67, 0xcb, 0x2f, 0xfd, 0x4b, 0xe5, 0xc5, 0x3d, 0xc9, 0xdb, 0xb4, 0xdf, 0xa7, 0x81, 0x1d, 0x0a,
-	0x57, 0x0c, 0xa2, 0xf4, 0x95, 0xc6, 0x43, 0x85, 0x78, 0xd8, 0x77, 0x03, 0xcf, 0xa1, 0xdc, 0x43,
-	0xae, 0xf9, 0xb5, 0xcb, 0x14, 0xac, 0xbc, 0x91, 0xf5, 0xed, 0xab, 0xd8, 0x3b, 0x19, 0x7a, 0xcd,
-	0xd1, 0x15, 0xe8, 0x99, 0xab, 0x50, 0x50, 0x54, 0x87, 0x78, 0x96, 0xb1, 0x61, 0xd4, 0x8b, 0xad,
-	0xbc, 0x5a, 0x1f, 0x78, 0xe6, 0x32, 0x64, 0x19, 0x27, 0x6d, 0xb4, 0x52, 0x0a, 0xd7, 0x0b, 0x73,
-	0x09, 0xd2, 0x1d, 0x44, 0x2b, 0xad, 0x30, 0xf9, 0x69, 0x6e, 0x43, 0x99, 0x84, 0x4e, 0x67, 0xe0,
-	0x77, 0x88, 0xef, 0xa3, 0x67, 0x65, 0x36, 0x8c, 0x7a, 0x61, 0x2f, 0x65, 0x19, 0xad, 0x12, 0x09,
-	0x9b, 0x31, 0x6c, 0x6e, 0xc1, 0x3c, 0x73, 0xdb, 0xc7, 0x28, 0x1c, 0x7d, 0x18, 0x2b, 0xab, 0x52,
-	0x94, 0x35, 0xf8, 0x51, 0x61, 0xe6, 0x3a, 0x40, 0x44, 0x3a, 0xc6, 0xa1, 0x95, 0x53, 0x8c, 0xa2,
-	0x46, 0x0e, 0x71, 0x28, 0xc3, 0x9c, 0xfa, 0xbe, 0xcb, 0x98, 0xac, 0x37, 0xaf, 0xc3, 0x11, 0x72,
-	0xe0, 0x99, 0x6b, 0x50, 0xe4, 0xd8, 0x26, 0x8c, 0x60, 0x20, 0xac, 0x42, 0x14, 0x8d, 0x01, 0xf3,
-	0x29, 0x94, 0xa2, 0xdc, 0x62, 0xc8, 0xd0, 0x2a, 0xaa, 0x78, 0xb4, 0xdd, 0xa7, 0x21, 0x43, 0x73,
-	0x13, 0xca, 0x8c, 0x53, 0xda, 0x71, 0x7a, 0x48, 0xba, 0x3d, 0x61, 0xc1, 0x86, 0x51, 0xcf, 0xb4,
-	0x4a, 0x0a, 0x7b, 0xab, 0x20, 0xf3, 0x31, 0xe4, 0xdc, 0x3e, 0x1d, 0x04, 0xc2, 0x2a, 0xa9, 0xdf,
-	0xa3, 0x55, 0xed, 0xbb, 0x01, 0x5b, 0xc9, 0x16, 0xbf, 0x1f, 0x3b, 0xd8, 0x67, 0xe6, 0xcd, 0x6a,
-	0xf7, 0x07, 0x78, 0x14, 0xe0, 0xa9, 0x33, 0xd9, 0x23, 0xd9, 0xfa, 0x85, 0x57, 0xdb, 0x8d, 0x7b,
-	0x0c, 0xa5, 0xdd, 0xd1, 0xd0, 0x7b, 0xb4, 0x16, 0x03, 0x3c, 0x1d, 0xdf, 0x54, 0x1e, 0x68, 0x42,
-	0x19, 0x29, 0x5a, 0x61, 0x42, 0x95, 0xda, 0x8d, 0x01, 0x95, 0x64, 0xe1, 0x4d, 0xc4, 0x3f, 0xa8,
-	0x77, 0x05, 0xf2, 0xb2, 0x5e, 0x69, 0x06, 0x6d, 0x90, 0x5c, 0x80, 0xa7, 0x4d, 0xc4, 0x5b, 0xdf,
-	0xa4, 0xc7, 0x7d, 0x33, 0x25, 0x7f, 0xe6, 0x6e, 0xf9, 0xc7, 0xf4, 0xcd, 0x26, 0xf5, 0x4d, 0x0a,
-	0x94, 0x7b, 0x48, 0xa0, 0xfc, 0x84, 0x40, 0x37, 0x06, 0xac, 0x4e, 0x9d, 0x73, 0xe4, 0xcd, 0x7f,
-	0x30, 0x05, 0x9b, 0x77, 0x4d, 0xc1, 0x5f, 0x4c, 0xc0, 0x1a, 0x14, 0xe3, 0x24, 0x3c, 0xf2, 0xe8,
-	0x2d, 0x90, 0xf4, 0x30, 0x24, 0x3d, 0x5c, 0xfb, 0x9a, 0x9e, 0x36, 0xe2, 0xa8, 0x82, 0xdd, 0x81,
-	0xe8, 0x51, 0x4e, 0xce, 0xff, 0xa7, 0x13, 0x9b, 0xcf, 0x60, 0xb1, 0x2d, 0x2f, 0x33, 0x42, 0x83,
-	0xd8, 0x17, 0x25, 0xe5, 0x8b, 0x85, 0x18, 0x8e, 0xac, 0xb1, 0x0e, 0xe0, 0x33, 0xc7, 0xf5, 0x3c,
-	0x8e, 0x61, 0x68, 0x95, 0xf5, 0x46, 0x3e, 0xdb, 0xd5, 0x80, 0xf9, 0x1c, 0x96, 0x28, 0x43, 0xee,
-	0x0a, 0xca, 0x47, 0xa4, 0x79, 0x45, 0x5a, 0x8c, 0xf1, 0x98, 0xba, 0x09, 0xe5, 0x11, 0x55, 0x36,
-	0x65, 0x41, 0xd1, 0x4a, 0x31, 0xd6, 0x44, 0xac, 0xfd, 0x30, 0xa6, 0xef, 0xdc, 0x7d, 0xf4, 0x71,
-	0xc6, 0x50, 0x4d, 0xde, 0x7f, 0xa9, 0xe4, 0xfd, 0x37, 0xd5, 0xcf, 0xf4, 0xcc, 0x21, 0xca, 0x24,
-	0x87, 0x28, 0xd1, 0xd0, 0x6c, 0xb2, 0xa1, 0x7b, 0x87, 0x3f, 0xaf, 0xaa, 0xc6, 0xc5, 0x55, 0xd5,
-	0xb8, 0xbc, 0xaa, 0x1a, 0xdf, 0xae, 0xab, 0x73, 0x17, 0xd7, 0xd5, 0xb9, 0x5f, 0xd7, 0xd5, 0xb9,
-	0x2f, 0x2f, 0xbb, 0x44, 0xf4, 0x06, 0x47, 0xf2, 0xda, 0xb1, 0xef, 0x79, 0x83, 0x4e, 0x76, 0xec,
-	0x33, 0xfd, 0x10, 0xc9, 0xec, 0xe1, 0x51, 0x4e, 0x3d, 0x41, 0x3b, 0xbf, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x2f, 0xed, 0xa3, 0x12, 0x3d, 0x07, 0x00, 0x00,
+func (m *EventDemandOrderFilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDemandOrderFilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventDemandOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDemandOrderFilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFilled.Merge(m, src)
+}
+func (m *EventDemandOrderFilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFilled.DiscardUnknown(m)
 }
 
-func (m *EventDemandOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x5a
+var xxx_messageInfo_EventDemandOrderFilled proto.InternalMessageInfo
+
+func (m *EventDemandOrderFilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if m.ProofHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
 	}
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetFee() string {
+	if m != nil {
+		return m.Fee
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetFilledPrice() string {
+	if m != nil {
+		return m.FilledPrice
 	}
-	if len(m.PacketKey) > 0 {
-		i -= len(m.PacketKey)
-		copy(dAtA[i:], m.PacketKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetIsFulfilled() bool {
+	if m != nil {
+		return m.IsFulfilled
 	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x2a
+	return false
+}
+
+func (m *EventDemandOrderFilled) GetPacketType() string {
+	if m != nil {
+		return m.PacketType
 	}
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// EventDemandOrderFillsPaid is emitted when the fulfillers of a partially filled demand order are paid
+// on finalization of the underlying packet.
+type EventDemandOrderFillsPaid struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fills is the number of paid fills.
+	Fills uint64 `protobuf:"varint,2,opt,name=fills,proto3" json:"fills,omitempty"`
+	// remainder is the unfilled part of the order paid to the original recipient.
+	Remainder string `protobuf:"bytes,3,opt,name=remainder,proto3" json:"remainder,omitempty"`
+}
+
+func (m *EventDemandOrderFillsPaid) Reset()         { *m = EventDemandOrderFillsPaid{} }
+func (m *EventDemandOrderFillsPaid) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFillsPaid) ProtoMessage()    {}
+func (*EventDemandOrderFillsPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{7}
+}
+func (m *EventDemandOrderFillsPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderFillsPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFillsPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventDemandOrderFillsPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFillsPaid.Merge(m, src)
+}
+func (m *EventDemandOrderFillsPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFillsPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFillsPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderFillsPaid proto.InternalMessageInfo
+
+func (m *EventDemandOrderFillsPaid) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDemandOrderFillsPaid) GetFills() uint64 {
+	if m != nil {
+		return m.Fills
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDemandOrderFillsPaid) GetRemainder() string {
+	if m != nil {
+		return m.Remainder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDemandOrderFillsUnpaid is emitted when the payment of the fills of a partially filled demand order
+// fails on finalization of the underlying packet. The fills can be paid later with MsgPayFills.
+type EventDemandOrderFillsUnpaid struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// error is the reason the payment failed.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventDemandOrderFillsUnpaid) Reset()         { *m = EventDemandOrderFillsUnpaid{} }
+func (m *EventDemandOrderFillsUnpaid) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFillsUnpaid) ProtoMessage()    {}
+func (*EventDemandOrderFillsUnpaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{8}
+}
+func (m *EventDemandOrderFillsUnpaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderFillsUnpaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFillsUnpaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NewPacketStatus != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewPacketStatus))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventDemandOrderFillsUnpaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFillsUnpaid.Merge(m, src)
+}
+func (m *EventDemandOrderFillsUnpaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFillsUnpaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFillsUnpaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderFillsUnpaid proto.InternalMessageInfo
+
+func (m *EventDemandOrderFillsUnpaid) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDemandOrderFeeUpdated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDemandOrderFillsUnpaid) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderFeeUpdated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventDemandOrderForwarded is emitted when the price paid for a demand order is forwarded to another
+// chain according to the packet-forward-middleware memo of the underlying packet.
+type EventDemandOrderForwarded struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// port is the port of the forward.
+	Port string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	// channel is the channel of the forward.
+	Channel string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	// receiver is the receiver of the forward on the destination chain.
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sequence is the sequence of the forward packet.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *EventDemandOrderFeeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.ProofHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
-		i--
-		dAtA[i] = 0x30
+func (m *EventDemandOrderForwarded) Reset()         { *m = EventDemandOrderForwarded{} }
+func (m *EventDemandOrderForwarded) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderForwarded) ProtoMessage()    {}
+func (*EventDemandOrderForwarded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{9}
+}
+func (m *EventDemandOrderForwarded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderForwarded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderForwarded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *EventDemandOrderForwarded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderForwarded.Merge(m, src)
+}
+func (m *EventDemandOrderForwarded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderForwarded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderForwarded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderForwarded proto.InternalMessageInfo
+
+func (m *EventDemandOrderForwarded) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EventDemandOrderForwarded) GetPort() string {
+	if m != nil {
+		return m.Port
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *EventDemandOrderForwarded) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventDemandOrderForwarded) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDemandOrderForwarded) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EventVaultDeposit is emitted when liquidity is deposited into a vault.
+type EventVaultDeposit struct {
+	// denom is the denom of the vault.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// depositor is the address of the depositor.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the deposited liquidity.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// shares is the amount of minted vault shares.
+	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *EventVaultDeposit) Reset()         { *m = EventVaultDeposit{} }
+func (m *EventVaultDeposit) String() string { return proto.CompactTextString(m) }
+func (*EventVaultDeposit) ProtoMessage()    {}
+func (*EventVaultDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{10}
+}
+func (m *EventVaultDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVaultDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVaultDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventVaultDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVaultDeposit.Merge(m, src)
+}
+func (m *EventVaultDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVaultDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVaultDeposit.DiscardUnknown(m)
 }
 
-func (m *EventDemandOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventVaultDeposit proto.InternalMessageInfo
+
+func (m *EventVaultDeposit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
-		i--
-		dAtA[i] = 0x52
+func (m *EventVaultDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *EventVaultDeposit) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.PacketStatus) > 0 {
-		i -= len(m.PacketStatus)
-		copy(dAtA[i:], m.PacketStatus)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventVaultDeposit) GetShares() string {
+	if m != nil {
+		return m.Shares
 	}
-	if m.IsFulfilled {
-		i--
-		if m.IsFulfilled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// EventVaultWithdrawal is emitted when liquidity is withdrawn from a vault.
+type EventVaultWithdrawal struct {
+	// denom is the denom of the vault.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// withdrawer is the address of the withdrawer.
+	Withdrawer string `protobuf:"bytes,2,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// amount is the withdrawn liquidity.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// shares is the amount of burnt vault shares.
+	Shares string `protobuf:"bytes,4,opt,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *EventVaultWithdrawal) Reset()         { *m = EventVaultWithdrawal{} }
+func (m *EventVaultWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*EventVaultWithdrawal) ProtoMessage()    {}
+func (*EventVaultWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{11}
+}
+func (m *EventVaultWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVaultWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVaultWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventVaultWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVaultWithdrawal.Merge(m, src)
+}
+func (m *EventVaultWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVaultWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVaultWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVaultWithdrawal proto.InternalMessageInfo
+
+func (m *EventVaultWithdrawal) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventVaultWithdrawal) GetWithdrawer() string {
+	if m != nil {
+		return m.Withdrawer
 	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventVaultWithdrawal) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventVaultWithdrawal) GetShares() string {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventVaultOrderFulfilled is emitted when a vault fulfills a demand order.
+type EventVaultOrderFulfilled struct {
+	// denom is the denom of the vault.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price is the price paid by the vault.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	// fee is the fee the vault earns on finalization.
+	Fee string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorFee) > 0 {
-		i -= len(m.OperatorFee)
-		copy(dAtA[i:], m.OperatorFee)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorFee)))
-		i--
-		dAtA[i] = 0x72
+func (m *EventVaultOrderFulfilled) Reset()         { *m = EventVaultOrderFulfilled{} }
+func (m *EventVaultOrderFulfilled) String() string { return proto.CompactTextString(m) }
+func (*EventVaultOrderFulfilled) ProtoMessage()    {}
+func (*EventVaultOrderFulfilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{12}
+}
+func (m *EventVaultOrderFulfilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVaultOrderFulfilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVaultOrderFulfilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *EventVaultOrderFulfilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVaultOrderFulfilled.Merge(m, src)
+}
+func (m *EventVaultOrderFulfilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVaultOrderFulfilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVaultOrderFulfilled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVaultOrderFulfilled proto.InternalMessageInfo
+
+func (m *EventVaultOrderFulfilled) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+func (m *EventVaultOrderFulfilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	if m.CreationHeight != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.CreationHeight))
+	return ""
+}
+
+func (m *EventVaultOrderFulfilled) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *EventVaultOrderFulfilled) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+// EventFulfillerStatsUpdated is emitted when the stats of a fulfiller are updated by a demand order.
+type EventFulfillerStatsUpdated struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// stats are the updated stats of the fulfiller.
+	Stats FulfillerStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *EventFulfillerStatsUpdated) Reset()         { *m = EventFulfillerStatsUpdated{} }
+func (m *EventFulfillerStatsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFulfillerStatsUpdated) ProtoMessage()    {}
+func (*EventFulfillerStatsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{13}
+}
+func (m *EventFulfillerStatsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFulfillerStatsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFulfillerStatsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFulfillerStatsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfillerStatsUpdated.Merge(m, src)
+}
+func (m *EventFulfillerStatsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFulfillerStatsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfillerStatsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFulfillerStatsUpdated proto.InternalMessageInfo
+
+func (m *EventFulfillerStatsUpdated) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventFulfillerStatsUpdated) GetStats() FulfillerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return FulfillerStats{}
+}
+
+// EventRollappFeesSet is emitted when the rollapp owner sets the fees of a rollapp.
+type EventRollappFeesSet struct {
+	// rollapp_id is the rollapp of the fees.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// timeout_fee is the fee of the demand orders of timed out packets.
+	TimeoutFee string `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3" json:"timeout_fee,omitempty"`
+	// errack_fee is the fee of the demand orders of packets with error acknowledgements.
+	ErrackFee string `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3" json:"errack_fee,omitempty"`
+}
+
+func (m *EventRollappFeesSet) Reset()         { *m = EventRollappFeesSet{} }
+func (m *EventRollappFeesSet) String() string { return proto.CompactTextString(m) }
+func (*EventRollappFeesSet) ProtoMessage()    {}
+func (*EventRollappFeesSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{14}
+}
+func (m *EventRollappFeesSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRollappFeesSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRollappFeesSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRollappFeesSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRollappFeesSet.Merge(m, src)
+}
+func (m *EventRollappFeesSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRollappFeesSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRollappFeesSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRollappFeesSet proto.InternalMessageInfo
+
+func (m *EventRollappFeesSet) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRollappFeesSet) GetTimeoutFee() string {
+	if m != nil {
+		return m.TimeoutFee
+	}
+	return ""
+}
+
+func (m *EventRollappFeesSet) GetErrackFee() string {
+	if m != nil {
+		return m.ErrackFee
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
+	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
+	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
+	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
+	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
+	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
+	proto.RegisterType((*EventDemandOrderFilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFilled")
+	proto.RegisterType((*EventDemandOrderFillsPaid)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFillsPaid")
+	proto.RegisterType((*EventDemandOrderFillsUnpaid)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFillsUnpaid")
+	proto.RegisterType((*EventDemandOrderForwarded)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderForwarded")
+	proto.RegisterType((*EventVaultDeposit)(nil), "dymensionxyz.dymension.eibc.EventVaultDeposit")
+	proto.RegisterType((*EventVaultWithdrawal)(nil), "dymensionxyz.dymension.eibc.EventVaultWithdrawal")
+	proto.RegisterType((*EventVaultOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventVaultOrderFulfilled")
+	proto.RegisterType((*EventFulfillerStatsUpdated)(nil), "dymensionxyz.dymension.eibc.EventFulfillerStatsUpdated")
+	proto.RegisterType((*EventRollappFeesSet)(nil), "dymensionxyz.dymension.eibc.EventRollappFeesSet")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/events.proto", fileDescriptor_87abe4479c806cf7)
+}
+
+var fileDescriptor_87abe4479c806cf7 = []byte{
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xf5, 0xaf, 0x91, 0x62, 0x27, 0xac, 0x91, 0x30, 0x4a, 0xac, 0xd8, 0x0c, 0x82, 0xba,
+	0x2d, 0x20, 0x21, 0xc9, 0x13, 0xc4, 0x75, 0xd5, 0x06, 0x01, 0x5a, 0x57, 0x69, 0x5a, 0xa0, 0x17,
+	0x82, 0x16, 0xc7, 0xd6, 0xd6, 0x14, 0x97, 0x5d, 0xae, 0xac, 0x28, 0xe8, 0xa1, 0x97, 0xde, 0xfb,
+	0x00, 0x7d, 0x8e, 0xa2, 0x8f, 0x90, 0x63, 0x8e, 0x3d, 0x15, 0x86, 0x8d, 0xbc, 0x47, 0xb1, 0x3f,
+	0xa4, 0x28, 0x52, 0x3f, 0x6e, 0xd1, 0x53, 0x6f, 0x9c, 0x8f, 0xb3, 0x3b, 0x7f, 0xdf, 0xcc, 0x0e,
+	0xec, 0x7b, 0xd3, 0x11, 0x06, 0x11, 0xa1, 0xc1, 0xeb, 0xe9, 0x9b, 0x6e, 0x22, 0x74, 0x91, 0x1c,
+	0x0f, 0xba, 0x78, 0x8e, 0x01, 0x8f, 0x3a, 0x21, 0xa3, 0x9c, 0x9a, 0xf7, 0xd2, 0x9a, 0x9d, 0x44,
+	0xe8, 0x08, 0xcd, 0xd6, 0xf6, 0x29, 0x3d, 0xa5, 0x52, 0xaf, 0x2b, 0xbe, 0xd4, 0x91, 0xd6, 0xc7,
+	0x4b, 0x2e, 0x1f, 0xd0, 0xd1, 0x88, 0x06, 0xdd, 0x88, 0xbb, 0x7c, 0xac, 0xaf, 0x6f, 0x75, 0x56,
+	0x39, 0xe2, 0xe1, 0xc8, 0x0d, 0x3c, 0x87, 0x32, 0x0f, 0x99, 0xd6, 0x7f, 0xbc, 0x4a, 0xff, 0x64,
+	0xec, 0x9f, 0x10, 0xdf, 0x47, 0xe6, 0x08, 0x1b, 0xda, 0x84, 0x7d, 0x51, 0x80, 0x3b, 0x9f, 0x89,
+	0x90, 0x0e, 0xe5, 0x75, 0x5f, 0x89, 0xdb, 0x3e, 0x65, 0xe8, 0x72, 0xf4, 0xcc, 0xbb, 0x50, 0x93,
+	0xb7, 0x3b, 0xc4, 0xb3, 0x8c, 0x5d, 0x63, 0xbf, 0xde, 0xaf, 0x4a, 0xf9, 0xb9, 0x67, 0x6e, 0x43,
+	0x39, 0x64, 0x64, 0x80, 0x56, 0x41, 0xe2, 0x4a, 0x30, 0x6f, 0x42, 0xf1, 0x04, 0xd1, 0x2a, 0x4a,
+	0x4c, 0x7c, 0x9a, 0x8f, 0xa0, 0x49, 0x22, 0x27, 0x36, 0xed, 0x59, 0xa5, 0x5d, 0x63, 0xbf, 0x76,
+	0x50, 0xb0, 0x8c, 0x7e, 0x83, 0x44, 0xbd, 0x18, 0x36, 0x1f, 0xc2, 0x8d, 0xd0, 0x1d, 0x9c, 0x21,
+	0x77, 0x54, 0xfc, 0x56, 0x59, 0x5e, 0xd1, 0x54, 0xe0, 0x4b, 0x89, 0x99, 0x3b, 0x00, 0x5a, 0xe9,
+	0x0c, 0xa7, 0x56, 0x45, 0x6a, 0xd4, 0x15, 0xf2, 0x02, 0xa7, 0xe2, 0x37, 0xa3, 0xbe, 0xef, 0x86,
+	0xa1, 0xf0, 0xb7, 0xaa, 0x7e, 0x6b, 0xe4, 0xb9, 0x67, 0xde, 0x87, 0x3a, 0xc3, 0x01, 0x09, 0x09,
+	0x06, 0xdc, 0xaa, 0xe9, 0xbf, 0x31, 0x60, 0x3e, 0x80, 0x86, 0xbe, 0x9b, 0x4f, 0x43, 0xb4, 0xea,
+	0xf2, 0xbf, 0x36, 0xf7, 0xcd, 0x34, 0x44, 0x73, 0x0f, 0x9a, 0x21, 0xa3, 0xf4, 0xc4, 0x19, 0x22,
+	0x39, 0x1d, 0x72, 0x0b, 0x76, 0x8d, 0xfd, 0x52, 0xbf, 0x21, 0xb1, 0x2f, 0x24, 0x64, 0xde, 0x86,
+	0x8a, 0x3b, 0xa2, 0xe3, 0x80, 0x5b, 0x0d, 0x79, 0x5c, 0x4b, 0xf6, 0xef, 0x06, 0x3c, 0xcc, 0xa6,
+	0xf8, 0x28, 0x15, 0xd8, 0xab, 0xd0, 0x5b, 0x97, 0xee, 0xaf, 0xe1, 0x56, 0x80, 0x13, 0x67, 0x3e,
+	0x47, 0x22, 0xf5, 0x9b, 0x4f, 0x1e, 0x75, 0x96, 0x70, 0x50, 0x11, 0xaa, 0xa3, 0x6c, 0xf4, 0xb7,
+	0x02, 0x9c, 0xa4, 0x8d, 0x9a, 0x7b, 0x99, 0xca, 0x88, 0xa2, 0xd5, 0xe6, 0xaa, 0x62, 0xbf, 0x37,
+	0xa0, 0x95, 0x75, 0xbc, 0x87, 0x78, 0x0d, 0x7f, 0xef, 0x40, 0x55, 0xf8, 0x2b, 0xc8, 0xa0, 0x08,
+	0x52, 0x09, 0x70, 0xd2, 0x43, 0x9c, 0xf1, 0xa6, 0x98, 0xe6, 0x4d, 0xae, 0xfc, 0xa5, 0xc5, 0xe5,
+	0x4f, 0xd5, 0xb7, 0x9c, 0xad, 0x6f, 0xb6, 0x40, 0x95, 0x55, 0x05, 0xaa, 0xce, 0x15, 0xe8, 0xbd,
+	0x01, 0x77, 0x73, 0x71, 0x26, 0xdc, 0xfc, 0x0f, 0xba, 0x60, 0x6f, 0x51, 0x17, 0xfc, 0x8b, 0x0e,
+	0xb8, 0x0f, 0xf5, 0xa4, 0x8b, 0x35, 0x47, 0x67, 0x40, 0x96, 0xc3, 0x90, 0xe5, 0xb0, 0xfd, 0x4b,
+	0x31, 0x4f, 0xc4, 0xc4, 0x83, 0x67, 0x63, 0x3e, 0xa4, 0x8c, 0xbc, 0xf9, 0x3f, 0x45, 0x6c, 0x7e,
+	0x08, 0x5b, 0x03, 0x31, 0xcc, 0x08, 0x0d, 0x62, 0x5e, 0x34, 0x24, 0x2f, 0x36, 0x63, 0x58, 0x53,
+	0x63, 0x07, 0xc0, 0x0f, 0x1d, 0xd7, 0xf3, 0x18, 0x46, 0x91, 0xd5, 0x54, 0x86, 0xfc, 0xf0, 0x99,
+	0x02, 0xcc, 0x8f, 0xe0, 0x26, 0x0d, 0x91, 0xb9, 0x9c, 0xb2, 0x44, 0xe9, 0x86, 0x54, 0xda, 0x8a,
+	0xf1, 0x58, 0x75, 0x0f, 0x9a, 0x89, 0xaa, 0x48, 0xca, 0xa6, 0x54, 0x6b, 0xc4, 0x58, 0x0f, 0xd1,
+	0xfe, 0xc3, 0xc8, 0xcf, 0xdc, 0x43, 0xf4, 0x71, 0x4d, 0x53, 0xcd, 0xcf, 0xbf, 0x42, 0x76, 0xfe,
+	0xe5, 0xf2, 0x59, 0x5c, 0xdb, 0x44, 0xa5, 0x6c, 0x13, 0x65, 0x12, 0x5a, 0xce, 0x51, 0xe8, 0xc2,
+	0x80, 0xdb, 0x39, 0x0a, 0xad, 0xed, 0x93, 0xb9, 0x2a, 0x16, 0xb2, 0x55, 0x5c, 0x3c, 0x13, 0x34,
+	0xa7, 0x4a, 0x73, 0x9c, 0x92, 0x27, 0x3c, 0x47, 0xa9, 0x2b, 0xef, 0x1a, 0x0a, 0x3b, 0x92, 0x87,
+	0xb2, 0xb4, 0xab, 0xe4, 0x69, 0x97, 0x09, 0xb1, 0x9a, 0x0b, 0xf1, 0x87, 0x05, 0xc3, 0x80, 0xf8,
+	0x7e, 0x74, 0xe4, 0x92, 0x75, 0xad, 0x21, 0x4c, 0xa8, 0xb9, 0x5c, 0xea, 0x2b, 0x41, 0x3d, 0x3b,
+	0x23, 0x97, 0x04, 0x1e, 0x32, 0x1d, 0xe0, 0x0c, 0xb0, 0xbf, 0x84, 0x7b, 0x0b, 0x6d, 0xbd, 0x0a,
+	0xc2, 0xf5, 0xd6, 0x90, 0x31, 0x1a, 0xa7, 0x53, 0x09, 0xf6, 0x6f, 0x8b, 0x26, 0x19, 0x65, 0x13,
+	0x97, 0x79, 0xab, 0x2b, 0x64, 0x42, 0x29, 0xa4, 0x8c, 0xeb, 0xdb, 0xe4, 0xb7, 0x69, 0x41, 0x75,
+	0x30, 0x74, 0x83, 0x00, 0x7d, 0xed, 0x78, 0x2c, 0x9a, 0x2d, 0xa8, 0x31, 0x1c, 0x20, 0x39, 0x47,
+	0xa6, 0x0b, 0x94, 0xc8, 0xe2, 0x5f, 0x84, 0x3f, 0x8e, 0x31, 0xd0, 0x15, 0x2a, 0xf5, 0x13, 0xd9,
+	0x9e, 0xc0, 0x2d, 0xe9, 0xdd, 0xb7, 0xee, 0xd8, 0xe7, 0x87, 0x18, 0xd2, 0x88, 0x70, 0x11, 0x89,
+	0x87, 0x01, 0x1d, 0x69, 0x97, 0x94, 0x20, 0xf2, 0xe6, 0x29, 0x85, 0x24, 0xc6, 0x19, 0x90, 0x9a,
+	0xe4, 0xc5, 0xf4, 0x24, 0x17, 0x78, 0x34, 0x74, 0x19, 0xc6, 0x2f, 0x88, 0x96, 0xec, 0x9f, 0x60,
+	0x7b, 0x66, 0xf8, 0x3b, 0xc2, 0x87, 0x1e, 0x73, 0x27, 0xae, 0xbf, 0xc4, 0x76, 0x1b, 0x60, 0xa2,
+	0x75, 0x12, 0xbe, 0xa6, 0x90, 0x7f, 0x6c, 0x3d, 0x02, 0x6b, 0x66, 0x3d, 0xf3, 0xba, 0x2c, 0xf6,
+	0x20, 0x5d, 0xa9, 0xc2, 0x92, 0x09, 0xbc, 0xba, 0x5b, 0xec, 0x9f, 0xe3, 0xc7, 0x3b, 0xb6, 0xc5,
+	0xc4, 0x08, 0xb8, 0xce, 0xb2, 0xf1, 0x39, 0x94, 0xe5, 0x86, 0x28, 0x2d, 0x37, 0x9e, 0x7c, 0xd2,
+	0x59, 0xb1, 0xe4, 0x76, 0xe6, 0x6f, 0x3f, 0x28, 0xbd, 0xfd, 0xeb, 0xc1, 0x46, 0x5f, 0x9d, 0xb7,
+	0x39, 0x7c, 0x20, 0x3d, 0xe8, 0xab, 0xf9, 0xd2, 0x43, 0x8c, 0x5e, 0x22, 0xcf, 0xcc, 0x20, 0x63,
+	0xc1, 0x0c, 0xe2, 0x64, 0x84, 0x74, 0xcc, 0x53, 0xfb, 0x03, 0x68, 0x48, 0xec, 0x10, 0x3b, 0x00,
+	0xc8, 0x98, 0x3b, 0x38, 0x73, 0x66, 0x8f, 0x4e, 0x5d, 0x21, 0x3d, 0xc4, 0x83, 0x17, 0x6f, 0x2f,
+	0xdb, 0xc6, 0xbb, 0xcb, 0xb6, 0x71, 0x71, 0xd9, 0x36, 0x7e, 0xbd, 0x6a, 0x6f, 0xbc, 0xbb, 0x6a,
+	0x6f, 0xfc, 0x79, 0xd5, 0xde, 0xf8, 0xfe, 0xf1, 0x29, 0xe1, 0xc3, 0xf1, 0xb1, 0xd8, 0x8c, 0xba,
+	0x4b, 0x36, 0xe5, 0xf3, 0xa7, 0xdd, 0xd7, 0x6a, 0x5d, 0x16, 0xd3, 0x21, 0x3a, 0xae, 0xc8, 0x2d,
+	0xf9, 0xe9, 0xdf, 0x03, 0x00, 0x21, 0x28, 0x99, 0x56, 0x13, 0x0c, 0x00, 0x00,
+}
+
+func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x5a
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x50
 	}
 	if len(m.PacketType) > 0 {
 		i -= len(m.PacketType)
 		copy(dAtA[i:], m.PacketType)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x4a
 	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x42
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if len(m.PacketStatus) > 0 {
 		i -= len(m.PacketStatus)
@@ -1094,7 +1475,7 @@ func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
+func (m *EventDemandOrderPacketStatusUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1104,41 +1485,98 @@ func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDemandOrderDeleted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPacketStatusUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventDemandOrderPacketStatusUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PacketType) > 0 {
-		i -= len(m.PacketType)
-		copy(dAtA[i:], m.PacketType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+	if m.IsFulfilled {
 		i--
-		dAtA[i] = 0x2a
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NewPacketStatus != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewPacketStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFeeUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFeeUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFeeUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ProofHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProofHeight))
+		i--
+		dAtA[i] = 0x30
 	}
 	if len(m.RollappId) > 0 {
 		i -= len(m.RollappId)
 		copy(dAtA[i:], m.RollappId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2a
 	}
 	if len(m.PacketStatus) > 0 {
 		i -= len(m.PacketStatus)
 		copy(dAtA[i:], m.PacketStatus)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
 		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.PacketKey) > 0 {
-		i -= len(m.PacketKey)
-		copy(dAtA[i:], m.PacketKey)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFee)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1152,246 +1590,2537 @@ func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *EventDemandOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderFulfilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.IsFulfilled {
-		n += 2
-	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PacketKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovEvents(uint64(m.ProofHeight))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderPacketStatusUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderFulfilledAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFulfilledAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFulfilledAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OperatorFee) > 0 {
+		i -= len(m.OperatorFee)
+		copy(dAtA[i:], m.OperatorFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorFee)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.NewPacketStatus != 0 {
-		n += 1 + sovEvents(uint64(m.NewPacketStatus))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.IsFulfilled {
-		n += 2
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *EventDemandOrderFeeUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CreationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.IsFulfilled {
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ProofHeight != 0 {
-		n += 1 + sovEvents(uint64(m.ProofHeight))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderFulfilled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PacketStatus) > 0 {
+		i -= len(m.PacketStatus)
+		copy(dAtA[i:], m.PacketStatus)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketType) > 0 {
+		i -= len(m.PacketType)
+		copy(dAtA[i:], m.PacketType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketType)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if m.IsFulfilled {
-		n += 2
+		i--
+		if m.IsFulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.FilledPrice) > 0 {
+		i -= len(m.FilledPrice)
+		copy(dAtA[i:], m.FilledPrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FilledPrice)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventDemandOrderFulfilledAuthorized) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDemandOrderFillsPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFillsPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFillsPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Remainder) > 0 {
+		i -= len(m.Remainder)
+		copy(dAtA[i:], m.Remainder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Remainder)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Fills != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Fills))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.IsFulfilled {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFillsUnpaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFillsUnpaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFillsUnpaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderForwarded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.CreationHeight != 0 {
-		n += 1 + sovEvents(uint64(m.CreationHeight))
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderForwarded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderForwarded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OperatorFee)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVaultDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVaultDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVaultDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		i -= len(m.Shares)
+		copy(dAtA[i:], m.Shares)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Shares)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVaultWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVaultWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVaultWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		i -= len(m.Shares)
+		copy(dAtA[i:], m.Shares)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Shares)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVaultOrderFulfilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVaultOrderFulfilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVaultOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFulfillerStatsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfillerStatsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfillerStatsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRollappFeesSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRollappFeesSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRollappFeesSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrackFee) > 0 {
+		i -= len(m.ErrackFee)
+		copy(dAtA[i:], m.ErrackFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ErrackFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TimeoutFee) > 0 {
+		i -= len(m.TimeoutFee)
+		copy(dAtA[i:], m.TimeoutFee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TimeoutFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventDemandOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ProofHeight))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderPacketStatusUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.NewPacketStatus != 0 {
+		n += 1 + sovEvents(uint64(m.NewPacketStatus))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDemandOrderFeeUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ProofHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ProofHeight))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFulfilledAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CreationHeight))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OperatorFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketStatus)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FilledPrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsFulfilled {
+		n += 2
+	}
+	l = len(m.PacketType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFillsPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Fills != 0 {
+		n += 1 + sovEvents(uint64(m.Fills))
+	}
+	l = len(m.Remainder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderFillsUnpaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderForwarded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *EventVaultDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Shares)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVaultWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Shares)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVaultOrderFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFulfillerStatsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRollappFeesSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TimeoutFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ErrackFee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+			}
+			m.NewPacketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			m.ProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventDemandOrderDeleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PacketKey)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PacketStatus)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PacketType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1414,10 +4143,10 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1454,7 +4183,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1482,11 +4211,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1514,13 +4243,13 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1530,15 +4259,109 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1566,11 +4389,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1598,11 +4421,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1630,11 +4453,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1662,11 +4485,11 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1694,13 +4517,13 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.FilledPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
 			}
-			m.ProofHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1710,14 +4533,15 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.IsFulfilled = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1745,7 +4569,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.PacketType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1768,7 +4592,7 @@ func (m *EventDemandOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderFillsPaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,10 +4615,10 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderFillsPaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderPacketStatusUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderFillsPaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1831,9 +4655,9 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
 			}
-			m.NewPacketStatus = 0
+			m.Fills = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1843,16 +4667,16 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewPacketStatus |= types.Status(b&0x7F) << shift
+				m.Fills |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1862,12 +4686,24 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1889,7 +4725,7 @@ func (m *EventDemandOrderPacketStatusUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventDemandOrderFillsUnpaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1912,10 +4748,10 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDemandOrderFillsUnpaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFeeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDemandOrderFillsUnpaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1952,7 +4788,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1980,11 +4816,61 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderForwarded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderForwarded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderForwarded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2012,11 +4898,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2044,11 +4930,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Port = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2076,30 +4962,11 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
-			}
-			m.ProofHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProofHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2127,8 +4994,27 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2150,7 +5036,7 @@ func (m *EventDemandOrderFeeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
+func (m *EventVaultDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,80 +5058,16 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Price = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVaultDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVaultDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2273,31 +5095,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2325,11 +5127,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2357,11 +5159,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2389,7 +5191,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Shares = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2412,7 +5214,7 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
+func (m *EventVaultWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2435,15 +5237,15 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVaultWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderFulfilledAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVaultWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2471,11 +5273,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2503,11 +5305,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2535,31 +5337,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFulfilled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsFulfilled = bool(v != 0)
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2587,43 +5369,61 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Shares = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVaultOrderFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVaultOrderFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVaultOrderFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2651,30 +5451,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2702,11 +5483,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2734,11 +5515,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2766,7 +5547,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2789,7 +5570,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventFulfillerStatsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2812,10 +5593,10 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFulfillerStatsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFulfillerStatsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2852,9 +5633,9 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2864,27 +5645,78 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRollappFeesSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRollappFeesSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRollappFeesSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2912,11 +5744,11 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2944,11 +5776,11 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.TimeoutFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrackFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2976,7 +5808,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.ErrackFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFulfillerStats returns empty stats of a fulfiller for a rollapp in a denom.
+func NewFulfillerStats(fulfiller, rollappID, denom string) FulfillerStats {
+	return FulfillerStats{
+		Fulfiller:        fulfiller,
+		RollappId:        rollappID,
+		Denom:            denom,
+		Active:           math.ZeroInt(),
+		Settled:          math.ZeroInt(),
+		FeesEarned:       math.ZeroInt(),
+		OperatorFeesPaid: math.ZeroInt(),
+		Lost:             math.ZeroInt(),
+	}
+}
+
+func (s FulfillerStats) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Fulfiller); err != nil {
+		return fmt.Errorf("fulfiller: %w", err)
+	}
+	if s.RollappId == "" {
+		return errors.New("empty rollapp id")
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+	for _, amt := range []math.Int{s.Active, s.Settled, s.FeesEarned, s.OperatorFeesPaid, s.Lost} {
+		if amt.IsNil() || amt.IsNegative() {
+			return fmt.Errorf("amount must be non-negative: %s", amt)
+		}
+	}
+	return nil
+}
+
+// AddFronted records a price paid for an order whose packet is pending.
+func (s *FulfillerStats) AddFronted(price math.Int) {
+	s.Active = s.Active.Add(price)
+	s.ActiveOrders++
+}
+
+// AddSettled records a price paid for an order whose packet was finalized, with the fee earned.
+func (s *FulfillerStats) AddSettled(price, fee math.Int) {
+	s.removeActive(price)
+	s.Settled = s.Settled.Add(price)
+	s.SettledOrders++
+	s.FeesEarned = s.FeesEarned.Add(fee)
+}
+
+// AddLost records a price paid for an order whose packet was reverted or failed.
+func (s *FulfillerStats) AddLost(price math.Int) {
+	s.removeActive(price)
+	s.Lost = s.Lost.Add(price)
+	s.LostOrders++
+}
+
+// removeActive removes the price of an order from the active amount. The orders fronted before the stats
+// were tracked are not part of the active amount, hence the floor at zero.
+func (s *FulfillerStats) removeActive(price math.Int) {
+	s.Active = math.MaxInt(s.Active.Sub(price), math.ZeroInt())
+	if s.ActiveOrders > 0 {
+		s.ActiveOrders--
+	}
+}
+
+// Front is a part of the price of a demand order paid by a fulfiller, with the fee earned for it.
+type Front struct {
+	Fulfiller string
+	Price     math.Int
+	Fee       math.Int
+}
+
+// Fronts returns the parts of the price of the order paid by the fulfillers: the fills of a partially
+// filled order, or the whole price paid by the collector of the order, or else by its fulfiller.
+func (m *DemandOrder) Fronts() []Front {
+	if m.IsPartiallyFilled() {
+		fronts := make([]Front, 0, len(m.Fills))
+		for _, fill := range m.Fills {
+			fronts = append(fronts, Front{Fulfiller: fill.FulfillerAddress, Price: fill.Price, Fee: fill.Fee})
+		}
+		return fronts
+	}
+	fulfiller := m.FulfillerAddress
+	if m.CollectorAddress != "" {
+		fulfiller = m.CollectorAddress
+	}
+	// the fulfiller of a deprecated fulfilled order is unknown
+	if fulfiller == "" {
+		return nil
+	}
+	return []Front{{Fulfiller: fulfiller, Price: m.GetPriceAmount(), Fee: m.GetFeeAmount()}}
+}
@@ -0,0 +1,791 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/fulfiller_stats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FulfillerStats are the aggregates of the demand orders fronted by a fulfiller for a rollapp in a denom.
+// The fulfiller is the account whose funds paid the price: the liquidity provider of an authorized
+// fulfillment, the fulfiller of a fill, or a vault.
+type FulfillerStats struct {
+	// fulfiller is the bech32-encoded address of the fulfiller
+	Fulfiller string `protobuf:"bytes,1,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// rollapp_id is the rollapp of the orders
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// denom is the denom of the orders
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// active is the price paid for the orders whose packets are pending
+	Active cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=active,proto3,customtype=cosmossdk.io/math.Int" json:"active"`
+	// active_orders is the number of orders whose packets are pending
+	ActiveOrders uint64 `protobuf:"varint,5,opt,name=active_orders,json=activeOrders,proto3" json:"active_orders,omitempty"`
+	// settled is the price paid for the orders whose packets were finalized
+	Settled cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=settled,proto3,customtype=cosmossdk.io/math.Int" json:"settled"`
+	// settled_orders is the number of orders whose packets were finalized
+	SettledOrders uint64 `protobuf:"varint,7,opt,name=settled_orders,json=settledOrders,proto3" json:"settled_orders,omitempty"`
+	// fees_earned is the fees of the settled orders, before operator fees
+	FeesEarned cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=fees_earned,json=feesEarned,proto3,customtype=cosmossdk.io/math.Int" json:"fees_earned"`
+	// operator_fees_paid is the fees paid to operators in authorized fulfillments
+	OperatorFeesPaid cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=operator_fees_paid,json=operatorFeesPaid,proto3,customtype=cosmossdk.io/math.Int" json:"operator_fees_paid"`
+	// lost is the price paid for the orders whose packets were reverted by a hard fork or failed
+	Lost cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=lost,proto3,customtype=cosmossdk.io/math.Int" json:"lost"`
+	// lost_orders is the number of orders whose packets were reverted by a hard fork or failed
+	LostOrders uint64 `protobuf:"varint,11,opt,name=lost_orders,json=lostOrders,proto3" json:"lost_orders,omitempty"`
+}
+
+func (m *FulfillerStats) Reset()         { *m = FulfillerStats{} }
+func (m *FulfillerStats) String() string { return proto.CompactTextString(m) }
+func (*FulfillerStats) ProtoMessage()    {}
+func (*FulfillerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1189f11782a567e9, []int{0}
+}
+func (m *FulfillerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FulfillerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillerStats.Merge(m, src)
+}
+func (m *FulfillerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillerStats proto.InternalMessageInfo
+
+func (m *FulfillerStats) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *FulfillerStats) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FulfillerStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FulfillerStats) GetActiveOrders() uint64 {
+	if m != nil {
+		return m.ActiveOrders
+	}
+	return 0
+}
+
+func (m *FulfillerStats) GetSettledOrders() uint64 {
+	if m != nil {
+		return m.SettledOrders
+	}
+	return 0
+}
+
+func (m *FulfillerStats) GetLostOrders() uint64 {
+	if m != nil {
+		return m.LostOrders
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*FulfillerStats)(nil), "dymensionxyz.dymension.eibc.FulfillerStats")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/fulfiller_stats.proto", fileDescriptor_1189f11782a567e9)
+}
+
+var fileDescriptor_1189f11782a567e9 = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0x86, 0x13, 0xcd, 0x76, 0xed, 0xa9, 0xbb, 0xc8, 0xb0, 0xc2, 0xb8, 0x6a, 0xba, 0x28, 0xc2,
+	0x82, 0x98, 0xb0, 0xec, 0x03, 0x08, 0x2b, 0xbb, 0x50, 0x14, 0x94, 0x7a, 0xa5, 0x37, 0x61, 0x9a,
+	0x39, 0x6d, 0x07, 0x93, 0x4c, 0x98, 0x99, 0x96, 0xd6, 0xa7, 0xf0, 0x61, 0x7c, 0x06, 0xe9, 0x65,
+	0xf1, 0x4a, 0xbc, 0x28, 0xd2, 0xbe, 0x88, 0x64, 0x26, 0xa9, 0xde, 0xec, 0x45, 0xae, 0x72, 0xce,
+	0x7f, 0xf2, 0xff, 0xdf, 0x70, 0x38, 0x70, 0xc1, 0x97, 0x39, 0x16, 0x5a, 0xc8, 0x62, 0xb1, 0xfc,
+	0x1a, 0xef, 0x9b, 0x18, 0xc5, 0x28, 0x8d, 0xc7, 0xb3, 0x6c, 0x2c, 0xb2, 0x0c, 0x55, 0xa2, 0x0d,
+	0x33, 0x3a, 0x2a, 0x95, 0x34, 0x92, 0x3c, 0xfe, 0xdf, 0x12, 0xed, 0x9b, 0xa8, 0xb2, 0x9c, 0x9e,
+	0x4c, 0xe4, 0x44, 0xda, 0xff, 0xe2, 0xaa, 0x72, 0x96, 0xd3, 0x47, 0xa9, 0xd4, 0xb9, 0xd4, 0x89,
+	0x1b, 0xb8, 0xc6, 0x8d, 0x9e, 0xfd, 0x08, 0xe0, 0xf8, 0xa6, 0xe1, 0x7c, 0xac, 0x30, 0xe4, 0x09,
+	0x74, 0xf7, 0x64, 0xea, 0x9f, 0xf9, 0xe7, 0xdd, 0xe1, 0x3f, 0x81, 0x3c, 0x05, 0x50, 0x32, 0xcb,
+	0x58, 0x59, 0x26, 0x82, 0xd3, 0x3b, 0x6e, 0x5c, 0x2b, 0x03, 0x4e, 0x4e, 0xe0, 0x80, 0x63, 0x21,
+	0x73, 0x7a, 0xd7, 0x4e, 0x5c, 0x43, 0xde, 0x40, 0x87, 0xa5, 0x46, 0xcc, 0x91, 0x06, 0x95, 0x7c,
+	0xf5, 0x72, 0xb5, 0xe9, 0x7b, 0xbf, 0x37, 0xfd, 0x87, 0xee, 0x2d, 0x9a, 0x7f, 0x89, 0x84, 0x8c,
+	0x73, 0x66, 0xa6, 0xd1, 0xa0, 0x30, 0x3f, 0xbf, 0xbf, 0x82, 0xfa, 0x91, 0x83, 0xc2, 0x0c, 0x6b,
+	0x2b, 0x79, 0x0e, 0x47, 0xae, 0x4a, 0xa4, 0xe2, 0xa8, 0x34, 0x3d, 0x38, 0xf3, 0xcf, 0x83, 0xe1,
+	0x7d, 0x27, 0xbe, 0xb7, 0x1a, 0xb9, 0x86, 0x43, 0x8d, 0xc6, 0x64, 0xc8, 0x69, 0xa7, 0x3d, 0xaa,
+	0xf1, 0x92, 0x17, 0x70, 0x5c, 0x97, 0x0d, 0xec, 0xd0, 0xc2, 0x8e, 0x6a, 0xb5, 0xa6, 0xbd, 0x83,
+	0xde, 0x18, 0x51, 0x27, 0xc8, 0x54, 0x81, 0x9c, 0xde, 0x6b, 0x4f, 0x84, 0xca, 0x7f, 0x6d, 0xed,
+	0xe4, 0x13, 0x10, 0x59, 0xa2, 0x62, 0x46, 0xaa, 0xc4, 0xc6, 0x96, 0x4c, 0x70, 0xda, 0x6d, 0x1f,
+	0xfa, 0xa0, 0x89, 0xb9, 0x41, 0xd4, 0x1f, 0x98, 0xe0, 0xe4, 0x35, 0x04, 0x99, 0xd4, 0x86, 0x42,
+	0xfb, 0x30, 0x6b, 0x24, 0x7d, 0xe8, 0x55, 0xdf, 0x66, 0x1b, 0x3d, 0xbb, 0x0d, 0xa8, 0x24, 0xb7,
+	0x8a, 0xab, 0xb7, 0xab, 0x6d, 0xe8, 0xaf, 0xb7, 0xa1, 0xff, 0x67, 0x1b, 0xfa, 0xdf, 0x76, 0xa1,
+	0xb7, 0xde, 0x85, 0xde, 0xaf, 0x5d, 0xe8, 0x7d, 0xbe, 0x98, 0x08, 0x33, 0x9d, 0x8d, 0xa2, 0x54,
+	0xe6, 0xf1, 0x2d, 0xe7, 0x3e, 0xbf, 0x8c, 0x17, 0xee, 0xe6, 0xcd, 0xb2, 0x44, 0x3d, 0xea, 0xd8,
+	0xe3, 0xbc, 0xfc, 0x3b, 0x00, 0x2e, 0x26, 0x2c, 0x78, 0x1f, 0x03, 0x00, 0x00,
+}
+
+func (m *FulfillerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FulfillerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LostOrders != 0 {
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(m.LostOrders))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.Lost.Size()
+		i -= size
+		if _, err := m.Lost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.OperatorFeesPaid.Size()
+		i -= size
+		if _, err := m.OperatorFeesPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.FeesEarned.Size()
+		i -= size
+		if _, err := m.FeesEarned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.SettledOrders != 0 {
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(m.SettledOrders))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Settled.Size()
+		i -= size
+		if _, err := m.Settled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ActiveOrders != 0 {
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(m.ActiveOrders))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Active.Size()
+		i -= size
+		if _, err := m.Active.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintFulfillerStats(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFulfillerStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFulfillerStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FulfillerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovFulfillerStats(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovFulfillerStats(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFulfillerStats(uint64(l))
+	}
+	l = m.Active.Size()
+	n += 1 + l + sovFulfillerStats(uint64(l))
+	if m.ActiveOrders != 0 {
+		n += 1 + sovFulfillerStats(uint64(m.ActiveOrders))
+	}
+	l = m.Settled.Size()
+	n += 1 + l + sovFulfillerStats(uint64(l))
+	if m.SettledOrders != 0 {
+		n += 1 + sovFulfillerStats(uint64(m.SettledOrders))
+	}
+	l = m.FeesEarned.Size()
+	n += 1 + l + sovFulfillerStats(uint64(l))
+	l = m.OperatorFeesPaid.Size()
+	n += 1 + l + sovFulfillerStats(uint64(l))
+	l = m.Lost.Size()
+	n += 1 + l + sovFulfillerStats(uint64(l))
+	if m.LostOrders != 0 {
+		n += 1 + sovFulfillerStats(uint64(m.LostOrders))
+	}
+	return n
+}
+
+func sovFulfillerStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFulfillerStats(x uint64) (n int) {
+	return sovFulfillerStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FulfillerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFulfillerStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Active.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveOrders", wireType)
+			}
+			m.ActiveOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Settled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledOrders", wireType)
+			}
+			m.SettledOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesEarned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeesPaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorFeesPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LostOrders", wireType)
+			}
+			m.LostOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LostOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFulfillerStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFulfillerStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFulfillerStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFulfillerStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFulfillerStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFulfillerStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFulfillerStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFulfillerStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFulfillerStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFulfillerStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFulfillerStats = fmt.Errorf("proto: unexpected end of group")
+)
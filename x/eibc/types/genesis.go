@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -23,5 +29,49 @@ func (gs GenesisState) Validate() error {
 		}
 		demandOrdersMap[demandOrder.Id] = struct{}{}
 	}
+	vaultsMap := make(map[string]struct{})
+	for _, vault := range gs.GetVaults() {
+		if err := vault.ValidateBasic(); err != nil {
+			return err
+		}
+		if _, ok := vaultsMap[vault.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidVault, "duplicate vault: %s", vault.Denom)
+		}
+		vaultsMap[vault.Denom] = struct{}{}
+	}
+	statsMap := make(map[string]struct{})
+	for _, stats := range gs.GetFulfillerStats() {
+		if err := stats.ValidateBasic(); err != nil {
+			return fmt.Errorf("fulfiller stats: %w", err)
+		}
+		key := stats.Fulfiller + KeySeparator + stats.RollappId + KeySeparator + stats.Denom
+		if _, ok := statsMap[key]; ok {
+			return fmt.Errorf("duplicate fulfiller stats: %s", key)
+		}
+		statsMap[key] = struct{}{}
+	}
+	rollappFeesMap := make(map[string]struct{})
+	for _, fees := range gs.GetRollappFees() {
+		if err := fees.ValidateBasic(); err != nil {
+			return fmt.Errorf("rollapp fees: %w", err)
+		}
+		if _, ok := rollappFeesMap[fees.RollappId]; ok {
+			return fmt.Errorf("duplicate rollapp fees: %s", fees.RollappId)
+		}
+		rollappFeesMap[fees.RollappId] = struct{}{}
+	}
+	unpaidFillsMap := make(map[string]struct{})
+	for _, order := range gs.GetUnpaidFills() {
+		if err := order.Validate(); err != nil {
+			return fmt.Errorf("unpaid fills: %w", err)
+		}
+		if !order.IsPartiallyFilled() {
+			return fmt.Errorf("unpaid fills: order is not partially filled: %s", order.Id)
+		}
+		if _, ok := unpaidFillsMap[order.Id]; ok {
+			return fmt.Errorf("duplicate unpaid fills: %s", order.Id)
+		}
+		unpaidFillsMap[order.Id] = struct{}{}
+	}
 	return gs.Params.Validate()
 }
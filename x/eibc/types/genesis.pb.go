@@ -25,8 +25,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the eibc module's genesis state.
 type GenesisState struct {
-	Params       Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	DemandOrders []DemandOrder `protobuf:"bytes,2,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders"`
+	Params         Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	DemandOrders   []DemandOrder    `protobuf:"bytes,2,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders"`
+	Vaults         []Vault          `protobuf:"bytes,3,rep,name=vaults,proto3" json:"vaults"`
+	FulfillerStats []FulfillerStats `protobuf:"bytes,4,rep,name=fulfiller_stats,json=fulfillerStats,proto3" json:"fulfiller_stats"`
+	RollappFees    []RollappFees    `protobuf:"bytes,5,rep,name=rollapp_fees,json=rollappFees,proto3" json:"rollapp_fees"`
+	// unpaid_fills are the partially filled demand orders whose fills were not paid on finalization.
+	UnpaidFills []DemandOrder `protobuf:"bytes,6,rep,name=unpaid_fills,json=unpaidFills,proto3" json:"unpaid_fills"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +81,34 @@ func (m *GenesisState) GetDemandOrders() []DemandOrder {
 	return nil
 }
 
+func (m *GenesisState) GetVaults() []Vault {
+	if m != nil {
+		return m.Vaults
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFulfillerStats() []FulfillerStats {
+	if m != nil {
+		return m.FulfillerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRollappFees() []RollappFees {
+	if m != nil {
+		return m.RollappFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnpaidFills() []DemandOrder {
+	if m != nil {
+		return m.UnpaidFills
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.eibc.GenesisState")
 }
@@ -85,23 +118,31 @@ func init() {
 }
 
 var fileDescriptor_cfd2504316b5c400 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
-	0x91, 0x95, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0xa5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75,
-	0xfa, 0x20, 0x16, 0x44, 0x8b, 0x94, 0x06, 0x3e, 0xd3, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0x86,
-	0x4b, 0xe9, 0xe1, 0x53, 0x99, 0x92, 0x9a, 0x9b, 0x98, 0x97, 0x12, 0x9f, 0x5f, 0x94, 0x92, 0x5a,
-	0x04, 0x51, 0xaf, 0xb4, 0x8c, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xbc, 0xe0, 0x92, 0xc4, 0x92, 0x54,
-	0x21, 0x47, 0x2e, 0x36, 0x88, 0x81, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xca, 0x7a, 0x78,
-	0x9c, 0xab, 0x17, 0x00, 0x56, 0xea, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xa3, 0x50,
-	0x30, 0x17, 0x2f, 0xb2, 0x4d, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x1a, 0x78, 0x4d,
-	0x72, 0x01, 0xeb, 0xf0, 0x07, 0x69, 0x80, 0x1a, 0xc7, 0x93, 0x82, 0x10, 0x2a, 0x76, 0xf2, 0x3e,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2,
-	0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0xbe, 0x2f, 0x33, 0xd6, 0xaf, 0x80, 0x04, 0x41, 0x49,
-	0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xf3, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f,
-	0xc3, 0xf5, 0xf7, 0xb6, 0x01, 0x00, 0x00,
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x4a, 0xe3, 0x40,
+	0x18, 0xc7, 0x93, 0x6d, 0x37, 0x87, 0xa4, 0xbb, 0x0b, 0x61, 0x0f, 0xa1, 0x42, 0x2c, 0xf5, 0x60,
+	0x44, 0x48, 0x68, 0xfb, 0x02, 0x5a, 0xa4, 0x1e, 0x3c, 0xa8, 0x2d, 0x78, 0xe8, 0x25, 0x4c, 0x9b,
+	0x49, 0x0c, 0x24, 0x99, 0x30, 0x33, 0x29, 0xad, 0x4f, 0xe1, 0x63, 0xf5, 0xd8, 0xa3, 0x27, 0x91,
+	0xf6, 0xe6, 0x53, 0xc8, 0xcc, 0x24, 0x6d, 0x15, 0x1c, 0x8a, 0xb7, 0xcc, 0xf0, 0xfb, 0xff, 0x32,
+	0xdf, 0x9f, 0x4f, 0x3f, 0x0b, 0x16, 0x29, 0xcc, 0x48, 0x8c, 0xb2, 0xf9, 0xe2, 0xc9, 0xdb, 0x1e,
+	0x3c, 0x18, 0x4f, 0xa6, 0x5e, 0x04, 0x33, 0x48, 0x62, 0xe2, 0xe6, 0x18, 0x51, 0x64, 0x1e, 0xed,
+	0xa3, 0xee, 0xf6, 0xe0, 0x32, 0xb4, 0xf9, 0x3f, 0x42, 0x11, 0xe2, 0x9c, 0xc7, 0xbe, 0x44, 0xa4,
+	0xe9, 0xc8, 0xec, 0x39, 0xc0, 0x20, 0x2d, 0xe5, 0x4d, 0x57, 0x46, 0x06, 0x30, 0x05, 0x59, 0xe0,
+	0x23, 0x1c, 0x40, 0x5c, 0xf2, 0xa7, 0x32, 0x7e, 0x06, 0x8a, 0x84, 0x96, 0x60, 0x47, 0x06, 0x86,
+	0x45, 0x12, 0xc6, 0x49, 0x02, 0xb1, 0x4f, 0x28, 0xa0, 0x07, 0xbd, 0x05, 0xa3, 0x24, 0x01, 0x79,
+	0xee, 0x87, 0x10, 0x96, 0x7c, 0xfb, 0xbd, 0xa6, 0x37, 0xae, 0x45, 0x55, 0x23, 0x0a, 0x28, 0x34,
+	0x2f, 0x75, 0x4d, 0x0c, 0x67, 0xa9, 0x2d, 0xd5, 0x31, 0xba, 0x27, 0xae, 0xa4, 0x3a, 0xf7, 0x8e,
+	0xa3, 0xfd, 0xfa, 0xf2, 0xf5, 0x58, 0x19, 0x96, 0x41, 0x73, 0xa4, 0xff, 0xd9, 0x9f, 0x9a, 0x58,
+	0xbf, 0x5a, 0x35, 0xc7, 0xe8, 0x3a, 0x52, 0xd3, 0x15, 0x4f, 0xdc, 0xb2, 0x40, 0xa9, 0x6b, 0x04,
+	0xbb, 0x2b, 0x62, 0x5e, 0xe8, 0x1a, 0xaf, 0x86, 0x58, 0x35, 0x6e, 0x6b, 0x4b, 0x6d, 0x0f, 0x0c,
+	0xad, 0x9e, 0x25, 0x72, 0xe6, 0x58, 0xff, 0xf7, 0xa5, 0x33, 0xab, 0xce, 0x55, 0xe7, 0x52, 0xd5,
+	0xa0, 0xca, 0xb0, 0x7e, 0xaa, 0x51, 0xff, 0x86, 0x9f, 0x6e, 0xcd, 0x7b, 0xbd, 0xb1, 0x5f, 0xae,
+	0xf5, 0xfb, 0x80, 0x89, 0x87, 0x22, 0x30, 0x80, 0xb0, 0xb2, 0x1a, 0x78, 0x77, 0xc5, 0x94, 0x45,
+	0x96, 0x83, 0x38, 0xf0, 0xd9, 0x8f, 0x88, 0xa5, 0xfd, 0xa8, 0x44, 0x43, 0x38, 0x06, 0x4c, 0xd1,
+	0xbf, 0x59, 0xae, 0x6d, 0x75, 0xb5, 0xb6, 0xd5, 0xb7, 0xb5, 0xad, 0x3e, 0x6f, 0x6c, 0x65, 0xb5,
+	0xb1, 0x95, 0x97, 0x8d, 0xad, 0x8c, 0x3b, 0x51, 0x4c, 0x1f, 0x8b, 0x89, 0x3b, 0x45, 0xa9, 0xf7,
+	0xcd, 0x06, 0xcd, 0x7a, 0xde, 0x5c, 0xac, 0x11, 0x5d, 0xe4, 0x90, 0x4c, 0x34, 0xbe, 0x40, 0xbd,
+	0x8f, 0x01, 0x00, 0x01, 0x1c, 0x43, 0xb1, 0x86, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +165,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnpaidFills) > 0 {
+		for iNdEx := len(m.UnpaidFills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnpaidFills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RollappFees) > 0 {
+		for iNdEx := len(m.RollappFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FulfillerStats) > 0 {
+		for iNdEx := len(m.FulfillerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FulfillerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DemandOrders) > 0 {
 		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +273,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FulfillerStats) > 0 {
+		for _, e := range m.FulfillerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RollappFees) > 0 {
+		for _, e := range m.RollappFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnpaidFills) > 0 {
+		for _, e := range m.UnpaidFills {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +402,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerStats = append(m.FulfillerStats, FulfillerStats{})
+			if err := m.FulfillerStats[len(m.FulfillerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappFees = append(m.RollappFees, RollappFees{})
+			if err := m.RollappFees[len(m.RollappFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpaidFills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnpaidFills = append(m.UnpaidFills, DemandOrder{})
+			if err := m.UnpaidFills[len(m.UnpaidFills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
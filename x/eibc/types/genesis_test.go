@@ -36,6 +36,26 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 			},
 			valid: false,
+		}, {
+			desc: "fee below the min rollapp fee",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					p := validParams
+					p.MinRollappTimeoutFee = sdk.NewDecWithPrec(15, 2)
+					return p
+				}(),
+			},
+			valid: false,
+		}, {
+			desc: "fee above the max rollapp fee",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					p := validParams
+					p.MaxRollappErrackFee = sdk.NewDecWithPrec(5, 2)
+					return p
+				}(),
+			},
+			valid: false,
 		}, {
 			desc:     "invalid demand order",
 			genState: &types.GenesisState{DemandOrders: []types.DemandOrder{{}}, Params: types.DefaultParams()},
@@ -47,6 +67,48 @@ func TestGenesisState_Validate(t *testing.T) {
 				validDemandOrder,
 			}, Params: types.DefaultParams()},
 			valid: false,
+		}, {
+			desc: "valid fulfiller stats",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				FulfillerStats: []types.FulfillerStats{validFulfillerStats},
+			},
+			valid: true,
+		}, {
+			desc: "invalid fulfiller stats",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				FulfillerStats: []types.FulfillerStats{types.NewFulfillerStats("invalid", "rollapp_1234-1", "adym")},
+			},
+			valid: false,
+		}, {
+			desc: "duplicate fulfiller stats",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				FulfillerStats: []types.FulfillerStats{validFulfillerStats, validFulfillerStats},
+			},
+			valid: false,
+		}, {
+			desc: "valid rollapp fees",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				RollappFees: []types.RollappFees{validRollappFees},
+			},
+			valid: true,
+		}, {
+			desc: "invalid rollapp fees",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				RollappFees: []types.RollappFees{types.NewRollappFees("rollapp_1234-1", sdk.NewDec(-1), sdk.ZeroDec())},
+			},
+			valid: false,
+		}, {
+			desc: "duplicate rollapp fees",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				RollappFees: []types.RollappFees{validRollappFees, validRollappFees},
+			},
+			valid: false,
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -69,7 +131,17 @@ var validDemandOrder = types.DemandOrder{
 }
 
 var validParams = types.Params{
-	EpochIdentifier: "hour",
-	TimeoutFee:      sdk.NewDecWithPrec(1, 1),
-	ErrackFee:       sdk.NewDecWithPrec(1, 1),
+	EpochIdentifier:      "hour",
+	TimeoutFee:           sdk.NewDecWithPrec(1, 1),
+	ErrackFee:            sdk.NewDecWithPrec(1, 1),
+	MaxRollappTimeoutFee: sdk.NewDecWithPrec(2, 1),
+	MaxRollappErrackFee:  sdk.NewDecWithPrec(2, 1),
+	MinRollappTimeoutFee: sdk.NewDecWithPrec(1, 2),
+	MinRollappErrackFee:  sdk.NewDecWithPrec(1, 2),
+	MinFillFraction:      sdk.NewDecWithPrec(1, 1),
+	MaxFillsPerOrder:     10,
 }
+
+var validFulfillerStats = types.NewFulfillerStats(sample.AccAddress(), "rollapp_1234-1", "adym")
+
+var validRollappFees = types.NewRollappFees("rollapp_1234-1", sdk.NewDecWithPrec(2, 3), sdk.NewDecWithPrec(2, 3))
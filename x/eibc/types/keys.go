@@ -36,6 +36,30 @@ var (
 	FinalizedDemandOrderKeyPrefix = []byte{0x00, 0x02}
 
 	_ = []byte{0x00, 0x03} // deprecated key
+
+	// VaultKeyPrefix is the prefix for the vaults, by denom
+	VaultKeyPrefix = []byte{0x01}
+
+	// DemandOrdersByRecipientKeyPrefix is the prefix for the index of the demand orders by recipient
+	DemandOrdersByRecipientKeyPrefix = []byte{0x02}
+	// DemandOrdersByFulfillerKeyPrefix is the prefix for the index of the demand orders by fulfiller
+	DemandOrdersByFulfillerKeyPrefix = []byte{0x03}
+	// DemandOrdersByRollappKeyPrefix is the prefix for the index of the demand orders by rollapp
+	DemandOrdersByRollappKeyPrefix = []byte{0x04}
+	// DemandOrdersByDenomKeyPrefix is the prefix for the index of the demand orders by denom
+	DemandOrdersByDenomKeyPrefix = []byte{0x05}
+
+	// FulfillerStatsKeyPrefix is the prefix for the fulfiller stats, by fulfiller, rollapp and denom
+	FulfillerStatsKeyPrefix = []byte{0x06}
+
+	// RollappFeesKeyPrefix is the prefix for the fees set by the rollapp owners, by rollapp
+	RollappFeesKeyPrefix = []byte{0x07}
+
+	// VaultCursorsKeyPrefix is the prefix for the last pending order scanned by the vaults, by denom
+	VaultCursorsKeyPrefix = []byte{0x08}
+
+	// UnpaidFillsKeyPrefix is the prefix for the partially filled demand orders whose fills were not paid, by order ID
+	UnpaidFillsKeyPrefix = []byte{0x09}
 )
 
 // GetDemandOrderKey constructs a key for a specific DemandOrder.
@@ -52,3 +76,8 @@ func GetDemandOrderKey(packetStatus commontypes.Status, orderId string) ([]byte,
 	}
 	return []byte(fmt.Sprintf("%s%s%s%s%s", prefix, KeySeparator, packetStatus, KeySeparator, orderId)), nil
 }
+
+// GetVaultKey constructs a key for the vault of a denom.
+func GetVaultKey(denom string) []byte {
+	return append(append([]byte{}, VaultKeyPrefix...), []byte(denom)...)
+}
@@ -17,12 +17,36 @@ var (
 	KeyTimeoutFee = []byte("TimeoutFee")
 	// KeyErrAckFee is the key for the error acknowledgement fee
 	KeyErrAckFee = []byte("ErrAckFee")
+	// KeyMaxRollappTimeoutFee is the key for the cap on the timeout fee of a rollapp
+	KeyMaxRollappTimeoutFee = []byte("MaxRollappTimeoutFee")
+	// KeyMaxRollappErrAckFee is the key for the cap on the error acknowledgement fee of a rollapp
+	KeyMaxRollappErrAckFee = []byte("MaxRollappErrAckFee")
+	// KeyMinRollappTimeoutFee is the key for the floor on the timeout fee of a rollapp
+	KeyMinRollappTimeoutFee = []byte("MinRollappTimeoutFee")
+	// KeyMinRollappErrAckFee is the key for the floor on the error acknowledgement fee of a rollapp
+	KeyMinRollappErrAckFee = []byte("MinRollappErrAckFee")
+	// KeyMinFillFraction is the key for the min fraction of the order price filled by a partial fill
+	KeyMinFillFraction = []byte("MinFillFraction")
+	// KeyMaxFillsPerOrder is the key for the max number of fills of an order
+	KeyMaxFillsPerOrder = []byte("MaxFillsPerOrder")
 )
 
 const (
 	defaultEpochIdentifier = "hour"
 	defaultTimeoutFee      = "0.0015"
 	defaultErrAckFee       = "0.0015"
+	// DefaultMaxRollappTimeoutFee is the default cap on the timeout fee of a rollapp
+	DefaultMaxRollappTimeoutFee = "0.01"
+	// DefaultMaxRollappErrAckFee is the default cap on the error acknowledgement fee of a rollapp
+	DefaultMaxRollappErrAckFee = "0.01"
+	// DefaultMinRollappTimeoutFee is the default floor on the timeout fee of a rollapp
+	DefaultMinRollappTimeoutFee = "0.001"
+	// DefaultMinRollappErrAckFee is the default floor on the error acknowledgement fee of a rollapp
+	DefaultMinRollappErrAckFee = "0.001"
+	// DefaultMinFillFraction is the default min fraction of the order price filled by a partial fill
+	DefaultMinFillFraction = "0.1"
+	// DefaultMaxFillsPerOrder is the default max number of fills of an order
+	DefaultMaxFillsPerOrder = 10
 )
 
 // ParamKeyTable the param key table for launch module
@@ -31,17 +55,43 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, timeoutFee sdk.Dec, errAckFee sdk.Dec) Params {
+func NewParams(
+	epochIdentifier string,
+	timeoutFee sdk.Dec,
+	errAckFee sdk.Dec,
+	maxRollappTimeoutFee sdk.Dec,
+	maxRollappErrAckFee sdk.Dec,
+	minRollappTimeoutFee sdk.Dec,
+	minRollappErrAckFee sdk.Dec,
+	minFillFraction sdk.Dec,
+	maxFillsPerOrder uint64,
+) Params {
 	return Params{
-		EpochIdentifier: epochIdentifier,
-		TimeoutFee:      timeoutFee,
-		ErrackFee:       errAckFee,
+		EpochIdentifier:      epochIdentifier,
+		TimeoutFee:           timeoutFee,
+		ErrackFee:            errAckFee,
+		MaxRollappTimeoutFee: maxRollappTimeoutFee,
+		MaxRollappErrackFee:  maxRollappErrAckFee,
+		MinRollappTimeoutFee: minRollappTimeoutFee,
+		MinRollappErrackFee:  minRollappErrAckFee,
+		MinFillFraction:      minFillFraction,
+		MaxFillsPerOrder:     maxFillsPerOrder,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(defaultEpochIdentifier, sdk.MustNewDecFromStr(defaultTimeoutFee), sdk.MustNewDecFromStr(defaultErrAckFee))
+	return NewParams(
+		defaultEpochIdentifier,
+		sdk.MustNewDecFromStr(defaultTimeoutFee),
+		sdk.MustNewDecFromStr(defaultErrAckFee),
+		sdk.MustNewDecFromStr(DefaultMaxRollappTimeoutFee),
+		sdk.MustNewDecFromStr(DefaultMaxRollappErrAckFee),
+		sdk.MustNewDecFromStr(DefaultMinRollappTimeoutFee),
+		sdk.MustNewDecFromStr(DefaultMinRollappErrAckFee),
+		sdk.MustNewDecFromStr(DefaultMinFillFraction),
+		DefaultMaxFillsPerOrder,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -50,6 +100,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateEpochIdentifier),
 		paramtypes.NewParamSetPair(KeyTimeoutFee, &p.TimeoutFee, validateTimeoutFee),
 		paramtypes.NewParamSetPair(KeyErrAckFee, &p.ErrackFee, validateErrAckFee),
+		paramtypes.NewParamSetPair(KeyMaxRollappTimeoutFee, &p.MaxRollappTimeoutFee, validateTimeoutFee),
+		paramtypes.NewParamSetPair(KeyMaxRollappErrAckFee, &p.MaxRollappErrackFee, validateErrAckFee),
+		paramtypes.NewParamSetPair(KeyMinRollappTimeoutFee, &p.MinRollappTimeoutFee, validateTimeoutFee),
+		paramtypes.NewParamSetPair(KeyMinRollappErrAckFee, &p.MinRollappErrackFee, validateErrAckFee),
+		paramtypes.NewParamSetPair(KeyMinFillFraction, &p.MinFillFraction, validateMinFillFraction),
+		paramtypes.NewParamSetPair(KeyMaxFillsPerOrder, &p.MaxFillsPerOrder, validateMaxFillsPerOrder),
 	}
 }
 
@@ -64,6 +120,32 @@ func (p Params) Validate() error {
 	if err := validateErrAckFee(p.ErrackFee); err != nil {
 		return fmt.Errorf("error acknowledgement fee: %w", err)
 	}
+	if err := validateTimeoutFee(p.MaxRollappTimeoutFee); err != nil {
+		return fmt.Errorf("max rollapp timeout fee: %w", err)
+	}
+	if err := validateErrAckFee(p.MaxRollappErrackFee); err != nil {
+		return fmt.Errorf("max rollapp error acknowledgement fee: %w", err)
+	}
+	if err := validateTimeoutFee(p.MinRollappTimeoutFee); err != nil {
+		return fmt.Errorf("min rollapp timeout fee: %w", err)
+	}
+	if err := validateErrAckFee(p.MinRollappErrackFee); err != nil {
+		return fmt.Errorf("min rollapp error acknowledgement fee: %w", err)
+	}
+	if err := validateMinFillFraction(p.MinFillFraction); err != nil {
+		return fmt.Errorf("min fill fraction: %w", err)
+	}
+	if err := validateMaxFillsPerOrder(p.MaxFillsPerOrder); err != nil {
+		return fmt.Errorf("max fills per order: %w", err)
+	}
+	if p.TimeoutFee.LT(p.MinRollappTimeoutFee) || p.TimeoutFee.GT(p.MaxRollappTimeoutFee) {
+		return fmt.Errorf("timeout fee must be between the min and max rollapp timeout fees: %s not in [%s, %s]",
+			p.TimeoutFee, p.MinRollappTimeoutFee, p.MaxRollappTimeoutFee)
+	}
+	if p.ErrackFee.LT(p.MinRollappErrackFee) || p.ErrackFee.GT(p.MaxRollappErrackFee) {
+		return fmt.Errorf("error acknowledgement fee must be between the min and max rollapp error acknowledgement fees: %s not in [%s, %s]",
+			p.ErrackFee, p.MinRollappErrackFee, p.MaxRollappErrackFee)
+	}
 	return nil
 }
 
@@ -121,3 +203,28 @@ func validateErrAckFee(i any) error {
 
 	return nil
 }
+
+func validateMinFillFraction(i any) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return fmt.Errorf("invalid min fill fraction: %+v", i)
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min fill fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateMaxFillsPerOrder(i any) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max fills per order must be positive")
+	}
+	return nil
+}
@@ -29,6 +29,19 @@ type Params struct {
 	EpochIdentifier string                                 `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
 	TimeoutFee      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_fee" yaml:"timeout_fee"`
 	ErrackFee       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"errack_fee" yaml:"errack_fee"`
+	// max_rollapp_timeout_fee is the cap on the timeout fee set by a rollapp owner for the rollapp
+	MaxRollappTimeoutFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_rollapp_timeout_fee,json=maxRollappTimeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rollapp_timeout_fee" yaml:"max_rollapp_timeout_fee"`
+	// max_rollapp_errack_fee is the cap on the errack fee set by a rollapp owner for the rollapp
+	MaxRollappErrackFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_rollapp_errack_fee,json=maxRollappErrackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rollapp_errack_fee" yaml:"max_rollapp_errack_fee"`
+	// min_rollapp_timeout_fee is the floor on the timeout fee set by a rollapp owner for the rollapp
+	MinRollappTimeoutFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_rollapp_timeout_fee,json=minRollappTimeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rollapp_timeout_fee" yaml:"min_rollapp_timeout_fee"`
+	// min_rollapp_errack_fee is the floor on the errack fee set by a rollapp owner for the rollapp
+	MinRollappErrackFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=min_rollapp_errack_fee,json=minRollappErrackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rollapp_errack_fee" yaml:"min_rollapp_errack_fee"`
+	// min_fill_fraction is the min fraction of the order price filled by a partial fill, unless the fill
+	// completes the order
+	MinFillFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_fill_fraction,json=minFillFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fill_fraction" yaml:"min_fill_fraction"`
+	// max_fills_per_order is the max number of fills of an order
+	MaxFillsPerOrder uint64 `protobuf:"varint,9,opt,name=max_fills_per_order,json=maxFillsPerOrder,proto3" json:"max_fills_per_order,omitempty" yaml:"max_fills_per_order"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -70,6 +83,13 @@ func (m *Params) GetEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetMaxFillsPerOrder() uint64 {
+	if m != nil {
+		return m.MaxFillsPerOrder
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.eibc.Params")
 }
@@ -79,26 +99,38 @@ func init() {
 }
 
 var fileDescriptor_fa18b53f607a3f90 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa,
-	0x20, 0x16, 0x44, 0x8b, 0xd2, 0x42, 0x26, 0x2e, 0xb6, 0x00, 0xb0, 0x19, 0x42, 0x6e, 0x5c, 0x02,
-	0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xf1, 0x99, 0x29, 0xa9, 0x79, 0x25, 0x99, 0x69, 0x99, 0xa9, 0x45,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0xd2, 0x9f, 0xee, 0xc9, 0x8b, 0x57, 0x26, 0xe6, 0xe6,
-	0x58, 0x29, 0xa1, 0xab, 0x50, 0x0a, 0xe2, 0x07, 0x0b, 0x79, 0xc2, 0x45, 0x84, 0x52, 0xb9, 0xb8,
-	0x4b, 0x32, 0x73, 0x53, 0xf3, 0x4b, 0x4b, 0xe2, 0xd3, 0x52, 0x53, 0x25, 0x98, 0xc0, 0x46, 0xb8,
-	0x9c, 0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x7e, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6,
-	0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0xd6, 0x73, 0x49, 0x4d, 0xfe, 0x74, 0x4f, 0x5e, 0x08, 0x62, 0x21,
-	0x92, 0x51, 0x4a, 0x41, 0x5c, 0x50, 0x9e, 0x5b, 0x6a, 0xaa, 0x50, 0x12, 0x17, 0x57, 0x6a, 0x51,
-	0x51, 0x62, 0x72, 0x36, 0xd8, 0x16, 0x66, 0xb0, 0x2d, 0xce, 0x24, 0xdb, 0x22, 0x08, 0xf5, 0x16,
-	0xdc, 0x24, 0xa5, 0x20, 0x4e, 0x08, 0xc7, 0x2d, 0x35, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06,
-	0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2,
-	0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x44, 0xb2, 0x07,
-	0x47, 0x2c, 0x95, 0x19, 0xeb, 0x57, 0x40, 0xa2, 0x0a, 0x6c, 0x6d, 0x12, 0x1b, 0x38, 0xdc, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x58, 0x0e, 0xde, 0xd6, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x21, 0x84, 0xe6, 0x18, 0xda, 0x9a, 0x8a, 0x5a, 0xad, 0xb0, 0x2b, 0x0f, 0x28, 0x0b,
+	0xb6, 0x50, 0xb7, 0x8e, 0xa1, 0x44, 0x02, 0x84, 0x1a, 0x59, 0x4c, 0x2c, 0xd6, 0xc5, 0x79, 0x49,
+	0x9f, 0xea, 0xbb, 0xb3, 0xce, 0x6e, 0xe5, 0x30, 0x23, 0x21, 0x31, 0x31, 0x32, 0xf2, 0x71, 0x3a,
+	0x76, 0x44, 0x0c, 0x16, 0x4a, 0xbe, 0x41, 0x3e, 0x01, 0xf2, 0x39, 0x4a, 0xdc, 0x3f, 0x1e, 0xac,
+	0x4c, 0xc9, 0x7b, 0xfe, 0xdd, 0xef, 0xdf, 0x49, 0x47, 0xba, 0xa3, 0x29, 0x03, 0x9e, 0xa0, 0xe0,
+	0xd9, 0xf4, 0xab, 0xb7, 0x1a, 0x3c, 0xc0, 0x61, 0xe8, 0xc5, 0x54, 0x52, 0x96, 0xb8, 0xb1, 0x14,
+	0xa9, 0x30, 0x0e, 0xab, 0x48, 0x77, 0x35, 0xb8, 0x05, 0xf2, 0x60, 0x6f, 0x22, 0x26, 0x42, 0xe1,
+	0xbc, 0xe2, 0x5f, 0x79, 0xc4, 0xf9, 0xb1, 0x45, 0xda, 0x03, 0xc5, 0x61, 0xf4, 0xc9, 0x0e, 0xc4,
+	0x22, 0x3c, 0x0f, 0x70, 0x04, 0x3c, 0xc5, 0x31, 0x82, 0x34, 0xf5, 0x23, 0xbd, 0xdb, 0xe9, 0x1d,
+	0x2e, 0x72, 0x7b, 0x7f, 0x4a, 0x59, 0x74, 0xe2, 0xdc, 0x45, 0x38, 0xfe, 0xb6, 0x5a, 0xbd, 0x5f,
+	0x6d, 0x0c, 0x20, 0xcf, 0x52, 0x64, 0x20, 0x2e, 0xd3, 0x60, 0x0c, 0x60, 0x3e, 0x52, 0x14, 0xa7,
+	0xd7, 0xb9, 0xad, 0xfd, 0xcd, 0xed, 0x57, 0x13, 0x4c, 0xcf, 0x2f, 0x87, 0x6e, 0x28, 0x98, 0x17,
+	0x8a, 0x84, 0x89, 0x64, 0xf9, 0xf3, 0x3a, 0x19, 0x5d, 0x78, 0xe9, 0x34, 0x86, 0xc4, 0x3d, 0x85,
+	0x70, 0x91, 0xdb, 0x46, 0x29, 0x58, 0xa1, 0x72, 0x7c, 0xb2, 0x9c, 0xfa, 0x00, 0xc6, 0x90, 0x10,
+	0x90, 0x92, 0x86, 0x17, 0x4a, 0xe5, 0xb1, 0x52, 0x79, 0xdb, 0x58, 0x65, 0x77, 0x19, 0x6b, 0xc5,
+	0xe4, 0xf8, 0x9d, 0x72, 0x28, 0x34, 0xbe, 0xeb, 0x64, 0x9f, 0xd1, 0x2c, 0x90, 0x22, 0x8a, 0x68,
+	0x1c, 0x07, 0xd5, 0x5c, 0x2d, 0xa5, 0x38, 0x68, 0xac, 0x68, 0x95, 0x8a, 0x35, 0xb4, 0x8e, 0xbf,
+	0xc7, 0x68, 0xe6, 0x97, 0x1f, 0x3e, 0xaf, 0xd3, 0x7e, 0xd3, 0xc9, 0x8b, 0xea, 0x91, 0x4a, 0xf4,
+	0x27, 0xca, 0xc8, 0x59, 0x63, 0x23, 0x2f, 0xef, 0x1b, 0xa9, 0xd6, 0xf0, 0x7c, 0xed, 0xe3, 0xdd,
+	0xed, 0x42, 0x90, 0x3f, 0x58, 0x48, 0x7b, 0xc3, 0x42, 0x90, 0xd7, 0x15, 0x82, 0xbc, 0xa6, 0x10,
+	0xe4, 0x0f, 0x58, 0x37, 0x9f, 0x6e, 0x58, 0x08, 0xf2, 0x9a, 0x42, 0x90, 0xdf, 0x2b, 0xe4, 0x8a,
+	0xec, 0x16, 0xf8, 0x31, 0x46, 0x51, 0x30, 0x96, 0x34, 0x4c, 0x51, 0x70, 0x73, 0x4b, 0x19, 0xf8,
+	0xd0, 0xd8, 0x80, 0xb9, 0x36, 0x70, 0x8b, 0xd0, 0xf1, 0xb7, 0x19, 0xf2, 0x3e, 0x46, 0x51, 0x7f,
+	0xb9, 0x31, 0x3e, 0x91, 0xe2, 0x7e, 0x14, 0x2c, 0x09, 0x62, 0x90, 0x81, 0x90, 0x23, 0x90, 0x66,
+	0xe7, 0x48, 0xef, 0xb6, 0x7a, 0xd6, 0x22, 0xb7, 0x0f, 0xd6, 0xb7, 0x7b, 0x07, 0xe4, 0xf8, 0x3b,
+	0x8c, 0x66, 0x05, 0x5b, 0x32, 0x00, 0x79, 0x56, 0xac, 0x4e, 0x5a, 0xbf, 0x7e, 0xdb, 0x5a, 0xef,
+	0xe3, 0xf5, 0xcc, 0xd2, 0x6f, 0x66, 0x96, 0xfe, 0x6f, 0x66, 0xe9, 0x3f, 0xe7, 0x96, 0x76, 0x33,
+	0xb7, 0xb4, 0x3f, 0x73, 0x4b, 0xfb, 0xf2, 0xa6, 0x92, 0xa1, 0xe6, 0x39, 0xba, 0x3a, 0xf6, 0xb2,
+	0xf2, 0x4d, 0x52, 0x91, 0x86, 0x6d, 0xf5, 0xc0, 0x1c, 0xff, 0x1f, 0x00, 0x7b, 0x08, 0xb4, 0xe6,
+	0xbf, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +153,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxFillsPerOrder != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFillsPerOrder))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinFillFraction.Size()
+		i -= size
+		if _, err := m.MinFillFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinRollappErrackFee.Size()
+		i -= size
+		if _, err := m.MinRollappErrackFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinRollappTimeoutFee.Size()
+		i -= size
+		if _, err := m.MinRollappTimeoutFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxRollappErrackFee.Size()
+		i -= size
+		if _, err := m.MaxRollappErrackFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxRollappTimeoutFee.Size()
+		i -= size
+		if _, err := m.MaxRollappTimeoutFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.ErrackFee.Size()
 		i -= size
@@ -176,6 +263,19 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.ErrackFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRollappTimeoutFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxRollappErrackFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinRollappTimeoutFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinRollappErrackFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinFillFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxFillsPerOrder != 0 {
+		n += 1 + sovParams(uint64(m.MaxFillsPerOrder))
+	}
 	return n
 }
 
@@ -314,6 +414,195 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRollappTimeoutFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRollappTimeoutFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRollappErrackFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRollappErrackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRollappTimeoutFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRollappTimeoutFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRollappErrackFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRollappErrackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFillFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFillsPerOrder", wireType)
+			}
+			m.MaxFillsPerOrder = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFillsPerOrder |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
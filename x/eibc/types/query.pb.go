@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -405,607 +407,2977 @@ func (m *QueryDemandOrdersByStatusResponse) GetPagination() *query.PageResponse
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.eibc.QueryParamsResponse")
-	proto.RegisterType((*QueryGetDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderRequest")
-	proto.RegisterType((*QueryDemandOrdersByStatusRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusRequest")
-	proto.RegisterType((*QueryGetDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderResponse")
-	proto.RegisterType((*QueryDemandOrdersByStatusResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusResponse")
+// QueryVaultRequest is the request type for the Query/Vault RPC method.
+type QueryVaultRequest struct {
+	// denom of the vault
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/query.proto", fileDescriptor_d85bfe71ceb5f8dc)
+func (m *QueryVaultRequest) Reset()         { *m = QueryVaultRequest{} }
+func (m *QueryVaultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultRequest) ProtoMessage()    {}
+func (*QueryVaultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{6}
 }
-
-var fileDescriptor_d85bfe71ceb5f8dc = []byte{
-	// 759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x4e, 0x13, 0x41,
-	0x14, 0xee, 0x96, 0xb6, 0xda, 0xe1, 0xaf, 0x8e, 0xbd, 0xd8, 0x14, 0xac, 0x58, 0x82, 0x34, 0xa8,
-	0x3b, 0xb4, 0xc4, 0x9f, 0xc4, 0x60, 0x42, 0xd3, 0x96, 0x34, 0x20, 0xe2, 0x2a, 0x89, 0xe1, 0x86,
-	0x6c, 0xbb, 0x43, 0x1d, 0xed, 0xee, 0x2c, 0xbb, 0x53, 0x42, 0x25, 0xdc, 0xf8, 0x04, 0x26, 0xde,
-	0xf8, 0x24, 0xde, 0xe8, 0x03, 0x70, 0x49, 0xe4, 0xc6, 0x2b, 0x63, 0xc0, 0x07, 0x31, 0x3b, 0x33,
-	0xa5, 0x0b, 0xc2, 0x16, 0xbc, 0x63, 0x4e, 0xcf, 0x77, 0xce, 0xf7, 0x7d, 0x7b, 0xce, 0x01, 0x4c,
-	0x9b, 0x1d, 0x0b, 0xdb, 0x1e, 0xa1, 0xf6, 0x4e, 0xe7, 0x03, 0x3a, 0x79, 0x20, 0x4c, 0xea, 0x0d,
-	0xb4, 0xd5, 0xc6, 0x6e, 0x47, 0x73, 0x5c, 0xca, 0x28, 0x1c, 0x0b, 0x26, 0x6a, 0x27, 0x0f, 0xcd,
-	0x4f, 0xcc, 0xa4, 0x9b, 0xb4, 0x49, 0x79, 0x1e, 0xf2, 0xff, 0x12, 0x90, 0xcc, 0x78, 0x93, 0xd2,
-	0x66, 0x0b, 0x23, 0xc3, 0x21, 0xc8, 0xb0, 0x6d, 0xca, 0x0c, 0x46, 0xa8, 0xed, 0xc9, 0x5f, 0x67,
-	0x1a, 0xd4, 0xb3, 0xa8, 0x87, 0xea, 0x86, 0x87, 0x45, 0x27, 0xb4, 0x5d, 0xa8, 0x63, 0x66, 0x14,
-	0x90, 0x63, 0x34, 0x89, 0xcd, 0x93, 0x65, 0x6e, 0x3e, 0x8c, 0xa5, 0x63, 0xb8, 0x86, 0xd5, 0xad,
-	0xaa, 0x85, 0x65, 0x9a, 0xd8, 0x32, 0x6c, 0x73, 0x83, 0xba, 0x26, 0x76, 0xbb, 0x2c, 0x2e, 0xc8,
-	0x6f, 0x50, 0xcb, 0xa2, 0x36, 0xf2, 0x98, 0xc1, 0xda, 0xdd, 0xda, 0xc5, 0xf0, 0x5c, 0x97, 0xb6,
-	0x5a, 0x86, 0xe3, 0x6c, 0x38, 0x46, 0xe3, 0x3d, 0x66, 0x02, 0x93, 0x4b, 0x03, 0xf8, 0xd2, 0xd7,
-	0xb6, 0xca, 0x49, 0xea, 0x78, 0xab, 0x8d, 0x3d, 0x96, 0x7b, 0x03, 0x6e, 0x9e, 0x8a, 0x7a, 0x0e,
-	0xb5, 0x3d, 0x0c, 0x17, 0x40, 0x42, 0x88, 0x51, 0x95, 0x09, 0x25, 0x3f, 0x58, 0x9c, 0xd4, 0x42,
-	0x4c, 0xd7, 0x04, 0xb8, 0x14, 0xdb, 0xff, 0x75, 0x3b, 0xa2, 0x4b, 0x60, 0xee, 0x3e, 0xc8, 0xf0,
-	0xca, 0x8b, 0x98, 0x95, 0xb9, 0xda, 0x17, 0xbe, 0x58, 0xd9, 0x17, 0x8e, 0x80, 0x28, 0x31, 0x79,
-	0xf1, 0xa4, 0x1e, 0x25, 0x66, 0xee, 0x70, 0x00, 0x4c, 0xf0, 0xf4, 0x40, 0xae, 0x57, 0xea, 0xbc,
-	0xe2, 0xaa, 0xbb, 0xa0, 0x79, 0x90, 0x10, 0x36, 0x70, 0xe0, 0x48, 0x71, 0xea, 0x22, 0x56, 0xc2,
-	0x07, 0x4d, 0xa2, 0x25, 0x08, 0x56, 0x40, 0x8c, 0x75, 0x1c, 0xac, 0x46, 0x39, 0xb8, 0xd0, 0x07,
-	0xac, 0x0b, 0x13, 0x57, 0x85, 0x87, 0xaf, 0x3b, 0x0e, 0xd6, 0x39, 0x1c, 0xde, 0x02, 0xa0, 0x6b,
-	0x30, 0x31, 0xd5, 0x01, 0x2e, 0x21, 0x29, 0x23, 0x35, 0x13, 0xa6, 0x41, 0xbc, 0x45, 0x2c, 0xc2,
-	0xd4, 0xd8, 0x84, 0x92, 0x8f, 0xeb, 0xe2, 0x01, 0xd7, 0xc1, 0x8d, 0xcd, 0x76, 0x6b, 0x93, 0xb4,
-	0x5a, 0x16, 0xb6, 0xd9, 0x86, 0xcf, 0x08, 0xab, 0x71, 0x4e, 0xe4, 0x41, 0xa8, 0xb7, 0xd5, 0x1e,
-	0xca, 0x97, 0x83, 0xf5, 0xd4, 0xe6, 0x99, 0x08, 0x1c, 0x07, 0x49, 0x19, 0xc3, 0xae, 0x9a, 0x10,
-	0x7c, 0x4e, 0x02, 0x3e, 0x1f, 0x13, 0xdb, 0xd4, 0x52, 0xaf, 0xf1, 0x5f, 0xc4, 0xc3, 0xc7, 0xb8,
-	0xb8, 0x41, 0x1c, 0x82, 0x6d, 0xa6, 0x5e, 0x97, 0x1a, 0xba, 0x01, 0x58, 0x05, 0xa0, 0x37, 0xf9,
-	0x6a, 0x92, 0x8f, 0xc0, 0x5d, 0x4d, 0xac, 0x89, 0xe6, 0xaf, 0x89, 0x26, 0x16, 0x52, 0xae, 0x89,
-	0xb6, 0x6a, 0x34, 0xb1, 0xfc, 0x48, 0x7a, 0x00, 0x99, 0x7b, 0x07, 0xc6, 0xce, 0x9d, 0x01, 0x39,
-	0x65, 0x4b, 0x60, 0x28, 0xb8, 0x08, 0x72, 0xd6, 0xf2, 0xa1, 0x7e, 0x04, 0xeb, 0x0c, 0x9a, 0xbd,
-	0x47, 0xee, 0x9b, 0x02, 0xee, 0x84, 0x4c, 0x90, 0x6c, 0xf9, 0x1c, 0x0c, 0x07, 0x5b, 0xfa, 0x93,
-	0x34, 0x70, 0xa5, 0x9e, 0x43, 0x81, 0x9e, 0x1e, 0x5c, 0x3c, 0x65, 0x54, 0x94, 0xf3, 0x9f, 0xee,
-	0x6b, 0x94, 0xe0, 0x12, 0x74, 0x6a, 0x66, 0x01, 0xa4, 0xce, 0x7e, 0x69, 0x38, 0x0c, 0x92, 0x6b,
-	0x2b, 0xe5, 0x4a, 0xb5, 0xb6, 0x52, 0x29, 0xa7, 0x22, 0xfe, 0xb3, 0xba, 0xb6, 0x5c, 0xad, 0x2d,
-	0x2f, 0x57, 0xca, 0x29, 0x05, 0x8e, 0x82, 0xc1, 0xb5, 0x95, 0x5e, 0x20, 0x5a, 0xfc, 0x1a, 0x03,
-	0x71, 0x6e, 0x00, 0xfc, 0xa2, 0x80, 0x84, 0xd8, 0x49, 0x88, 0x42, 0x85, 0xfd, 0x7b, 0x10, 0x32,
-	0xb3, 0x97, 0x07, 0x08, 0x19, 0xb9, 0x7b, 0x1f, 0x0f, 0xff, 0x7c, 0x8e, 0x4e, 0xc1, 0x49, 0xd4,
-	0xff, 0x36, 0xc2, 0xef, 0x0a, 0x18, 0x0d, 0xd8, 0x59, 0xea, 0xd4, 0x4c, 0xf8, 0xb8, 0x7f, 0xcb,
-	0x73, 0x8f, 0x48, 0xe6, 0xc9, 0xd5, 0x81, 0x92, 0xf3, 0x23, 0xce, 0x79, 0x16, 0x6a, 0xe8, 0xb2,
-	0x57, 0x1a, 0xed, 0x12, 0x73, 0x0f, 0xfe, 0x50, 0x40, 0xfa, 0xbc, 0xf9, 0x82, 0xf3, 0xfd, 0xa9,
-	0x84, 0x5c, 0xb6, 0xcc, 0xb3, 0xff, 0x85, 0x4b, 0x3d, 0x4f, 0xb9, 0x9e, 0x87, 0x70, 0xee, 0xd2,
-	0x7a, 0x3c, 0xb4, 0x2b, 0xce, 0xe2, 0x5e, 0x69, 0x69, 0xff, 0x28, 0xab, 0x1c, 0x1c, 0x65, 0x95,
-	0xdf, 0x47, 0x59, 0xe5, 0xd3, 0x71, 0x36, 0x72, 0x70, 0x9c, 0x8d, 0xfc, 0x3c, 0xce, 0x46, 0xd6,
-	0x0b, 0x4d, 0xc2, 0xde, 0xb6, 0xeb, 0xfe, 0x49, 0xbc, 0xa8, 0xf0, 0xf6, 0x1c, 0xda, 0x11, 0xd5,
-	0xfd, 0xe3, 0xe8, 0xd5, 0x13, 0xfc, 0xbf, 0xcd, 0xdc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf8,
-	0xa6, 0xf2, 0x65, 0xcf, 0x07, 0x00, 0x00,
+func (m *QueryVaultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Demand Order by id.
-	DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error)
-	// Queries a list of demand orders by status.
-	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
+func (m *QueryVaultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVaultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryVaultRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVaultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error) {
-	out := new(QueryGetDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrderById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryVaultRequest proto.InternalMessageInfo
 
-func (c *queryClient) DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error) {
-	out := new(QueryDemandOrdersByStatusResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVaultRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Demand Order by id.
-	DemandOrderById(context.Context, *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error)
-	// Queries a list of demand orders by status.
-	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
+// QueryVaultResponse is the response type for the Query/Vault RPC method.
+type QueryVaultResponse struct {
+	Vault Vault `protobuf:"bytes,1,opt,name=vault,proto3" json:"vault"`
+	// liquidity is the amount available to fulfill orders and to withdraw
+	Liquidity types1.Coin `protobuf:"bytes,2,opt,name=liquidity,proto3" json:"liquidity"`
+	// shares is the total supply of the vault shares
+	Shares types1.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVaultResponse) Reset()         { *m = QueryVaultResponse{} }
+func (m *QueryVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultResponse) ProtoMessage()    {}
+func (*QueryVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DemandOrderById(ctx context.Context, req *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemandOrderById not implemented")
+func (m *QueryVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DemandOrdersByStatus(ctx context.Context, req *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DemandOrdersByStatus not implemented")
+func (m *QueryVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryVaultResponse proto.InternalMessageInfo
+
+func (m *QueryVaultResponse) GetVault() Vault {
+	if m != nil {
+		return m.Vault
 	}
-	return interceptor(ctx, in, info, handler)
+	return Vault{}
 }
 
-func _Query_DemandOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDemandOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DemandOrderById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrderById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DemandOrderById(ctx, req.(*QueryGetDemandOrderRequest))
+func (m *QueryVaultResponse) GetLiquidity() types1.Coin {
+	if m != nil {
+		return m.Liquidity
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-func _Query_DemandOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDemandOrdersByStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DemandOrdersByStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DemandOrdersByStatus(ctx, req.(*QueryDemandOrdersByStatusRequest))
+func (m *QueryVaultResponse) GetShares() types1.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return interceptor(ctx, in, info, handler)
+	return types1.Coin{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DemandOrderById",
-			Handler:    _Query_DemandOrderById_Handler,
-		},
-		{
-			MethodName: "DemandOrdersByStatus",
-			Handler:    _Query_DemandOrdersByStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/query.proto",
+// QueryVaultsRequest is the request type for the Query/Vaults RPC method.
+type QueryVaultsRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVaultsRequest) Reset()         { *m = QueryVaultsRequest{} }
+func (m *QueryVaultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultsRequest) ProtoMessage()    {}
+func (*QueryVaultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{8}
+}
+func (m *QueryVaultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVaultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryVaultsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryVaultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryVaultsRequest proto.InternalMessageInfo
+
+// QueryVaultsResponse is the response type for the Query/Vaults RPC method.
+type QueryVaultsResponse struct {
+	Vaults []Vault `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVaultsResponse) Reset()         { *m = QueryVaultsResponse{} }
+func (m *QueryVaultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVaultsResponse) ProtoMessage()    {}
+func (*QueryVaultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{9}
+}
+func (m *QueryVaultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVaultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVaultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVaultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVaultsResponse.Merge(m, src)
+}
+func (m *QueryVaultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVaultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVaultsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDemandOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVaultsResponse proto.InternalMessageInfo
+
+func (m *QueryVaultsResponse) GetVaults() []Vault {
+	if m != nil {
+		return m.Vaults
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQuoteDemandOrderRequest is the request type for the Query/QuoteDemandOrder RPC method.
+type QueryQuoteDemandOrderRequest struct {
+	// rollapp_id of the rollapp the transfer is from, or to for a failed transfer
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// type of the packet: ON_RECV for a transfer from the rollapp, ON_TIMEOUT or ON_ACK for a
+	// timed out or failed transfer to the rollapp
+	Type types.RollappPacket_Type `protobuf:"varint,2,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
+	// amount of the transfer
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// denom of the transfer, as in the packet data
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// sender of the transfer
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver of the transfer
+	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// memo of the transfer
+	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
-func (m *QueryGetDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryQuoteDemandOrderRequest) Reset()         { *m = QueryQuoteDemandOrderRequest{} }
+func (m *QueryQuoteDemandOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteDemandOrderRequest) ProtoMessage()    {}
+func (*QueryQuoteDemandOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{10}
+}
+func (m *QueryQuoteDemandOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteDemandOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteDemandOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQuoteDemandOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteDemandOrderRequest.Merge(m, src)
+}
+func (m *QueryQuoteDemandOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteDemandOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteDemandOrderRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQuoteDemandOrderRequest proto.InternalMessageInfo
+
+func (m *QueryQuoteDemandOrderRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQuoteDemandOrderRequest) GetType() types.RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.RollappPacket_ON_RECV
 }
 
-func (m *QueryDemandOrdersByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Fulfiller) > 0 {
-		i -= len(m.Fulfiller)
-		copy(dAtA[i:], m.Fulfiller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.FulfillmentState != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryQuoteDemandOrderRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuoteDemandOrderRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryQuoteDemandOrderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *QueryGetDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		{
-			size, err := m.DemandOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryQuoteDemandOrderRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQuoteDemandOrderRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDemandOrdersByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryQuoteDemandOrderResponse is the response type for the Query/QuoteDemandOrder RPC method.
+type QueryQuoteDemandOrderResponse struct {
+	// creates_order is whether a demand order would be created for the transfer
+	CreatesOrder bool `protobuf:"varint,1,opt,name=creates_order,json=createsOrder,proto3" json:"creates_order,omitempty"`
+	// price is the amount the recipient gets when the order is fulfilled
+	Price types1.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+	// fee is the eIBC fee the fulfiller gets
+	Fee types1.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// bridging_fee is the bridging fee taken by the hub
+	BridgingFee types1.Coin `protobuf:"bytes,4,opt,name=bridging_fee,json=bridgingFee,proto3" json:"bridging_fee"`
+	// fee_curve is the fee curve of the order, if any
+	FeeCurve *FeeCurve `protobuf:"bytes,5,opt,name=fee_curve,json=feeCurve,proto3" json:"fee_curve,omitempty"`
 }
 
-func (m *QueryDemandOrdersByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DemandOrders) > 0 {
-		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryQuoteDemandOrderResponse) Reset()         { *m = QueryQuoteDemandOrderResponse{} }
+func (m *QueryQuoteDemandOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQuoteDemandOrderResponse) ProtoMessage()    {}
+func (*QueryQuoteDemandOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{11}
+}
+func (m *QueryQuoteDemandOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQuoteDemandOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQuoteDemandOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQuoteDemandOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQuoteDemandOrderResponse.Merge(m, src)
+}
+func (m *QueryQuoteDemandOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQuoteDemandOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQuoteDemandOrderResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryQuoteDemandOrderResponse proto.InternalMessageInfo
+
+func (m *QueryQuoteDemandOrderResponse) GetCreatesOrder() bool {
+	if m != nil {
+		return m.CreatesOrder
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return false
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryQuoteDemandOrderResponse) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
 	}
-	var l int
-	_ = l
-	return n
+	return types1.Coin{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryQuoteDemandOrderResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return types1.Coin{}
 }
 
-func (m *QueryGetDemandOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryQuoteDemandOrderResponse) GetBridgingFee() types1.Coin {
+	if m != nil {
+		return m.BridgingFee
 	}
-	return n
+	return types1.Coin{}
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+func (m *QueryQuoteDemandOrderResponse) GetFeeCurve() *FeeCurve {
+	if m != nil {
+		return m.FeeCurve
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryFulfillerStatsRequest is the request type for the Query/FulfillerStats RPC method.
+type QueryFulfillerStatsRequest struct {
+	// fulfiller is the bech32-encoded address of the fulfiller
+	Fulfiller string `protobuf:"bytes,1,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// optional rollapp_id
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryFulfillerStatsRequest) Reset()         { *m = QueryFulfillerStatsRequest{} }
+func (m *QueryFulfillerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerStatsRequest) ProtoMessage()    {}
+func (*QueryFulfillerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{12}
+}
+func (m *QueryFulfillerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+}
+func (m *QueryFulfillerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerStatsRequest.Merge(m, src)
+}
+func (m *QueryFulfillerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryFulfillerStatsRequest) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
 	}
-	if m.FulfillmentState != 0 {
-		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	return ""
+}
+
+func (m *QueryFulfillerStatsRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryFulfillerStatsResponse is the response type for the Query/FulfillerStats RPC method.
+type QueryFulfillerStatsResponse struct {
+	// stats are the stats of the fulfiller per rollapp and denom
+	Stats []FulfillerStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryFulfillerStatsResponse) Reset()         { *m = QueryFulfillerStatsResponse{} }
+func (m *QueryFulfillerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillerStatsResponse) ProtoMessage()    {}
+func (*QueryFulfillerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{13}
+}
+func (m *QueryFulfillerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFulfillerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillerStatsResponse.Merge(m, src)
+}
+func (m *QueryFulfillerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryFulfillerStatsResponse) GetStats() []FulfillerStats {
+	if m != nil {
+		return m.Stats
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryRollappFeesRequest is the request type for the Query/RollappFees RPC method.
+type QueryRollappFeesRequest struct {
+	// rollapp_id of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryRollappFeesRequest) Reset()         { *m = QueryRollappFeesRequest{} }
+func (m *QueryRollappFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRollappFeesRequest) ProtoMessage()    {}
+func (*QueryRollappFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{14}
+}
+func (m *QueryRollappFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRollappFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRollappFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryRollappFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRollappFeesRequest.Merge(m, src)
+}
+func (m *QueryRollappFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRollappFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRollappFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRollappFeesRequest proto.InternalMessageInfo
+
+func (m *QueryRollappFeesRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryRollappFeesResponse is the response type for the Query/RollappFees RPC method.
+type QueryRollappFeesResponse struct {
+	// timeout_fee is the fee applied to the demand orders of timed out packets of the rollapp
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_fee"`
+	// errack_fee is the fee applied to the demand orders of packets of the rollapp with error acknowledgements
+	ErrackFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=errack_fee,json=errackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"errack_fee"`
+	// rollapp_fees are the fees set by the rollapp owner, if any
+	RollappFees *RollappFees `protobuf:"bytes,3,opt,name=rollapp_fees,json=rollappFees,proto3" json:"rollapp_fees,omitempty"`
+}
+
+func (m *QueryRollappFeesResponse) Reset()         { *m = QueryRollappFeesResponse{} }
+func (m *QueryRollappFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRollappFeesResponse) ProtoMessage()    {}
+func (*QueryRollappFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{15}
+}
+func (m *QueryRollappFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRollappFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRollappFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		l = m.DemandOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryRollappFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRollappFeesResponse.Merge(m, src)
+}
+func (m *QueryRollappFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRollappFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRollappFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRollappFeesResponse proto.InternalMessageInfo
+
+func (m *QueryRollappFeesResponse) GetRollappFees() *RollappFees {
+	if m != nil {
+		return m.RollappFees
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.eibc.QueryParamsResponse")
+	proto.RegisterType((*QueryGetDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderRequest")
+	proto.RegisterType((*QueryDemandOrdersByStatusRequest)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusRequest")
+	proto.RegisterType((*QueryGetDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryGetDemandOrderResponse")
+	proto.RegisterType((*QueryDemandOrdersByStatusResponse)(nil), "dymensionxyz.dymension.eibc.QueryDemandOrdersByStatusResponse")
+	proto.RegisterType((*QueryVaultRequest)(nil), "dymensionxyz.dymension.eibc.QueryVaultRequest")
+	proto.RegisterType((*QueryVaultResponse)(nil), "dymensionxyz.dymension.eibc.QueryVaultResponse")
+	proto.RegisterType((*QueryVaultsRequest)(nil), "dymensionxyz.dymension.eibc.QueryVaultsRequest")
+	proto.RegisterType((*QueryVaultsResponse)(nil), "dymensionxyz.dymension.eibc.QueryVaultsResponse")
+	proto.RegisterType((*QueryQuoteDemandOrderRequest)(nil), "dymensionxyz.dymension.eibc.QueryQuoteDemandOrderRequest")
+	proto.RegisterType((*QueryQuoteDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.QueryQuoteDemandOrderResponse")
+	proto.RegisterType((*QueryFulfillerStatsRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerStatsRequest")
+	proto.RegisterType((*QueryFulfillerStatsResponse)(nil), "dymensionxyz.dymension.eibc.QueryFulfillerStatsResponse")
+	proto.RegisterType((*QueryRollappFeesRequest)(nil), "dymensionxyz.dymension.eibc.QueryRollappFeesRequest")
+	proto.RegisterType((*QueryRollappFeesResponse)(nil), "dymensionxyz.dymension.eibc.QueryRollappFeesResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/query.proto", fileDescriptor_d85bfe71ceb5f8dc)
+}
+
+var fileDescriptor_d85bfe71ceb5f8dc = []byte{
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x73, 0xd3, 0xc6,
+	0x17, 0x8f, 0x9c, 0xd8, 0xdf, 0xf8, 0x39, 0x40, 0x58, 0x32, 0x7c, 0x55, 0x01, 0x86, 0x8a, 0x01,
+	0xd2, 0x00, 0x5a, 0x12, 0x0a, 0xa4, 0xb4, 0x64, 0x20, 0x38, 0x66, 0x32, 0xfc, 0x76, 0x4b, 0x7f,
+	0x70, 0xc9, 0xc8, 0xd2, 0xda, 0x6c, 0xb1, 0xb4, 0x42, 0x92, 0x33, 0xb8, 0x99, 0x5c, 0x7a, 0xe9,
+	0xb5, 0x33, 0xbd, 0xf4, 0xd0, 0xbf, 0xa3, 0x87, 0xb6, 0xd7, 0x4e, 0x39, 0x74, 0xa6, 0x4c, 0xb9,
+	0x74, 0x7a, 0x60, 0x3a, 0xd0, 0xbf, 0xa2, 0xa7, 0x8e, 0x76, 0x57, 0xb6, 0x6c, 0x1c, 0x59, 0xce,
+	0xf4, 0x84, 0x77, 0xf3, 0x3e, 0xef, 0x7d, 0xde, 0xdb, 0xcf, 0xbe, 0x7d, 0x02, 0x4e, 0xd9, 0x1d,
+	0x87, 0xb8, 0x01, 0x65, 0xee, 0xd3, 0xce, 0x17, 0xb8, 0xbb, 0xc0, 0x84, 0xd6, 0x2d, 0xfc, 0xa4,
+	0x4d, 0xfc, 0x8e, 0xe1, 0xf9, 0x2c, 0x64, 0xe8, 0x50, 0xd2, 0xd0, 0xe8, 0x2e, 0x8c, 0xc8, 0x50,
+	0x9b, 0x6b, 0xb2, 0x26, 0xe3, 0x76, 0x38, 0xfa, 0x25, 0x20, 0xda, 0xe1, 0x26, 0x63, 0xcd, 0x16,
+	0xc1, 0xa6, 0x47, 0xb1, 0xe9, 0xba, 0x2c, 0x34, 0x43, 0xca, 0xdc, 0x40, 0xfe, 0x75, 0xc1, 0x62,
+	0x81, 0xc3, 0x02, 0x5c, 0x37, 0x03, 0x22, 0x22, 0xe1, 0xcd, 0xc5, 0x3a, 0x09, 0xcd, 0x45, 0xec,
+	0x99, 0x4d, 0xea, 0x72, 0x63, 0x69, 0x3b, 0x9f, 0xc6, 0xd2, 0x33, 0x7d, 0xd3, 0x89, 0xbd, 0x1a,
+	0x69, 0x96, 0x36, 0x71, 0x4c, 0xd7, 0xde, 0x60, 0xbe, 0x4d, 0x7c, 0x69, 0x9f, 0x9a, 0xff, 0xa6,
+	0xd9, 0x6e, 0x85, 0xd2, 0x70, 0x31, 0xcd, 0xb0, 0xd1, 0x6e, 0x35, 0x68, 0xab, 0x45, 0xfc, 0x8d,
+	0x20, 0x34, 0xc3, 0x4c, 0x5c, 0x7c, 0xd6, 0x6a, 0x99, 0x9e, 0xb7, 0xd1, 0x20, 0x24, 0xb6, 0x2f,
+	0x27, 0x2b, 0x12, 0xd7, 0xc2, 0x62, 0x34, 0xae, 0xc2, 0xc2, 0x0e, 0xfe, 0x2c, 0xe6, 0x38, 0xcc,
+	0xc5, 0x51, 0xe8, 0x76, 0xec, 0x6b, 0x29, 0xdd, 0x36, 0x8e, 0xee, 0x99, 0xd6, 0x63, 0x22, 0x53,
+	0xd4, 0xe7, 0x00, 0xdd, 0x8f, 0xce, 0xe1, 0x1e, 0x2f, 0x68, 0x8d, 0x3c, 0x69, 0x93, 0x20, 0xd4,
+	0x3f, 0x85, 0x03, 0x7d, 0xbb, 0x81, 0xc7, 0xdc, 0x80, 0xa0, 0x6b, 0x50, 0x10, 0x85, 0x57, 0x95,
+	0x63, 0xca, 0x7c, 0x69, 0xe9, 0xb8, 0x91, 0x22, 0x10, 0x43, 0x80, 0x57, 0xa7, 0x9e, 0xbd, 0x3c,
+	0x3a, 0x51, 0x93, 0x40, 0xfd, 0x0c, 0x68, 0xdc, 0xf3, 0x0d, 0x12, 0x56, 0xf8, 0xc9, 0xdc, 0x8d,
+	0x0e, 0x46, 0xc6, 0x45, 0x7b, 0x21, 0x47, 0x6d, 0xee, 0xbc, 0x58, 0xcb, 0x51, 0x5b, 0x7f, 0x31,
+	0x09, 0xc7, 0xb8, 0x79, 0xc2, 0x36, 0x58, 0xed, 0x7c, 0xc8, 0xb3, 0x8e, 0x41, 0x57, 0xa0, 0x20,
+	0xca, 0xc0, 0x81, 0x7b, 0x97, 0x4e, 0xec, 0xc4, 0x4a, 0xd4, 0xc1, 0x90, 0x68, 0x09, 0x42, 0x6b,
+	0x30, 0x15, 0x76, 0x3c, 0xa2, 0xe6, 0x38, 0x78, 0x71, 0x04, 0xb8, 0x26, 0x8a, 0x78, 0x4f, 0xd4,
+	0xf0, 0xa3, 0x8e, 0x47, 0x6a, 0x1c, 0x8e, 0x8e, 0x00, 0xc4, 0x05, 0xa6, 0xb6, 0x3a, 0xc9, 0x53,
+	0x28, 0xca, 0x9d, 0x75, 0x1b, 0xcd, 0x41, 0xbe, 0x45, 0x1d, 0x1a, 0xaa, 0x53, 0xc7, 0x94, 0xf9,
+	0x7c, 0x4d, 0x2c, 0xd0, 0x43, 0xd8, 0x2f, 0x65, 0xe4, 0x10, 0x37, 0xe4, 0x42, 0x22, 0x6a, 0x9e,
+	0x13, 0x39, 0x9b, 0x5a, 0xdb, 0x6a, 0x0f, 0x15, 0xa5, 0x43, 0x6a, 0xb3, 0x8d, 0x81, 0x1d, 0x74,
+	0x18, 0x8a, 0x5d, 0x89, 0xaa, 0x05, 0xc1, 0xa7, 0xbb, 0x11, 0xf1, 0xb1, 0x89, 0xcb, 0x1c, 0xf5,
+	0x7f, 0xfc, 0x2f, 0x62, 0x11, 0x61, 0x7c, 0x62, 0x51, 0x8f, 0x12, 0x37, 0x54, 0xa7, 0x65, 0x0e,
+	0xf1, 0x06, 0xaa, 0x02, 0xf4, 0x6e, 0xa9, 0x5a, 0xe4, 0x12, 0x38, 0x69, 0x08, 0x01, 0x1b, 0x91,
+	0x80, 0x0d, 0xd1, 0x3c, 0xa4, 0x8c, 0x8d, 0x7b, 0x66, 0x93, 0xc8, 0x43, 0xaa, 0x25, 0x90, 0xfa,
+	0xe7, 0x70, 0x68, 0xa8, 0x06, 0xa4, 0xca, 0x6e, 0xc2, 0x4c, 0xf2, 0xd2, 0x4a, 0xad, 0xcd, 0xa7,
+	0xd6, 0x23, 0xe9, 0xa7, 0x64, 0xf7, 0x16, 0xfa, 0x8f, 0x0a, 0xbc, 0x9d, 0xa2, 0x20, 0x19, 0xf2,
+	0x36, 0xec, 0x49, 0x86, 0x8c, 0x94, 0x34, 0x39, 0x56, 0xcc, 0x99, 0x44, 0xcc, 0x00, 0xdd, 0xe8,
+	0x2b, 0x54, 0x8e, 0xf3, 0x3f, 0x35, 0xb2, 0x50, 0x82, 0x4b, 0x5f, 0xa5, 0xde, 0x81, 0xfd, 0x9c,
+	0xfc, 0xc7, 0x51, 0x53, 0x8a, 0xf5, 0xde, 0x3d, 0x3a, 0x25, 0x71, 0x74, 0xfa, 0xaf, 0x0a, 0xa0,
+	0xa4, 0xad, 0xcc, 0x6c, 0x05, 0xf2, 0xbc, 0xa3, 0xc9, 0x2a, 0xea, 0xa9, 0x19, 0x71, 0xa8, 0xbc,
+	0xb0, 0x02, 0x86, 0xae, 0x40, 0xb1, 0x45, 0x9f, 0xb4, 0xa9, 0x4d, 0xc3, 0x8e, 0xcc, 0xe4, 0xad,
+	0xbe, 0x4c, 0xe2, 0x1c, 0xae, 0x33, 0xea, 0x4a, 0x68, 0x0f, 0x81, 0x2e, 0x41, 0x21, 0x78, 0x64,
+	0xfa, 0x24, 0x50, 0x27, 0xb3, 0x61, 0xa5, 0x79, 0xb7, 0x2f, 0x71, 0x4a, 0xdd, 0xbe, 0xf4, 0x09,
+	0x1c, 0xe8, 0xdb, 0x95, 0x49, 0x5e, 0x85, 0x02, 0x67, 0x1b, 0x9f, 0x5b, 0xf6, 0x2c, 0x25, 0x4e,
+	0xff, 0x47, 0x81, 0xc3, 0xdc, 0xf3, 0xfd, 0x36, 0x0b, 0xc9, 0x90, 0xce, 0xd4, 0x7f, 0xbd, 0x95,
+	0xc1, 0xeb, 0xfd, 0x1f, 0x35, 0x91, 0x83, 0x50, 0x30, 0x1d, 0xd6, 0x76, 0x43, 0xd9, 0x40, 0xe4,
+	0xaa, 0x77, 0xe4, 0x53, 0xc9, 0xdb, 0x7a, 0x10, 0x0a, 0x01, 0x71, 0xa3, 0x2b, 0x92, 0x17, 0xd6,
+	0x62, 0x85, 0x34, 0x98, 0xf6, 0x89, 0x45, 0xe8, 0x66, 0xf7, 0xe2, 0x77, 0xd7, 0x08, 0xc1, 0x94,
+	0x43, 0x1c, 0x26, 0xaf, 0x3d, 0xff, 0xad, 0x7f, 0x9f, 0x83, 0x23, 0x3b, 0x24, 0x2f, 0x0b, 0x7c,
+	0x1c, 0xf6, 0x58, 0x3e, 0x31, 0x43, 0x12, 0x24, 0xee, 0xe4, 0x74, 0x6d, 0x46, 0x6e, 0x72, 0x63,
+	0x74, 0x01, 0xf2, 0x9e, 0x4f, 0x2d, 0x92, 0x55, 0x26, 0xc2, 0x1a, 0x2d, 0xc2, 0x64, 0x83, 0x90,
+	0xac, 0xfa, 0x88, 0x6c, 0xd1, 0x2a, 0xcc, 0xd4, 0x7d, 0x6a, 0x37, 0xa9, 0xdb, 0x8c, 0xde, 0x52,
+	0x75, 0x2a, 0x1b, 0xb6, 0x14, 0x83, 0xaa, 0xdc, 0x47, 0xb1, 0x41, 0xc8, 0x86, 0xd5, 0xf6, 0x37,
+	0x45, 0xcb, 0x2d, 0x2d, 0x9d, 0x48, 0x95, 0x4d, 0x95, 0x90, 0xeb, 0x91, 0x71, 0x6d, 0xba, 0x21,
+	0x7f, 0xe9, 0x9f, 0xc9, 0xc7, 0xac, 0x1a, 0xb7, 0xd5, 0xa8, 0xad, 0x74, 0xdf, 0xa5, 0xbe, 0x06,
+	0xac, 0x0c, 0x36, 0xe0, 0x7e, 0x41, 0xe5, 0x06, 0x04, 0xa5, 0x37, 0xe0, 0xd0, 0x50, 0xd7, 0xf2,
+	0x40, 0x6e, 0x40, 0x9e, 0x4f, 0x1d, 0x52, 0xf0, 0xa7, 0xb3, 0x3c, 0x16, 0xd2, 0x47, 0x5c, 0x7d,
+	0x8e, 0xd7, 0x97, 0xe1, 0xff, 0x3c, 0x8e, 0x94, 0x64, 0x95, 0x90, 0x20, 0x9b, 0xe4, 0xf5, 0xaf,
+	0x72, 0xa0, 0xbe, 0x09, 0x95, 0xfc, 0xee, 0x42, 0x29, 0xa4, 0x0e, 0x61, 0xed, 0x90, 0x1f, 0x10,
+	0x07, 0xaf, 0x1a, 0x51, 0xe0, 0x3f, 0x5f, 0x1e, 0x3d, 0xd9, 0xa4, 0xe1, 0xa3, 0x76, 0x3d, 0xba,
+	0x0d, 0x58, 0x8e, 0x3f, 0xe2, 0x9f, 0xb3, 0x81, 0xfd, 0x18, 0x47, 0x17, 0x21, 0x30, 0x2a, 0xc4,
+	0xaa, 0x81, 0x74, 0x11, 0x1d, 0xd7, 0x6d, 0x00, 0xe2, 0xfb, 0xa6, 0xf5, 0x98, 0xfb, 0xcb, 0xed,
+	0xca, 0x5f, 0x51, 0x78, 0x88, 0xdc, 0xdd, 0x84, 0x99, 0xe4, 0x30, 0x26, 0xd5, 0x97, 0xde, 0xef,
+	0x93, 0x79, 0x96, 0xfc, 0xde, 0x62, 0xe1, 0x1a, 0xcc, 0x0e, 0xbe, 0xc7, 0x68, 0x0f, 0x14, 0x1f,
+	0xdc, 0xa9, 0xac, 0x55, 0xd7, 0xef, 0xac, 0x55, 0x66, 0x27, 0xa2, 0x65, 0xf5, 0xc1, 0xad, 0xea,
+	0xfa, 0xad, 0x5b, 0x6b, 0x95, 0x59, 0x05, 0xed, 0x83, 0xd2, 0x83, 0x3b, 0xbd, 0x8d, 0xdc, 0xd2,
+	0x2f, 0x25, 0xc8, 0xf3, 0x62, 0xa2, 0x6f, 0x15, 0x28, 0x88, 0xc9, 0x09, 0xe1, 0x54, 0x3a, 0x6f,
+	0x8e, 0x6d, 0xda, 0xb9, 0xec, 0x00, 0x71, 0x4e, 0xfa, 0xe9, 0x2f, 0x5f, 0xfc, 0xfd, 0x4d, 0xee,
+	0x04, 0x3a, 0x8e, 0x47, 0x4f, 0xdb, 0xe8, 0x27, 0x05, 0xf6, 0x25, 0xba, 0xc3, 0x6a, 0x67, 0xdd,
+	0x46, 0x97, 0x46, 0x87, 0x1c, 0x3a, 0xea, 0x69, 0xcb, 0xe3, 0x03, 0x25, 0xe7, 0x8b, 0x9c, 0xf3,
+	0x39, 0x64, 0xe0, 0xac, 0x73, 0x3f, 0xde, 0xa2, 0xf6, 0x36, 0xfa, 0x5d, 0x81, 0xb9, 0x61, 0x53,
+	0x00, 0xba, 0x32, 0x9a, 0x4a, 0xca, 0xfc, 0xa9, 0xad, 0xec, 0x16, 0x2e, 0xf3, 0x79, 0x9f, 0xe7,
+	0x73, 0x01, 0x9d, 0xcf, 0x9c, 0x4f, 0x80, 0xb7, 0xc4, 0xf0, 0xba, 0x8d, 0xbe, 0x53, 0x20, 0xcf,
+	0x1f, 0x34, 0x64, 0x8c, 0xa6, 0x91, 0x1c, 0x23, 0x34, 0x9c, 0xd9, 0x5e, 0xf2, 0x5c, 0xe2, 0x3c,
+	0xcf, 0xa0, 0x05, 0x3c, 0xf2, 0xfb, 0x09, 0x6f, 0xf1, 0x17, 0x6a, 0x9b, 0xab, 0x99, 0x7b, 0xc9,
+	0xa4, 0xe6, 0xbe, 0xc7, 0x5e, 0x3b, 0x97, 0x1d, 0x30, 0x96, 0x9a, 0xc5, 0x93, 0x8f, 0x7e, 0x56,
+	0x60, 0x6f, 0x7f, 0x67, 0xcc, 0x22, 0xe6, 0xa1, 0xad, 0x5e, 0x5b, 0x1e, 0x1f, 0x28, 0x29, 0x5f,
+	0xe5, 0x94, 0x2f, 0xa3, 0x65, 0x3c, 0xc6, 0xb7, 0x26, 0xde, 0xea, 0x6e, 0x6c, 0xa3, 0x1f, 0x14,
+	0x28, 0x25, 0x5a, 0x13, 0x7a, 0x77, 0x34, 0x97, 0x37, 0x9b, 0xbd, 0x76, 0x61, 0x4c, 0x94, 0xa4,
+	0xbf, 0xc2, 0xe9, 0x2f, 0xa3, 0x8b, 0x38, 0xeb, 0x77, 0x2f, 0xde, 0xea, 0x3d, 0x2a, 0xdb, 0xe8,
+	0x37, 0x05, 0x66, 0x07, 0xa7, 0x0e, 0xf4, 0xde, 0x68, 0x2e, 0x3b, 0x8c, 0x69, 0xda, 0xe5, 0xdd,
+	0x40, 0x65, 0x2e, 0x15, 0x9e, 0xcb, 0x0a, 0xfa, 0x00, 0xa7, 0xff, 0xff, 0x08, 0x0b, 0xc9, 0x46,
+	0x7f, 0x77, 0x49, 0x64, 0xb4, 0x7a, 0xf3, 0xd9, 0xab, 0xb2, 0xf2, 0xfc, 0x55, 0x59, 0xf9, 0xeb,
+	0x55, 0x59, 0xf9, 0xfa, 0x75, 0x79, 0xe2, 0xf9, 0xeb, 0xf2, 0xc4, 0x1f, 0xaf, 0xcb, 0x13, 0x0f,
+	0x17, 0x13, 0xcf, 0xd4, 0x0e, 0x11, 0x36, 0xcf, 0xe3, 0xa7, 0x22, 0x0c, 0x7f, 0xb5, 0xea, 0x05,
+	0xfe, 0x91, 0x7e, 0xfe, 0xdf, 0x01, 0x00, 0x5f, 0xc5, 0x47, 0xe7, 0xb2, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Demand Order by id.
+	DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error)
+	// Queries a list of demand orders by status.
+	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
+	// Queries the vault of a denom.
+	Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error)
+	// Queries all the vaults.
+	Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error)
+	// Queries the stats of a fulfiller, per rollapp and denom.
+	FulfillerStats(ctx context.Context, in *QueryFulfillerStatsRequest, opts ...grpc.CallOption) (*QueryFulfillerStatsResponse, error)
+	// Queries the timeout and errack fees of a rollapp.
+	RollappFees(ctx context.Context, in *QueryRollappFeesRequest, opts ...grpc.CallOption) (*QueryRollappFeesResponse, error)
+	// Queries the demand order that would be created for a transfer, without creating it.
+	QuoteDemandOrder(ctx context.Context, in *QueryQuoteDemandOrderRequest, opts ...grpc.CallOption) (*QueryQuoteDemandOrderResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.DemandOrders) > 0 {
-		for _, e := range m.DemandOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DemandOrderById(ctx context.Context, in *QueryGetDemandOrderRequest, opts ...grpc.CallOption) (*QueryGetDemandOrderResponse, error) {
+	out := new(QueryGetDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrderById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error) {
+	out := new(QueryDemandOrdersByStatusResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vault(ctx context.Context, in *QueryVaultRequest, opts ...grpc.CallOption) (*QueryVaultResponse, error) {
+	out := new(QueryVaultResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Vault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Vaults(ctx context.Context, in *QueryVaultsRequest, opts ...grpc.CallOption) (*QueryVaultsResponse, error) {
+	out := new(QueryVaultsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/Vaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FulfillerStats(ctx context.Context, in *QueryFulfillerStatsRequest, opts ...grpc.CallOption) (*QueryFulfillerStatsResponse, error) {
+	out := new(QueryFulfillerStatsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RollappFees(ctx context.Context, in *QueryRollappFeesRequest, opts ...grpc.CallOption) (*QueryRollappFeesResponse, error) {
+	out := new(QueryRollappFeesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/RollappFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteDemandOrder(ctx context.Context, in *QueryQuoteDemandOrderRequest, opts ...grpc.CallOption) (*QueryQuoteDemandOrderResponse, error) {
+	out := new(QueryQuoteDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/QuoteDemandOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Demand Order by id.
+	DemandOrderById(context.Context, *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error)
+	// Queries a list of demand orders by status.
+	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
+	// Queries the vault of a denom.
+	Vault(context.Context, *QueryVaultRequest) (*QueryVaultResponse, error)
+	// Queries all the vaults.
+	Vaults(context.Context, *QueryVaultsRequest) (*QueryVaultsResponse, error)
+	// Queries the stats of a fulfiller, per rollapp and denom.
+	FulfillerStats(context.Context, *QueryFulfillerStatsRequest) (*QueryFulfillerStatsResponse, error)
+	// Queries the timeout and errack fees of a rollapp.
+	RollappFees(context.Context, *QueryRollappFeesRequest) (*QueryRollappFeesResponse, error)
+	// Queries the demand order that would be created for a transfer, without creating it.
+	QuoteDemandOrder(context.Context, *QueryQuoteDemandOrderRequest) (*QueryQuoteDemandOrderResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrderById(ctx context.Context, req *QueryGetDemandOrderRequest) (*QueryGetDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrderById not implemented")
+}
+func (*UnimplementedQueryServer) DemandOrdersByStatus(ctx context.Context, req *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DemandOrdersByStatus not implemented")
+}
+func (*UnimplementedQueryServer) Vault(ctx context.Context, req *QueryVaultRequest) (*QueryVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vault not implemented")
+}
+func (*UnimplementedQueryServer) Vaults(ctx context.Context, req *QueryVaultsRequest) (*QueryVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vaults not implemented")
+}
+func (*UnimplementedQueryServer) FulfillerStats(ctx context.Context, req *QueryFulfillerStatsRequest) (*QueryFulfillerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillerStats not implemented")
+}
+func (*UnimplementedQueryServer) RollappFees(ctx context.Context, req *QueryRollappFeesRequest) (*QueryRollappFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollappFees not implemented")
+}
+func (*UnimplementedQueryServer) QuoteDemandOrder(ctx context.Context, req *QueryQuoteDemandOrderRequest) (*QueryQuoteDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteDemandOrder not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DemandOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDemandOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrderById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrderById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrderById(ctx, req.(*QueryGetDemandOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DemandOrdersByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDemandOrdersByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DemandOrdersByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/DemandOrdersByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DemandOrdersByStatus(ctx, req.(*QueryDemandOrdersByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/Vault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vault(ctx, req.(*QueryVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Vaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/Vaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vaults(ctx, req.(*QueryVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FulfillerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillerStats(ctx, req.(*QueryFulfillerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RollappFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRollappFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RollappFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/RollappFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RollappFees(ctx, req.(*QueryRollappFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteDemandOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteDemandOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/QuoteDemandOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteDemandOrder(ctx, req.(*QueryQuoteDemandOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DemandOrderById",
+			Handler:    _Query_DemandOrderById_Handler,
+		},
+		{
+			MethodName: "DemandOrdersByStatus",
+			Handler:    _Query_DemandOrdersByStatus_Handler,
+		},
+		{
+			MethodName: "Vault",
+			Handler:    _Query_Vault_Handler,
+		},
+		{
+			MethodName: "Vaults",
+			Handler:    _Query_Vaults_Handler,
+		},
+		{
+			MethodName: "FulfillerStats",
+			Handler:    _Query_FulfillerStats_Handler,
+		},
+		{
+			MethodName: "RollappFees",
+			Handler:    _Query_RollappFees_Handler,
+		},
+		{
+			MethodName: "QuoteDemandOrder",
+			Handler:    _Query_QuoteDemandOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDemandOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FulfillmentState != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FulfillmentState))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		{
+			size, err := m.DemandOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDemandOrdersByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDemandOrdersByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DemandOrders) > 0 {
+		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Liquidity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVaultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVaultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVaultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteDemandOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteDemandOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteDemandOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQuoteDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQuoteDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQuoteDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeCurve != nil {
+		{
+			size, err := m.FeeCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.BridgingFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CreatesOrder {
+		i--
+		if m.CreatesOrder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RollappFees != nil {
+		{
+			size, err := m.RollappFees.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.ErrackFee.Size()
+		i -= size
+		if _, err := m.ErrackFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TimeoutFee.Size()
+		i -= size
+		if _, err := m.TimeoutFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDemandOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.FulfillmentState != 0 {
+		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		l = m.DemandOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vault.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVaultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVaultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryQuoteDemandOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQuoteDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatesOrder {
+		n += 2
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BridgingFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FeeCurve != nil {
+		l = m.FeeCurve.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRollappFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimeoutFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ErrackFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RollappFees != nil {
+		l = m.RollappFees.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			}
+			m.FulfillmentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DemandOrder == nil {
+				m.DemandOrder = &DemandOrder{}
+			}
+			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1028,10 +3400,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1055,7 +3427,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVaultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +3450,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVaultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVaultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1113,7 +3485,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vaults = append(m.Vaults, Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1138,7 +3511,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteDemandOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1161,15 +3534,15 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteDemandOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1197,7 +3570,186 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1220,7 +3772,7 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQuoteDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1243,17 +3795,17 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQuoteDemandOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQuoteDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatesOrder", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1263,35 +3815,17 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CreatesOrder = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1301,29 +3835,30 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1333,16 +3868,30 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.FulfillmentState = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgingFee", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1352,16 +3901,30 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgingFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCurve", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1371,27 +3934,81 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			if m.FeeCurve == nil {
+				m.FeeCurve = &FeeCurve{}
+			}
+			if err := m.FeeCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFulfillerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFulfillerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFulfillerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1419,11 +4036,11 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1451,11 +4068,61 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFulfillerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFulfillerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFulfillerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1482,10 +4149,8 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, FulfillerStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1510,7 +4175,7 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRollappFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1533,17 +4198,17 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRollappFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRollappFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1553,27 +4218,23 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DemandOrder == nil {
-				m.DemandOrder = &DemandOrder{}
-			}
-			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1596,7 +4257,7 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRollappFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1619,17 +4280,17 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRollappFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRollappFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1639,29 +4300,63 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
-			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimeoutFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrackFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1688,10 +4383,10 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.RollappFees == nil {
+				m.RollappFees = &RollappFees{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollappFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewRollappFees(rollappID string, timeoutFee, errAckFee sdk.Dec) RollappFees {
+	return RollappFees{
+		RollappId:  rollappID,
+		TimeoutFee: timeoutFee,
+		ErrackFee:  errAckFee,
+	}
+}
+
+func (f RollappFees) ValidateBasic() error {
+	if f.RollappId == "" {
+		return errors.New("empty rollapp id")
+	}
+	if err := validateTimeoutFee(f.TimeoutFee); err != nil {
+		return fmt.Errorf("timeout fee: %w", err)
+	}
+	if err := validateErrAckFee(f.ErrackFee); err != nil {
+		return fmt.Errorf("error acknowledgement fee: %w", err)
+	}
+	return nil
+}
+
+// ValidateCaps checks the fees are within the floors and the caps of the params.
+func (f RollappFees) ValidateCaps(params Params) error {
+	if f.TimeoutFee.LT(params.MinRollappTimeoutFee) {
+		return fmt.Errorf("%w: timeout fee below floor: %s < %s", ErrFeeTooLow, f.TimeoutFee, params.MinRollappTimeoutFee)
+	}
+	if f.ErrackFee.LT(params.MinRollappErrackFee) {
+		return fmt.Errorf("%w: error acknowledgement fee below floor: %s < %s", ErrFeeTooLow, f.ErrackFee, params.MinRollappErrackFee)
+	}
+	if f.TimeoutFee.GT(params.MaxRollappTimeoutFee) {
+		return fmt.Errorf("%w: timeout fee above cap: %s > %s", ErrFeeTooHigh, f.TimeoutFee, params.MaxRollappTimeoutFee)
+	}
+	if f.ErrackFee.GT(params.MaxRollappErrackFee) {
+		return fmt.Errorf("%w: error acknowledgement fee above cap: %s > %s", ErrFeeTooHigh, f.ErrackFee, params.MaxRollappErrackFee)
+	}
+	return nil
+}
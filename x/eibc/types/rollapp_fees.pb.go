@@ -0,0 +1,424 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/rollapp_fees.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RollappFees are the timeout and errack fees of the demand orders of a rollapp, set by the rollapp owner.
+// They override the global fees of the params, capped by the params.
+type RollappFees struct {
+	// rollapp_id is the rollapp of the fees
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// timeout_fee is the fee of the demand orders of timed out packets, relative to the packet amount
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_fee"`
+	// errack_fee is the fee of the demand orders of packets with error acknowledgements, relative to the
+	// packet amount
+	ErrackFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"errack_fee"`
+}
+
+func (m *RollappFees) Reset()         { *m = RollappFees{} }
+func (m *RollappFees) String() string { return proto.CompactTextString(m) }
+func (*RollappFees) ProtoMessage()    {}
+func (*RollappFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_780caa2e1d7b5dfa, []int{0}
+}
+func (m *RollappFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappFees.Merge(m, src)
+}
+func (m *RollappFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappFees proto.InternalMessageInfo
+
+func (m *RollappFees) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RollappFees)(nil), "dymensionxyz.dymension.eibc.RollappFees")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/rollapp_fees.proto", fileDescriptor_780caa2e1d7b5dfa)
+}
+
+var fileDescriptor_780caa2e1d7b5dfa = []byte{
+	// 253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
+	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
+	0xf5, 0x8b, 0xf2, 0x73, 0x72, 0x12, 0x0b, 0x0a, 0xe2, 0xd3, 0x52, 0x53, 0x8b, 0xf5, 0x0a, 0x8a,
+	0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x81, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
+	0xa7, 0xe7, 0x83, 0xd5, 0xe9, 0x83, 0x58, 0x10, 0x2d, 0x4a, 0x67, 0x18, 0xb9, 0xb8, 0x83, 0x20,
+	0x26, 0xb9, 0xa5, 0xa6, 0x16, 0x0b, 0xc9, 0x72, 0x71, 0xc1, 0x0c, 0xce, 0x4c, 0x91, 0x60, 0x54,
+	0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x84, 0x8a, 0x78, 0xa6, 0x08, 0xf9, 0x73, 0x71, 0x97, 0x64, 0xe6,
+	0xa6, 0xe6, 0x97, 0x96, 0x80, 0xec, 0x95, 0x60, 0x02, 0xc9, 0x3b, 0xe9, 0x9d, 0xb8, 0x27, 0xcf,
+	0x70, 0xeb, 0x9e, 0xbc, 0x5a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e,
+	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x31, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xa9, 0x2c, 0x48,
+	0x2d, 0xd6, 0x73, 0x49, 0x4d, 0x0e, 0xe2, 0x82, 0x1a, 0xe1, 0x96, 0x9a, 0x2a, 0xe4, 0xcb, 0xc5,
+	0x95, 0x5a, 0x54, 0x94, 0x98, 0x9c, 0x0d, 0x36, 0x8f, 0x99, 0x2c, 0xf3, 0x38, 0x21, 0x26, 0xb8,
+	0xa5, 0xa6, 0x3a, 0x79, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
+	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x21,
+	0x92, 0x61, 0x38, 0x82, 0xb5, 0xcc, 0x58, 0xbf, 0x02, 0x12, 0xb6, 0x60, 0xb3, 0x93, 0xd8, 0xc0,
+	0x41, 0x64, 0x0c, 0x18, 0x00, 0x4e, 0xed, 0xcc, 0xe1, 0x87, 0x01, 0x00, 0x00,
+}
+
+func (m *RollappFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ErrackFee.Size()
+		i -= size
+		if _, err := m.ErrackFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRollappFees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TimeoutFee.Size()
+		i -= size
+		if _, err := m.TimeoutFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRollappFees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRollappFees(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRollappFees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRollappFees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RollappFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRollappFees(uint64(l))
+	}
+	l = m.TimeoutFee.Size()
+	n += 1 + l + sovRollappFees(uint64(l))
+	l = m.ErrackFee.Size()
+	n += 1 + l + sovRollappFees(uint64(l))
+	return n
+}
+
+func sovRollappFees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRollappFees(x uint64) (n int) {
+	return sovRollappFees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RollappFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollappFees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrackFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappFees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollappFees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollappFees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRollappFees(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRollappFees
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRollappFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRollappFees
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRollappFees
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRollappFees
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRollappFees
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRollappFees        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRollappFees          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRollappFees = fmt.Errorf("proto: unexpected end of group")
+)
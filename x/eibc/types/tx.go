@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
@@ -30,6 +31,13 @@ func NewMsgFulfillOrder(fulfillerAddress, orderId, expectedFee string) *MsgFulfi
 	}
 }
 
+// NewMsgFulfillOrderPartially creates a message filling a slice of the order price.
+func NewMsgFulfillOrderPartially(fulfillerAddress, orderId, expectedFee, fillPrice string) *MsgFulfillOrder {
+	msg := NewMsgFulfillOrder(fulfillerAddress, orderId, expectedFee)
+	msg.FillPrice = fillPrice
+	return msg
+}
+
 func (msg *MsgFulfillOrder) Route() string {
 	return RouterKey
 }
@@ -56,9 +64,26 @@ func (msg *MsgFulfillOrder) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error()) // TODO: join
 	}
+	if msg.FillPrice != "" {
+		if _, err := msg.FillPriceInt(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 	return nil
 }
 
+// FillPriceInt returns the price to fill. Zero means the remaining price of the order.
+func (msg *MsgFulfillOrder) FillPriceInt() (math.Int, error) {
+	if msg.FillPrice == "" {
+		return math.ZeroInt(), nil
+	}
+	price, ok := math.NewIntFromString(msg.FillPrice)
+	if !ok || !price.IsPositive() {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidFillPrice, "%s", msg.FillPrice)
+	}
+	return price, nil
+}
+
 func (msg *MsgFulfillOrder) GetFulfillerBech32Address() []byte {
 	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
 }
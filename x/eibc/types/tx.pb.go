@@ -40,6 +40,9 @@ type MsgFulfillOrder struct {
 	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	// expected_fee is the nominal fee set in the order. Fulfiller will generally make less profit (after deducting bridge fee)
 	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// fill_price is the part of the order price to fill, for a partial fulfillment. The fulfiller gets a
+	// pro-rata share of the fee. Empty fills the remaining price.
+	FillPrice string `protobuf:"bytes,4,opt,name=fill_price,json=fillPrice,proto3" json:"fill_price,omitempty"`
 }
 
 func (m *MsgFulfillOrder) Reset()         { *m = MsgFulfillOrder{} }
@@ -96,6 +99,13 @@ func (m *MsgFulfillOrder) GetExpectedFee() string {
 	return ""
 }
 
+func (m *MsgFulfillOrder) GetFillPrice() string {
+	if m != nil {
+		return m.FillPrice
+	}
+	return ""
+}
+
 // MsgFulfillOrderResponse defines the FulfillOrder response type.
 type MsgFulfillOrderResponse struct {
 }
@@ -390,606 +400,2816 @@ func (m *MsgUpdateDemandOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateDemandOrderResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
-	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
-	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
-	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
-	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
-	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+// MsgSetVault creates the vault of a denom, or updates its criteria.
+type MsgSetVault struct {
+	// authority is the address that controls the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom of the vault.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// criteria are the conditions a demand order has to meet to be fulfilled by the vault.
+	Criteria VaultCriteria `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+func (m *MsgSetVault) Reset()         { *m = MsgSetVault{} }
+func (m *MsgSetVault) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVault) ProtoMessage()    {}
+func (*MsgSetVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{6}
 }
-
-var fileDescriptor_47537f11f512b254 = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x58, 0x28, 0x30, 0x60, 0x80, 0xb1, 0x81, 0xb6, 0xca, 0x02, 0xc5, 0x43, 0x23, 0xba,
-	0x4b, 0xc1, 0x98, 0xc8, 0xc1, 0x04, 0x24, 0x24, 0xc4, 0x10, 0x4d, 0x8d, 0x1e, 0xbc, 0x34, 0xdb,
-	0x9d, 0xc7, 0xb2, 0xba, 0xbb, 0xb3, 0xd9, 0x99, 0x96, 0xc2, 0xc9, 0xc8, 0x17, 0x30, 0xc6, 0xf8,
-	0x21, 0x3c, 0xf9, 0x31, 0x38, 0x72, 0xf4, 0x24, 0x06, 0x0e, 0x7e, 0x0d, 0x33, 0xfb, 0xaf, 0xdb,
-	0x16, 0x90, 0x7a, 0xda, 0x7d, 0x7f, 0x7e, 0xef, 0xfd, 0xe6, 0xbd, 0xf9, 0xed, 0xe2, 0xfb, 0xf4,
-	0xd0, 0x01, 0x97, 0x5b, 0xcc, 0x6d, 0x1f, 0x1e, 0x69, 0x89, 0xa1, 0x81, 0xd5, 0x30, 0x34, 0xd1,
-	0x56, 0x3d, 0x9f, 0x09, 0x46, 0xee, 0xa6, 0xb3, 0xd4, 0xc4, 0x50, 0x65, 0x56, 0x69, 0xd6, 0x60,
-	0xdc, 0x61, 0x5c, 0x73, 0xb8, 0xa9, 0xb5, 0xaa, 0xf2, 0x11, 0xa2, 0x4a, 0xc5, 0x30, 0x50, 0x0f,
-	0x2c, 0x2d, 0x34, 0xa2, 0x50, 0xde, 0x64, 0x26, 0x0b, 0xfd, 0xf2, 0x2d, 0xf2, 0x2a, 0x51, 0xa5,
-	0x86, 0xce, 0x41, 0x6b, 0x55, 0x1b, 0x20, 0xf4, 0xaa, 0x66, 0x30, 0xcb, 0x0d, 0xe3, 0xe5, 0xaf,
-	0x08, 0x4f, 0xee, 0x72, 0x73, 0xbb, 0x69, 0xef, 0x59, 0xb6, 0xfd, 0xd2, 0xa7, 0xe0, 0x93, 0x65,
-	0x3c, 0xbd, 0x17, 0xda, 0xe0, 0xd7, 0x75, 0x4a, 0x7d, 0xe0, 0xbc, 0x80, 0x16, 0x50, 0x65, 0xac,
-	0x36, 0x95, 0x04, 0x36, 0x42, 0x3f, 0x29, 0xe2, 0x51, 0x26, 0x51, 0x75, 0x8b, 0x16, 0x6e, 0x05,
-	0x39, 0x23, 0x81, 0xbd, 0x43, 0xc9, 0x22, 0x9e, 0x80, 0xb6, 0x07, 0x86, 0x00, 0x5a, 0xdf, 0x03,
-	0x28, 0x64, 0x83, 0xf0, 0x78, 0xec, 0xdb, 0x06, 0x58, 0x9f, 0xf9, 0xf4, 0xe7, 0xc7, 0x83, 0xfe,
-	0x6e, 0xe5, 0x22, 0x9e, 0xed, 0x61, 0x55, 0x03, 0xee, 0x31, 0x97, 0x43, 0xf9, 0x6c, 0x08, 0x17,
-	0x7b, 0x62, 0x1b, 0x4d, 0xb1, 0xcf, 0x7c, 0xeb, 0x08, 0x68, 0x17, 0x1d, 0xd4, 0x4d, 0x67, 0x0e,
-	0x63, 0x9f, 0xd9, 0xb6, 0xee, 0x79, 0x1d, 0xae, 0x63, 0x91, 0x67, 0x87, 0x12, 0x1d, 0x0f, 0x7b,
-	0xbe, 0x65, 0x48, 0x9a, 0xd9, 0xca, 0xf8, 0x6a, 0x51, 0x8d, 0xa6, 0x2b, 0x27, 0xa7, 0x46, 0x93,
-	0x53, 0x9f, 0x33, 0xcb, 0xdd, 0x5c, 0x39, 0xf9, 0x35, 0x9f, 0xf9, 0x7e, 0x36, 0x5f, 0x31, 0x2d,
-	0xb1, 0xdf, 0x6c, 0xa8, 0x06, 0x73, 0xa2, 0x55, 0x44, 0x8f, 0x47, 0x9c, 0x7e, 0xd0, 0xc4, 0xa1,
-	0x07, 0x3c, 0x00, 0xf0, 0x5a, 0x58, 0x99, 0xbc, 0xc7, 0x39, 0xdd, 0x61, 0x4d, 0x57, 0x14, 0x86,
-	0x16, 0x50, 0x65, 0x7c, 0x75, 0xee, 0xd2, 0x1e, 0x3b, 0xae, 0x78, 0x25, 0x77, 0xb3, 0xb9, 0x16,
-	0xf5, 0x59, 0xbe, 0x41, 0x9f, 0x18, 0x54, 0x8b, 0x3a, 0xc8, 0xd3, 0xda, 0x5e, 0xb2, 0xbd, 0xe1,
-	0xf0, 0xb4, 0xb6, 0x17, 0xaf, 0x6d, 0x05, 0xe7, 0x99, 0x07, 0xbe, 0x2e, 0x98, 0x2f, 0x77, 0x93,
-	0x24, 0xe6, 0x82, 0x44, 0x12, 0xc7, 0xb6, 0x01, 0x62, 0x44, 0xef, 0x36, 0x47, 0xfa, 0xb6, 0x49,
-	0x3e, 0x22, 0x4c, 0xba, 0xaa, 0xf2, 0x7d, 0xdd, 0x87, 0xc2, 0xe8, 0x35, 0x87, 0xdd, 0x02, 0x63,
-	0xf0, 0xc3, 0xc6, 0xa0, 0xda, 0x54, 0x8a, 0xe7, 0x6b, 0xd9, 0x8b, 0x54, 0x71, 0x9e, 0x83, 0x10,
-	0x36, 0x38, 0xe0, 0x8a, 0x7a, 0x4b, 0xb7, 0x2d, 0xaa, 0x0b, 0xa0, 0x85, 0xb1, 0x05, 0x54, 0x19,
-	0xad, 0xdd, 0xe9, 0xc4, 0xde, 0xc6, 0xa1, 0xf5, 0x49, 0x79, 0x07, 0x53, 0xc3, 0x2a, 0x2f, 0xe1,
-	0xc5, 0x2b, 0x2f, 0x58, 0x72, 0x0d, 0x8f, 0x11, 0xce, 0xef, 0x72, 0xf3, 0x8d, 0x27, 0x8b, 0x6c,
-	0x81, 0xa3, 0xbb, 0x34, 0x54, 0xcf, 0x12, 0xbe, 0xcd, 0x0e, 0xdc, 0x3e, 0xe5, 0x4c, 0x04, 0xce,
-	0x1b, 0xa8, 0x66, 0x16, 0x8f, 0xb8, 0x70, 0x90, 0x12, 0x4c, 0xce, 0x85, 0x03, 0xa9, 0x15, 0x22,
-	0x79, 0x76, 0xd7, 0x2e, 0x2b, 0xf8, 0xde, 0x65, 0x24, 0x62, 0x96, 0xab, 0xdf, 0xb2, 0x38, 0xbb,
-	0xcb, 0x4d, 0x22, 0xf0, 0x44, 0x97, 0xc4, 0x1f, 0xaa, 0xd7, 0x7c, 0x7e, 0xd4, 0x9e, 0xd3, 0x97,
-	0x1e, 0x0f, 0x92, 0x9d, 0x4c, 0x28, 0x43, 0xbe, 0x20, 0x3c, 0x73, 0x85, 0x4e, 0x9f, 0x0c, 0x52,
-	0xb2, 0x83, 0x2b, 0x3d, 0xfb, 0x3f, 0x5c, 0x8a, 0xd4, 0x31, 0xc2, 0xd3, 0xfd, 0x5b, 0xab, 0xfe,
-	0xab, 0x6e, 0x1f, 0xa4, 0xf4, 0x74, 0x60, 0x48, 0x87, 0xc5, 0xe6, 0x8b, 0x93, 0x73, 0x05, 0x9d,
-	0x9e, 0x2b, 0xe8, 0xf7, 0xb9, 0x82, 0x3e, 0x5f, 0x28, 0x99, 0xd3, 0x0b, 0x25, 0xf3, 0xf3, 0x42,
-	0xc9, 0xbc, 0xab, 0xa6, 0x04, 0x70, 0xc5, 0x9f, 0xa4, 0xb5, 0xa6, 0xb5, 0xa3, 0xdf, 0x89, 0xd4,
-	0x43, 0x23, 0x17, 0x7c, 0xcb, 0xd7, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x90, 0xe0, 0x20,
-	0x7a, 0x06, 0x00, 0x00,
+func (m *MsgSetVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
-	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
-	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+func (m *MsgSetVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVault.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVault.DiscardUnknown(m)
 }
 
-func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
-	out := new(MsgFulfillOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetVault proto.InternalMessageInfo
+
+func (m *MsgSetVault) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
-	out := new(MsgFulfillOrderAuthorizedResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetVault) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
-	out := new(MsgUpdateDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetVault) GetCriteria() VaultCriteria {
+	if m != nil {
+		return m.Criteria
 	}
-	return out, nil
+	return VaultCriteria{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
-	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
-	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+type MsgSetVaultResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetVaultResponse) Reset()         { *m = MsgSetVaultResponse{} }
+func (m *MsgSetVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVaultResponse) ProtoMessage()    {}
+func (*MsgSetVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{7}
 }
-
-func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+func (m *MsgSetVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
+func (m *MsgSetVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+func (m *MsgSetVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVaultResponse.Merge(m, src)
+}
+func (m *MsgSetVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVaultResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetVaultResponse proto.InternalMessageInfo
+
+// MsgDepositToVault deposits liquidity into the vault of its denom in exchange for vault shares.
+type MsgDepositToVault struct {
+	// depositor is the bech32-encoded address of the depositor.
+	Depositor string `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the liquidity to deposit.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
+func (m *MsgDepositToVault) Reset()         { *m = MsgDepositToVault{} }
+func (m *MsgDepositToVault) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToVault) ProtoMessage()    {}
+func (*MsgDepositToVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{8}
+}
+func (m *MsgDepositToVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositToVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositToVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToVault.Merge(m, src)
+}
+func (m *MsgDepositToVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToVault.DiscardUnknown(m)
 }
 
-func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrderAuthorized)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrderAuthorized(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrderAuthorized(ctx, req.(*MsgFulfillOrderAuthorized))
+var xxx_messageInfo_MsgDepositToVault proto.InternalMessageInfo
+
+func (m *MsgDepositToVault) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDemandOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+func (m *MsgDepositToVault) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FulfillOrder",
-			Handler:    _Msg_FulfillOrder_Handler,
-		},
-		{
-			MethodName: "FulfillOrderAuthorized",
-			Handler:    _Msg_FulfillOrderAuthorized_Handler,
-		},
-		{
-			MethodName: "UpdateDemandOrder",
-			Handler:    _Msg_UpdateDemandOrder_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+type MsgDepositToVaultResponse struct {
+	// shares are the vault shares minted for the deposit.
+	Shares types.Coin `protobuf:"bytes,1,opt,name=shares,proto3" json:"shares"`
 }
 
-func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgDepositToVaultResponse) Reset()         { *m = MsgDepositToVaultResponse{} }
+func (m *MsgDepositToVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositToVaultResponse) ProtoMessage()    {}
+func (*MsgDepositToVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{9}
 }
-
-func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositToVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FulfillerAddress) > 0 {
-		i -= len(m.FulfillerAddress)
-		copy(dAtA[i:], m.FulfillerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgDepositToVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositToVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDepositToVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositToVaultResponse.Merge(m, src)
+}
+func (m *MsgDepositToVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositToVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositToVaultResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositToVaultResponse proto.InternalMessageInfo
+
+func (m *MsgDepositToVaultResponse) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawFromVault burns vault shares in exchange for their value in the vault denom.
+type MsgWithdrawFromVault struct {
+	// withdrawer is the bech32-encoded address of the withdrawer.
+	Withdrawer string `protobuf:"bytes,1,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// shares are the vault shares to burn.
+	Shares types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
 }
 
-func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawFromVault) Reset()         { *m = MsgWithdrawFromVault{} }
+func (m *MsgWithdrawFromVault) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromVault) ProtoMessage()    {}
+func (*MsgWithdrawFromVault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{10}
 }
-
-func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFromVault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromVault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromVault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWithdrawFromVault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromVault.Merge(m, src)
+}
+func (m *MsgWithdrawFromVault) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromVault) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromVault.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWithdrawFromVault proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromVault) GetWithdrawer() string {
+	if m != nil {
+		return m.Withdrawer
+	}
+	return ""
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementValidated {
-		i--
-		if m.SettlementValidated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+func (m *MsgWithdrawFromVault) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	{
-		size, err := m.OperatorFeeShare.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+type MsgWithdrawFromVaultResponse struct {
+	// amount is the liquidity withdrawn for the shares.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawFromVaultResponse) Reset()         { *m = MsgWithdrawFromVaultResponse{} }
+func (m *MsgWithdrawFromVaultResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFromVaultResponse) ProtoMessage()    {}
+func (*MsgWithdrawFromVaultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{11}
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFromVaultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OperatorFeeAddress) > 0 {
-		i -= len(m.OperatorFeeAddress)
-		copy(dAtA[i:], m.OperatorFeeAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFromVaultResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFromVaultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFromVaultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFromVaultResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawFromVaultResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+// MsgSetRollappFees sets the timeout and errack fees of the demand orders of a rollapp, overriding the
+// global fees. The fees are capped by the params.
+type MsgSetRollappFees struct {
+	// owner is the bech32-encoded address of the rollapp owner.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// rollapp_id is the rollapp of the fees.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// timeout_fee is the fee of the demand orders of timed out packets.
+	TimeoutFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"timeout_fee"`
+	// errack_fee is the fee of the demand orders of packets with error acknowledgements.
+	ErrackFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=errack_fee,json=errackFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"errack_fee"`
+}
+
+func (m *MsgSetRollappFees) Reset()         { *m = MsgSetRollappFees{} }
+func (m *MsgSetRollappFees) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappFees) ProtoMessage()    {}
+func (*MsgSetRollappFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{12}
+}
+func (m *MsgSetRollappFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Price) > 0 {
-		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetRollappFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappFees.Merge(m, src)
+}
+func (m *MsgSetRollappFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappFees.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrderAuthorizedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetRollappFees proto.InternalMessageInfo
+
+func (m *MsgSetRollappFees) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFulfillOrderAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRollappFees) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func (m *MsgFulfillOrderAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSetRollappFeesResponse defines the SetRollappFees response type.
+type MsgSetRollappFeesResponse struct {
 }
 
-func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRollappFeesResponse) Reset()         { *m = MsgSetRollappFeesResponse{} }
+func (m *MsgSetRollappFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRollappFeesResponse) ProtoMessage()    {}
+func (*MsgSetRollappFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{13}
+}
+func (m *MsgSetRollappFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRollappFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRollappFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetRollappFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRollappFeesResponse.Merge(m, src)
+}
+func (m *MsgSetRollappFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRollappFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRollappFeesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRollappFeesResponse proto.InternalMessageInfo
+
+// MsgPayFills pays the fills of a partially filled demand order whose payment failed on finalization of
+// the underlying packet. Anyone can send it.
+type MsgPayFills struct {
+	// signer is the bech32-encoded address of the account paying for the transaction.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgPayFills) Reset()         { *m = MsgPayFills{} }
+func (m *MsgPayFills) String() string { return proto.CompactTextString(m) }
+func (*MsgPayFills) ProtoMessage()    {}
+func (*MsgPayFills) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{14}
+}
+func (m *MsgPayFills) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayFills) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayFills.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPayFills) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayFills.Merge(m, src)
+}
+func (m *MsgPayFills) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayFills) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayFills.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPayFills proto.InternalMessageInfo
+
+func (m *MsgPayFills) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPayFills) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgPayFillsResponse defines the PayFills response type.
+type MsgPayFillsResponse struct {
 }
 
-func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPayFillsResponse) Reset()         { *m = MsgPayFillsResponse{} }
+func (m *MsgPayFillsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPayFillsResponse) ProtoMessage()    {}
+func (*MsgPayFillsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{15}
+}
+func (m *MsgPayFillsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPayFillsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPayFillsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPayFillsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPayFillsResponse.Merge(m, src)
+}
+func (m *MsgPayFillsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPayFillsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPayFillsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgPayFillsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
+	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
+	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
+	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
+	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
+	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+	proto.RegisterType((*MsgSetVault)(nil), "dymensionxyz.dymension.eibc.MsgSetVault")
+	proto.RegisterType((*MsgSetVaultResponse)(nil), "dymensionxyz.dymension.eibc.MsgSetVaultResponse")
+	proto.RegisterType((*MsgDepositToVault)(nil), "dymensionxyz.dymension.eibc.MsgDepositToVault")
+	proto.RegisterType((*MsgDepositToVaultResponse)(nil), "dymensionxyz.dymension.eibc.MsgDepositToVaultResponse")
+	proto.RegisterType((*MsgWithdrawFromVault)(nil), "dymensionxyz.dymension.eibc.MsgWithdrawFromVault")
+	proto.RegisterType((*MsgWithdrawFromVaultResponse)(nil), "dymensionxyz.dymension.eibc.MsgWithdrawFromVaultResponse")
+	proto.RegisterType((*MsgSetRollappFees)(nil), "dymensionxyz.dymension.eibc.MsgSetRollappFees")
+	proto.RegisterType((*MsgSetRollappFeesResponse)(nil), "dymensionxyz.dymension.eibc.MsgSetRollappFeesResponse")
+	proto.RegisterType((*MsgPayFills)(nil), "dymensionxyz.dymension.eibc.MsgPayFills")
+	proto.RegisterType((*MsgPayFillsResponse)(nil), "dymensionxyz.dymension.eibc.MsgPayFillsResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+}
+
+var fileDescriptor_47537f11f512b254 = []byte{
+	// 1093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xfd, 0xaf, 0x91, 0x9b, 0xc4, 0x8c, 0x6a, 0x4b, 0x8a, 0x2d, 0x3b, 0x4c, 0xd1, 0x1a,
+	0x49, 0x43, 0x59, 0x76, 0xe1, 0x22, 0x3e, 0x14, 0x88, 0x62, 0x08, 0x30, 0x5a, 0x21, 0x86, 0x9c,
+	0x26, 0x40, 0x2f, 0x02, 0x2d, 0x8e, 0x69, 0x36, 0x24, 0x97, 0xe0, 0xae, 0xfc, 0x77, 0x0a, 0x9a,
+	0x17, 0x28, 0xfa, 0x18, 0x3d, 0x15, 0x68, 0x2f, 0x7d, 0x83, 0x1c, 0x83, 0x9e, 0x8a, 0x1e, 0x92,
+	0xc2, 0x3e, 0xb4, 0x0f, 0xd0, 0x07, 0x28, 0x96, 0xbb, 0xfc, 0x91, 0x64, 0xeb, 0x27, 0x27, 0x7b,
+	0x67, 0xbf, 0xf9, 0xe6, 0x9b, 0x99, 0x9d, 0x11, 0x08, 0x9f, 0x98, 0x67, 0x2e, 0x7a, 0xd4, 0x26,
+	0xde, 0xe9, 0xd9, 0x79, 0x39, 0x3e, 0x94, 0xd1, 0x3e, 0x68, 0x95, 0xd9, 0xa9, 0xee, 0x07, 0x84,
+	0x11, 0xf5, 0x4e, 0x1a, 0xa5, 0xc7, 0x07, 0x9d, 0xa3, 0x8a, 0x8b, 0x2d, 0x42, 0x5d, 0x42, 0xcb,
+	0x2e, 0xb5, 0xca, 0xc7, 0x15, 0xfe, 0x47, 0x78, 0x15, 0x0b, 0xe2, 0xa2, 0x19, 0x9e, 0xca, 0xe2,
+	0x20, 0xaf, 0x72, 0x16, 0xb1, 0x88, 0xb0, 0xf3, 0xff, 0xa4, 0xb5, 0x24, 0x99, 0x0e, 0x0c, 0x8a,
+	0xe5, 0xe3, 0xca, 0x01, 0x32, 0xa3, 0x52, 0x6e, 0x11, 0xdb, 0x93, 0xf7, 0x9f, 0xf5, 0x13, 0x7b,
+	0x6c, 0xb4, 0x1d, 0x26, 0x80, 0xda, 0xaf, 0x0a, 0xdc, 0xac, 0x53, 0xab, 0xd6, 0x76, 0x0e, 0x6d,
+	0xc7, 0x79, 0x1a, 0x98, 0x18, 0xa8, 0x0f, 0x60, 0xfe, 0x50, 0x9c, 0x31, 0x68, 0x1a, 0xa6, 0x19,
+	0x20, 0xa5, 0x79, 0x65, 0x55, 0x59, 0xcb, 0x34, 0x6e, 0xc5, 0x17, 0x8f, 0x85, 0x5d, 0x2d, 0xc0,
+	0x2c, 0xe1, 0x5e, 0x4d, 0xdb, 0xcc, 0x8f, 0x87, 0x98, 0x99, 0xf0, 0xbc, 0x6b, 0xaa, 0x77, 0x61,
+	0x0e, 0x4f, 0x7d, 0x6c, 0x31, 0x34, 0x9b, 0x87, 0x88, 0xf9, 0x89, 0xf0, 0x3a, 0x1b, 0xd9, 0x6a,
+	0x88, 0xea, 0x32, 0x00, 0xa7, 0x6b, 0xfa, 0x81, 0xdd, 0xc2, 0xfc, 0x64, 0x08, 0xc8, 0x70, 0xcb,
+	0x1e, 0x37, 0x6c, 0x2f, 0xfc, 0xf0, 0xcf, 0x2f, 0xf7, 0x7b, 0xc5, 0x68, 0x05, 0x58, 0xec, 0x12,
+	0xdd, 0x40, 0xea, 0x13, 0x8f, 0xa2, 0xf6, 0x7e, 0x12, 0x0a, 0x5d, 0x77, 0x8f, 0xdb, 0xec, 0x88,
+	0x04, 0xf6, 0x39, 0x9a, 0x1d, 0x6a, 0x95, 0x4e, 0xb5, 0xcb, 0x00, 0x01, 0x71, 0x1c, 0xc3, 0xf7,
+	0x93, 0x54, 0x32, 0xd2, 0xb2, 0x6b, 0xaa, 0x06, 0x4c, 0x09, 0x91, 0x13, 0xab, 0x13, 0x6b, 0xd9,
+	0x8d, 0x82, 0x2e, 0xbb, 0xc4, 0x3b, 0xa0, 0xcb, 0x0e, 0xe8, 0x4f, 0x88, 0xed, 0x55, 0xd7, 0xdf,
+	0xbc, 0x5b, 0x19, 0xfb, 0xf9, 0xfd, 0xca, 0x9a, 0x65, 0xb3, 0xa3, 0xf6, 0x81, 0xde, 0x22, 0xae,
+	0x6c, 0xa9, 0xfc, 0xf3, 0x90, 0x9a, 0x2f, 0xcb, 0xec, 0xcc, 0x47, 0x1a, 0x3a, 0xd0, 0x86, 0x60,
+	0x56, 0xbf, 0x87, 0x69, 0xc3, 0x25, 0x6d, 0x8f, 0x85, 0x85, 0xc8, 0x6e, 0x2c, 0x5f, 0x19, 0x63,
+	0xd7, 0x63, 0x7b, 0xbc, 0x75, 0xd5, 0x4d, 0x19, 0xe7, 0xc1, 0x10, 0x71, 0x22, 0xa7, 0x86, 0x8c,
+	0xc0, 0xb3, 0x75, 0xfc, 0xb8, 0xb9, 0x53, 0x22, 0x5b, 0xc7, 0x8f, 0xba, 0xba, 0x0e, 0x39, 0xe2,
+	0x63, 0x60, 0x30, 0x12, 0xf0, 0xd6, 0xc5, 0xc0, 0xe9, 0x10, 0xa8, 0x46, 0x77, 0x35, 0xc4, 0xc8,
+	0xa3, 0xbb, 0xd9, 0x33, 0xbd, 0xcd, 0x7e, 0xa5, 0x80, 0xda, 0xc1, 0x4a, 0x8f, 0x8c, 0x00, 0xf3,
+	0xb3, 0x7d, 0x92, 0xdd, 0xc1, 0xd6, 0xe8, 0xc9, 0x46, 0x4e, 0x8d, 0x5b, 0x29, 0x9d, 0xfb, 0x3c,
+	0x96, 0x5a, 0x81, 0x1c, 0x45, 0xc6, 0x1c, 0x74, 0xd1, 0x63, 0xcd, 0x63, 0xc3, 0xb1, 0x4d, 0x83,
+	0xa1, 0x99, 0xcf, 0xac, 0x2a, 0x6b, 0xb3, 0x8d, 0xdb, 0xc9, 0xdd, 0xf3, 0xe8, 0x6a, 0xfb, 0x26,
+	0x7f, 0x83, 0xa9, 0x62, 0x69, 0xf7, 0xe0, 0xee, 0xb5, 0x0f, 0x2c, 0x7e, 0x86, 0xaf, 0x15, 0xc8,
+	0xd5, 0xa9, 0xf5, 0xad, 0xcf, 0x49, 0x76, 0xd0, 0x35, 0x3c, 0x53, 0x0c, 0xd7, 0x3d, 0xf8, 0x88,
+	0x9c, 0x78, 0x3d, 0x83, 0x35, 0x17, 0x1a, 0x87, 0x18, 0xaa, 0x45, 0x98, 0xf1, 0xf0, 0x24, 0x35,
+	0x4f, 0xd3, 0x1e, 0x9e, 0xd4, 0x10, 0xb7, 0x55, 0xae, 0xb3, 0x93, 0x5b, 0x2b, 0xc1, 0xd2, 0x55,
+	0x22, 0x62, 0x95, 0xbf, 0x2b, 0x90, 0xad, 0x53, 0x6b, 0x1f, 0xd9, 0x73, 0xbe, 0x13, 0xd4, 0x2d,
+	0xc8, 0x18, 0x22, 0x17, 0x76, 0x26, 0x84, 0x55, 0xf3, 0x7f, 0xfc, 0xf6, 0x30, 0x27, 0x5b, 0x23,
+	0xe5, 0xed, 0xb3, 0xc0, 0xf6, 0xac, 0x46, 0x02, 0x55, 0x73, 0x30, 0x65, 0xa2, 0x47, 0x5c, 0x29,
+	0x56, 0x1c, 0xd4, 0x6f, 0x60, 0xb6, 0x15, 0xd8, 0x0c, 0x03, 0xdb, 0x08, 0xb5, 0x66, 0x37, 0xee,
+	0xeb, 0x7d, 0xd6, 0xa3, 0x1e, 0x6a, 0x78, 0x22, 0x3d, 0xaa, 0x93, 0xbc, 0xe3, 0x8d, 0x98, 0x61,
+	0xfb, 0x06, 0xcf, 0x2f, 0x89, 0xa9, 0x7d, 0x0c, 0xb7, 0x53, 0xd2, 0xe3, 0x94, 0xce, 0x61, 0xbe,
+	0x4e, 0xad, 0x1d, 0xf4, 0x09, 0xb5, 0xd9, 0x33, 0x22, 0xf2, 0x5a, 0x82, 0x8c, 0x29, 0x2c, 0x24,
+	0x90, 0x05, 0x4f, 0x0c, 0xea, 0x97, 0xf1, 0xdc, 0x8d, 0xaf, 0x2a, 0xfd, 0x67, 0x5b, 0x88, 0x92,
+	0x70, 0x29, 0x29, 0x26, 0xd2, 0x9e, 0x41, 0xa1, 0x27, 0x76, 0x24, 0x8c, 0x47, 0x09, 0xdf, 0xbb,
+	0xe8, 0xf8, 0x30, 0x51, 0x04, 0x5c, 0x7b, 0x25, 0x9e, 0xd2, 0x0b, 0x9b, 0x1d, 0x99, 0x81, 0x71,
+	0x52, 0x0b, 0x88, 0x2b, 0xb2, 0x2a, 0x01, 0x9c, 0x48, 0x23, 0x46, 0x69, 0xa5, 0x2c, 0xa9, 0x88,
+	0xe3, 0x23, 0x45, 0x94, 0x4f, 0x3e, 0x61, 0xd2, 0x5e, 0xc0, 0xd2, 0x55, 0x0a, 0xd2, 0xb9, 0xc9,
+	0x0a, 0x2a, 0x23, 0x55, 0x50, 0xfb, 0x4f, 0x09, 0xdb, 0xb5, 0x8f, 0xac, 0x21, 0x36, 0x6d, 0x0d,
+	0x91, 0xf2, 0xe7, 0x14, 0xbe, 0x63, 0x99, 0x93, 0x38, 0x0c, 0x5a, 0xd0, 0x4f, 0x21, 0xcb, 0x6c,
+	0x17, 0x49, 0x9b, 0x25, 0xc3, 0x51, 0xd5, 0x79, 0xb4, 0xbf, 0xde, 0xad, 0x7c, 0x3a, 0xdc, 0xda,
+	0x68, 0x80, 0xa4, 0xe0, 0xeb, 0xaa, 0x0e, 0x80, 0x41, 0x60, 0xb4, 0x5e, 0x86, 0x7c, 0x93, 0x1f,
+	0xc4, 0x97, 0x11, 0x0c, 0x7c, 0x3e, 0x81, 0x17, 0x55, 0xa4, 0xa2, 0xdd, 0x81, 0x42, 0x4f, 0xd6,
+	0xf1, 0x0b, 0xae, 0x87, 0x33, 0xb9, 0x67, 0x9c, 0xd5, 0x6c, 0xc7, 0xa1, 0xea, 0x02, 0x4c, 0x53,
+	0xdb, 0x4a, 0xaa, 0x21, 0x4f, 0x7d, 0x76, 0xc4, 0x76, 0x96, 0x87, 0x92, 0x38, 0x39, 0x27, 0x11,
+	0x5d, 0x14, 0x65, 0xe3, 0xdf, 0x19, 0x98, 0xa8, 0x53, 0x4b, 0x65, 0x30, 0xd7, 0xf1, 0xe3, 0xff,
+	0x79, 0xdf, 0x11, 0xed, 0x5a, 0x7c, 0xc5, 0x2f, 0x46, 0x41, 0xc7, 0x19, 0x8e, 0xa9, 0x3f, 0x29,
+	0xb0, 0x70, 0xcd, 0x4f, 0xf4, 0xd6, 0x28, 0x94, 0x89, 0x5f, 0xf1, 0xab, 0x0f, 0xf3, 0x4b, 0x89,
+	0x7a, 0xad, 0xc0, 0x7c, 0xef, 0xc2, 0xae, 0x0c, 0xe2, 0xed, 0x71, 0x29, 0x3e, 0x1a, 0xd9, 0x25,
+	0xa5, 0xe2, 0x08, 0x66, 0xe3, 0x7d, 0xbc, 0x36, 0x88, 0x28, 0x42, 0x16, 0xd7, 0x87, 0x45, 0xa6,
+	0x22, 0x9d, 0xc3, 0x8d, 0xae, 0x3d, 0xa9, 0x0f, 0x62, 0xe9, 0xc4, 0x17, 0xb7, 0x46, 0xc3, 0x77,
+	0xd5, 0xba, 0x77, 0xa3, 0x0d, 0xac, 0x75, 0x8f, 0x4b, 0xf1, 0xd1, 0xc8, 0x2e, 0x9d, 0x15, 0xe8,
+	0x5a, 0x3d, 0xfa, 0x10, 0x75, 0x4c, 0xe1, 0x8b, 0x5b, 0xa3, 0xe1, 0x3b, 0xfb, 0x1c, 0xcf, 0xf8,
+	0xc0, 0x3e, 0x47, 0xc8, 0xe2, 0xfa, 0xb0, 0xc8, 0x24, 0x52, 0xf5, 0xeb, 0x37, 0x17, 0x25, 0xe5,
+	0xed, 0x45, 0x49, 0xf9, 0xfb, 0xa2, 0xa4, 0xfc, 0x78, 0x59, 0x1a, 0x7b, 0x7b, 0x59, 0x1a, 0xfb,
+	0xf3, 0xb2, 0x34, 0xf6, 0x5d, 0x25, 0xb5, 0xc6, 0xae, 0xf9, 0x62, 0x38, 0xde, 0x2c, 0x9f, 0xca,
+	0x6f, 0x1c, 0xbe, 0xd5, 0x0e, 0xa6, 0xc3, 0xef, 0x86, 0xcd, 0xff, 0x07, 0x00, 0x7b, 0x6c, 0x48,
+	0x96, 0x0f, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
+	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
+	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+	SetVault(ctx context.Context, in *MsgSetVault, opts ...grpc.CallOption) (*MsgSetVaultResponse, error)
+	DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error)
+	WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error)
+	SetRollappFees(ctx context.Context, in *MsgSetRollappFees, opts ...grpc.CallOption) (*MsgSetRollappFeesResponse, error)
+	PayFills(ctx context.Context, in *MsgPayFills, opts ...grpc.CallOption) (*MsgPayFillsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
+	out := new(MsgFulfillOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgFulfillOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
+	out := new(MsgFulfillOrderAuthorizedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FulfillerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
+	out := new(MsgUpdateDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetVault(ctx context.Context, in *MsgSetVault, opts ...grpc.CallOption) (*MsgSetVaultResponse, error) {
+	out := new(MsgSetVaultResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/SetVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) DepositToVault(ctx context.Context, in *MsgDepositToVault, opts ...grpc.CallOption) (*MsgDepositToVaultResponse, error) {
+	out := new(MsgDepositToVaultResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DepositToVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawFromVault(ctx context.Context, in *MsgWithdrawFromVault, opts ...grpc.CallOption) (*MsgWithdrawFromVaultResponse, error) {
+	out := new(MsgWithdrawFromVaultResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/WithdrawFromVault", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillOrderAuthorized) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetRollappFees(ctx context.Context, in *MsgSetRollappFees, opts ...grpc.CallOption) (*MsgSetRollappFeesResponse, error) {
+	out := new(MsgSetRollappFeesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/SetRollappFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) PayFills(ctx context.Context, in *MsgPayFills, opts ...grpc.CallOption) (*MsgPayFillsResponse, error) {
+	out := new(MsgPayFillsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/PayFills", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
+	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
+	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+	SetVault(context.Context, *MsgSetVault) (*MsgSetVaultResponse, error)
+	DepositToVault(context.Context, *MsgDepositToVault) (*MsgDepositToVaultResponse, error)
+	WithdrawFromVault(context.Context, *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error)
+	SetRollappFees(context.Context, *MsgSetRollappFees) (*MsgSetRollappFeesResponse, error)
+	PayFills(context.Context, *MsgPayFills) (*MsgPayFillsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+}
+func (*UnimplementedMsgServer) SetVault(ctx context.Context, req *MsgSetVault) (*MsgSetVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVault not implemented")
+}
+func (*UnimplementedMsgServer) DepositToVault(ctx context.Context, req *MsgDepositToVault) (*MsgDepositToVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToVault not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFromVault(ctx context.Context, req *MsgWithdrawFromVault) (*MsgWithdrawFromVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromVault not implemented")
+}
+func (*UnimplementedMsgServer) SetRollappFees(ctx context.Context, req *MsgSetRollappFees) (*MsgSetRollappFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRollappFees not implemented")
+}
+func (*UnimplementedMsgServer) PayFills(ctx context.Context, req *MsgPayFills) (*MsgPayFillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayFills not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Price) > 0 {
-		for _, e := range m.Price {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrder(ctx, in)
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
 	}
-	l = len(m.OperatorFeeAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
 	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderAuthorized)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.OperatorFeeShare.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SettlementValidated {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderAuthorized(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderAuthorized(ctx, req.(*MsgFulfillOrderAuthorized))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDemandOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/SetVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVault(ctx, req.(*MsgSetVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositToVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositToVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositToVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DepositToVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositToVault(ctx, req.(*MsgDepositToVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFromVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFromVault)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFromVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/WithdrawFromVault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFromVault(ctx, req.(*MsgWithdrawFromVault))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRollappFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRollappFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRollappFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/SetRollappFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRollappFees(ctx, req.(*MsgSetRollappFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PayFills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPayFills)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PayFills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/PayFills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PayFills(ctx, req.(*MsgPayFills))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _Msg_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrderAuthorized",
+			Handler:    _Msg_FulfillOrderAuthorized_Handler,
+		},
+		{
+			MethodName: "UpdateDemandOrder",
+			Handler:    _Msg_UpdateDemandOrder_Handler,
+		},
+		{
+			MethodName: "SetVault",
+			Handler:    _Msg_SetVault_Handler,
+		},
+		{
+			MethodName: "DepositToVault",
+			Handler:    _Msg_DepositToVault_Handler,
+		},
+		{
+			MethodName: "WithdrawFromVault",
+			Handler:    _Msg_WithdrawFromVault_Handler,
+		},
+		{
+			MethodName: "SetRollappFees",
+			Handler:    _Msg_SetRollappFees_Handler,
+		},
+		{
+			MethodName: "PayFills",
+			Handler:    _Msg_PayFills_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+}
+
+func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FillPrice) > 0 {
+		i -= len(m.FillPrice)
+		copy(dAtA[i:], m.FillPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FillPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementValidated {
+		i--
+		if m.SettlementValidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.OperatorFeeShare.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OperatorFeeAddress) > 0 {
+		i -= len(m.OperatorFeeAddress)
+		copy(dAtA[i:], m.OperatorFeeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Criteria.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositToVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositToVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositToVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromVault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromVault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromVault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFromVaultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFromVaultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFromVaultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ErrackFee.Size()
+		i -= size
+		if _, err := m.ErrackFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TimeoutFee.Size()
+		i -= size
+		if _, err := m.TimeoutFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRollappFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRollappFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRollappFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayFills) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayFills) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayFills) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPayFillsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPayFillsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPayFillsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFulfillOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FillPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrderAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorFeeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OperatorFeeShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SettlementValidated {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDemandOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Criteria.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositToVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositToVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawFromVault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawFromVaultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRollappFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ErrackFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRollappFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPayFills) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPayFillsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FillPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FillPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorFeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettlementValidated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Criteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Criteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgFulfillOrderAuthorizedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateDemandOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositToVault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1012,15 +3232,15 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositToVault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositToVault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1048,11 +3268,177 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositToVaultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositToVaultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositToVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFromVault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFromVault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFromVault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1080,13 +3466,13 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1096,23 +3482,24 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1135,7 +3522,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFromVaultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1158,12 +3545,45 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFromVaultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1185,7 +3605,7 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRollappFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1208,15 +3628,15 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRollappFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRollappFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1244,7 +3664,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1280,74 +3700,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Price = append(m.Price, types.Coin{})
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1375,43 +3728,13 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.TimeoutFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.OperatorFeeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrackFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1439,61 +3762,10 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeShare", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OperatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ErrackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SettlementValidated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1515,7 +3787,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRollappFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,10 +3810,10 @@ func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRollappFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRollappFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1565,7 +3837,7 @@ func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgPayFills) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,15 +3860,15 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayFills: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayFills: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1624,7 +3896,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1658,38 +3930,6 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1711,7 +3951,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPayFillsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1734,10 +3974,10 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPayFillsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPayFillsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
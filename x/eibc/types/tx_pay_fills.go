@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgPayFills{}
+	_ legacytx.LegacyMsg = &MsgPayFills{}
+)
+
+func NewMsgPayFills(signer, orderID string) *MsgPayFills {
+	return &MsgPayFills{
+		Signer:  signer,
+		OrderId: orderID,
+	}
+}
+
+func (m *MsgPayFills) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Signer)}
+}
+
+func (m *MsgPayFills) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !isValidOrderId(m.OrderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", m.OrderId)
+	}
+	return nil
+}
+
+func (m *MsgPayFills) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgPayFills) Route() string {
+	return RouterKey
+}
+
+func (m *MsgPayFills) Type() string {
+	return sdk.MsgTypeURL(m)
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgSetRollappFees{}
+	_ legacytx.LegacyMsg = &MsgSetRollappFees{}
+)
+
+func NewMsgSetRollappFees(owner, rollappID string, timeoutFee, errAckFee sdk.Dec) *MsgSetRollappFees {
+	return &MsgSetRollappFees{
+		Owner:      owner,
+		RollappId:  rollappID,
+		TimeoutFee: timeoutFee,
+		ErrackFee:  errAckFee,
+	}
+}
+
+func (m *MsgSetRollappFees) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Owner)}
+}
+
+func (m *MsgSetRollappFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := m.RollappFees().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (m *MsgSetRollappFees) RollappFees() RollappFees {
+	return NewRollappFees(m.RollappId, m.TimeoutFee, m.ErrackFee)
+}
+
+func (m *MsgSetRollappFees) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetRollappFees) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetRollappFees) Type() string {
+	return sdk.MsgTypeURL(m)
+}
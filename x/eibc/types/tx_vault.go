@@ -0,0 +1,117 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgSetVault{}
+	_ sdk.Msg            = &MsgDepositToVault{}
+	_ sdk.Msg            = &MsgWithdrawFromVault{}
+	_ legacytx.LegacyMsg = &MsgSetVault{}
+	_ legacytx.LegacyMsg = &MsgDepositToVault{}
+	_ legacytx.LegacyMsg = &MsgWithdrawFromVault{}
+)
+
+func NewMsgSetVault(authority, denom string, criteria VaultCriteria) *MsgSetVault {
+	return &MsgSetVault{
+		Authority: authority,
+		Denom:     denom,
+		Criteria:  criteria,
+	}
+}
+
+func (m *MsgSetVault) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+func (m *MsgSetVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return NewVault(m.Denom, m.Criteria).ValidateBasic()
+}
+
+func (m *MsgSetVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetVault) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetVault) Type() string {
+	return sdk.MsgTypeURL(m)
+}
+
+func NewMsgDepositToVault(depositor string, amount sdk.Coin) *MsgDepositToVault {
+	return &MsgDepositToVault{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+func (m *MsgDepositToVault) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Depositor)}
+}
+
+func (m *MsgDepositToVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Depositor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "amount: %s", m.Amount)
+	}
+	return nil
+}
+
+func (m *MsgDepositToVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgDepositToVault) Route() string {
+	return RouterKey
+}
+
+func (m *MsgDepositToVault) Type() string {
+	return sdk.MsgTypeURL(m)
+}
+
+func NewMsgWithdrawFromVault(withdrawer string, shares sdk.Coin) *MsgWithdrawFromVault {
+	return &MsgWithdrawFromVault{
+		Withdrawer: withdrawer,
+		Shares:     shares,
+	}
+}
+
+func (m *MsgWithdrawFromVault) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Withdrawer)}
+}
+
+func (m *MsgWithdrawFromVault) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Withdrawer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if !m.Shares.IsValid() || !m.Shares.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "shares: %s", m.Shares)
+	}
+	return nil
+}
+
+func (m *MsgWithdrawFromVault) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgWithdrawFromVault) Route() string {
+	return RouterKey
+}
+
+func (m *MsgWithdrawFromVault) Type() string {
+	return sdk.MsgTypeURL(m)
+}
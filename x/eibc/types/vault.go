@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// VaultAccountName is the name of the module account holding the liquidity of the vaults.
+// It mints and burns the vault shares.
+const VaultAccountName = ModuleName + "_vault"
+
+// MaxVaultFulfillmentsPerBlock bounds the number of orders a vault fulfills at the end of a block.
+const MaxVaultFulfillmentsPerBlock = 50
+
+// MaxVaultScannedOrdersPerBlock bounds the number of pending orders of its denom a vault scans at the end
+// of a block. The scan continues from where it stopped in the next block.
+const MaxVaultScannedOrdersPerBlock = 200
+
+// VaultAddress returns the address of the account holding the liquidity of the vaults.
+func VaultAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(VaultAccountName)
+}
+
+// VaultShareDenom returns the denom of the shares of the vault of the given denom.
+func VaultShareDenom(denom string) string {
+	return fmt.Sprintf("%s/vault/%s", ModuleName, denom)
+}
+
+// VaultDenomFromShareDenom returns the denom of the vault of the given share denom.
+func VaultDenomFromShareDenom(shareDenom string) (string, bool) {
+	return strings.CutPrefix(shareDenom, VaultShareDenom(""))
+}
+
+func NewVault(denom string, criteria VaultCriteria) Vault {
+	return Vault{
+		Denom:     denom,
+		Criteria:  criteria,
+		Locked:    math.ZeroInt(),
+		Liquidity: math.ZeroInt(),
+	}
+}
+
+func (v Vault) ShareDenom() string {
+	return VaultShareDenom(v.Denom)
+}
+
+func (v Vault) ValidateBasic() error {
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidVault, err.Error())
+	}
+	if err := sdk.ValidateDenom(v.ShareDenom()); err != nil {
+		return errorsmod.Wrapf(ErrInvalidVault, "share denom: %s", err)
+	}
+	if v.Locked.IsNil() || v.Locked.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidVault, "locked: %s", v.Locked)
+	}
+	if v.Liquidity.IsNil() || v.Liquidity.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidVault, "liquidity: %s", v.Liquidity)
+	}
+	return v.Criteria.ValidateBasic()
+}
+
+func (c VaultCriteria) ValidateBasic() error {
+	if len(c.Rollapps) == 0 {
+		return errorsmod.Wrap(ErrInvalidVault, "empty rollapp allowlist")
+	}
+	for i, rollappID := range c.Rollapps {
+		if err := validateRollappID(rollappID); err != nil {
+			return errorsmod.Wrap(ErrInvalidVault, err.Error())
+		}
+		if slices.Contains(c.Rollapps[:i], rollappID) {
+			return errorsmod.Wrapf(ErrInvalidVault, "duplicate rollapp: %s", rollappID)
+		}
+	}
+	minFee := c.MinFeePercentage.Dec
+	if minFee.IsNil() || minFee.IsNegative() || minFee.GT(sdk.OneDec()) {
+		return errorsmod.Wrap(ErrInvalidVault, "min fee percentage must be between 0 and 1")
+	}
+	return nil
+}
+
+// AcceptsFee returns whether the fee of an order meets the minimum fee percentage of the transfer amount.
+func (c VaultCriteria) AcceptsFee(fee, amount math.Int) bool {
+	minFee := sdk.NewDecFromInt(amount).Mul(c.MinFeePercentage.Dec)
+	return sdk.NewDecFromInt(fee).GTE(minFee)
+}
+
+// SharesForDeposit returns the shares minted for a deposit into a vault with the given value and
+// total shares. The first deposit into an empty vault mints shares one to one.
+// A virtual share and a virtual unit of value are added to the vault, so that inflating the share
+// price to round down the shares of the next deposits costs the attacker as much as the depositors lose.
+func SharesForDeposit(amount, value, totalShares math.Int) (math.Int, error) {
+	if totalShares.IsPositive() && !value.IsPositive() {
+		return math.Int{}, errorsmod.Wrap(ErrInvalidVault, "vault has shares but no value")
+	}
+	return amount.Mul(totalShares.AddRaw(1)).Quo(value.AddRaw(1)), nil
+}
+
+// AmountForShares returns the value of the given shares of a vault with the given value and total shares.
+// The virtual share and unit of value of SharesForDeposit are added to the vault.
+func AmountForShares(shares, value, totalShares math.Int) math.Int {
+	return shares.Mul(value.AddRaw(1)).Quo(totalShares.AddRaw(1))
+}
@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/vault.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Vault is a pool of liquidity in a single denom which fulfills the demand orders meeting its criteria
+// at the end of each block. Depositors get vault shares, whose price grows with the fees earned.
+type Vault struct {
+	// denom is the denom of the liquidity and of the orders the vault fulfills
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// criteria are the conditions a demand order has to meet to be fulfilled by the vault
+	Criteria VaultCriteria `protobuf:"bytes,2,opt,name=criteria,proto3" json:"criteria"`
+	// locked is the price and the fee due for the fulfilled orders whose packets are not finalized yet
+	Locked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=locked,proto3,customtype=cosmossdk.io/math.Int" json:"locked"`
+	// liquidity is the liquidity deposited into the vault and received from the finalized orders,
+	// available to fulfill orders and to withdraw. Funds sent to the vault account otherwise are
+	// not part of it.
+	Liquidity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.Int" json:"liquidity"`
+}
+
+func (m *Vault) Reset()         { *m = Vault{} }
+func (m *Vault) String() string { return proto.CompactTextString(m) }
+func (*Vault) ProtoMessage()    {}
+func (*Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92cfdb289a924737, []int{0}
+}
+func (m *Vault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vault.Merge(m, src)
+}
+func (m *Vault) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vault proto.InternalMessageInfo
+
+func (m *Vault) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Vault) GetCriteria() VaultCriteria {
+	if m != nil {
+		return m.Criteria
+	}
+	return VaultCriteria{}
+}
+
+// VaultCriteria are the conditions a demand order has to meet to be fulfilled by a vault.
+type VaultCriteria struct {
+	// rollapps is the allowlist of rollapps whose orders the vault fulfills
+	Rollapps []string `protobuf:"bytes,1,rep,name=rollapps,proto3" json:"rollapps,omitempty"`
+	// min_fee_percentage is the minimum fee of an order relative to the amount of the IBC transfer
+	MinFeePercentage types.DecProto `protobuf:"bytes,2,opt,name=min_fee_percentage,json=minFeePercentage,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecProto" json:"min_fee_percentage"`
+	// settlement_validated is the flag to only fulfill demand orders that have been settlement validated
+	SettlementValidated bool `protobuf:"varint,3,opt,name=settlement_validated,json=settlementValidated,proto3" json:"settlement_validated,omitempty"`
+}
+
+func (m *VaultCriteria) Reset()         { *m = VaultCriteria{} }
+func (m *VaultCriteria) String() string { return proto.CompactTextString(m) }
+func (*VaultCriteria) ProtoMessage()    {}
+func (*VaultCriteria) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92cfdb289a924737, []int{1}
+}
+func (m *VaultCriteria) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultCriteria) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultCriteria.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultCriteria) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultCriteria.Merge(m, src)
+}
+func (m *VaultCriteria) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultCriteria) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultCriteria.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultCriteria proto.InternalMessageInfo
+
+func (m *VaultCriteria) GetRollapps() []string {
+	if m != nil {
+		return m.Rollapps
+	}
+	return nil
+}
+
+func (m *VaultCriteria) GetMinFeePercentage() types.DecProto {
+	if m != nil {
+		return m.MinFeePercentage
+	}
+	return types.DecProto{}
+}
+
+func (m *VaultCriteria) GetSettlementValidated() bool {
+	if m != nil {
+		return m.SettlementValidated
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*Vault)(nil), "dymensionxyz.dymension.eibc.Vault")
+	proto.RegisterType((*VaultCriteria)(nil), "dymensionxyz.dymension.eibc.VaultCriteria")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/vault.proto", fileDescriptor_92cfdb289a924737)
+}
+
+var fileDescriptor_92cfdb289a924737 = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0xcc, 0xd2, 0x1f, 0x25, 0x8b, 0x90, 0xd0, 0x12, 0x24, 0x13, 0x84, 0x13, 0xf5, 0x42, 0x44,
+	0xd5, 0x5d, 0x85, 0xbc, 0x41, 0x8a, 0x90, 0x22, 0x38, 0x54, 0x3e, 0xf4, 0xc0, 0x25, 0x5a, 0xdb,
+	0x1f, 0xe9, 0x2a, 0xde, 0x5d, 0xe3, 0xdd, 0x44, 0x0d, 0x27, 0x1e, 0x81, 0xe7, 0xe0, 0xcc, 0x43,
+	0xf4, 0x58, 0x71, 0x42, 0x1c, 0x0a, 0x24, 0x8f, 0xc1, 0x05, 0xad, 0x77, 0x71, 0xcb, 0x01, 0xa4,
+	0x9e, 0xec, 0xd1, 0x7c, 0xf3, 0xcd, 0x78, 0xfc, 0xe1, 0xa7, 0xf9, 0x5a, 0x82, 0x32, 0x42, 0xab,
+	0xf3, 0xf5, 0x7b, 0xd6, 0x00, 0x06, 0x22, 0xcd, 0xd8, 0x8a, 0x2f, 0x0b, 0x4b, 0xcb, 0x4a, 0x5b,
+	0x4d, 0x1e, 0xdf, 0x1c, 0xa4, 0x0d, 0xa0, 0x6e, 0xb0, 0xd7, 0x9d, 0xeb, 0xb9, 0xae, 0xe7, 0x98,
+	0x7b, 0xf3, 0x92, 0xde, 0xa3, 0x4c, 0x1b, 0xa9, 0xcd, 0xcc, 0x13, 0x1e, 0x04, 0x2a, 0xf6, 0x88,
+	0xa5, 0xdc, 0x00, 0x5b, 0x8d, 0x52, 0xb0, 0x7c, 0xc4, 0x32, 0x2d, 0x94, 0xe7, 0x0f, 0x7e, 0x21,
+	0xbc, 0x77, 0xea, 0xdc, 0x49, 0x17, 0xef, 0xe5, 0xa0, 0xb4, 0x8c, 0xd0, 0x00, 0x0d, 0x3b, 0x89,
+	0x07, 0xe4, 0x35, 0x6e, 0x67, 0x95, 0xb0, 0x50, 0x09, 0x1e, 0xdd, 0x19, 0xa0, 0xe1, 0xdd, 0xe7,
+	0xcf, 0xe8, 0x7f, 0x02, 0xd2, 0x7a, 0xd7, 0x71, 0x50, 0x4c, 0x76, 0x2f, 0xae, 0xfa, 0xad, 0xa4,
+	0xd9, 0x40, 0x8e, 0xf1, 0x7e, 0xa1, 0xb3, 0x05, 0xe4, 0xd1, 0x8e, 0x33, 0x99, 0x1c, 0x3a, 0xfe,
+	0xdb, 0x55, 0xff, 0xa1, 0x4f, 0x69, 0xf2, 0x05, 0x15, 0x9a, 0x49, 0x6e, 0xcf, 0xe8, 0x54, 0xd9,
+	0x2f, 0x9f, 0x8f, 0x70, 0xf8, 0x98, 0xa9, 0xb2, 0x49, 0x90, 0x92, 0x29, 0xee, 0x14, 0xe2, 0xdd,
+	0x52, 0xe4, 0xc2, 0xae, 0xa3, 0xdd, 0xdb, 0xef, 0xb9, 0x56, 0x1f, 0xfc, 0x44, 0xf8, 0xde, 0x5f,
+	0x89, 0x49, 0x0f, 0xb7, 0x2b, 0x5d, 0x14, 0xbc, 0x2c, 0x4d, 0x84, 0x06, 0x3b, 0xc3, 0x4e, 0xd2,
+	0x60, 0xf2, 0x01, 0x61, 0x22, 0x85, 0x9a, 0xbd, 0x05, 0x98, 0x95, 0x50, 0x65, 0xa0, 0x2c, 0x9f,
+	0x43, 0xa8, 0xe5, 0x09, 0x0d, 0x16, 0xae, 0x69, 0x1a, 0x9a, 0xa6, 0x2f, 0x20, 0x3b, 0x71, 0x3d,
+	0x4f, 0xc6, 0x2e, 0xe1, 0xa7, 0xef, 0xfd, 0xc3, 0xb9, 0xb0, 0x67, 0xcb, 0x94, 0x66, 0x5a, 0x86,
+	0x1f, 0x15, 0x1e, 0x47, 0x26, 0x5f, 0x30, 0xbb, 0x2e, 0xc1, 0x34, 0xa2, 0xe4, 0xbe, 0x14, 0xea,
+	0x25, 0xc0, 0x49, 0xe3, 0x45, 0x46, 0xb8, 0x6b, 0xc0, 0xda, 0x02, 0x24, 0x28, 0x3b, 0x5b, 0xf1,
+	0x42, 0xe4, 0xdc, 0x86, 0x3a, 0xdb, 0xc9, 0x83, 0x6b, 0xee, 0xf4, 0x0f, 0x35, 0x79, 0x75, 0xb1,
+	0x89, 0xd1, 0xe5, 0x26, 0x46, 0x3f, 0x36, 0x31, 0xfa, 0xb8, 0x8d, 0x5b, 0x97, 0xdb, 0xb8, 0xf5,
+	0x75, 0x1b, 0xb7, 0xde, 0x8c, 0x6e, 0x64, 0xf9, 0xc7, 0x75, 0xae, 0xc6, 0xec, 0xdc, 0x9f, 0x68,
+	0x1d, 0x2d, 0xdd, 0xaf, 0xaf, 0x66, 0xfc, 0x7b, 0x00, 0xd8, 0xc7, 0x49, 0x94, 0xce, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Criteria.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintVault(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VaultCriteria) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultCriteria) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultCriteria) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementValidated {
+		i--
+		if m.SettlementValidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.MinFeePercentage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintVault(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Rollapps) > 0 {
+		for iNdEx := len(m.Rollapps) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rollapps[iNdEx])
+			copy(dAtA[i:], m.Rollapps[iNdEx])
+			i = encodeVarintVault(dAtA, i, uint64(len(m.Rollapps[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVault(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVault(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovVault(uint64(l))
+	}
+	l = m.Criteria.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovVault(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovVault(uint64(l))
+	return n
+}
+
+func (m *VaultCriteria) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rollapps) > 0 {
+		for _, s := range m.Rollapps {
+			l = len(s)
+			n += 1 + l + sovVault(uint64(l))
+		}
+	}
+	l = m.MinFeePercentage.Size()
+	n += 1 + l + sovVault(uint64(l))
+	if m.SettlementValidated {
+		n += 2
+	}
+	return n
+}
+
+func sovVault(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVault(x uint64) (n int) {
+	return sovVault(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Vault) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Criteria", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Criteria.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultCriteria) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultCriteria: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultCriteria: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapps = append(m.Rollapps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePercentage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVault
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVault
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeePercentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettlementValidated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVault(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVault
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVault(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVault
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVault
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVault
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVault
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVault
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVault        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVault          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVault = fmt.Errorf("proto: unexpected end of group")
+)
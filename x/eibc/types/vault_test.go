@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func TestVault_ValidateBasic(t *testing.T) {
+	validCriteria := types.VaultCriteria{
+		Rollapps:         []string{"rollapp_1234-1"},
+		MinFeePercentage: sdk.DecProto{Dec: sdk.NewDecWithPrec(1, 2)},
+	}
+	require.NoError(t, types.NewVault("ibc/ABC", validCriteria).ValidateBasic())
+
+	for name, criteria := range map[string]types.VaultCriteria{
+		"empty allowlist":   {MinFeePercentage: validCriteria.MinFeePercentage},
+		"duplicate rollapp": {Rollapps: []string{"rollapp_1234-1", "rollapp_1234-1"}, MinFeePercentage: validCriteria.MinFeePercentage},
+		"invalid rollapp":   {Rollapps: []string{""}, MinFeePercentage: validCriteria.MinFeePercentage},
+		"min fee above one": {Rollapps: validCriteria.Rollapps, MinFeePercentage: sdk.DecProto{Dec: sdk.NewDec(2)}},
+		"nil min fee":       {Rollapps: validCriteria.Rollapps},
+	} {
+		require.ErrorIs(t, types.NewVault("ibc/ABC", criteria).ValidateBasic(), types.ErrInvalidVault, name)
+	}
+
+	require.ErrorIs(t, types.NewVault("", validCriteria).ValidateBasic(), types.ErrInvalidVault)
+}
+
+func TestVaultShares(t *testing.T) {
+	shares, err := types.SharesForDeposit(math.NewInt(100), math.ZeroInt(), math.ZeroInt())
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), shares)
+
+	// the value of the vault grew by a half
+	shares, err = types.SharesForDeposit(math.NewInt(150), math.NewInt(150), math.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), shares)
+	// the virtual share and unit of value round the withdrawal down
+	require.Equal(t, math.NewInt(149), types.AmountForShares(math.NewInt(100), math.NewInt(300), math.NewInt(200)))
+
+	// inflating the share price of a vault with a single share costs the attacker more than the depositor loses
+	shares, err = types.SharesForDeposit(math.NewInt(10_000), math.NewInt(10_001), math.OneInt())
+	require.NoError(t, err)
+	require.Equal(t, math.OneInt(), shares)
+	require.Equal(t, math.NewInt(6_667), types.AmountForShares(math.OneInt(), math.NewInt(20_001), math.NewInt(2)))
+
+	// the vault lost all its value
+	_, err = types.SharesForDeposit(math.NewInt(100), math.ZeroInt(), math.NewInt(100))
+	require.ErrorIs(t, err, types.ErrInvalidVault)
+
+	denom, ok := types.VaultDenomFromShareDenom(types.VaultShareDenom("ibc/ABC"))
+	require.True(t, ok)
+	require.Equal(t, "ibc/ABC", denom)
+}
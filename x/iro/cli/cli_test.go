@@ -122,6 +122,14 @@ func TestParseBondingCurve(t *testing.T) {
 		{"Negative values M", "-1.2,0.4,0", true},
 		{"Negative values N", "1.2,-0.4,0", true},
 		{"Negative values C", "1.2,0.4,-1", true},
+		{"Valid logarithmic curve", "logarithmic:0.1,1000,0.01", false},
+		{"Valid capped exponential curve", "capped-exponential:0.01,0.0001,1", false},
+		{"Valid sigmoid curve", "sigmoid:1,0.0001,50000,0.01", false},
+		{"Valid piecewise linear curve", "piecewise-linear:0:0.01,100000:0.5", false},
+		{"Invalid sigmoid params count", "sigmoid:1,0.0001,50000", true},
+		{"Invalid piecewise linear point", "piecewise-linear:0:0.01,100000", true},
+		{"Invalid capped exponential cap", "capped-exponential:1,0.0001,1", true},
+		{"Unknown curve family", "cubic:1,2,3", true},
 	}
 
 	for _, tt := range tests {
@@ -25,6 +25,15 @@ const (
 	FlagBondingCurve                           = "curve"
 	FlagIncentivesStartDurationAfterSettlement = "incentives-start"
 	FlagIncentivesEpochs                       = "incentives-epochs"
+	FlagLiquidityDenom                         = "liquidity-denom"
+	FlagVestingCliff                           = "vesting-cliff"
+	FlagVestingDuration                        = "vesting-duration"
+	FlagAllowlistAddresses                     = "allowlist-addresses"
+	FlagAllowlistMerkleRoot                    = "allowlist-merkle-root"
+	FlagAllowlistEndTime                       = "allowlist-end-time"
+	FlagAllowlistMaxAmount                     = "allowlist-max-amount"
+	FlagMaxAmountPerAddress                    = "max-amount-per-address"
+	FlagAllowlistProof                         = "allowlist-proof"
 	FlagNonSettledOnly                         = "non-settled"
 )
 
@@ -41,6 +50,14 @@ func FlagSetCreatePlan() *flag.FlagSet {
 	fs.String(FlagBondingCurve, "", "The bonding curve parameters.")
 	fs.Duration(FlagIncentivesStartDurationAfterSettlement, defaultIncentivePlanParams_start, "The duration after the plan is settled to start the incentives.")
 	fs.Uint64(FlagIncentivesEpochs, defaultIncentivePlanParams_epochs, "The number of epochs for the incentives.")
+	fs.String(FlagLiquidityDenom, "", "The denom to raise liquidity in. Default is DYM.")
+	fs.Duration(FlagVestingCliff, 0, "The duration after the plan is settled before claims start vesting.")
+	fs.Duration(FlagVestingDuration, 0, "The duration after the vesting cliff over which claims vest linearly.")
+	fs.StringSlice(FlagAllowlistAddresses, nil, "The addresses allowed to buy during the allowlist phase.")
+	fs.String(FlagAllowlistMerkleRoot, "", "The hex encoded merkle root of the addresses allowed to buy during the allowlist phase.")
+	fs.String(FlagAllowlistEndTime, "", "The end time of the allowlist phase.")
+	fs.String(FlagAllowlistMaxAmount, "0", "The maximum amount of tokens an address can buy during the allowlist phase. Default is no limit.")
+	fs.String(FlagMaxAmountPerAddress, "0", "The maximum amount of tokens an address can buy. Default is no limit.")
 
 	return fs
 }
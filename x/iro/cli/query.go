@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -29,6 +30,10 @@ func GetQueryCmd() *cobra.Command {
 		CmdQuerySpotPrice(),
 		CmdQueryCost(),
 		CmdQueryClaimed(),
+		CmdQueryVesting(),
+		CmdQueryAllowance(),
+		CmdQueryTrades(),
+		CmdQueryCandles(),
 	)
 
 	return iroQueryCmd
@@ -221,3 +226,125 @@ func CmdQueryClaimed() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting [plan-id] [claimer]",
+		Short: "Query the vested and unvested amounts of a claimer for a specific plan",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryVesting(cmd.Context(), &types.QueryVestingRequest{PlanId: args[0], Claimer: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance [plan-id] [address]",
+		Short: "Query the remaining amount of tokens an address can buy from a specific plan",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proof, err := parseAllowlistProof(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryAllowance(cmd.Context(), &types.QueryAllowanceRequest{PlanId: args[0], Address: args[1], AllowlistProof: proof})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAllowlistProof, nil, "The hex encoded merkle proof of the address in the allowlist, separated by commas.")
+	return cmd
+}
+
+func CmdQueryTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades [plan-id]",
+		Short: "Query the latest trades of a specific plan",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryTrades(cmd.Context(), &types.QueryTradesRequest{PlanId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "candles [plan-id] [interval]",
+		Short:   "Query the OHLCV candles of a specific plan over an interval (5m, 1h or 24h)",
+		Example: "dymd query iro candles 1 1h",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			interval, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid interval: %w", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryCandles(cmd.Context(), &types.QueryCandlesRequest{
+				PlanId:          args[0],
+				IntervalSeconds: uint64(interval / time.Second),
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
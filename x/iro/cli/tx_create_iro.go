@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -30,15 +31,32 @@ Parameters:
 
 Required Flags:
   --curve           : The bonding curve parameters in the format "M,N,C" where the curve is defined as p(x) = M * x^N + C.
+                      Other curve families are set in the format "family:params":
+                        "logarithmic:A,B,C" for p(x) = A * ln(1 + x / B) + C
+                        "capped-exponential:A,K,Cap" for p(x) = min(A * e^(K * x), Cap)
+                        "sigmoid:L,K,X0,C" for p(x) = C + L / (1 + e^(-K * (x - X0)))
+                        "piecewise-linear:X1:P1,X2:P2,..." for a price interpolated between the (supply, price) points
 
 Optional Flags:
   --start-time      : The time when the IRO will start. If not provided, it starts immediately after creation.
   --incentives-start: The duration after settlement when incentives distribution starts.
   --incentives-epochs: The number of epochs over which incentives will be distributed. (1 minute epoch)
+  --liquidity-denom : The governance whitelisted denom to raise liquidity in. If not provided, DYM is used.
+  --vesting-cliff   : The duration after settlement before claims start vesting. If not provided, claims are released at once.
+  --vesting-duration: The duration after the vesting cliff over which claims vest linearly.
+  --allowlist-addresses: The addresses allowed to buy during the allowlist phase, separated by commas.
+  --allowlist-merkle-root: The hex encoded merkle root of the addresses allowed to buy during the allowlist phase, instead of the addresses.
+  --allowlist-end-time: The time when the allowlist phase ends. Required with an allowlist.
+  --allowlist-max-amount: The maximum amount of tokens an address can buy during the allowlist phase.
+  --max-amount-per-address: The maximum amount of tokens an address can buy, including the allowlist phase purchases.
 
 Examples:
   dymd tx iro create-iro myrollapp1 1000000000 24h --curve "1.2,0.4,0" --from mykey
   dymd tx iro create-iro myrollapp2 500000000 30m --curve "1.5,0.5,100" --start-time "2023-10-01T00:00:00Z" --incentives-start 24h --incentives-epochs 3000 --from mykey
+  dymd tx iro create-iro myrollapp3 1000000000 24h --curve "piecewise-linear:0:0.01,100000:0.5" --from mykey
+  dymd tx iro create-iro myrollapp4 1000000000 24h --curve "0.01,1,0" --liquidity-denom ibc/B3504E092456BA618CC28AC671A71FB08C6CA0FD0BE7C8A5B5A3E2DD933CC9E4 --from mykey
+  dymd tx iro create-iro myrollapp5 1000000000 24h --curve "1.2,0.4,0" --vesting-cliff 720h --vesting-duration 4320h --from mykey
+  dymd tx iro create-iro myrollapp6 1000000000 24h --curve "1.2,0.4,0" --start-time "2023-10-01T00:00:00Z" --allowlist-addresses dym1...,dym1... --allowlist-end-time "2023-10-01T06:00:00Z" --allowlist-max-amount 1000000000000000000000 --from mykey
 `,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -75,11 +93,7 @@ Examples:
 			}
 			if timeStr == "" { // empty start time
 				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
+			} else if startTime, err = parseTime(timeStr); err != nil {
 				return errors.New("invalid start time format")
 			}
 
@@ -93,6 +107,35 @@ Examples:
 				return err
 			}
 
+			liquidityDenom, err := cmd.Flags().GetString(FlagLiquidityDenom)
+			if err != nil {
+				return err
+			}
+
+			vestingCliff, err := cmd.Flags().GetDuration(FlagVestingCliff)
+			if err != nil {
+				return err
+			}
+
+			vestingDuration, err := cmd.Flags().GetDuration(FlagVestingDuration)
+			if err != nil {
+				return err
+			}
+
+			allowlistPhase, err := parseAllowlistPhase(cmd)
+			if err != nil {
+				return errors.Join(types.ErrInvalidAllowlistPhase, err)
+			}
+
+			maxAmountStr, err := cmd.Flags().GetString(FlagMaxAmountPerAddress)
+			if err != nil {
+				return err
+			}
+			maxAmountPerAddress, ok := math.NewIntFromString(maxAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid max amount per address: %s", maxAmountStr)
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -109,6 +152,10 @@ Examples:
 					StartTimeAfterSettlement: incentivesStart,
 					NumEpochsPaidOver:        incentivesEpochs,
 				},
+				LiquidityDenom:      liquidityDenom,
+				VestingPlan:         types.NewVestingPlan(vestingCliff, vestingDuration),
+				AllowlistPhase:      allowlistPhase,
+				MaxAmountPerAddress: maxAmountPerAddress,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -125,10 +172,19 @@ Examples:
 }
 
 // ParseBondingCurve parses the bonding curve string into a BondingCurve struct
-// expected format: "M,N,C" for p(x) = M * x^N + C
+// expected format: "M,N,C" for p(x) = M * x^N + C, or "family:params" for the other curve families
 func ParseBondingCurve(curveStr string) (types.BondingCurve, error) {
 	var curve types.BondingCurve
 
+	if family, params, ok := strings.Cut(curveStr, ":"); ok {
+		f, err := parseCurveFamily(family, params)
+		if err != nil {
+			return curve, err
+		}
+		curve = types.NewBondingCurveFromFamily(f)
+		return curve, curve.ValidateBasic()
+	}
+
 	curveParams := strings.Split(curveStr, ",")
 	if len(curveParams) != 3 {
 		return curve, errors.New("invalid bonding curve parameters")
@@ -153,6 +209,111 @@ func ParseBondingCurve(curveStr string) (types.BondingCurve, error) {
 	return curve, curve.ValidateBasic()
 }
 
+// parseCurveFamily parses the parameters of a curve family
+func parseCurveFamily(family, params string) (types.Curve, error) {
+	switch family {
+	case "logarithmic":
+		p, err := parseDecs(params, ",", 3)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewLogarithmicCurve(p[0], p[1], p[2]), nil
+	case "capped-exponential":
+		p, err := parseDecs(params, ",", 3)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewCappedExponentialCurve(p[0], p[1], p[2]), nil
+	case "sigmoid":
+		p, err := parseDecs(params, ",", 4)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSigmoidCurve(p[0], p[1], p[2], p[3]), nil
+	case "piecewise-linear":
+		var points []types.PricePoint
+		for _, pointStr := range strings.Split(params, ",") {
+			p, err := parseDecs(pointStr, ":", 2)
+			if err != nil {
+				return nil, fmt.Errorf("invalid point %s: %w", pointStr, err)
+			}
+			points = append(points, types.NewPricePoint(p[0], p[1]))
+		}
+		return types.NewPiecewiseLinearCurve(points...), nil
+	default:
+		return nil, fmt.Errorf("unknown curve family: %s", family)
+	}
+}
+
+// parseDecs parses n decimals separated by sep
+func parseDecs(s, sep string, n int) ([]math.LegacyDec, error) {
+	parts := strings.Split(s, sep)
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected %d parameters, got %d", n, len(parts))
+	}
+	decs := make([]math.LegacyDec, n)
+	for i, part := range parts {
+		d, err := math.LegacyNewDecFromStr(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter %s: %w", part, err)
+		}
+		decs[i] = d
+	}
+	return decs, nil
+}
+
 // buy
 // sell
 // claim
+
+// parseTime parses a unix or RFC3339 time
+func parseTime(timeStr string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+		return time.Unix(timeUnix, 0), nil
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
+
+// parseAllowlistPhase parses the allowlist phase flags, empty if no allowlist is set
+func parseAllowlistPhase(cmd *cobra.Command) (types.AllowlistPhase, error) {
+	addresses, err := cmd.Flags().GetStringSlice(FlagAllowlistAddresses)
+	if err != nil {
+		return types.AllowlistPhase{}, err
+	}
+	merkleRootStr, err := cmd.Flags().GetString(FlagAllowlistMerkleRoot)
+	if err != nil {
+		return types.AllowlistPhase{}, err
+	}
+	if len(addresses) == 0 && merkleRootStr == "" {
+		return types.AllowlistPhase{}, nil
+	}
+
+	endTimeStr, err := cmd.Flags().GetString(FlagAllowlistEndTime)
+	if err != nil {
+		return types.AllowlistPhase{}, err
+	}
+	endTime, err := parseTime(endTimeStr)
+	if err != nil {
+		return types.AllowlistPhase{}, fmt.Errorf("invalid allowlist end time: %w", err)
+	}
+
+	maxAmountStr, err := cmd.Flags().GetString(FlagAllowlistMaxAmount)
+	if err != nil {
+		return types.AllowlistPhase{}, err
+	}
+	maxAmount, ok := math.NewIntFromString(maxAmountStr)
+	if !ok {
+		return types.AllowlistPhase{}, fmt.Errorf("invalid allowlist max amount: %s", maxAmountStr)
+	}
+
+	if merkleRootStr == "" {
+		return types.NewAddressesAllowlistPhase(addresses, endTime, maxAmount), nil
+	}
+	merkleRoot, err := hex.DecodeString(merkleRootStr)
+	if err != nil {
+		return types.AllowlistPhase{}, fmt.Errorf("invalid allowlist merkle root: %w", err)
+	}
+	phase := types.NewMerkleAllowlistPhase(merkleRoot, endTime, maxAmount)
+	phase.Addresses = addresses // rejected on validation if both are set
+	return phase, nil
+}
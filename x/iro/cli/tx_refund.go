@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func CmdRefund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund [plan-id]",
+		Short: "Refund tokens for the raised liquidity after the plan is cancelled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			planID := args[0]
+
+			msg := types.MsgRefund{
+				Refunder: clientCtx.GetFromAddress().String(),
+				PlanId:   planID,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -56,11 +57,17 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 
 			var msg sdk.Msg
 			if isBuy {
+				proof, err := parseAllowlistProof(cmd)
+				if err != nil {
+					return err
+				}
+
 				msg = &types.MsgBuy{
-					Buyer:         clientCtx.GetFromAddress().String(),
-					PlanId:        planID,
-					Amount:        amount,
-					MaxCostAmount: expectedAmount,
+					Buyer:          clientCtx.GetFromAddress().String(),
+					PlanId:         planID,
+					Amount:         amount,
+					MaxCostAmount:  expectedAmount,
+					AllowlistProof: proof,
 				}
 			} else {
 				msg = &types.MsgSell{
@@ -79,6 +86,26 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 		},
 	}
 
+	if isBuy {
+		cmd.Flags().StringSlice(FlagAllowlistProof, nil, "The hex encoded merkle proof of the buyer in the allowlist, separated by commas.")
+	}
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// parseAllowlistProof parses the hex encoded nodes of the allowlist proof flag
+func parseAllowlistProof(cmd *cobra.Command) ([][]byte, error) {
+	nodes, err := cmd.Flags().GetStringSlice(FlagAllowlistProof)
+	if err != nil {
+		return nil, err
+	}
+	var proof [][]byte
+	for _, node := range nodes {
+		bz, err := hex.DecodeString(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowlist proof node: %s: %w", node, err)
+		}
+		proof = append(proof, bz)
+	}
+	return proof, nil
+}
@@ -27,6 +27,33 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 	k.SetLastPlanId(ctx, lastPlanId)
+
+	for _, vesting := range genState.Vestings {
+		k.SetClaimerVesting(ctx, vesting)
+	}
+
+	for _, purchase := range genState.Purchases {
+		k.SetPurchase(ctx, purchase)
+	}
+
+	// the next trade sequence of each plan follows its latest trade
+	for _, trade := range genState.Trades {
+		k.SetTrade(ctx, trade)
+		if trade.Seq >= k.GetTradeCount(ctx, trade.PlanId) {
+			k.SetTradeCount(ctx, trade.PlanId, trade.Seq+1)
+		}
+	}
+
+	for _, candle := range genState.Candles {
+		k.SetCandle(ctx, candle)
+	}
+
+	// the pruning of the candles of the settled or cancelled plans is rescheduled from the genesis time
+	for _, plan := range genState.Plans {
+		if plan.IsSettled() || plan.IsCancelled() {
+			k.PruneCandles(ctx, fmt.Sprintf("%d", plan.Id))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -34,6 +61,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.GenesisState{}
 	genesis.Params = k.GetParams(ctx)
 	genesis.Plans = append(genesis.Plans, k.GetAllPlans(ctx, false)...)
+	genesis.Vestings = append(genesis.Vestings, k.GetAllClaimerVestings(ctx)...)
+	genesis.Purchases = append(genesis.Purchases, k.GetAllPurchases(ctx)...)
+	genesis.Trades = append(genesis.Trades, k.GetAllTrades(ctx)...)
+	genesis.Candles = append(genesis.Candles, k.GetAllCandles(ctx)...)
 
 	return &genesis
 }
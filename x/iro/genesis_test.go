@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/iro"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
@@ -16,8 +17,8 @@ import (
 var (
 	amt   = sdk.NewCoin("foo", math.NewInt(100))
 	plans = []types.Plan{
-		types.NewPlan(1, "rollapp1", amt, types.DefaultBondingCurve(), time.Time{}, time.Time{}, types.DefaultIncentivePlanParams()),
-		types.NewPlan(2, "rollapp2", amt, types.DefaultBondingCurve(), time.Time{}, time.Time{}, types.DefaultIncentivePlanParams()),
+		types.NewPlan(1, "rollapp1", amt, types.DefaultBondingCurve(), time.Time{}, time.Time{}, types.DefaultIncentivePlanParams(), appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt()),
+		types.NewPlan(2, "rollapp2", amt, types.DefaultBondingCurve(), time.Time{}, time.Time{}, types.DefaultIncentivePlanParams(), appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt()),
 	}
 )
 
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// CancelExpiredPlans cancels the plans which were not settled within the maximum settlement duration since their pre-launch time
+// A plan failing to be cancelled is skipped, and retried on the next block
+func (k Keeper) CancelExpiredPlans(ctx sdk.Context) {
+	maxSettlementDuration := k.GetParams(ctx).MaxSettlementDuration
+	if maxSettlementDuration == 0 {
+		return
+	}
+
+	// only the plans whose settlement expired are iterated
+	expiredPreLaunchTime := ctx.BlockTime().Add(-maxSettlementDuration)
+	for _, plan := range k.GetUnsettledPlansPreLaunchedBefore(ctx, expiredPreLaunchTime) {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.CancelPlan(ctx, plan)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Cancel expired plan.", "planId", plan.Id, "err", err)
+		}
+	}
+}
+
+// CancelPlan cancels the plan, as it was not settled in time
+//
+// This function performs the following steps:
+// - Burns the unsold IRO tokens in the module account.
+// - Marks the plan as cancelled, stopping the trading and allowing holders to refund their IRO tokens.
+// - Prunes the old candles of the plan.
+// - Marks the IRO of the rollapp as failed.
+func (k Keeper) CancelPlan(ctx sdk.Context, plan types.Plan) error {
+	// burn all the remaining IRO token. The sold tokens are burnt on refund
+	iroTokenBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.TotalAllocation.Denom)
+	err := k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(iroTokenBalance))
+	if err != nil {
+		return err
+	}
+
+	plan.Cancelled = true
+	k.SetPlan(ctx, plan)
+	k.PruneCandles(ctx, fmt.Sprintf("%d", plan.Id))
+
+	err = k.rk.MarkIROFailed(ctx, plan.RollappId)
+	if err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventCancel{
+		PlanId:    fmt.Sprintf("%d", plan.Id),
+		RollappId: plan.RollappId,
+	})
+}
+
+// ReturnCancelledAllocation returns the RA tokens allocated to a cancelled plan to the rollapp owner
+// The tokens are received on the genesis transfer, after the IRO holders were refunded with the raised liquidity
+func (k Keeper) ReturnCancelledAllocation(ctx sdk.Context, plan types.Plan, rollappIBCDenom string) error {
+	if !plan.IsCancelled() {
+		return errorsmod.Wrapf(errors.Join(gerrc.ErrInternal, types.ErrPlanNotCancelled), "planId: %d", plan.Id)
+	}
+
+	// funds expected as it's validated in the genesis transfer handler
+	allocation := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), rollappIBCDenom)
+	if !allocation.Amount.Equal(plan.TotalAllocation.Amount) {
+		return errorsmod.Wrapf(gerrc.ErrInternal, "required: %s, available: %s", plan.TotalAllocation.String(), allocation.String())
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	err := k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(allocation))
+	if err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventReturnAllocation{
+		PlanId:     fmt.Sprintf("%d", plan.Id),
+		RollappId:  plan.RollappId,
+		Owner:      owner.String(),
+		Allocation: allocation,
+	})
+}
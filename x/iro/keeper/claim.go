@@ -2,7 +2,10 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
@@ -23,7 +26,9 @@ func (m msgServer) Claim(ctx context.Context, req *types.MsgClaim) (*types.MsgCl
 // Claim claims the FUT token for the real RA token
 //
 // This function allows a user to claim their RA tokens by burning their FUT tokens.
-// It burns *all* the FUT tokens the claimer has, and sends the equivalent amount of RA tokens to the claimer.
+// It burns *all* the FUT tokens the claimer has, and adds them to the claimer's vesting.
+// The vested amount which is not claimed yet is sent to the claimer in RA tokens.
+// If the plan has no vesting plan, all the burnt amount is sent at once.
 func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) error {
 	plan, found := k.GetPlan(ctx, planId)
 	if !found {
@@ -34,37 +39,59 @@ func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) er
 		return types.ErrPlanNotSettled
 	}
 
+	vesting, found := k.GetClaimerVesting(ctx, planId, claimer)
+	if !found {
+		vesting = types.NewClaimerVesting(planId, claimer)
+	}
+
 	availableTokens := k.BK.GetBalance(ctx, claimer, plan.TotalAllocation.Denom)
-	if availableTokens.IsZero() {
+	if availableTokens.IsZero() && vesting.Unreleased().IsZero() {
 		return types.ErrNoTokensToClaim
 	}
 
 	// Burn all the FUT tokens the user have
-	err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
-	if err != nil {
-		return err
+	if availableTokens.IsPositive() {
+		err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
+		if err != nil {
+			return err
+		}
+		err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+		if err != nil {
+			return err
+		}
+		vesting.Total = vesting.Total.Add(availableTokens.Amount)
 	}
-	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
-	if err != nil {
-		return err
+
+	// The burnt FUT tokens are vested according to the vesting plan
+	claimable := plan.VestingPlan.VestedAmount(vesting.Total, ctx.BlockTime()).Sub(vesting.Claimed)
+	if claimable.IsZero() && availableTokens.IsZero() {
+		return errorsmod.Wrap(types.ErrNoTokensToClaim, "no vested tokens")
 	}
 
-	// Give the user the RA token in return (same amount as the FUT token)
-	err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, sdk.NewCoins(sdk.NewCoin(plan.SettledDenom, availableTokens.Amount)))
-	if err != nil {
-		return err
+	// Give the user the RA token in return (same amount as the vested FUT token)
+	if claimable.IsPositive() {
+		err := k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, sdk.NewCoins(sdk.NewCoin(plan.SettledDenom, claimable)))
+		if err != nil {
+			return err
+		}
+	}
+	vesting.Claimed = vesting.Claimed.Add(claimable)
+
+	// Track the claims of the claimer if vested over time
+	if plan.VestingPlan.IsVesting() {
+		k.SetClaimerVesting(ctx, vesting)
 	}
 
 	// Update the plan
-	plan.ClaimedAmt = plan.ClaimedAmt.Add(availableTokens.Amount)
+	plan.ClaimedAmt = plan.ClaimedAmt.Add(claimable)
 	k.SetPlan(ctx, plan)
 
 	// Emit event
-	err = uevent.EmitTypedEvent(ctx, &types.EventClaim{
+	err := uevent.EmitTypedEvent(ctx, &types.EventClaim{
 		Claimer:   claimer.String(),
 		PlanId:    planId,
 		RollappId: plan.RollappId,
-		Amount:    availableTokens.Amount,
+		Amount:    claimable,
 		Denom:     plan.SettledDenom,
 	})
 	if err != nil {
@@ -73,3 +100,20 @@ func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) er
 
 	return nil
 }
+
+// ClaimerVestingStatus returns the vesting claims of a claimer, including the FUT tokens not claimed yet, and the vested amount.
+// Nothing is vested before the plan is settled.
+func (k Keeper) ClaimerVestingStatus(ctx sdk.Context, plan types.Plan, claimer sdk.AccAddress) (vesting types.ClaimerVesting, vested math.Int) {
+	planId := fmt.Sprintf("%d", plan.Id)
+	vesting, found := k.GetClaimerVesting(ctx, planId, claimer)
+	if !found {
+		vesting = types.NewClaimerVesting(planId, claimer)
+	}
+	availableTokens := k.BK.GetBalance(ctx, claimer, plan.TotalAllocation.Denom)
+	vesting.Total = vesting.Total.Add(availableTokens.Amount)
+
+	if !plan.IsSettled() {
+		return vesting, math.ZeroInt()
+	}
+	return vesting, plan.VestingPlan.VestedAmount(vesting.Total, ctx.BlockTime())
+}
@@ -3,8 +3,11 @@ package keeper_test
 import (
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	keeper "github.com/dymensionxyz/dymension/v3/x/iro/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -19,7 +22,7 @@ func (s *KeeperTestSuite) TestClaim() {
 	amt := sdk.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, amt, startTime, startTime.Add(time.Hour), rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, amt, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 	planDenom := k.MustGetPlan(s.Ctx, planId).TotalAllocation.Denom
 	balance := s.App.BankKeeper.GetBalance(s.Ctx, k.AK.GetModuleAddress(types.ModuleName), planDenom)
@@ -54,3 +57,94 @@ func (s *KeeperTestSuite) TestClaim() {
 	balance = s.App.BankKeeper.GetBalance(s.Ctx, claimer, rollappDenom)
 	s.Require().Equal(soldAmt, balance.Amount)
 }
+
+func (s *KeeperTestSuite) TestClaimVesting() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	vestingPlan := types.NewVestingPlan(time.Hour, 10*time.Hour)
+	rollappDenom := "dasdasdasdasdsa"
+
+	startTime := time.Now()
+	amt := sdk.NewInt(1_000_000).MulRaw(1e18)
+
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+
+	// the cliff and the duration are capped by the params
+	params := k.GetParams(s.Ctx)
+	params.MaxVestingCliff = 30 * time.Minute
+	params.MaxVestingDuration = 10 * time.Hour
+	k.SetParams(s.Ctx, params)
+	_, err := k.CreatePlan(s.Ctx, amt, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, vestingPlan, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrInvalidVestingPlan)
+	params.MaxVestingCliff = time.Hour
+	params.MaxVestingDuration = 5 * time.Hour
+	k.SetParams(s.Ctx, params)
+	_, err = k.CreatePlan(s.Ctx, amt, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, vestingPlan, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrInvalidVestingPlan)
+	params.MaxVestingDuration = 10 * time.Hour
+	k.SetParams(s.Ctx, params)
+
+	planId, err := k.CreatePlan(s.Ctx, amt, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, vestingPlan, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().NoError(err)
+
+	claimer := sample.Acc()
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	soldAmt := sdk.NewInt(1_000).MulRaw(1e18)
+	s.BuySomeTokens(planId, claimer, soldAmt)
+
+	// settle, the vesting starts
+	settleTime := startTime.Add(2 * time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(settleTime)
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, amt)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	s.Require().True(settleTime.Equal(k.MustGetPlan(s.Ctx, planId).VestingPlan.StartTime))
+
+	// nothing is vested before the cliff, the IRO tokens are burnt into the vesting
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, claimer, rollappDenom).IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, claimer, types.IRODenom(rollappId)).IsZero())
+
+	vesting, found := k.GetClaimerVesting(s.Ctx, planId, claimer)
+	s.Require().True(found)
+	s.Require().Equal(soldAmt, vesting.Total)
+	s.Require().True(vesting.Claimed.IsZero())
+
+	// claim again should fail as nothing is vested
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().ErrorIs(err, types.ErrNoTokensToClaim)
+
+	// half way through the linear vesting
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(6 * time.Hour))
+	res, err := k.QueryVesting(s.Ctx, &types.QueryVestingRequest{PlanId: planId, Claimer: claimer.String()})
+	s.Require().NoError(err)
+	half := soldAmt.QuoRaw(2)
+	s.Require().Equal(half, res.Vested)
+	s.Require().Equal(half, res.Unvested)
+	s.Require().True(res.Claimed.IsZero())
+	s.Require().Equal(half, res.Claimable)
+
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().NoError(err)
+	s.Require().Equal(half, s.App.BankKeeper.GetBalance(s.Ctx, claimer, rollappDenom).Amount)
+
+	err = keeper.InvariantVesting(*k)(s.Ctx)
+	s.Require().NoError(err)
+
+	// all vested after the duration
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(12 * time.Hour))
+	err = k.Claim(s.Ctx, planId, claimer)
+	s.Require().NoError(err)
+	s.Require().Equal(soldAmt, s.App.BankKeeper.GetBalance(s.Ctx, claimer, rollappDenom).Amount)
+
+	vesting, _ = k.GetClaimerVesting(s.Ctx, planId, claimer)
+	s.Require().Equal(soldAmt, vesting.Claimed)
+
+	err = keeper.InvariantVesting(*k)(s.Ctx)
+	s.Require().NoError(err)
+	err = keeper.InvariantAccounting(*k)(s.Ctx)
+	s.Require().NoError(err)
+}
@@ -28,6 +28,7 @@ import (
 // - The rollapp PreLaunchTime must be in the future
 // - The plan duration must be at least the minimum duration set in the module params
 // - The incentive plan params must be valid and meet the minimum requirements set in the module params
+// - The liquidity denom must be whitelisted in the module params, DYM is used if not set
 func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (*types.MsgCreatePlanResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -59,6 +60,11 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 		return nil, errors.Join(gerrc.ErrFailedPrecondition, errorsmod.Wrap(types.ErrInvalidIncentivePlanParams, "start time after settlement"))
 	}
 
+	// a rollapp whose IRO failed can't get another one
+	if rollapp.IroFailed {
+		return nil, rollapptypes.ErrIROFailed
+	}
+
 	// Check if the plan already exists
 	_, found = m.Keeper.GetPlanByRollapp(ctx, rollapp.RollappId)
 	if found {
@@ -79,7 +85,12 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no genesis account for iro module account")
 	}
 
-	planId, err := m.Keeper.CreatePlan(ctx, req.AllocatedAmount, startTime, preLaunchTime, rollapp, req.BondingCurve, req.IncentivePlanParams)
+	liquidityDenom := req.LiquidityDenom
+	if liquidityDenom == "" {
+		liquidityDenom = appparams.BaseDenom
+	}
+
+	planId, err := m.Keeper.CreatePlan(ctx, req.AllocatedAmount, startTime, preLaunchTime, rollapp, req.BondingCurve, req.IncentivePlanParams, liquidityDenom, req.VestingPlan, req.AllowlistPhase, req.MaxAmountPerAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -93,17 +104,27 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 // This function performs the following steps:
 // 1. Sets the IRO plan to the rollapp with the specified pre-launch time.
 // 2. Mints the allocated amount of tokens for the rollapp.
-// 3. Creates a new plan with the provided parameters and validates it.
+// 3. Creates a new plan with the provided parameters, quoted in the whitelisted liquidity denom, and validates it.
 // 4. Creates a new module account for the IRO plan.
 // 5. Charges the creation fee from the rollapp owner to the plan's module account.
 // 6. Stores the plan in the keeper.
-func (k Keeper) CreatePlan(ctx sdk.Context, allocatedAmount math.Int, start, preLaunchTime time.Time, rollapp rollapptypes.Rollapp, curve types.BondingCurve, incentivesParams types.IncentivePlanParams) (string, error) {
+func (k Keeper) CreatePlan(ctx sdk.Context, allocatedAmount math.Int, start, preLaunchTime time.Time, rollapp rollapptypes.Rollapp, curve types.BondingCurve, incentivesParams types.IncentivePlanParams, liquidityDenom string, vestingPlan types.VestingPlan, allowlistPhase types.AllowlistPhase, maxAmountPerAddress math.Int) (string, error) {
+	liquidity, found := k.GetParams(ctx).GetLiquidityDenom(liquidityDenom)
+	if !found {
+		return "", errors.Join(gerrc.ErrFailedPrecondition, errorsmod.Wrapf(types.ErrInvalidLiquidityDenom, "not whitelisted: %s", liquidityDenom))
+	}
+	curve.LiquidityDenomDecimals = liquidity.Exponent
+
+	if err := k.GetParams(ctx).ValidateVestingPlan(vestingPlan); err != nil {
+		return "", errors.Join(gerrc.ErrFailedPrecondition, errorsmod.Wrap(types.ErrInvalidVestingPlan, err.Error()))
+	}
+
 	allocation, err := k.MintAllocation(ctx, allocatedAmount, rollapp.RollappId, rollapp.GenesisInfo.NativeDenom.Display, uint64(rollapp.GenesisInfo.NativeDenom.Exponent))
 	if err != nil {
 		return "", err
 	}
 
-	plan := types.NewPlan(k.GetNextPlanIdAndIncrement(ctx), rollapp.RollappId, allocation, curve, start, preLaunchTime, incentivesParams)
+	plan := types.NewPlan(k.GetNextPlanIdAndIncrement(ctx), rollapp.RollappId, allocation, curve, start, preLaunchTime, incentivesParams, liquidity.Denom, types.NewVestingPlan(vestingPlan.Cliff, vestingPlan.Duration), allowlistPhase, maxAmountPerAddress)
 	if err := plan.ValidateBasic(); err != nil {
 		return "", errors.Join(gerrc.ErrInvalidArgument, err)
 	}
@@ -126,8 +147,8 @@ func (k Keeper) CreatePlan(ctx sdk.Context, allocatedAmount math.Int, start, pre
 		return "", errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid cost for fee charge")
 	}
 
-	feeCostInDym := sdk.NewCoin(appparams.BaseDenom, cost)
-	err = k.BK.SendCoins(ctx, sdk.MustAccAddressFromBech32(rollapp.Owner), plan.GetAddress(), sdk.NewCoins(feeCostInDym))
+	feeCost := sdk.NewCoin(plan.LiquidityDenom, cost)
+	err = k.BK.SendCoins(ctx, sdk.MustAccAddressFromBech32(rollapp.Owner), plan.GetAddress(), sdk.NewCoins(feeCost))
 	if err != nil {
 		return "", err
 	}
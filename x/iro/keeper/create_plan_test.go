@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -24,7 +25,7 @@ func (s *KeeperTestSuite) TestValidateRollappPreconditions() {
 		rollapp.GenesisInfo.GenesisChecksum = ""
 		s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
 
-		_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives)
+		_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 		s.Require().Error(err)
 	})
 
@@ -38,7 +39,7 @@ func (s *KeeperTestSuite) TestValidateRollappPreconditions() {
 		rollapp.Launched = true
 		s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
 
-		_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives)
+		_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 		s.Require().Error(err)
 	})
 
@@ -52,7 +53,7 @@ func (s *KeeperTestSuite) TestValidateRollappPreconditions() {
 		rollapp.Launched = false
 		s.App.RollappKeeper.SetRollapp(s.Ctx, rollapp)
 
-		_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives)
+		_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 		s.Require().NoError(err)
 	})
 }
@@ -67,16 +68,16 @@ func (s *KeeperTestSuite) TestCreatePlan() {
 	allocation := sdk.NewInt(100).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 
 	// creating a a plan for same rollapp should fail
-	_, err = k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives)
+	_, err = k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().Error(err)
 
 	// create plan for different rollappID. test last planId increases
 	rollapp2, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId2)
-	planId2, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp2, curve, incentives)
+	planId2, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp2, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 	s.Require().Greater(planId2, planId)
 
@@ -132,3 +133,34 @@ func (s *KeeperTestSuite) TestMintAllocation() {
 	coins := s.App.BankKeeper.GetSupply(s.Ctx, expectedBaseDenom)
 	s.Require().Equal(allocatedAmount, coins.Amount)
 }
+
+func (s *KeeperTestSuite) TestCreatePlanLiquidityDenom() {
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+	allocation := sdk.NewInt(100).MulRaw(1e18)
+	k := s.App.IROKeeper
+
+	// not whitelisted
+	rollappId := s.CreateDefaultRollapp()
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	_, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, "uusdc", types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidityDenom)
+
+	// whitelisted
+	params := k.GetParams(s.Ctx)
+	params.LiquidityDenoms = append(params.LiquidityDenoms, types.LiquidityDenom{Denom: "uusdc", Exponent: 6})
+	k.SetParams(s.Ctx, params)
+
+	s.FundAcc(sdk.MustAccAddressFromBech32(rollapp.Owner), sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1_000_000))))
+	planId, err := k.CreatePlan(s.Ctx, allocation, time.Now(), time.Now().Add(time.Hour), rollapp, curve, incentives, "uusdc", types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().NoError(err)
+
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal("uusdc", plan.LiquidityDenom)
+	s.Require().Equal(int64(6), plan.BondingCurve.LiquidityDecimals())
+
+	// creation fee of 1 token is charged in the liquidity denom with its exponent
+	// cost = 0.005 / 2 * 1^2 = 0.0025 USDC
+	balance := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "uusdc")
+	s.Require().Equal(math.NewInt(2500), balance.Amount)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/utils/uinv"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
@@ -13,6 +14,7 @@ import (
 var invs = uinv.NamedFuncsList[Keeper]{
 	{Name: "plan", Func: InvariantPlan},
 	{Name: "accounting", Func: InvariantAccounting},
+	{Name: "vesting", Func: InvariantVesting},
 }
 
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
@@ -97,6 +99,53 @@ func InvariantAccounting(k Keeper) uinv.Func {
 						plan.Id, claimable, moduleBal.Amount))
 				}
 			}
+
+			if plan.IsCancelled() {
+				// unsold IRO tokens are burnt on cancellation, and the sold ones on refund
+				iroBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom())
+				if !iroBalance.IsZero() {
+					errs = append(errs, fmt.Errorf("iro tokens left in module, cancelled: planID: %d, balance: %s", plan.Id, iroBalance))
+				}
+			}
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+// the vesting claims should be valid and covered by the claimable amount of their plan
+func InvariantVesting(k Keeper) uinv.Func {
+	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
+		plans := k.GetAllPlans(ctx, false)
+		var errs []error
+
+		for _, plan := range plans {
+			planId := fmt.Sprintf("%d", plan.Id)
+			vestings := k.GetClaimerVestingsByPlan(ctx, planId)
+			if len(vestings) == 0 {
+				continue
+			}
+
+			if !plan.IsSettled() {
+				errs = append(errs, fmt.Errorf("vesting claims for non settled plan: planID: %d", plan.Id))
+				continue
+			}
+
+			unreleased := math.ZeroInt()
+			for _, vesting := range vestings {
+				if err := vesting.ValidateBasic(); err != nil {
+					errs = append(errs, fmt.Errorf("vesting validate basic: planID: %d, claimer: %s, err: %w", plan.Id, vesting.Claimer, err))
+					continue
+				}
+				unreleased = unreleased.Add(vesting.Unreleased())
+			}
+
+			// the unreleased vesting claims are part of the claimable amount, kept in the module account
+			claimable := plan.SoldAmt.Sub(plan.ClaimedAmt)
+			if claimable.LT(unreleased) {
+				errs = append(errs, fmt.Errorf("unreleased vesting claims greater than claimable amount: planID: %d, unreleased: %s, claimable: %s",
+					plan.Id, unreleased, claimable))
+			}
 		}
 
 		return errors.Join(errs...)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,14 @@ func (k Keeper) SetPlan(ctx sdk.Context, plan types.Plan) {
 	planByRollappKey := types.PlansByRollappKey(plan.RollappId)
 	// Store the plan ID instead of the plan itself
 	store.Set(planByRollappKey, []byte(fmt.Sprintf("%d", plan.Id)))
+
+	// Index the plan by its pre-launch time until it's settled or cancelled
+	unsettledPlanKey := types.UnsettledPlanKey(plan.PreLaunchTime, fmt.Sprintf("%d", plan.Id))
+	if plan.IsSettled() || plan.IsCancelled() {
+		store.Delete(unsettledPlanKey)
+	} else {
+		store.Set(unsettledPlanKey, []byte(fmt.Sprintf("%d", plan.Id)))
+	}
 }
 
 // GetPlan returns a plan from its index
@@ -75,7 +84,7 @@ func (k Keeper) GetAllPlans(ctx sdk.Context, tradableOnly bool) (list []types.Pl
 	for ; iterator.Valid(); iterator.Next() {
 		var val types.Plan
 		k.cdc.MustUnmarshal(iterator.Value(), &val)
-		if tradableOnly && (val.IsSettled() || val.StartTime.After(ctx.BlockTime())) {
+		if tradableOnly && (val.IsSettled() || val.IsCancelled() || val.StartTime.After(ctx.BlockTime())) {
 			continue
 		}
 		list = append(list, val)
@@ -130,3 +139,24 @@ func (k Keeper) GetNextPlanIdAndIncrement(ctx sdk.Context) uint64 {
 	k.SetLastPlanId(ctx, lastPlanId+1)
 	return lastPlanId + 1
 }
+
+// GetUnsettledPlansPreLaunchedBefore returns the plans which are neither settled nor cancelled,
+// and whose pre-launch time is before or at the given time, sorted by their pre-launch time
+func (k Keeper) GetUnsettledPlansPreLaunchedBefore(ctx sdk.Context, t time.Time) (list []types.Plan) {
+	store := ctx.KVStore(k.storeKey)
+	start := types.UnsettledPlanKeyPrefix
+	// the end is exclusive, so the plans of the whole second of the given time are included
+	end := types.UnsettledPlansByPreLaunchTimeKey(time.Unix(t.Unix()+1, 0))
+	iterator := store.Iterator(start, end)
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		plan := k.MustGetPlan(ctx, string(iterator.Value()))
+		if plan.PreLaunchTime.After(t) {
+			continue
+		}
+		list = append(list, plan)
+	}
+	return list
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetPurchase sets the amount bought by a buyer in the store
+func (k Keeper) SetPurchase(ctx sdk.Context, purchase types.Purchase) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&purchase)
+	store.Set(types.PurchaseKey(purchase.PlanId, purchase.Buyer), b)
+}
+
+// GetPurchasedAmount returns the amount bought by a buyer in a plan, zero if nothing was bought
+func (k Keeper) GetPurchasedAmount(ctx sdk.Context, planId string, buyer sdk.AccAddress) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PurchaseKey(planId, buyer.String()))
+	if b == nil {
+		return math.ZeroInt()
+	}
+
+	var val types.Purchase
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Amount
+}
+
+// GetAllPurchases returns the purchases of all the plans
+func (k Keeper) GetAllPurchases(ctx sdk.Context) (list []types.Purchase) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PurchaseKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Purchase
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// validatePurchase checks the buyer is allowed to buy the amount of tokens from the plan
+// - during the allowlist phase, the buyer must be allowlisted
+// - the total amount bought by the buyer must not exceed the maximum amount per address
+func (k Keeper) validatePurchase(ctx sdk.Context, plan types.Plan, buyer sdk.AccAddress, amount math.Int, allowlistProof [][]byte) error {
+	if plan.AllowlistPhase.IsActive(ctx.BlockTime()) && !plan.AllowlistPhase.IsAllowlisted(buyer.String(), allowlistProof) {
+		return errorsmod.Wrapf(types.ErrNotAllowlisted, "planId: %d, buyer: %s", plan.Id, buyer)
+	}
+
+	maxAmt, limited := plan.MaxPurchaseAmount(ctx.BlockTime())
+	if !limited {
+		return nil
+	}
+	purchased := k.GetPurchasedAmount(ctx, fmt.Sprintf("%d", plan.Id), buyer)
+	if purchased.Add(amount).GT(maxAmt) {
+		return errorsmod.Wrapf(types.ErrPurchaseLimitExceeded, "max: %s, purchased: %s, amount: %s", maxAmt, purchased, amount)
+	}
+	return nil
+}
+
+// addPurchase adds the amount to the tokens bought by the buyer, if the plan limits the purchases
+func (k Keeper) addPurchase(ctx sdk.Context, plan types.Plan, buyer sdk.AccAddress, amount math.Int) {
+	if !plan.HasPurchaseLimits() {
+		return
+	}
+	planId := fmt.Sprintf("%d", plan.Id)
+	purchased := k.GetPurchasedAmount(ctx, planId, buyer)
+	k.SetPurchase(ctx, types.NewPurchase(planId, buyer, purchased.Add(amount)))
+}
+
+// Allowance returns the remaining amount of tokens an address can buy from the plan, if limited
+func (k Keeper) Allowance(ctx sdk.Context, plan types.Plan, address sdk.AccAddress) (purchased, remaining math.Int, limited bool) {
+	purchased = k.GetPurchasedAmount(ctx, fmt.Sprintf("%d", plan.Id), address)
+	maxAmt, limited := plan.MaxPurchaseAmount(ctx.BlockTime())
+	if !limited {
+		return purchased, math.ZeroInt(), false
+	}
+	if purchased.GTE(maxAmt) {
+		return purchased, math.ZeroInt(), true
+	}
+	return purchased, maxAmt.Sub(purchased), true
+}
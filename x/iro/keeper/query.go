@@ -2,13 +2,13 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -61,7 +61,7 @@ func (k Keeper) QueryCost(goCtx context.Context, req *types.QueryCostRequest) (*
 	} else {
 		costAmt = plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Add(req.Amt))
 	}
-	cost := sdk.NewCoin(appparams.BaseDenom, costAmt)
+	cost := sdk.NewCoin(plan.LiquidityDenom, costAmt)
 	return &types.QueryCostResponse{Cost: &cost}, nil
 }
 
@@ -86,6 +86,60 @@ func (k Keeper) QueryTokensForDYM(goCtx context.Context, req *types.QueryTokensF
 	return &types.QueryTokensForDYMResponse{Tokens: &tokens}, nil
 }
 
+// QueryVesting implements types.QueryServer.
+func (k Keeper) QueryVesting(goCtx context.Context, req *types.QueryVestingRequest) (*types.QueryVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimer, err := sdk.AccAddressFromBech32(req.Claimer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid claimer address")
+	}
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	vesting, vested := k.ClaimerVestingStatus(ctx, plan, claimer)
+	return &types.QueryVestingResponse{
+		Vested:    vested,
+		Unvested:  vesting.Total.Sub(vested),
+		Claimed:   vesting.Claimed,
+		Claimable: vested.Sub(vesting.Claimed),
+	}, nil
+}
+
+// QueryAllowance implements types.QueryServer.
+func (k Keeper) QueryAllowance(goCtx context.Context, req *types.QueryAllowanceRequest) (*types.QueryAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	purchased, remaining, limited := k.Allowance(ctx, plan, address)
+	return &types.QueryAllowanceResponse{
+		AllowlistPhase: plan.AllowlistPhase.IsActive(ctx.BlockTime()),
+		Allowlisted:    plan.AllowlistPhase.IsAllowlisted(req.Address, req.AllowlistProof),
+		Purchased:      purchased,
+		Remaining:      remaining,
+		Unlimited:      !limited,
+		RequiresProof:  len(plan.AllowlistPhase.MerkleRoot) > 0 && len(req.AllowlistProof) == 0,
+	}, nil
+}
+
 // QueryPlan implements types.QueryServer.
 func (k Keeper) QueryPlan(goCtx context.Context, req *types.QueryPlanRequest) (*types.QueryPlanResponse, error) {
 	if req == nil {
@@ -146,3 +200,43 @@ func (k Keeper) QuerySpotPrice(goCtx context.Context, req *types.QuerySpotPriceR
 		Price: plan.SpotPrice(),
 	}, nil
 }
+
+// QueryTrades implements types.QueryServer.
+func (k Keeper) QueryTrades(goCtx context.Context, req *types.QueryTradesRequest) (*types.QueryTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	return &types.QueryTradesResponse{Trades: k.GetTrades(ctx, req.PlanId)}, nil
+}
+
+// QueryCandles implements types.QueryServer.
+func (k Keeper) QueryCandles(goCtx context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	interval := time.Duration(req.IntervalSeconds) * time.Second
+	if !types.IsCandleInterval(interval) {
+		return nil, status.Error(codes.InvalidArgument, "invalid candle interval")
+	}
+
+	_, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	candles, pageRes, err := k.GetCandlesPaginated(ctx, req.PlanId, interval, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
@@ -2,11 +2,15 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	testkeeper "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -20,3 +24,47 @@ func TestParamsQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
 }
+
+func (s *KeeperTestSuite) TestQueryBondingCurveFamilies() {
+	k := s.App.IROKeeper
+	incentives := types.DefaultIncentivePlanParams()
+	startTime := time.Now()
+	totalAllocation := math.NewInt(1_000_000).MulRaw(1e18)
+
+	curves := []types.BondingCurve{
+		types.DefaultBondingCurve(),
+		types.NewBondingCurveFromFamily(types.NewLogarithmicCurve(math.LegacyMustNewDecFromStr("0.1"), math.LegacyNewDec(1000), math.LegacyMustNewDecFromStr("0.01"))),
+		types.NewBondingCurveFromFamily(types.NewCappedExponentialCurve(math.LegacyMustNewDecFromStr("0.01"), math.LegacyMustNewDecFromStr("0.0001"), math.LegacyNewDec(1))),
+		types.NewBondingCurveFromFamily(types.NewSigmoidCurve(math.LegacyNewDec(1), math.LegacyMustNewDecFromStr("0.0001"), math.LegacyNewDec(50_000), math.LegacyMustNewDecFromStr("0.01"))),
+		types.NewBondingCurveFromFamily(types.NewPiecewiseLinearCurve(
+			types.NewPricePoint(math.LegacyZeroDec(), math.LegacyMustNewDecFromStr("0.01")),
+			types.NewPricePoint(math.LegacyNewDec(100_000), math.LegacyMustNewDecFromStr("0.5")),
+		)),
+	}
+	for _, curve := range curves {
+		rollappId := s.CreateDefaultRollapp()
+		rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+		planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
+		s.Require().NoError(err, curve.Stringify())
+		ctx := s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+		buyer := sample.Acc()
+		s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", math.NewInt(1_000_000).MulRaw(1e18))))
+		buyAmt := math.NewInt(10_000).MulRaw(1e18)
+		err = k.Buy(ctx, planId, buyer, buyAmt, math.NewInt(1_000_000).MulRaw(1e18), nil)
+		s.Require().NoError(err, curve.Stringify())
+
+		price, err := k.QuerySpotPrice(ctx, &types.QuerySpotPriceRequest{PlanId: planId})
+		s.Require().NoError(err)
+		s.Require().True(price.Price.IsPositive(), curve.Stringify())
+
+		cost, err := k.QueryCost(ctx, &types.QueryCostRequest{PlanId: planId, Amt: buyAmt})
+		s.Require().NoError(err)
+		s.Require().True(cost.Cost.Amount.IsPositive(), curve.Stringify())
+
+		// the tokens for the cost of an amount are that amount
+		tokens, err := k.QueryTokensForDYM(ctx, &types.QueryTokensForDYMRequest{PlanId: planId, Amt: cost.Cost.Amount})
+		s.Require().NoError(err)
+		s.Require().True(tokens.Tokens.Amount.Sub(buyAmt).Abs().LT(math.NewInt(1e12)), "%s: %s", curve.Stringify(), tokens.Tokens)
+	}
+}
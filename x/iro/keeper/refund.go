@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// Refund implements types.MsgServer.
+func (m msgServer) Refund(ctx context.Context, req *types.MsgRefund) (*types.MsgRefundResponse, error) {
+	refunderAddr := sdk.MustAccAddressFromBech32(req.Refunder)
+	err := m.Keeper.Refund(sdk.UnwrapSDKContext(ctx), req.PlanId, refunderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundResponse{}, nil
+}
+
+// Refund refunds the IRO tokens of a cancelled plan
+//
+// This function burns *all* the IRO tokens the refunder has, and sends a pro-rata share of the raised liquidity in return.
+// The refunded tokens are tracked as claimed, so the tokens held by the buyers are always the sold amount minus the claimed amount.
+// The raised liquidity includes the creation fee paid by the rollapp owner. The fee tokens are claimed on creation,
+// so the fee is not refunded to the owner, and it's shared by the buyers as a compensation for the failed IRO.
+func (k Keeper) Refund(ctx sdk.Context, planId string, refunder sdk.AccAddress) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if !plan.IsCancelled() {
+		return types.ErrPlanNotCancelled
+	}
+
+	availableTokens := k.BK.GetBalance(ctx, refunder, plan.TotalAllocation.Denom)
+	if availableTokens.IsZero() {
+		return errorsmod.Wrap(types.ErrNoTokensToClaim, "no tokens to refund")
+	}
+
+	// the share of the raised liquidity, according to the tokens not refunded yet
+	outstanding := plan.SoldAmt.Sub(plan.ClaimedAmt)
+	raised := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom)
+	refund := sdk.NewCoin(plan.LiquidityDenom, refundAmount(availableTokens.Amount, outstanding, raised.Amount))
+
+	// Burn all the IRO tokens the user have
+	err := k.BK.SendCoinsFromAccountToModule(ctx, refunder, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return err
+	}
+	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return err
+	}
+
+	if refund.IsPositive() {
+		err = k.BK.SendCoins(ctx, plan.GetAddress(), refunder, sdk.NewCoins(refund))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Update the plan
+	plan.ClaimedAmt = plan.ClaimedAmt.Add(availableTokens.Amount)
+	k.SetPlan(ctx, plan)
+
+	// Emit event
+	err = uevent.EmitTypedEvent(ctx, &types.EventRefund{
+		Refunder:    refunder.String(),
+		PlanId:      planId,
+		RollappId:   plan.RollappId,
+		BurntAmount: availableTokens.Amount,
+		Refund:      refund,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// refundAmount returns the pro-rata share of the raised liquidity for the refunded tokens.
+// The last refund of the outstanding tokens gets all the remaining liquidity.
+func refundAmount(tokens, outstanding, raised math.Int) math.Int {
+	if tokens.GTE(outstanding) {
+		return raised
+	}
+	return raised.Mul(tokens).Quo(outstanding)
+}
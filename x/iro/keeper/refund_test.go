@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	keeper "github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *KeeperTestSuite) TestCancelAndRefund() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+
+	params := k.GetParams(s.Ctx)
+	params.MaxSettlementDuration = 24 * time.Hour
+	k.SetParams(s.Ctx, params)
+
+	startTime := time.Now()
+	preLaunchTime := startTime.Add(time.Hour)
+	amt := sdk.NewInt(1_000_000).MulRaw(1e18)
+
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, amt, startTime, preLaunchTime, rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().NoError(err)
+	planDenom := k.MustGetPlan(s.Ctx, planId).TotalAllocation.Denom
+
+	// buy some tokens
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	buyer1 := sample.Acc()
+	buyer2 := sample.Acc()
+	s.FundAcc(buyer2, sdk.NewCoins(sdk.NewCoin(appparams.BaseDenom, sdk.NewInt(100_000).MulRaw(1e18))))
+	s.BuySomeTokens(planId, buyer1, sdk.NewInt(1_000).MulRaw(1e18))
+	s.BuySomeTokens(planId, buyer2, sdk.NewInt(3_000).MulRaw(1e18))
+
+	// not expired yet - should not be cancelled
+	s.Ctx = s.Ctx.WithBlockTime(preLaunchTime.Add(23 * time.Hour))
+	k.CancelExpiredPlans(s.Ctx)
+	s.Require().False(k.MustGetPlan(s.Ctx, planId).IsCancelled())
+
+	// the plan is indexed as unsettled by its pre-launch time
+	s.Require().Empty(k.GetUnsettledPlansPreLaunchedBefore(s.Ctx, preLaunchTime.Add(-time.Second)))
+	s.Require().Len(k.GetUnsettledPlansPreLaunchedBefore(s.Ctx, preLaunchTime), 1)
+
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().ErrorIs(err, types.ErrPlanNotCancelled)
+
+	// expired - trading is stopped even before the plan is cancelled
+	s.Ctx = s.Ctx.WithBlockTime(preLaunchTime.Add(24 * time.Hour))
+	err = k.Sell(s.Ctx, planId, buyer1, sdk.NewInt(100).MulRaw(1e18), math.ZeroInt())
+	s.Require().ErrorIs(err, types.ErrPlanCancelled)
+
+	k.CancelExpiredPlans(s.Ctx)
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(plan.IsCancelled())
+	s.Require().True(s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId).IroFailed)
+	s.Require().Empty(k.GetUnsettledPlansPreLaunchedBefore(s.Ctx, s.Ctx.BlockTime()))
+
+	// the failed IRO is exposed by the rollapp query, and the rollapp can't get another plan
+	rollappRes, err := s.App.RollappKeeper.Rollapp(s.Ctx, &rollapptypes.QueryGetRollappRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().True(rollappRes.Rollapp.IroFailed)
+	unsealed := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	unsealed.GenesisInfo.Sealed = false
+	err = s.App.RollappKeeper.SetIROPlanToRollapp(s.Ctx, &unsealed, plan)
+	s.Require().ErrorIs(err, rollapptypes.ErrIROFailed)
+
+	// unsold tokens are burnt
+	balance := s.App.BankKeeper.GetBalance(s.Ctx, k.AK.GetModuleAddress(types.ModuleName), planDenom)
+	s.Require().True(balance.IsZero())
+
+	// trading and settlement are not allowed
+	err = k.Buy(s.Ctx, planId, buyer1, sdk.NewInt(100).MulRaw(1e18), sdk.NewInt(1_000_000).MulRaw(1e18), nil)
+	s.Require().ErrorIs(err, types.ErrPlanCancelled)
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin("rollappDenom", amt)))
+	err = k.Settle(s.Ctx, rollappId, "rollappDenom")
+	s.Require().ErrorIs(err, types.ErrPlanCancelled)
+
+	// the genesis transfer does not settle the plan, and the RA tokens are returned to the owner
+	err = k.AfterTransfersEnabled(s.Ctx, rollappId, "rollappDenom")
+	s.Require().NoError(err)
+	s.Require().False(k.MustGetPlan(s.Ctx, planId).IsSettled())
+	owner := sdk.MustAccAddressFromBech32(rollapp.Owner)
+	s.Require().Equal(amt, s.App.BankKeeper.GetBalance(s.Ctx, owner, "rollappDenom").Amount)
+
+	// refunds are pro-rata to the raised liquidity
+	raised := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), appparams.BaseDenom).Amount
+	balance1 := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, appparams.BaseDenom).Amount
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().NoError(err)
+	refund1 := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, appparams.BaseDenom).Amount.Sub(balance1)
+	s.Require().Equal(raised.QuoRaw(4), refund1)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer1, planDenom).IsZero())
+
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().ErrorIs(err, types.ErrNoTokensToClaim)
+
+	// the last refund gets the remaining liquidity
+	balance2 := s.App.BankKeeper.GetBalance(s.Ctx, buyer2, appparams.BaseDenom).Amount
+	err = k.Refund(s.Ctx, planId, buyer2)
+	s.Require().NoError(err)
+	refund2 := s.App.BankKeeper.GetBalance(s.Ctx, buyer2, appparams.BaseDenom).Amount.Sub(balance2)
+	s.Require().Equal(raised.Sub(refund1), refund2)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), appparams.BaseDenom).IsZero())
+
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().Equal(plan.SoldAmt, plan.ClaimedAmt)
+
+	err = keeper.InvariantAccounting(*k)(s.Ctx)
+	s.Require().NoError(err)
+	err = keeper.InvariantPlan(*k)(s.Ctx)
+	s.Require().NoError(err)
+}
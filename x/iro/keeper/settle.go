@@ -12,14 +12,18 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 
-	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 	lockuptypes "github.com/dymensionxyz/dymension/v3/x/lockup/types"
 )
 
 // AfterTransfersEnabled called by the genesis transfer IBC module when a transfer is handled
 // This is a rollapp module hook
+// A cancelled plan is not settled, and its RA tokens are returned to the rollapp owner, so the bridge can still be opened
 func (k Keeper) AfterTransfersEnabled(ctx sdk.Context, rollappId, rollappIBCDenom string) error {
+	plan, found := k.GetPlanByRollapp(ctx, rollappId)
+	if found && plan.IsCancelled() {
+		return k.ReturnCancelledAllocation(ctx, plan, rollappIBCDenom)
+	}
 	return k.Settle(ctx, rollappId, rollappIBCDenom)
 }
 
@@ -28,8 +32,9 @@ func (k Keeper) AfterTransfersEnabled(ctx sdk.Context, rollappId, rollappIBCDeno
 // This function performs the following steps:
 // - Validates that the "TotalAllocation.Amount" of the RA token are available in the module account.
 // - Burns any unsold FUT tokens in the module account.
-// - Marks the plan as settled, allowing users to claim tokens.
-// - Uses the raised DYM and unsold tokens to bootstrap the rollapp's liquidity pool.
+// - Marks the plan as settled, allowing users to claim tokens. The vesting of the claims starts.
+// - Prunes the old candles of the plan.
+// - Uses the raised liquidity and unsold tokens to bootstrap the rollapp's liquidity pool.
 func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error {
 	plan, found := k.GetPlanByRollapp(ctx, rollappId)
 	if !found {
@@ -40,6 +45,10 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 		return errorsmod.Wrapf(errors.Join(gerrc.ErrInternal, types.ErrPlanSettled), "rollappId: %s", rollappId)
 	}
 
+	if plan.IsCancelled() {
+		return errorsmod.Wrapf(errors.Join(gerrc.ErrFailedPrecondition, types.ErrPlanCancelled), "rollappId: %s", rollappId)
+	}
+
 	// validate the required funds are available in the module account
 	// funds expected as it's validated in the genesis transfer handler
 	balance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), rollappIBCDenom)
@@ -56,9 +65,13 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 
 	// mark the plan as `settled`, allowing users to claim tokens
 	plan.SettledDenom = rollappIBCDenom
+	plan.VestingPlan.StartTime = ctx.BlockTime()
 	k.SetPlan(ctx, plan)
 
-	// uses the raised DYM and unsold tokens to bootstrap the rollapp's liquidity pool
+	// the trading is over, so the old candles are not needed anymore
+	k.PruneCandles(ctx, fmt.Sprintf("%d", plan.Id))
+
+	// uses the raised liquidity and unsold tokens to bootstrap the rollapp's liquidity pool
 	poolID, gaugeID, err := k.bootstrapLiquidityPool(ctx, plan)
 	if err != nil {
 		return errors.Join(types.ErrFailedBootstrapLiquidityPool, err)
@@ -79,12 +92,13 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 	return nil
 }
 
-// bootstrapLiquidityPool bootstraps the liquidity pool with the raised DYM and unsold tokens.
+// bootstrapLiquidityPool bootstraps the liquidity pool with the raised liquidity and unsold tokens.
+// The pool is created against the liquidity denom of the plan (e.g DYM).
 //
 // This function performs the following steps:
-// - Sends the raised DYM to the IRO module to be used as the pool creator.
+// - Sends the raised liquidity to the IRO module to be used as the pool creator.
 // - Determines the required pool liquidity amounts to fulfill the last price.
-// - Creates a balancer pool with the determined tokens and DYM.
+// - Creates a balancer pool with the determined tokens and liquidity.
 // - Uses leftover tokens as incentives to the pool LP token holders.
 func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan) (poolID, gaugeID uint64, err error) {
 	// claimable amount is kept in the module account and used for user's claims
@@ -93,24 +107,24 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan) (poolID
 	// the remaining tokens are used to bootstrap the liquidity pool
 	unallocatedTokens := plan.TotalAllocation.Amount.Sub(claimableAmt)
 
-	// send the raised DYM to the iro module as it will be used as the pool creator
-	raisedDYM := k.BK.GetBalance(ctx, plan.GetAddress(), appparams.BaseDenom)
-	err = k.BK.SendCoinsFromAccountToModule(ctx, plan.GetAddress(), types.ModuleName, sdk.NewCoins(raisedDYM))
+	// send the raised liquidity to the iro module as it will be used as the pool creator
+	raised := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom)
+	err = k.BK.SendCoinsFromAccountToModule(ctx, plan.GetAddress(), types.ModuleName, sdk.NewCoins(raised))
 	if err != nil {
 		return 0, 0, err
 	}
 
 	// find the tokens needed to bootstrap the pool, to fulfill last price
-	tokens, dym := calcLiquidityPoolTokens(unallocatedTokens, raisedDYM.Amount, plan.SpotPrice())
+	tokens, liquidity := calcLiquidityPoolTokens(unallocatedTokens, raised.Amount, plan.SpotPrice(), plan.BondingCurve.SupplyDecimals(), plan.BondingCurve.LiquidityDecimals())
 	rollappLiquidityCoin := sdk.NewCoin(plan.SettledDenom, tokens)
-	dymLiquidityCoin := sdk.NewCoin(appparams.BaseDenom, dym)
+	liquidityCoin := sdk.NewCoin(plan.LiquidityDenom, liquidity)
 
 	// create pool
 	gammGlobalParams := k.gk.GetParams(ctx).GlobalFees
 	poolParams := balancer.NewPoolParams(gammGlobalParams.SwapFee, gammGlobalParams.ExitFee, nil)
 	balancerPool := balancer.NewMsgCreateBalancerPool(k.AK.GetModuleAddress(types.ModuleName), poolParams, []balancer.PoolAsset{
 		{
-			Token:  dymLiquidityCoin,
+			Token:  liquidityCoin,
 			Weight: math.OneInt(),
 		},
 		{
@@ -128,7 +142,7 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan) (poolID
 	// Add incentives
 	poolDenom := gammtypes.GetPoolShareDenom(poolId)
 	incentives := sdk.NewCoins(
-		sdk.NewCoin(dymLiquidityCoin.Denom, raisedDYM.Amount.Sub(dymLiquidityCoin.Amount)),
+		sdk.NewCoin(liquidityCoin.Denom, raised.Amount.Sub(liquidityCoin.Amount)),
 		sdk.NewCoin(rollappLiquidityCoin.Denom, unallocatedTokens.Sub(rollappLiquidityCoin.Amount)),
 	)
 	distrTo := lockuptypes.QueryCondition{
@@ -144,29 +158,30 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan) (poolID
 	return poolID, gaugeID, nil
 }
 
-// calcLiquidityPoolTokens determines the tokens and DYM to be used for bootstrapping the liquidity pool.
+// calcLiquidityPoolTokens determines the tokens and liquidity to be used for bootstrapping the liquidity pool.
 //
-// This function calculates the required DYM based on the settled token price and compares it with the raised DYM.
-// It returns the amount of RA tokens and DYM to be used for bootstrapping the liquidity pool so it fulfills the last price.
-func calcLiquidityPoolTokens(unsoldRATokens, raisedDYM math.Int, settledTokenPrice math.LegacyDec) (RATokens, dym math.Int) {
-	requiredDYM := settledTokenPrice.MulInt(unsoldRATokens).TruncateInt()
-
-	// if raisedDYM is less than requiredDYM, than DYM is the limiting factor
-	// we use all the raisedDYM, and the corresponding amount of tokens
-	if raisedDYM.LT(requiredDYM) {
-		dym = raisedDYM
-		RATokens = raisedDYM.ToLegacyDec().Quo(settledTokenPrice).TruncateInt()
+// This function calculates the required liquidity based on the settled token price and compares it with the raised liquidity.
+// It returns the amount of RA tokens and liquidity to be used for bootstrapping the liquidity pool so it fulfills the last price.
+// The price is per token in the decimal representation, so the amounts are scaled with the supply and liquidity decimals.
+func calcLiquidityPoolTokens(unsoldRATokens, raisedLiquidity math.Int, settledTokenPrice math.LegacyDec, supplyDecimals, liquidityDecimals int64) (RATokens, liquidity math.Int) {
+	requiredLiquidity := types.ScaleToBase(settledTokenPrice.Mul(types.ScaleFromBase(unsoldRATokens, supplyDecimals)), liquidityDecimals)
+
+	// if raisedLiquidity is less than requiredLiquidity, than liquidity is the limiting factor
+	// we use all the raisedLiquidity, and the corresponding amount of tokens
+	if raisedLiquidity.LT(requiredLiquidity) {
+		liquidity = raisedLiquidity
+		RATokens = types.ScaleToBase(types.ScaleFromBase(raisedLiquidity, liquidityDecimals).Quo(settledTokenPrice), supplyDecimals)
 	} else {
-		// if raisedDYM is more than requiredDYM, than tokens are the limiting factor
-		// we use all the unsold tokens, and the corresponding amount of DYM
+		// if raisedLiquidity is more than requiredLiquidity, than tokens are the limiting factor
+		// we use all the unsold tokens, and the corresponding amount of liquidity
 		RATokens = unsoldRATokens
-		dym = requiredDYM
+		liquidity = requiredLiquidity
 	}
 
 	// for the edge cases where required liquidity truncated to 0
 	// we use what we have as it guaranteed to be more than 0
-	if dym.IsZero() {
-		dym = raisedDYM
+	if liquidity.IsZero() {
+		liquidity = raisedLiquidity
 	}
 	if RATokens.IsZero() {
 		RATokens = unsoldRATokens
@@ -26,7 +26,7 @@ func (s *KeeperTestSuite) TestSettle() {
 	rollappDenom := "dasdasdasdasdsa"
 
 	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, amt, startTime, endTime, rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, amt, startTime, endTime, rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 	planDenom := k.MustGetPlan(s.Ctx, planId).TotalAllocation.Denom
 
@@ -117,7 +117,7 @@ func (s *KeeperTestSuite) TestBootstrapLiquidityPool() {
 
 			// Create IRO plan
 			apptesting.FundAccount(s.App, s.Ctx, sdk.MustAccAddressFromBech32(rollapp.Owner), sdk.NewCoins(sdk.NewCoin(appparams.BaseDenom, k.GetParams(s.Ctx).CreationFee)))
-			planId, err := k.CreatePlan(s.Ctx, allocation, startTime, startTime.Add(time.Hour), rollapp, curve, types.DefaultIncentivePlanParams())
+			planId, err := k.CreatePlan(s.Ctx, allocation, startTime, startTime.Add(time.Hour), rollapp, curve, types.DefaultIncentivePlanParams(), appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 			s.Require().NoError(err)
 			reservedTokens := k.MustGetPlan(s.Ctx, planId).SoldAmt
 
@@ -174,3 +174,62 @@ func (s *KeeperTestSuite) TestBootstrapLiquidityPool() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSettleLiquidityDenom() {
+	curve := types.BondingCurve{
+		M: math.LegacyMustNewDecFromStr("0"),
+		N: math.LegacyMustNewDecFromStr("1"),
+		C: math.LegacyMustNewDecFromStr("0.1"), // each token costs 0.1 USDC
+	}
+	liquidityDenom := "uusdc"
+
+	startTime := time.Now()
+	allocation := sdk.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "dasdasdasdasdsa"
+
+	rollappId := s.CreateDefaultRollapp()
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	k := s.App.IROKeeper
+
+	params := k.GetParams(s.Ctx)
+	params.LiquidityDenoms = append(params.LiquidityDenoms, types.LiquidityDenom{Denom: liquidityDenom, Exponent: 6})
+	k.SetParams(s.Ctx, params)
+
+	// Create IRO plan, the creation fee of 1 token costs 0.1 USDC
+	s.FundAcc(sdk.MustAccAddressFromBech32(rollapp.Owner), sdk.NewCoins(sdk.NewCoin(liquidityDenom, math.NewInt(100_000))))
+	planId, err := k.CreatePlan(s.Ctx, allocation, startTime, startTime.Add(time.Hour), rollapp, curve, types.DefaultIncentivePlanParams(), liquidityDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().NoError(err)
+
+	// Buy 1000 tokens for 100 USDC, plus the taker fee
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	buyer := sample.Acc()
+	s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin(liquidityDenom, math.NewInt(200_000_000))))
+	err = k.Buy(s.Ctx, planId, buyer, math.NewInt(1_000).MulRaw(1e18), math.NewInt(200_000_000), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(98_000_000), s.App.BankKeeper.GetBalance(s.Ctx, buyer, liquidityDenom).Amount)
+
+	plan := k.MustGetPlan(s.Ctx, planId)
+	raised := k.BK.GetBalance(s.Ctx, plan.GetAddress(), liquidityDenom)
+	s.Require().Equal(math.NewInt(100_100_000), raised.Amount) // 100.1 USDC
+	s.Require().True(k.BK.GetBalance(s.Ctx, plan.GetAddress(), appparams.BaseDenom).IsZero())
+
+	// Settle
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+
+	// Assert the liquidity pool is created against the liquidity denom, as the raised liquidity is the limiting factor
+	poolId := uint64(1)
+	pool, err := s.App.GAMMKeeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	poolCoins := pool.GetTotalPoolLiquidity(s.Ctx)
+	s.Require().Equal(raised.Amount, poolCoins.AmountOf(liquidityDenom))
+	s.Require().Equal(math.NewInt(1_001).MulRaw(1e18), poolCoins.AmountOf(rollappDenom))
+	s.Require().True(poolCoins.AmountOf(appparams.BaseDenom).IsZero())
+
+	// Assert pool price, 0.1 USDC per token in base denominations is 1e5 uusdc per 1e18 tokens
+	price, err := pool.SpotPrice(s.Ctx, liquidityDenom, rollappDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(math.LegacyNewDecWithPrec(1, 13), price)
+}
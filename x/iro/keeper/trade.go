@@ -9,7 +9,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
-	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
 
@@ -20,7 +19,7 @@ func (m msgServer) Buy(ctx context.Context, req *types.MsgBuy) (*types.MsgBuyRes
 		return nil, err
 	}
 
-	err = m.Keeper.Buy(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Amount, req.MaxCostAmount)
+	err = m.Keeper.Buy(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Amount, req.MaxCostAmount, req.AllowlistProof)
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +34,7 @@ func (m msgServer) BuyExactSpend(ctx context.Context, req *types.MsgBuyExactSpen
 		return nil, err
 	}
 
-	err = m.Keeper.BuyExactSpend(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Spend, req.MinOutTokensAmount)
+	err = m.Keeper.BuyExactSpend(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Spend, req.MinOutTokensAmount, req.AllowlistProof)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +57,8 @@ func (m msgServer) Sell(ctx context.Context, req *types.MsgSell) (*types.MsgSell
 }
 
 // Buy buys fixed amount of allocation with price according to the price curve
-func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountTokensToBuy, maxCostAmt math.Int) error {
+// The allowlist proof is required during the allowlist phase of a plan with a merkle root allowlist
+func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountTokensToBuy, maxCostAmt math.Int, allowlistProof [][]byte) error {
 	plan, err := k.GetTradeableIRO(ctx, planId)
 	if err != nil {
 		return err
@@ -69,6 +69,12 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 		return types.ErrInsufficientTokens
 	}
 
+	// validate the buyer is allowed to buy the amount
+	err = k.validatePurchase(ctx, *plan, buyer, amountTokensToBuy, allowlistProof)
+	if err != nil {
+		return err
+	}
+
 	// Calculate costAmt for buying amountTokensToBuy over the price curve
 	costAmt := plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Add(amountTokensToBuy))
 	costPlusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(costAmt, k.GetParams(ctx).TakerFee, true)
@@ -82,15 +88,15 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 	}
 
 	// Charge taker fee
-	takerFee := sdk.NewCoin(appparams.BaseDenom, takerFeeAmt)
+	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
 	err = k.chargeTakerFee(ctx, takerFee, buyer, &owner)
 	if err != nil {
 		return err
 	}
 
-	// send DYM from buyer to the plan. DYM sent directly to the plan's module account
-	cost := sdk.NewCoin(appparams.BaseDenom, costAmt)
+	// send the liquidity denom from buyer to the plan. Sent directly to the plan's module account
+	cost := sdk.NewCoin(plan.LiquidityDenom, costAmt)
 	err = k.BK.SendCoins(ctx, buyer, plan.GetAddress(), sdk.NewCoins(cost))
 	if err != nil {
 		return err
@@ -105,6 +111,8 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 	// Update plan
 	plan.SoldAmt = plan.SoldAmt.Add(amountTokensToBuy)
 	k.SetPlan(ctx, *plan)
+	k.addPurchase(ctx, *plan, buyer, amountTokensToBuy)
+	k.recordTrade(ctx, *plan, buyer, true, amountTokensToBuy, costAmt)
 
 	// Emit event
 	err = uevent.EmitTypedEvent(ctx, &types.EventBuy{
@@ -124,8 +132,9 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 	return nil
 }
 
-// BuyExactSpend uses exact amount of DYM to buy tokens on the curve
-func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountToSpend, minTokensAmt math.Int) error {
+// BuyExactSpend uses exact amount of the liquidity denom to buy tokens on the curve
+// The allowlist proof is required during the allowlist phase of a plan with a merkle root allowlist
+func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountToSpend, minTokensAmt math.Int, allowlistProof [][]byte) error {
 	plan, err := k.GetTradeableIRO(ctx, planId)
 	if err != nil {
 		return err
@@ -153,16 +162,22 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 		return types.ErrInsufficientTokens
 	}
 
+	// validate the buyer is allowed to buy the amount
+	err = k.validatePurchase(ctx, *plan, buyer, tokensOutAmt, allowlistProof)
+	if err != nil {
+		return err
+	}
+
 	// Charge taker fee
-	takerFee := sdk.NewCoin(appparams.BaseDenom, takerFeeAmt)
+	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
 	err = k.chargeTakerFee(ctx, takerFee, buyer, &owner)
 	if err != nil {
 		return err
 	}
 
-	// send DYM from buyer to the plan. DYM sent directly to the plan's module account
-	cost := sdk.NewCoin(appparams.BaseDenom, toSpendMinusTakerFeeAmt)
+	// send the liquidity denom from buyer to the plan. Sent directly to the plan's module account
+	cost := sdk.NewCoin(plan.LiquidityDenom, toSpendMinusTakerFeeAmt)
 	err = k.BK.SendCoins(ctx, buyer, plan.GetAddress(), sdk.NewCoins(cost))
 	if err != nil {
 		return err
@@ -177,6 +192,8 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	// Update plan
 	plan.SoldAmt = plan.SoldAmt.Add(tokensOutAmt)
 	k.SetPlan(ctx, *plan)
+	k.addPurchase(ctx, *plan, buyer, tokensOutAmt)
+	k.recordTrade(ctx, *plan, buyer, true, tokensOutAmt, toSpendMinusTakerFeeAmt)
 
 	// Emit event
 	err = uevent.EmitTypedEvent(ctx, &types.EventBuy{
@@ -216,7 +233,7 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 	}
 
 	// Charge taker fee
-	takerFee := sdk.NewCoin(appparams.BaseDenom, takerFeeAmt)
+	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
 	err = k.chargeTakerFee(ctx, takerFee, seller, &owner)
 	if err != nil {
@@ -229,8 +246,8 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 		return err
 	}
 
-	// send DYM from the plan to the seller. DYM managed by the plan's module account
-	cost := sdk.NewCoin(appparams.BaseDenom, costAmt)
+	// send the liquidity denom from the plan to the seller. Managed by the plan's module account
+	cost := sdk.NewCoin(plan.LiquidityDenom, costAmt)
 	err = k.BK.SendCoins(ctx, plan.GetAddress(), seller, sdk.NewCoins(cost))
 	if err != nil {
 		return err
@@ -239,6 +256,7 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 	// Update plan
 	plan.SoldAmt = plan.SoldAmt.Sub(amountTokensToSell)
 	k.SetPlan(ctx, *plan)
+	k.recordTrade(ctx, *plan, seller, false, amountTokensToSell, costAmt)
 
 	// Emit event
 	err = uevent.EmitTypedEvent(ctx, &types.EventSell{
@@ -272,6 +290,11 @@ func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string) (*types.Plan, er
 		return nil, errorsmod.Wrapf(types.ErrPlanSettled, "planId: %d", plan.Id)
 	}
 
+	// the plan is cancelled at the end of the block it expires
+	if plan.IsCancelled() || plan.IsSettlementExpired(ctx.BlockTime(), k.GetParams(ctx).MaxSettlementDuration) {
+		return nil, errorsmod.Wrapf(types.ErrPlanCancelled, "planId: %d", plan.Id)
+	}
+
 	// Validate start time started
 	if ctx.BlockTime().Before(plan.StartTime) {
 		return nil, errorsmod.Wrapf(types.ErrPlanNotStarted, "planId: %d", plan.Id)
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// recordTrade adds the trade to the latest trades of the plan, and rolls it up into the candles of the plan
+// The trade price is the spot price of the plan after the trade
+func (k Keeper) recordTrade(ctx sdk.Context, plan types.Plan, trader sdk.AccAddress, buy bool, amount, liquidity math.Int) {
+	planId := fmt.Sprintf("%d", plan.Id)
+	seq := k.GetTradeCount(ctx, planId)
+	trade := types.NewTrade(planId, seq, trader, buy, amount, liquidity, plan.SpotPrice(), ctx.BlockTime(), ctx.BlockHeight())
+	k.SetTrade(ctx, trade)
+	k.SetTradeCount(ctx, planId, seq+1)
+
+	for _, interval := range types.CandleIntervals {
+		candle, found := k.GetCandle(ctx, planId, interval.Interval, types.CandleStartTime(trade.Time, interval.Interval))
+		if found {
+			candle.AddTrade(trade)
+		} else {
+			// a new candle is started, so the oldest candles may be out of the retention
+			k.pruneCandlesEndedBefore(ctx, planId, interval.Interval, trade.Time.Add(-interval.Retention))
+			candle = types.NewCandle(trade, interval.Interval)
+		}
+		k.SetCandle(ctx, candle)
+	}
+}
+
+/* --------------------------------- trades --------------------------------- */
+
+// SetTrade sets the trade in its slot of the plan's latest trades, overriding the oldest trade once the history is full
+func (k Keeper) SetTrade(ctx sdk.Context, trade types.Trade) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&trade)
+	store.Set(types.TradeKey(trade.PlanId, trade.Seq%types.MaxTradesPerPlan), b)
+}
+
+// GetTrades returns the latest trades of the plan, oldest first
+func (k Keeper) GetTrades(ctx sdk.Context, planId string) (list []types.Trade) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TradesByPlanKey(planId))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Trade
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	// the trades are stored by their slot, so the history wraps around
+	sort.Slice(list, func(i, j int) bool { return list[i].Seq < list[j].Seq })
+	return
+}
+
+// GetAllTrades returns the latest trades of all the plans
+func (k Keeper) GetAllTrades(ctx sdk.Context) (list []types.Trade) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TradeKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Trade
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetTradeCount sets the number of trades recorded for the plan
+func (k Keeper) SetTradeCount(ctx sdk.Context, planId string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TradeCountKey(planId), sdk.Uint64ToBigEndian(count))
+}
+
+// GetTradeCount returns the number of trades recorded for the plan, which is the sequence number of the next trade
+func (k Keeper) GetTradeCount(ctx sdk.Context, planId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.TradeCountKey(planId))
+	if b == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(b)
+}
+
+/* --------------------------------- candles -------------------------------- */
+
+// SetCandle sets the candle in the store
+func (k Keeper) SetCandle(ctx sdk.Context, candle types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&candle)
+	store.Set(types.CandleKey(candle.PlanId, candle.Interval, candle.StartTime), b)
+}
+
+// GetCandle returns the candle of the plan with the given interval and start time
+func (k Keeper) GetCandle(ctx sdk.Context, planId string, interval time.Duration, startTime time.Time) (val types.Candle, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.CandleKey(planId, interval, startTime))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetCandlesPaginated returns the candles of the plan with the given interval, oldest first
+func (k Keeper) GetCandlesPaginated(ctx sdk.Context, planId string, interval time.Duration, pageReq *query.PageRequest) (list []types.Candle, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandlesByPlanIntervalKey(planId, interval))
+
+	pageRes, err = query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		var val types.Candle
+		if er := k.cdc.Unmarshal(value, &val); er != nil {
+			return er
+		}
+		list = append(list, val)
+		return nil
+	})
+	return
+}
+
+// GetAllCandles returns the candles of all the plans
+func (k Keeper) GetAllCandles(ctx sdk.Context) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CandleKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PruneCandles deletes the candles of the plan which ended before the retention of their interval,
+// and schedules the pruning of the remaining candles once their retention passes
+// Called once the plan is settled or cancelled, as no more candles are added
+func (k Keeper) PruneCandles(ctx sdk.Context, planId string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, interval := range types.CandleIntervals {
+		k.pruneCandlesEndedBefore(ctx, planId, interval.Interval, ctx.BlockTime().Add(-interval.Retention))
+
+		pruneTime := ctx.BlockTime().Add(interval.Retention)
+		store.Set(types.CandlesPruningKey(pruneTime, planId, interval.Interval), types.CandlesByPlanIntervalKey(planId, interval.Interval))
+	}
+}
+
+// PruneExpiredCandles deletes the candles of the settled or cancelled plans whose retention has passed
+func (k Keeper) PruneExpiredCandles(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// the end is exclusive, so the pruning scheduled for the whole second of the block time is included
+	end := types.CandlesPruningByTimeKey(time.Unix(ctx.BlockTime().Unix()+1, 0))
+	iterator := store.Iterator(types.CandlesPruningKeyPrefix, end)
+
+	var keys, candlesPrefixes [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		// the value is the prefix of the candles to prune
+		keys = append(keys, iterator.Key())
+		candlesPrefixes = append(candlesPrefixes, iterator.Value())
+	}
+	iterator.Close() // nolint: errcheck
+
+	for i, key := range keys {
+		k.deleteCandles(ctx, candlesPrefixes[i], nil)
+		store.Delete(key)
+	}
+}
+
+// pruneCandlesEndedBefore deletes the candles of the plan and interval which ended before or at the given time
+func (k Keeper) pruneCandlesEndedBefore(ctx sdk.Context, planId string, interval time.Duration, t time.Time) {
+	k.deleteCandles(ctx, types.CandlesByPlanIntervalKey(planId, interval), func(candle types.Candle) bool {
+		return !candle.EndTime().After(t)
+	})
+}
+
+// deleteCandles deletes the candles under the prefix, oldest first, while the predicate holds
+// A nil predicate deletes all the candles under the prefix
+func (k Keeper) deleteCandles(ctx sdk.Context, candlesPrefix []byte, predicate func(types.Candle) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), candlesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var toDelete [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if predicate != nil {
+			var val types.Candle
+			k.cdc.MustUnmarshal(iterator.Value(), &val)
+			// the candles are ordered by their start time
+			if !predicate(val) {
+				break
+			}
+		}
+		toDelete = append(toDelete, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+}
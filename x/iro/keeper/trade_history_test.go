@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestTradeHistory() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+
+	// aligned to the candle intervals
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+	amt := sdk.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "dasdasdasdasdsa"
+
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, amt, startTime, endTime, rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
+	s.Require().NoError(err)
+
+	trader := sample.Acc()
+	s.FundAcc(trader, sdk.NewCoins(sdk.NewCoin(appparams.BaseDenom, sdk.NewInt(100_000).MulRaw(1e18))))
+	maxAmt := sdk.NewInt(1_000_000_000).MulRaw(1e18)
+	buyAmt := sdk.NewInt(1_000).MulRaw(1e18)
+
+	// two buys in the first 5 minutes, and a sell in the next 5 minutes
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+	err = k.Buy(s.Ctx, planId, trader, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+	price1 := k.MustGetPlan(s.Ctx, planId).SpotPrice()
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(2 * time.Minute))
+	err = k.Buy(s.Ctx, planId, trader, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+	price2 := k.MustGetPlan(s.Ctx, planId).SpotPrice()
+
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(7 * time.Minute))
+	err = k.Sell(s.Ctx, planId, trader, buyAmt.QuoRaw(2), math.ZeroInt())
+	s.Require().NoError(err)
+	price3 := k.MustGetPlan(s.Ctx, planId).SpotPrice()
+
+	trades, err := s.queryClient.QueryTrades(s.Ctx, &types.QueryTradesRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Len(trades.Trades, 3)
+	for i, trade := range trades.Trades {
+		s.Require().Equal(uint64(i), trade.Seq)
+		s.Require().Equal(trader.String(), trade.Trader)
+	}
+	s.Require().True(trades.Trades[0].Buy)
+	s.Require().False(trades.Trades[2].Buy)
+	s.Require().Equal(price3, trades.Trades[2].Price)
+
+	// 5 minutes candles
+	candles, err := s.queryClient.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId, IntervalSeconds: 300})
+	s.Require().NoError(err)
+	s.Require().Len(candles.Candles, 2)
+	first := candles.Candles[0]
+	s.Require().True(startTime.Equal(first.StartTime))
+	s.Require().Equal(price1, first.Open)
+	s.Require().Equal(price2, first.High)
+	s.Require().Equal(price1, first.Low)
+	s.Require().Equal(price2, first.Close)
+	s.Require().Equal(buyAmt.MulRaw(2), first.Volume)
+	s.Require().Equal(uint64(2), first.NumTrades)
+	second := candles.Candles[1]
+	s.Require().True(startTime.Add(5 * time.Minute).Equal(second.StartTime))
+	s.Require().Equal(price3, second.Close)
+	s.Require().Equal(uint64(1), second.NumTrades)
+
+	// hourly candles
+	candles, err = s.queryClient.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId, IntervalSeconds: 3600})
+	s.Require().NoError(err)
+	s.Require().Len(candles.Candles, 1)
+	s.Require().Equal(price1, candles.Candles[0].Open)
+	s.Require().Equal(price2, candles.Candles[0].High)
+	s.Require().Equal(price3, candles.Candles[0].Close)
+	s.Require().Equal(uint64(3), candles.Candles[0].NumTrades)
+
+	// invalid interval
+	_, err = s.queryClient.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId, IntervalSeconds: 60})
+	s.Require().Error(err)
+
+	// the history keeps the latest trades only
+	smallAmt := sdk.NewInt(1).MulRaw(1e18)
+	for i := uint64(0); i < types.MaxTradesPerPlan; i++ {
+		err = k.Buy(s.Ctx, planId, trader, smallAmt, maxAmt, nil)
+		s.Require().NoError(err)
+	}
+	trades, err = s.queryClient.QueryTrades(s.Ctx, &types.QueryTradesRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Len(trades.Trades, int(types.MaxTradesPerPlan))
+	s.Require().Equal(uint64(3), trades.Trades[0].Seq)
+	s.Require().Equal(types.MaxTradesPerPlan+2, trades.Trades[len(trades.Trades)-1].Seq)
+
+	// a trade on the next day starts new candles, and prunes the 5 minutes candles out of the retention
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	err = k.Buy(s.Ctx, planId, trader, smallAmt, maxAmt, nil)
+	s.Require().NoError(err)
+	s.requireCandles(planId, 5*time.Minute, 1)
+	s.requireCandles(planId, time.Hour, 2)
+	s.requireCandles(planId, 24*time.Hour, 2)
+
+	// settle after 2 days - all the candles are in the retention
+	settleTime := startTime.Add(48 * time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(settleTime)
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, amt)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	s.requireCandles(planId, 5*time.Minute, 1)
+
+	// the candles of each interval are pruned once their retention after the settlement passes
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(24*time.Hour - time.Second))
+	k.PruneExpiredCandles(s.Ctx)
+	s.requireCandles(planId, 5*time.Minute, 1)
+
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(24 * time.Hour))
+	k.PruneExpiredCandles(s.Ctx)
+	s.requireCandles(planId, 5*time.Minute, 0)
+	s.requireCandles(planId, time.Hour, 2)
+
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(30 * 24 * time.Hour))
+	k.PruneExpiredCandles(s.Ctx)
+	s.requireCandles(planId, time.Hour, 0)
+	s.requireCandles(planId, 24*time.Hour, 2)
+
+	s.Ctx = s.Ctx.WithBlockTime(settleTime.Add(365 * 24 * time.Hour))
+	k.PruneExpiredCandles(s.Ctx)
+	s.Require().Empty(k.GetAllCandles(s.Ctx))
+}
+
+func (s *KeeperTestSuite) requireCandles(planId string, interval time.Duration, expected int) {
+	candles, err := s.queryClient.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId, IntervalSeconds: uint64(interval / time.Second)})
+	s.Require().NoError(err)
+	s.Require().Len(candles.Candles, expected)
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
 )
@@ -22,7 +23,7 @@ func (s *KeeperTestSuite) TestBuy() {
 	totalAllocation := sdk.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 	initialOwnerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId))
 
@@ -39,19 +40,19 @@ func (s *KeeperTestSuite) TestBuy() {
 	expectedCost := curve.Cost(plan.SoldAmt, plan.SoldAmt.Add(buyAmt))
 
 	// buy before plan start - should fail
-	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx.WithBlockTime(startTime.Add(-time.Minute)), planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().Error(err)
 
 	// cost is higher than maxCost specified - should fail
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, expectedCost.SubRaw(1))
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, expectedCost.SubRaw(1), nil)
 	s.Require().Error(err)
 
 	// buy more than user's balance - should fail
-	err = k.Buy(s.Ctx, planId, buyer, sdk.NewInt(100_000).MulRaw(1e18), maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, sdk.NewInt(100_000).MulRaw(1e18), maxAmt, nil)
 	s.Require().Error(err)
 
 	// buy very small amount - should fail (as cost ~= 0)
-	err = k.Buy(s.Ctx, planId, buyer, sdk.NewInt(100), maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, sdk.NewInt(100), maxAmt, nil)
 	s.Require().Error(err)
 
 	// assert nothing sold
@@ -61,7 +62,7 @@ func (s *KeeperTestSuite) TestBuy() {
 	s.Assert().Equal(buyersFunds.AmountOf("adym"), buyerBalance)
 
 	// successful buy
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 	plan, _ = k.GetPlan(s.Ctx, planId)
 	s.Assert().True(plan.SoldAmt.Sub(reservedTokens).Equal(buyAmt))
@@ -99,7 +100,7 @@ func (s *KeeperTestSuite) TestTradeAfterSettled() {
 	totalAllocation := sdk.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, endTime, rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, endTime, rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 
 	buyer := sample.Acc()
@@ -110,7 +111,7 @@ func (s *KeeperTestSuite) TestTradeAfterSettled() {
 
 	// Buy before settlement
 	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 
 	// settle
@@ -120,7 +121,7 @@ func (s *KeeperTestSuite) TestTradeAfterSettled() {
 	s.Require().NoError(err)
 
 	// Attempt to buy after settlement - should fail
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().Error(err)
 }
 
@@ -139,7 +140,7 @@ func (s *KeeperTestSuite) TestTakerFee() {
 	totalAllocation := sdk.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
 
@@ -150,12 +151,12 @@ func (s *KeeperTestSuite) TestTakerFee() {
 	buyAmt := sdk.NewInt(1_000).MulRaw(1e18)
 
 	// Attempt to buy while ignoring taker fee - should fail
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt, nil)
 	s.Require().Error(err)
 
 	// Successful buy
 	expectedTakerFee := s.App.IROKeeper.GetParams(s.Ctx).TakerFee.MulInt(buyAmt).TruncateInt()
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.Add(expectedTakerFee))
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, buyAmt.Add(expectedTakerFee), nil)
 	s.Require().NoError(err)
 
 	// Extract taker fee from buy event
@@ -176,7 +177,7 @@ func (s *KeeperTestSuite) TestSell() {
 	totalAllocation := sdk.NewInt(1_000_000).MulRaw(1e18)
 
 	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
-	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives)
+	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, types.AllowlistPhase{}, math.ZeroInt())
 	s.Require().NoError(err)
 	initialOwnerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId))
 	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
@@ -188,7 +189,7 @@ func (s *KeeperTestSuite) TestSell() {
 	buyAmt := sdk.NewInt(1_000).MulRaw(1e18)
 
 	// Buy tokens first
-	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
 	s.Require().NoError(err)
 
 	// Extract taker fee from buy event
@@ -255,3 +256,128 @@ func (s *KeeperTestSuite) ExtractTakerFeeAmtFromEvents(events []sdk.Event, event
 	}
 	return sdk.ZeroInt(), false
 }
+
+func (s *KeeperTestSuite) TestBuyAllowlist() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+
+	startTime := time.Now()
+	maxAmt := sdk.NewInt(1_000_000_000).MulRaw(1e18)
+	totalAllocation := sdk.NewInt(1_000_000).MulRaw(1e18)
+
+	allowlisted := sample.Acc()
+	other := sample.Acc()
+	phase := types.NewAddressesAllowlistPhase([]string{allowlisted.String()}, startTime.Add(time.Hour), sdk.NewInt(2_000).MulRaw(1e18))
+	maxAmountPerAddress := sdk.NewInt(3_000).MulRaw(1e18)
+
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(2*time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, phase, maxAmountPerAddress)
+	s.Require().NoError(err)
+
+	for _, buyer := range []sdk.AccAddress{allowlisted, other} {
+		s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", sdk.NewInt(100_000).MulRaw(1e18))))
+	}
+	buyAmt := sdk.NewInt(1_000).MulRaw(1e18)
+
+	// allowlist phase
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+	err = k.Buy(s.Ctx, planId, other, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrNotAllowlisted)
+	err = k.BuyExactSpend(s.Ctx, planId, other, sdk.NewInt(10).MulRaw(1e18), sdk.NewInt(1), nil)
+	s.Require().ErrorIs(err, types.ErrNotAllowlisted)
+
+	err = k.Buy(s.Ctx, planId, allowlisted, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+
+	// the allowlist phase limit is 2000 tokens
+	err = k.Buy(s.Ctx, planId, allowlisted, buyAmt.MulRaw(3).QuoRaw(2), maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrPurchaseLimitExceeded)
+
+	res, err := k.QueryAllowance(s.Ctx, &types.QueryAllowanceRequest{PlanId: planId, Address: allowlisted.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.AllowlistPhase)
+	s.Require().True(res.Allowlisted)
+	s.Require().Equal(buyAmt, res.Purchased)
+	s.Require().Equal(buyAmt, res.Remaining)
+	s.Require().False(res.Unlimited)
+
+	// after the allowlist phase, anyone can buy up to 3000 tokens, including the purchases during the phase
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Hour))
+
+	err = k.Buy(s.Ctx, planId, other, buyAmt, maxAmt, nil)
+	s.Require().NoError(err)
+
+	err = k.Buy(s.Ctx, planId, allowlisted, buyAmt.MulRaw(2), maxAmt, nil)
+	s.Require().NoError(err)
+
+	err = k.BuyExactSpend(s.Ctx, planId, allowlisted, sdk.NewInt(10).MulRaw(1e18), sdk.NewInt(1), nil)
+	s.Require().ErrorIs(err, types.ErrPurchaseLimitExceeded)
+
+	res, err = k.QueryAllowance(s.Ctx, &types.QueryAllowanceRequest{PlanId: planId, Address: allowlisted.String()})
+	s.Require().NoError(err)
+	s.Require().False(res.AllowlistPhase)
+	s.Require().Equal(maxAmountPerAddress, res.Purchased)
+	s.Require().True(res.Remaining.IsZero())
+
+	// selling does not restore the allowance
+	err = k.Sell(s.Ctx, planId, allowlisted, buyAmt, sdk.NewInt(1))
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, allowlisted, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrPurchaseLimitExceeded)
+}
+
+func (s *KeeperTestSuite) TestBuyAllowlistMerkle() {
+	rollappId := s.CreateDefaultRollapp()
+	k := s.App.IROKeeper
+	curve := types.DefaultBondingCurve()
+	incentives := types.DefaultIncentivePlanParams()
+
+	startTime := time.Now()
+	maxAmt := sdk.NewInt(1_000_000_000).MulRaw(1e18)
+	totalAllocation := sdk.NewInt(1_000_000).MulRaw(1e18)
+
+	addresses := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	phase := types.NewMerkleAllowlistPhase(types.MerkleRoot(addresses), startTime.Add(time.Hour), math.ZeroInt())
+
+	rollapp, _ := s.App.RollappKeeper.GetRollapp(s.Ctx, rollappId)
+	planId, err := k.CreatePlan(s.Ctx, totalAllocation, startTime, startTime.Add(2*time.Hour), rollapp, curve, incentives, appparams.BaseDenom, types.VestingPlan{}, phase, math.ZeroInt())
+	s.Require().NoError(err)
+
+	buyer := sdk.MustAccAddressFromBech32(addresses[1])
+	s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", sdk.NewInt(100_000).MulRaw(1e18))))
+	buyAmt := sdk.NewInt(1_000).MulRaw(1e18)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Minute))
+
+	// no proof - should fail
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, nil)
+	s.Require().ErrorIs(err, types.ErrNotAllowlisted)
+
+	// proof of another address - should fail
+	otherProof, err := types.MerkleProof(addresses, addresses[0])
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, otherProof)
+	s.Require().ErrorIs(err, types.ErrNotAllowlisted)
+
+	proof, err := types.MerkleProof(addresses, addresses[1])
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, buyer, buyAmt, maxAmt, proof)
+	s.Require().NoError(err)
+
+	// no limits, so the purchases are not tracked
+	res, err := k.QueryAllowance(s.Ctx, &types.QueryAllowanceRequest{PlanId: planId, Address: buyer.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.AllowlistPhase)
+	s.Require().True(res.Unlimited)
+	s.Require().True(res.Purchased.IsZero())
+
+	// the address is allowlisted only if its proof is given
+	s.Require().True(res.RequiresProof)
+	s.Require().False(res.Allowlisted)
+	res, err = k.QueryAllowance(s.Ctx, &types.QueryAllowanceRequest{PlanId: planId, Address: buyer.String(), AllowlistProof: proof})
+	s.Require().NoError(err)
+	s.Require().False(res.RequiresProof)
+	s.Require().True(res.Allowlisted)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetClaimerVesting sets the vesting claims of a claimer in the store
+func (k Keeper) SetClaimerVesting(ctx sdk.Context, vesting types.ClaimerVesting) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&vesting)
+	store.Set(types.ClaimerVestingKey(vesting.PlanId, vesting.Claimer), b)
+}
+
+// GetClaimerVesting returns the vesting claims of a claimer in a plan
+func (k Keeper) GetClaimerVesting(ctx sdk.Context, planId string, claimer sdk.AccAddress) (val types.ClaimerVesting, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ClaimerVestingKey(planId, claimer.String()))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetClaimerVestingsByPlan returns the vesting claims of all the claimers in a plan
+func (k Keeper) GetClaimerVestingsByPlan(ctx sdk.Context, planId string) (list []types.ClaimerVesting) {
+	return k.getClaimerVestings(ctx, types.ClaimerVestingsByPlanKey(planId))
+}
+
+// GetAllClaimerVestings returns the vesting claims of all the plans
+func (k Keeper) GetAllClaimerVestings(ctx sdk.Context) (list []types.ClaimerVesting) {
+	return k.getClaimerVestings(ctx, types.ClaimerVestingKeyPrefix)
+}
+
+func (k Keeper) getClaimerVestings(ctx sdk.Context, keyPrefix []byte) (list []types.ClaimerVesting) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ClaimerVesting
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
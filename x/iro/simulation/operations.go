@@ -73,9 +73,10 @@ func SimulateTestBondingCurve(k keeper.Keeper, cdc *codec.ProtoCodec) simtypes.O
 		}
 
 		// prepare base error with curve context
-		totalAll := types.ScaleFromBase(plan.TotalAllocation.Amount, types.DYMDecimals)
-		soldAmt := types.ScaleFromBase(plan.SoldAmt, types.DYMDecimals)
-		targetRaise := types.ScaleFromBase(curve.Cost(math.ZeroInt(), plan.TotalAllocation.Amount), types.DYMDecimals)
+		// token amounts are scaled by the supply decimals, and costs by the decimals of the plan's liquidity denom
+		totalAll := types.ScaleFromBase(plan.TotalAllocation.Amount, curve.SupplyDecimals())
+		soldAmt := types.ScaleFromBase(plan.SoldAmt, curve.SupplyDecimals())
+		targetRaise := types.ScaleFromBase(curve.Cost(math.ZeroInt(), plan.TotalAllocation.Amount), curve.LiquidityDecimals())
 		curveDesc := fmt.Sprintf("total supply: %s, sold amount: %s, target raise: %s, bonding curve: %s", totalAll.String(), soldAmt.String(), targetRaise.String(), curve.Stringify())
 
 		if curve.M.IsZero() {
@@ -87,7 +88,7 @@ func SimulateTestBondingCurve(k keeper.Keeper, cdc *codec.ProtoCodec) simtypes.O
 		managed := false
 		lastCost := math.ZeroInt()
 		for _, amount := range testAmounts {
-			scaledAmount := types.ScaleFromBase(amount, types.DYMDecimals)
+			scaledAmount := types.ScaleFromBase(amount, curve.SupplyDecimals())
 			// Calculate cost for buying tokens
 			cost := curve.Cost(plan.SoldAmt, amount.Add(plan.SoldAmt))
 			if !cost.IsPositive() {
@@ -116,8 +117,8 @@ func SimulateTestBondingCurve(k keeper.Keeper, cdc *codec.ProtoCodec) simtypes.O
 			managed = true
 
 			results = append(results, fmt.Sprintf(
-				"Amount: %s tokens, Cost: %s DYM, TokensForExactDYM: %s tokens",
-				amount.String(), cost.String(), tokens.String(),
+				"Amount: %s tokens, Cost: %s %s, TokensForExactDYM: %s tokens",
+				amount.String(), cost.String(), plan.LiquidityDenom, tokens.String(),
 			))
 		}
 
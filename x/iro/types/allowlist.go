@@ -0,0 +1,202 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAllowlistAddresses is the maximum number of addresses of an allowlist, as they are stored in the plan.
+// Larger allowlists should use a merkle root instead.
+const MaxAllowlistAddresses = 100
+
+// NewMerkleAllowlistPhase returns an allowlist phase of the addresses in the merkle tree with the given root
+func NewMerkleAllowlistPhase(merkleRoot []byte, endTime time.Time, maxAmountPerAddress math.Int) AllowlistPhase {
+	return AllowlistPhase{
+		MerkleRoot:          merkleRoot,
+		EndTime:             endTime,
+		MaxAmountPerAddress: maxAmountPerAddress,
+	}
+}
+
+// NewAddressesAllowlistPhase returns an allowlist phase of the given addresses
+func NewAddressesAllowlistPhase(addresses []string, endTime time.Time, maxAmountPerAddress math.Int) AllowlistPhase {
+	return AllowlistPhase{
+		Addresses:           addresses,
+		EndTime:             endTime,
+		MaxAmountPerAddress: maxAmountPerAddress,
+	}
+}
+
+// IsEnabled returns true if the plan has an allowlist phase
+func (a AllowlistPhase) IsEnabled() bool {
+	return len(a.MerkleRoot) > 0 || len(a.Addresses) > 0
+}
+
+// IsActive returns true if the allowlist phase is not over at time t
+func (a AllowlistPhase) IsActive(t time.Time) bool {
+	return a.IsEnabled() && t.Before(a.EndTime)
+}
+
+// ValidateBasic checks the allowlist phase of a plan starting at the given start time
+func (a AllowlistPhase) ValidateBasic(startTime time.Time) error {
+	if !a.MaxAmountPerAddress.IsNil() && a.MaxAmountPerAddress.IsNegative() {
+		return fmt.Errorf("max amount per address must be non-negative: %s", a.MaxAmountPerAddress)
+	}
+
+	if !a.IsEnabled() {
+		if !a.EndTime.IsZero() || (!a.MaxAmountPerAddress.IsNil() && !a.MaxAmountPerAddress.IsZero()) {
+			return fmt.Errorf("end time and max amount per address must be empty without an allowlist")
+		}
+		return nil
+	}
+
+	if len(a.MerkleRoot) > 0 && len(a.Addresses) > 0 {
+		return fmt.Errorf("either merkle root or addresses must be set, not both")
+	}
+	if len(a.MerkleRoot) > 0 && len(a.MerkleRoot) != sha256.Size {
+		return fmt.Errorf("merkle root must be %d bytes: got %d", sha256.Size, len(a.MerkleRoot))
+	}
+
+	if len(a.Addresses) > MaxAllowlistAddresses {
+		return fmt.Errorf("too many allowlist addresses, use a merkle root instead: max: %d, got: %d", MaxAllowlistAddresses, len(a.Addresses))
+	}
+
+	seen := make(map[string]struct{}, len(a.Addresses))
+	for _, addr := range a.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid allowlist address: %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate allowlist address: %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	if !a.EndTime.After(startTime) {
+		return fmt.Errorf("allowlist phase end time must be after the start time: end: %s, start: %s", a.EndTime, startTime)
+	}
+
+	return nil
+}
+
+// IsAllowlisted returns true if the address is in the allowlist
+// The proof is required for a merkle root allowlist, and ignored otherwise
+func (a AllowlistPhase) IsAllowlisted(address string, proof [][]byte) bool {
+	if len(a.MerkleRoot) > 0 {
+		return VerifyMerkleProof(a.MerkleRoot, address, proof)
+	}
+	for _, addr := range a.Addresses {
+		if addr == address {
+			return true
+		}
+	}
+	return false
+}
+
+/* ---------------------------------- merkle --------------------------------- */
+// The merkle tree of the allowlist addresses:
+// - the leaves are the sha256 hashes of the bech32 addresses
+// - the nodes are the sha256 hashes of their children, sorted, so the proof does not need the positions
+// - a node without a sibling is promoted to the next level
+
+// MerkleLeaf returns the leaf of an address in the merkle tree
+func MerkleLeaf(address string) []byte {
+	h := sha256.Sum256([]byte(address))
+	return h[:]
+}
+
+func merkleNode(a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	h := sha256.Sum256(append(append([]byte{}, a...), b...))
+	return h[:]
+}
+
+// MerkleRoot returns the root of the merkle tree of the addresses
+func MerkleRoot(addresses []string) []byte {
+	root, _ := merkleRootAndProof(addresses, -1)
+	return root
+}
+
+// MerkleProof returns the proof of the address in the merkle tree of the addresses
+func MerkleProof(addresses []string, address string) ([][]byte, error) {
+	for i, addr := range addresses {
+		if addr == address {
+			_, proof := merkleRootAndProof(addresses, i)
+			return proof, nil
+		}
+	}
+	return nil, fmt.Errorf("address not in the addresses: %s", address)
+}
+
+// merkleRootAndProof returns the root of the merkle tree and the proof of the leaf at index, if not negative
+func merkleRootAndProof(addresses []string, index int) (root []byte, proof [][]byte) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	level := make([][]byte, len(addresses))
+	for i, addr := range addresses {
+		level[i] = MerkleLeaf(addr)
+	}
+
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			if index == i {
+				proof = append(proof, level[i+1])
+			} else if index == i+1 {
+				proof = append(proof, level[i])
+			}
+			next = append(next, merkleNode(level[i], level[i+1]))
+		}
+		if index >= 0 {
+			index /= 2
+		}
+		level = next
+	}
+
+	return level[0], proof
+}
+
+// VerifyMerkleProof returns true if the proof shows the address is in the merkle tree with the given root
+func VerifyMerkleProof(root []byte, address string, proof [][]byte) bool {
+	node := MerkleLeaf(address)
+	for _, sibling := range proof {
+		node = merkleNode(node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+/* -------------------------------- purchases -------------------------------- */
+
+func NewPurchase(planId string, buyer sdk.AccAddress, amount math.Int) Purchase {
+	return Purchase{
+		PlanId: planId,
+		Buyer:  buyer.String(),
+		Amount: amount,
+	}
+}
+
+func (p Purchase) ValidateBasic() error {
+	if p.PlanId == "" {
+		return fmt.Errorf("plan id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return fmt.Errorf("purchase amount must be positive: %s", p.Amount)
+	}
+	return nil
+}
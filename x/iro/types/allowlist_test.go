@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for n := 1; n <= 7; n++ {
+		addresses := make([]string, n)
+		for i := range addresses {
+			addresses[i] = sample.AccAddress()
+		}
+		root := types.MerkleRoot(addresses)
+
+		for _, addr := range addresses {
+			proof, err := types.MerkleProof(addresses, addr)
+			require.NoError(t, err)
+			require.True(t, types.VerifyMerkleProof(root, addr, proof), "n=%d", n)
+		}
+
+		proof, err := types.MerkleProof(addresses, addresses[0])
+		require.NoError(t, err)
+		require.False(t, types.VerifyMerkleProof(root, sample.AccAddress(), proof), "n=%d", n)
+
+		_, err = types.MerkleProof(addresses, sample.AccAddress())
+		require.Error(t, err)
+	}
+}
+
+func TestAllowlistPhase_ValidateBasic(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	addr := sample.AccAddress()
+	root := types.MerkleRoot([]string{addr})
+
+	tests := []struct {
+		name    string
+		phase   types.AllowlistPhase
+		wantErr bool
+	}{
+		{
+			name:  "disabled",
+			phase: types.AllowlistPhase{},
+		},
+		{
+			name:  "addresses",
+			phase: types.NewAddressesAllowlistPhase([]string{addr}, end, math.NewInt(100)),
+		},
+		{
+			name:  "merkle root",
+			phase: types.NewMerkleAllowlistPhase(root, end, math.ZeroInt()),
+		},
+		{
+			name:    "end time without allowlist",
+			phase:   types.AllowlistPhase{EndTime: end},
+			wantErr: true,
+		},
+		{
+			name:    "both merkle root and addresses",
+			phase:   types.AllowlistPhase{MerkleRoot: root, Addresses: []string{addr}, EndTime: end},
+			wantErr: true,
+		},
+		{
+			name:    "invalid merkle root",
+			phase:   types.NewMerkleAllowlistPhase(root[:16], end, math.ZeroInt()),
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			phase:   types.NewAddressesAllowlistPhase([]string{"invalid"}, end, math.ZeroInt()),
+			wantErr: true,
+		},
+		{
+			name:    "duplicate address",
+			phase:   types.NewAddressesAllowlistPhase([]string{addr, addr}, end, math.ZeroInt()),
+			wantErr: true,
+		},
+		{
+			name:    "end time before start",
+			phase:   types.NewAddressesAllowlistPhase([]string{addr}, start, math.ZeroInt()),
+			wantErr: true,
+		},
+		{
+			name:    "too many addresses",
+			phase:   types.NewAddressesAllowlistPhase(manyAddresses(types.MaxAllowlistAddresses+1), end, math.ZeroInt()),
+			wantErr: true,
+		},
+		{
+			name:    "negative max amount",
+			phase:   types.NewAddressesAllowlistPhase([]string{addr}, end, math.NewInt(-1)),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.phase.ValidateBasic(start)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func manyAddresses(n int) []string {
+	addresses := make([]string, n)
+	for i := range addresses {
+		addresses[i] = sample.AccAddress()
+	}
+	return addresses
+}
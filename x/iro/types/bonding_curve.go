@@ -24,6 +24,8 @@ M (multiplier) controls the overall steepness of the curve. A higher M value res
 N (exponent) shapes the curve's trajectory. When N > 1, the curve becomes convex, accelerating price growth at higher supply levels, which can create strong incentives for early adoption. When 0 < N < 1, the curve is concave, slowing price growth as supply increases, which can promote more stable long-term growth.
 
 C (constant) sets the starting price when supply is zero, effectively establishing a price floor and influencing the token's initial accessibility.
+
+Other curve families can be set instead of the power curve to shape the price discovery differently, see bonding_curve_families.go.
 */
 
 const (
@@ -41,6 +43,29 @@ The bonding curve implementation based on decimal representation of the X (rolla
 we use scaling functions to convert between the decimal scale and the base denomination.
 */
 
+// Curve is the function of a bonding curve family.
+// The inputs and outputs are in the decimal representation.
+type Curve interface {
+	// ValidateBasic checks if the curve parameters are valid
+	ValidateBasic() error
+	// SpotPrice returns the price at supply x
+	SpotPrice(x math.LegacyDec) math.LegacyDec
+	// Integral returns the cost of the supply from 0 to x
+	Integral(x math.LegacyDec) math.LegacyDec
+	// Inverse returns the supply which can be bought from supply x with spend
+	Inverse(x, spend math.LegacyDec) (math.LegacyDec, error)
+	// Stringify returns a human readable representation of the curve
+	Stringify() string
+}
+
+var (
+	_ Curve = powerCurve{}
+	_ Curve = &LogarithmicCurve{}
+	_ Curve = &CappedExponentialCurve{}
+	_ Curve = &SigmoidCurve{}
+	_ Curve = &PiecewiseLinearCurve{}
+)
+
 func NewBondingCurve(m, n, c math.LegacyDec) BondingCurve {
 	return BondingCurve{
 		M: m,
@@ -49,6 +74,24 @@ func NewBondingCurve(m, n, c math.LegacyDec) BondingCurve {
 	}
 }
 
+// NewBondingCurveFromFamily returns a bonding curve of the given curve family.
+func NewBondingCurveFromFamily(family Curve) BondingCurve {
+	var curve BondingCurve
+	switch f := family.(type) {
+	case *LogarithmicCurve:
+		curve.Family = &BondingCurve_Logarithmic{Logarithmic: f}
+	case *CappedExponentialCurve:
+		curve.Family = &BondingCurve_CappedExponential{CappedExponential: f}
+	case *SigmoidCurve:
+		curve.Family = &BondingCurve_Sigmoid{Sigmoid: f}
+	case *PiecewiseLinearCurve:
+		curve.Family = &BondingCurve_PiecewiseLinear{PiecewiseLinear: f}
+	case powerCurve:
+		return NewBondingCurve(f.m, f.n, f.c)
+	}
+	return curve
+}
+
 func DefaultBondingCurve() BondingCurve {
 	// linear bonding curve as default
 	return BondingCurve{
@@ -64,27 +107,42 @@ func (lbc BondingCurve) SupplyDecimals() int64 {
 	return rollappTokenDefaultDecimals
 }
 
-// validateBasic checks if the bonding curve is valid
-func (lbc BondingCurve) ValidateBasic() error {
-	if lbc.M.IsNegative() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %d", lbc.M)
-	}
-	if !lbc.N.IsPositive() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n: %d", lbc.N)
-	}
-	if lbc.N.GT(math.LegacyNewDec(MaxNValue)) {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n exceeds maximum value of %d: %s", MaxNValue, lbc.N)
+// LiquidityDecimals returns the decimals of the liquidity denom the curve is quoted in, DYM decimals if not set
+func (lbc BondingCurve) LiquidityDecimals() int64 {
+	if lbc.LiquidityDenomDecimals == 0 {
+		return DYMDecimals
 	}
+	return int64(lbc.LiquidityDenomDecimals)
+}
 
-	if lbc.C.IsNegative() {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", lbc.C.String())
+// Curve returns the function of the curve family of the bonding curve, the power curve if no family is set.
+func (lbc BondingCurve) Curve() Curve {
+	switch f := lbc.Family.(type) {
+	case *BondingCurve_Logarithmic:
+		return f.Logarithmic
+	case *BondingCurve_CappedExponential:
+		return f.CappedExponential
+	case *BondingCurve_Sigmoid:
+		return f.Sigmoid
+	case *BondingCurve_PiecewiseLinear:
+		return f.PiecewiseLinear
+	default:
+		return powerCurve{m: lbc.M, n: lbc.N, c: lbc.C}
 	}
+}
 
-	if !checkPrecision(lbc.N) {
-		return errorsmod.Wrapf(ErrInvalidBondingCurve, "N must have at most %d decimal places", MaxNPrecision)
+// validateBasic checks if the bonding curve is valid
+func (lbc BondingCurve) ValidateBasic() error {
+	if lbc.Family == nil {
+		return lbc.Curve().ValidateBasic()
 	}
-
-	return nil
+	// the power curve parameters are not used by the other families
+	for _, d := range []math.LegacyDec{lbc.M, lbc.N, lbc.C} {
+		if !d.IsNil() && !d.IsZero() {
+			return errorsmod.Wrap(ErrInvalidBondingCurve, "M, N and C must be empty for a curve family")
+		}
+	}
+	return lbc.Curve().ValidateBasic()
 }
 
 /* ---------------------------------- APIs ---------------------------------- */
@@ -93,68 +151,70 @@ func (lbc BondingCurve) ValidateBasic() error {
 
 // SpotPrice returns the spot price at x
 // - x: the current supply, in the base denomination
-// - returns: the spot price at x, as price per token (e.g 0.1 DYM per token)
+// - returns: the spot price at x, as price per token in the liquidity denom (e.g 0.1 DYM per token)
 func (lbc BondingCurve) SpotPrice(x math.Int) math.LegacyDec {
-	return lbc.spotPriceInternal(ScaleFromBase(x, lbc.SupplyDecimals()))
+	return lbc.Curve().SpotPrice(ScaleFromBase(x, lbc.SupplyDecimals()))
 }
 
 /*
-The cost to purchase tokens from supply S1 to S2 is given by the definite integral of this function from S1 to S2. Mathematically, this is expressed as:
+The cost to purchase tokens from supply S1 to S2 is given by the definite integral of the curve from S1 to S2.
+For the power curve, this is expressed as:
 Cost = ∫(S1 to S2) (M * S^N + C) dS
 Solving this integral yields:
 Cost = [M / (N + 1) * S^(N + 1) + C * S](S1 to S2)
 
 // - x: the current supply, in the base denomination
 // - x1: the new supply, in the base denomination
-// - returns: the cost to purchase tokens from x to x1, in adym
+// - returns: the cost to purchase tokens from x to x1, in the base denomination of the liquidity denom (e.g adym)
 */
 func (lbc BondingCurve) Cost(x, x1 math.Int) math.Int {
-	cost := lbc.integral(ScaleFromBase(x1, lbc.SupplyDecimals())).
-		Sub(lbc.integral(ScaleFromBase(x, lbc.SupplyDecimals())))
-	return ScaleToBase(cost, DYMDecimals)
+	curve := lbc.Curve()
+	cost := curve.Integral(ScaleFromBase(x1, lbc.SupplyDecimals())).
+		Sub(curve.Integral(ScaleFromBase(x, lbc.SupplyDecimals())))
+	return ScaleToBase(cost, lbc.LiquidityDecimals())
 }
 
-// Calculate the number of tokens that can be bought with a given amount of DYM
-// As the integral of the bonding curve function is not invertible, we use the Newton-Raphson method to approximate the solution
+// Calculate the number of tokens that can be bought with a given amount of the liquidity denom
+// The inverse of the integral is given by the curve family, approximated if it has no closed form
 // - currX: the current supply, in the base denomination
-// - spendAmt: the amount of DYM to spend, in adym
+// - spendAmt: the amount to spend, in the base denomination of the liquidity denom (e.g adym)
 // - returns: the number of tokens that can be bought with spendAmt, in the base denomination
 func (lbc BondingCurve) TokensForExactDYM(currX, spendAmt math.Int) (math.Int, error) {
 	startingX := ScaleFromBase(currX, lbc.SupplyDecimals())
-	spendTokens := ScaleFromBase(spendAmt, DYMDecimals)
-
-	// If the current supply is less than 1, return 0
-	if startingX.LT(math.LegacyOneDec()) {
-		return math.ZeroInt(), fmt.Errorf("current supply is less than 1")
-	}
+	spendTokens := ScaleFromBase(spendAmt, lbc.LiquidityDecimals())
 
 	// If the spend amount is not positive, return 0
 	if !spendAmt.IsPositive() {
 		return math.ZeroInt(), fmt.Errorf("spend amount is not positive")
 	}
 
-	tokens, _, err := lbc.TokensApproximation(startingX, spendTokens)
+	tokens, err := lbc.Curve().Inverse(startingX, spendTokens)
 	if err != nil {
 		return math.ZeroInt(), err
 	}
 
-	return ScaleToBase(tokens, DYMDecimals), nil
+	return ScaleToBase(tokens, lbc.SupplyDecimals()), nil
 }
 
 /* --------------------------- internal functions --------------------------- */
 // Calculate the number of tokens that can be bought with a given amount of DYM
+// using the Newton-Raphson method
 // inputs validated and scaled by caller
 func (lbc BondingCurve) TokensApproximation(startingX, spendTokens math.LegacyDec) (math.LegacyDec, int, error) {
+	return tokensApproximation(lbc.Curve(), startingX, spendTokens)
+}
+
+func tokensApproximation(curve Curve, startingX, spendTokens math.LegacyDec) (math.LegacyDec, int, error) {
 	// Define the function we're trying to solve: f(x) = Integral(startingX + x) - Integral(startingX) - spendAmt
 	f := func(x math.LegacyDec) math.LegacyDec {
 		newX := startingX.Add(x)
-		return lbc.integral(newX).Sub(lbc.integral(startingX)).Sub(spendTokens)
+		return curve.Integral(newX).Sub(curve.Integral(startingX)).Sub(spendTokens)
 	}
 
 	// Define the derivative of the function: f'(x) = SpotPrice(startingX + x)
 	fPrime := func(x math.LegacyDec) math.LegacyDec {
 		newX := startingX.Add(x)
-		return lbc.spotPriceInternal(newX)
+		return curve.SpotPrice(newX)
 	}
 
 	// Initial guess for the solution to the bonding curve equation
@@ -192,11 +252,48 @@ func (lbc BondingCurve) TokensApproximation(startingX, spendTokens math.LegacyDe
 	return math.LegacyDec{}, maxIterations, fmt.Errorf("solution did not converge")
 }
 
+// powerCurve is the curve P = M * x^N + C
+type powerCurve struct {
+	m, n, c math.LegacyDec
+}
+
+func (pc powerCurve) ValidateBasic() error {
+	if pc.m.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "m: %d", pc.m)
+	}
+	if !pc.n.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n: %d", pc.n)
+	}
+	if pc.n.GT(math.LegacyNewDec(MaxNValue)) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "n exceeds maximum value of %d: %s", MaxNValue, pc.n)
+	}
+
+	if pc.c.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", pc.c.String())
+	}
+
+	if !checkPrecision(pc.n) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "N must have at most %d decimal places", MaxNPrecision)
+	}
+
+	return nil
+}
+
+// Inverse approximates the inverse with the Newton-Raphson method, as the integral is not invertible
+func (pc powerCurve) Inverse(x, spend math.LegacyDec) (math.LegacyDec, error) {
+	// If the current supply is less than 1, return 0
+	if x.LT(math.LegacyOneDec()) {
+		return math.LegacyZeroDec(), fmt.Errorf("current supply is less than 1")
+	}
+	tokens, _, err := tokensApproximation(pc, x, spend)
+	return tokens, err
+}
+
 // SpotPrice returns the spot price at x
-func (lbc BondingCurve) spotPriceInternal(x sdk.Dec) sdk.Dec {
+func (pc powerCurve) SpotPrice(x sdk.Dec) sdk.Dec {
 	xDec := osmomath.BigDecFromSDKDec(x)
-	nDec := osmomath.BigDecFromSDKDec(lbc.N)
-	mDec := osmomath.BigDecFromSDKDec(lbc.M)
+	nDec := osmomath.BigDecFromSDKDec(pc.n)
+	mDec := osmomath.BigDecFromSDKDec(pc.m)
 
 	var xPowN osmomath.BigDec
 	if xDec.LT(osmomath.OneDec()) {
@@ -204,19 +301,19 @@ func (lbc BondingCurve) spotPriceInternal(x sdk.Dec) sdk.Dec {
 	} else {
 		xPowN = xDec.Power(nDec) // Calculate x^N
 	}
-	price := mDec.Mul(xPowN).SDKDec().Add(lbc.C) // M * x^N + C
+	price := mDec.Mul(xPowN).SDKDec().Add(pc.c) // M * x^N + C
 	return price
 }
 
 // The integral of y = M * x^N + C is:
 //
 //	Cost = (M / (N + 1)) * x^(N + 1) + C * x.
-func (lbc BondingCurve) integral(x math.LegacyDec) math.LegacyDec {
+func (pc powerCurve) Integral(x math.LegacyDec) math.LegacyDec {
 	xDec := osmomath.BigDecFromSDKDec(x)
-	mDec := osmomath.BigDecFromSDKDec(lbc.M)
-	cDec := osmomath.BigDecFromSDKDec(lbc.C)
+	mDec := osmomath.BigDecFromSDKDec(pc.m)
+	cDec := osmomath.BigDecFromSDKDec(pc.c)
 
-	nPlusOne := osmomath.BigDecFromSDKDec(lbc.N.Add(math.LegacyNewDec(1)))
+	nPlusOne := osmomath.BigDecFromSDKDec(pc.n.Add(math.LegacyNewDec(1)))
 
 	var xPowNplusOne osmomath.BigDec
 	if xDec.LT(osmomath.OneDec()) {
@@ -290,9 +387,13 @@ func checkPrecision(d math.LegacyDec) bool {
 
 // String returns a human readable string representation of the bonding curve
 func (lbc BondingCurve) Stringify() string {
+	return lbc.Curve().Stringify()
+}
+
+func (pc powerCurve) Stringify() string {
 	return fmt.Sprintf("M=%s N=%s C=%s",
-		lbc.M.String(),
-		lbc.N.String(),
-		lbc.C.String(),
+		pc.m.String(),
+		pc.n.String(),
+		pc.c.String(),
 	)
 }
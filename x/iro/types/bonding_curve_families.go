@@ -0,0 +1,415 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+/*
+The curve families shape the price discovery differently than the power curve:
+- logarithmic: the price grows fast at first, then slower as the supply increases
+- capped exponential: the price grows exponentially until it reaches a maximum price
+- sigmoid: the price grows slowly at first, then fast around a supply, then slowly towards a maximum price
+- piecewise linear: the price follows the segments between arbitrary points
+
+Each family gives the spot price, its integral for the cost, and the inverse of the integral for the tokens
+bought with an amount of DYM. The inverse is in closed form when the integral is invertible, and approximated
+otherwise.
+*/
+
+const (
+	MaxPiecewiseLinearPoints = 16 // Maximum number of points of a piecewise linear curve
+
+	maxExpArg = 300 // exponents beyond which e^-x is considered zero, e^x is supported up to ~354
+)
+
+// log2(e), used to compute e^x = 2^(x * log2(e))
+var log2E = osmomath.MustNewDecFromStr("1.442695040888963407359924681001892137")
+
+/* ------------------------------- logarithmic ------------------------------ */
+
+func NewLogarithmicCurve(a, b, c math.LegacyDec) *LogarithmicCurve {
+	return &LogarithmicCurve{A: a, B: b, C: c}
+}
+
+func (lc *LogarithmicCurve) ValidateBasic() error {
+	if lc == nil {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "empty logarithmic curve")
+	}
+	if !lc.A.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "a must be positive: %s", lc.A)
+	}
+	if !lc.B.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "b must be positive: %s", lc.B)
+	}
+	if lc.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", lc.C)
+	}
+	return nil
+}
+
+// SpotPrice returns A * ln(1 + x / B) + C
+func (lc *LogarithmicCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	a := osmomath.BigDecFromSDKDec(lc.A)
+	return a.Mul(lc.logTerm(x)).SDKDec().Add(lc.C)
+}
+
+// The integral of y = A * ln(1 + x / B) + C is:
+//
+//	Cost = A * ((B + x) * ln(1 + x / B) - x) + C * x
+func (lc *LogarithmicCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	a := osmomath.BigDecFromSDKDec(lc.A)
+	b := osmomath.BigDecFromSDKDec(lc.B)
+	c := osmomath.BigDecFromSDKDec(lc.C)
+
+	integral := a.Mul(b.Add(xDec).Mul(lc.logTerm(x)).Sub(xDec)).Add(c.Mul(xDec))
+	return integral.SDKDec()
+}
+
+// Inverse is approximated, as the integral is not invertible
+func (lc *LogarithmicCurve) Inverse(x, spend math.LegacyDec) (math.LegacyDec, error) {
+	return approximateInverse(lc, x, spend)
+}
+
+// logTerm returns ln(1 + x / B)
+func (lc *LogarithmicCurve) logTerm(x math.LegacyDec) osmomath.BigDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	b := osmomath.BigDecFromSDKDec(lc.B)
+	return osmomath.OneDec().Add(xDec.Quo(b)).Ln()
+}
+
+func (lc *LogarithmicCurve) Stringify() string {
+	return fmt.Sprintf("logarithmic: A=%s B=%s C=%s", lc.A, lc.B, lc.C)
+}
+
+/* --------------------------- capped exponential --------------------------- */
+
+func NewCappedExponentialCurve(a, k, priceCap math.LegacyDec) *CappedExponentialCurve {
+	return &CappedExponentialCurve{A: a, K: k, Cap: priceCap}
+}
+
+func (ec *CappedExponentialCurve) ValidateBasic() error {
+	if ec == nil {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "empty capped exponential curve")
+	}
+	if !ec.A.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "a must be positive: %s", ec.A)
+	}
+	if !ec.K.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k must be positive: %s", ec.K)
+	}
+	if !ec.Cap.GT(ec.A) {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "cap must be greater than a: %s", ec.Cap)
+	}
+	return nil
+}
+
+// capX returns the supply at which the price reaches the cap: ln(Cap / A) / K
+func (ec *CappedExponentialCurve) capX() osmomath.BigDec {
+	a := osmomath.BigDecFromSDKDec(ec.A)
+	k := osmomath.BigDecFromSDKDec(ec.K)
+	priceCap := osmomath.BigDecFromSDKDec(ec.Cap)
+	return priceCap.Quo(a).Ln().Quo(k)
+}
+
+// SpotPrice returns min(A * e^(K * x), Cap)
+func (ec *CappedExponentialCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	if xDec.GTE(ec.capX()) {
+		return ec.Cap
+	}
+	a := osmomath.BigDecFromSDKDec(ec.A)
+	k := osmomath.BigDecFromSDKDec(ec.K)
+	return a.Mul(exp(k.Mul(xDec))).SDKDec()
+}
+
+// The integral of y = min(A * e^(K * x), Cap) is:
+//
+//	Cost = A / K * (e^(K * x) - 1) before the cap
+//	Cost = (Cap - A) / K + Cap * (x - capX) after the cap
+func (ec *CappedExponentialCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	a := osmomath.BigDecFromSDKDec(ec.A)
+	k := osmomath.BigDecFromSDKDec(ec.K)
+	priceCap := osmomath.BigDecFromSDKDec(ec.Cap)
+
+	capX := ec.capX()
+	if xDec.LTE(capX) {
+		return a.Quo(k).Mul(exp(k.Mul(xDec)).Sub(osmomath.OneDec())).SDKDec()
+	}
+	return priceCap.Sub(a).Quo(k).Add(priceCap.Mul(xDec.Sub(capX))).SDKDec()
+}
+
+// Inverse solves the integral for the new supply:
+//
+//	x1 = ln(1 + Cost * K / A) / K before the cap
+//	x1 = capX + (Cost - (Cap - A) / K) / Cap after the cap
+func (ec *CappedExponentialCurve) Inverse(x, spend math.LegacyDec) (math.LegacyDec, error) {
+	a := osmomath.BigDecFromSDKDec(ec.A)
+	k := osmomath.BigDecFromSDKDec(ec.K)
+	priceCap := osmomath.BigDecFromSDKDec(ec.Cap)
+
+	cost := osmomath.BigDecFromSDKDec(ec.Integral(x).Add(spend))
+	costAtCap := priceCap.Sub(a).Quo(k)
+
+	var x1 osmomath.BigDec
+	if cost.LTE(costAtCap) {
+		x1 = osmomath.OneDec().Add(cost.Mul(k).Quo(a)).Ln().Quo(k)
+	} else {
+		x1 = ec.capX().Add(cost.Sub(costAtCap).Quo(priceCap))
+	}
+	return x1.SDKDec().Sub(x), nil
+}
+
+func (ec *CappedExponentialCurve) Stringify() string {
+	return fmt.Sprintf("capped exponential: A=%s K=%s Cap=%s", ec.A, ec.K, ec.Cap)
+}
+
+/* --------------------------------- sigmoid -------------------------------- */
+
+func NewSigmoidCurve(l, k, x0, c math.LegacyDec) *SigmoidCurve {
+	return &SigmoidCurve{L: l, K: k, X0: x0, C: c}
+}
+
+func (sc *SigmoidCurve) ValidateBasic() error {
+	if sc == nil {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "empty sigmoid curve")
+	}
+	if !sc.L.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "l must be positive: %s", sc.L)
+	}
+	if !sc.K.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "k must be positive: %s", sc.K)
+	}
+	if sc.X0.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "x0: %s", sc.X0)
+	}
+	if sc.C.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "c: %s", sc.C)
+	}
+	return nil
+}
+
+// SpotPrice returns C + L / (1 + e^(-K * (x - X0)))
+func (sc *SigmoidCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	l := osmomath.BigDecFromSDKDec(sc.L)
+	return l.Mul(sigmoid(sc.z(x))).SDKDec().Add(sc.C)
+}
+
+// The integral of y = C + L / (1 + e^(-K * (x - X0))) is:
+//
+//	Cost = C * x + L / K * (ln(1 + e^(K * (x - X0))) - ln(1 + e^(-K * X0)))
+func (sc *SigmoidCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	xDec := osmomath.BigDecFromSDKDec(x)
+	l := osmomath.BigDecFromSDKDec(sc.L)
+	k := osmomath.BigDecFromSDKDec(sc.K)
+	c := osmomath.BigDecFromSDKDec(sc.C)
+
+	softplusDiff := softplus(sc.z(x)).Sub(softplus(sc.z(math.LegacyZeroDec())))
+	return c.Mul(xDec).Add(l.Quo(k).Mul(softplusDiff)).SDKDec()
+}
+
+// Inverse is approximated, as the integral is not invertible
+func (sc *SigmoidCurve) Inverse(x, spend math.LegacyDec) (math.LegacyDec, error) {
+	return approximateInverse(sc, x, spend)
+}
+
+// z returns K * (x - X0)
+func (sc *SigmoidCurve) z(x math.LegacyDec) osmomath.BigDec {
+	return osmomath.BigDecFromSDKDec(sc.K).Mul(osmomath.BigDecFromSDKDec(x.Sub(sc.X0)))
+}
+
+func (sc *SigmoidCurve) Stringify() string {
+	return fmt.Sprintf("sigmoid: L=%s K=%s X0=%s C=%s", sc.L, sc.K, sc.X0, sc.C)
+}
+
+/* ---------------------------- piecewise linear ---------------------------- */
+
+func NewPiecewiseLinearCurve(points ...PricePoint) *PiecewiseLinearCurve {
+	return &PiecewiseLinearCurve{Points: points}
+}
+
+func NewPricePoint(x, price math.LegacyDec) PricePoint {
+	return PricePoint{X: x, Price: price}
+}
+
+func (pl *PiecewiseLinearCurve) ValidateBasic() error {
+	if pl == nil || len(pl.Points) == 0 {
+		return errorsmod.Wrap(ErrInvalidBondingCurve, "empty piecewise linear curve")
+	}
+	if len(pl.Points) > MaxPiecewiseLinearPoints {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "too many points: %d > %d", len(pl.Points), MaxPiecewiseLinearPoints)
+	}
+	if !pl.Points[0].X.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidBondingCurve, "first point must be at zero supply: %s", pl.Points[0].X)
+	}
+	for i, p := range pl.Points {
+		if !p.Price.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidBondingCurve, "price of point %d must be positive: %s", i, p.Price)
+		}
+		if 0 < i && !p.X.GT(pl.Points[i-1].X) {
+			return errorsmod.Wrapf(ErrInvalidBondingCurve, "points must be by strictly increasing supply: %s", p.X)
+		}
+	}
+	return nil
+}
+
+// segment returns the index of the last point at or before x
+func (pl *PiecewiseLinearCurve) segment(x math.LegacyDec) int {
+	i := 0
+	for i+1 < len(pl.Points) && pl.Points[i+1].X.LTE(x) {
+		i++
+	}
+	return i
+}
+
+// SpotPrice interpolates the price between the points around x, and is the last price after the last point
+func (pl *PiecewiseLinearCurve) SpotPrice(x math.LegacyDec) math.LegacyDec {
+	i := pl.segment(x)
+	p := pl.Points[i]
+	if i+1 == len(pl.Points) {
+		return p.Price
+	}
+	next := pl.Points[i+1]
+	slope := next.Price.Sub(p.Price).Quo(next.X.Sub(p.X))
+	return p.Price.Add(slope.Mul(x.Sub(p.X)))
+}
+
+// Integral sums the areas of the trapezoids under the segments up to x
+func (pl *PiecewiseLinearCurve) Integral(x math.LegacyDec) math.LegacyDec {
+	integral := math.LegacyZeroDec()
+	last := pl.segment(x)
+	for i := 0; i < last; i++ {
+		p, next := pl.Points[i], pl.Points[i+1]
+		integral = integral.Add(next.X.Sub(p.X).Mul(p.Price.Add(next.Price)).QuoInt64(2))
+	}
+	p := pl.Points[last]
+	return integral.Add(x.Sub(p.X).Mul(p.Price.Add(pl.SpotPrice(x))).QuoInt64(2))
+}
+
+// Inverse walks the segments from x until the spend is exhausted, and solves the segment it ends in:
+//
+//	d = spend / P on a flat segment
+//	d = (sqrt(P^2 + 2 * slope * spend) - P) / slope otherwise
+func (pl *PiecewiseLinearCurve) Inverse(x, spend math.LegacyDec) (math.LegacyDec, error) {
+	curr := x
+	remaining := spend
+	for i := pl.segment(x); ; i++ {
+		price := pl.SpotPrice(curr)
+		if i+1 == len(pl.Points) {
+			return curr.Add(remaining.Quo(price)).Sub(x), nil
+		}
+		next := pl.Points[i+1]
+		costToNext := next.X.Sub(curr).Mul(price.Add(next.Price)).QuoInt64(2)
+		if costToNext.LT(remaining) {
+			remaining = remaining.Sub(costToNext)
+			curr = next.X
+			continue
+		}
+
+		slope := next.Price.Sub(pl.Points[i].Price).Quo(next.X.Sub(pl.Points[i].X))
+		if slope.IsZero() {
+			return curr.Add(remaining.Quo(price)).Sub(x), nil
+		}
+		p := osmomath.BigDecFromSDKDec(price)
+		s := osmomath.BigDecFromSDKDec(slope)
+		discriminant := p.Mul(p).Add(s.Mul(osmomath.BigDecFromSDKDec(remaining)).MulInt64(2))
+		root, err := discriminant.ApproxRoot(2)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		d := root.Sub(p).Quo(s)
+		return curr.Add(d.SDKDec()).Sub(x), nil
+	}
+}
+
+func (pl *PiecewiseLinearCurve) Stringify() string {
+	s := "piecewise linear:"
+	for _, p := range pl.Points {
+		s += fmt.Sprintf(" (%s, %s)", p.X, p.Price)
+	}
+	return s
+}
+
+/* ---------------------------- helper functions ---------------------------- */
+
+// approximateInverse approximates the supply which can be bought from supply x with spend, for the curves
+// without a closed form inverse. It uses the Newton-Raphson method, bisecting a bracket of the solution
+// when a step leaves it, so it converges for any curve with a non-negative price.
+func approximateInverse(curve Curve, x, spend math.LegacyDec) (math.LegacyDec, error) {
+	target := curve.Integral(x).Add(spend)
+	f := func(y math.LegacyDec) math.LegacyDec {
+		return curve.Integral(y).Sub(target)
+	}
+	epsilonDec := math.LegacyNewDecWithPrec(1, epsilonPrecision)
+
+	// find a bracket of the solution, assuming 1 DYM = 1 token for the first guess
+	lo, hi := x, x.Add(spend)
+	for i := 0; f(hi).IsNegative(); i++ {
+		if i == maxIterations {
+			return math.LegacyDec{}, fmt.Errorf("solution did not converge")
+		}
+		lo = hi
+		hi = x.Add(hi.Sub(x).MulInt64(2))
+	}
+
+	y := hi
+	for i := 0; i < maxIterations; i++ {
+		fy := f(y)
+		// If the function converges, return the result
+		if fy.Abs().LT(epsilonDec) {
+			return y.Sub(x), nil
+		}
+		if fy.IsNegative() {
+			lo = y
+		} else {
+			hi = y
+		}
+
+		next := lo.Add(hi).QuoInt64(2)
+		if price := curve.SpotPrice(y); price.IsPositive() {
+			if step := y.Sub(fy.Quo(price)); step.GT(lo) && step.LT(hi) {
+				next = step
+			}
+		}
+
+		// If the change in y is less than epsilon * y, return the result
+		if next.Sub(y).Abs().LT(epsilonDec.Mul(y.Abs())) {
+			return next.Sub(x), nil
+		}
+		y = next
+	}
+	return math.LegacyDec{}, fmt.Errorf("solution did not converge")
+}
+
+// exp returns e^x. Panics if x exceeds the range of osmomath.Exp2.
+func exp(x osmomath.BigDec) osmomath.BigDec {
+	if x.IsNegative() {
+		if x.Neg().GT(osmomath.NewBigDec(maxExpArg)) {
+			return osmomath.ZeroDec()
+		}
+		return osmomath.OneDec().Quo(exp(x.Neg()))
+	}
+	return osmomath.Exp2(x.Mul(log2E))
+}
+
+// softplus returns ln(1 + e^x) without overflowing for large x
+func softplus(x osmomath.BigDec) osmomath.BigDec {
+	if x.IsPositive() {
+		return x.Add(osmomath.OneDec().Add(exp(x.Neg())).Ln())
+	}
+	return osmomath.OneDec().Add(exp(x)).Ln()
+}
+
+// sigmoid returns 1 / (1 + e^-x) without overflowing for large x
+func sigmoid(x osmomath.BigDec) osmomath.BigDec {
+	if x.IsNegative() {
+		ex := exp(x)
+		return ex.Quo(osmomath.OneDec().Add(ex))
+	}
+	return osmomath.OneDec().Quo(osmomath.OneDec().Add(exp(x.Neg())))
+}
@@ -0,0 +1,141 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func dec(s string) math.LegacyDec {
+	return math.LegacyMustNewDecFromStr(s)
+}
+
+func TestBondingCurveFamilies_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		curve     types.BondingCurve
+		expectErr bool
+	}{
+		{"Valid logarithmic curve", types.NewBondingCurveFromFamily(types.NewLogarithmicCurve(dec("1"), dec("1000"), dec("0"))), false},
+		{"Invalid logarithmic curve A", types.NewBondingCurveFromFamily(types.NewLogarithmicCurve(dec("0"), dec("1000"), dec("0"))), true},
+		{"Invalid logarithmic curve B", types.NewBondingCurveFromFamily(types.NewLogarithmicCurve(dec("1"), dec("0"), dec("0"))), true},
+		{"Valid capped exponential curve", types.NewBondingCurveFromFamily(types.NewCappedExponentialCurve(dec("0.01"), dec("0.001"), dec("1"))), false},
+		{"Invalid capped exponential curve cap", types.NewBondingCurveFromFamily(types.NewCappedExponentialCurve(dec("1"), dec("0.001"), dec("1"))), true},
+		{"Valid sigmoid curve", types.NewBondingCurveFromFamily(types.NewSigmoidCurve(dec("1"), dec("0.01"), dec("1000"), dec("0.1"))), false},
+		{"Invalid sigmoid curve X0", types.NewBondingCurveFromFamily(types.NewSigmoidCurve(dec("1"), dec("0.01"), dec("-1"), dec("0.1"))), true},
+		{"Valid piecewise linear curve", types.NewBondingCurveFromFamily(types.NewPiecewiseLinearCurve(
+			types.NewPricePoint(dec("0"), dec("1")),
+			types.NewPricePoint(dec("10"), dec("3")),
+		)), false},
+		{"Piecewise linear curve not starting at zero", types.NewBondingCurveFromFamily(types.NewPiecewiseLinearCurve(
+			types.NewPricePoint(dec("1"), dec("1")),
+		)), true},
+		{"Piecewise linear curve not increasing", types.NewBondingCurveFromFamily(types.NewPiecewiseLinearCurve(
+			types.NewPricePoint(dec("0"), dec("1")),
+			types.NewPricePoint(dec("0"), dec("3")),
+		)), true},
+		{"Piecewise linear curve with zero price", types.NewBondingCurveFromFamily(types.NewPiecewiseLinearCurve(
+			types.NewPricePoint(dec("0"), dec("0")),
+		)), true},
+		{"Empty family", types.BondingCurve{Family: &types.BondingCurve_Sigmoid{}}, true},
+		{"Family with power curve parameters", types.BondingCurve{
+			M:      dec("1"),
+			Family: &types.BondingCurve_Logarithmic{Logarithmic: types.NewLogarithmicCurve(dec("1"), dec("1000"), dec("0"))},
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.curve.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBondingCurveFamilies(t *testing.T) {
+	tokens := func(x int64) math.Int {
+		return math.NewInt(x).MulRaw(1e18)
+	}
+
+	tests := []struct {
+		name  string
+		curve types.BondingCurve
+		// spot prices and integrals from zero at the supply x
+		x         []int64
+		spotPrice []string
+		integral  []string
+	}{
+		{
+			// y = ln(1 + x)
+			name:      "logarithmic",
+			curve:     types.NewBondingCurveFromFamily(types.NewLogarithmicCurve(dec("1"), dec("1"), dec("0"))),
+			x:         []int64{0, 1, 10},
+			spotPrice: []string{"0", "0.693147180559945309", "2.397895272798370544"},
+			integral:  []string{"0", "0.386294361119890618", "16.376848000782075988"},
+		},
+		{
+			// y = min(e^x, 10)
+			name:      "capped exponential",
+			curve:     types.NewBondingCurveFromFamily(types.NewCappedExponentialCurve(dec("1"), dec("1"), dec("10"))),
+			x:         []int64{0, 1, 5},
+			spotPrice: []string{"1", "2.718281828459045235", "10"},
+			integral:  []string{"0", "1.718281828459045235", "35.974149070059542"},
+		},
+		{
+			// y = 2 / (1 + e^-x)
+			name:      "sigmoid",
+			curve:     types.NewBondingCurveFromFamily(types.NewSigmoidCurve(dec("2"), dec("1"), dec("0"), dec("0"))),
+			x:         []int64{0, 1, 500},
+			spotPrice: []string{"1", "1.462117157260009758", "2"},
+			integral:  []string{"0", "1.240229013916555856", "998.613705638880109381"},
+		},
+		{
+			name: "piecewise linear",
+			curve: types.NewBondingCurveFromFamily(types.NewPiecewiseLinearCurve(
+				types.NewPricePoint(dec("0"), dec("1")),
+				types.NewPricePoint(dec("10"), dec("3")),
+				types.NewPricePoint(dec("20"), dec("3")),
+			)),
+			x:         []int64{0, 5, 10, 25},
+			spotPrice: []string{"1", "2", "3", "3"},
+			integral:  []string{"0", "7.5", "20", "65"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.curve.ValidateBasic())
+
+			for i, x := range tt.x {
+				approxEqualDec(t, dec(tt.spotPrice[i]), tt.curve.SpotPrice(tokens(x)))
+				approxEqualInt(t, dec(tt.integral[i]).MulInt64(1e18).TruncateInt(), tt.curve.Cost(math.ZeroInt(), tokens(x)))
+			}
+
+			// the tokens bought with an amount of DYM cost that amount
+			for _, x := range tt.x {
+				for _, spend := range []math.Int{math.NewInt(1e15), tokens(1), tokens(50)} {
+					bought, err := tt.curve.TokensForExactDYM(tokens(x), spend)
+					require.NoError(t, err)
+					require.True(t, bought.IsPositive())
+					approxEqualInt(t, spend, tt.curve.Cost(tokens(x), tokens(x).Add(bought)))
+				}
+			}
+		})
+	}
+}
+
+func TestBondingCurveFamilies_Amino(t *testing.T) {
+	msg := types.MsgCreatePlan{
+		Owner:        "dym1nq5y8p6t3lkkv7m2uexsjuzs3afl8kprjmh5y3",
+		RollappId:    "rollapp_1234-1",
+		BondingCurve: types.NewBondingCurveFromFamily(types.NewSigmoidCurve(dec("2"), dec("1"), dec("0"), dec("0"))),
+	}
+	require.Contains(t, string(msg.GetSignBytes()), "iro/SigmoidCurve")
+}
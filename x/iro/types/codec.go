@@ -12,9 +12,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBuy{}, "iro/Buy", nil)
 	cdc.RegisterConcrete(&MsgSell{}, "iro/Sell", nil)
 	cdc.RegisterConcrete(&MsgClaim{}, "iro/Claim", nil)
+	cdc.RegisterConcrete(&MsgRefund{}, "iro/Refund", nil)
 	cdc.RegisterConcrete(&MsgCreatePlan{}, "iro/CreatePlan", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "iro/UpdateParams", nil)
 	cdc.RegisterConcrete(&MsgBuyExactSpend{}, "iro/BuyExactSpend", nil)
+
+	cdc.RegisterInterface((*isBondingCurve_Family)(nil), nil)
+	cdc.RegisterConcrete(&BondingCurve_Logarithmic{}, "iro/LogarithmicCurve", nil)
+	cdc.RegisterConcrete(&BondingCurve_CappedExponential{}, "iro/CappedExponentialCurve", nil)
+	cdc.RegisterConcrete(&BondingCurve_Sigmoid{}, "iro/SigmoidCurve", nil)
+	cdc.RegisterConcrete(&BondingCurve_PiecewiseLinear{}, "iro/PiecewiseLinearCurve", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,6 +30,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBuy{},
 		&MsgSell{},
 		&MsgClaim{},
+		&MsgRefund{},
 		&MsgCreatePlan{},
 		&MsgUpdateParams{},
 	)
@@ -28,4 +28,11 @@ var (
 	ErrInsufficientTokens           = errorsmod.Register(ModuleName, 1118, "insufficient tokens")
 	ErrRollappGenesisInfoNotSet     = errorsmod.Register(ModuleName, 1119, "rollapp genesis info not set")
 	ErrInvalidIncentivePlanParams   = errorsmod.Register(ModuleName, 1120, "invalid incentive plan params")
+	ErrInvalidLiquidityDenom        = errorsmod.Register(ModuleName, 1121, "invalid liquidity denom")
+	ErrInvalidVestingPlan           = errorsmod.Register(ModuleName, 1122, "invalid vesting plan")
+	ErrInvalidAllowlistPhase        = errorsmod.Register(ModuleName, 1123, "invalid allowlist phase")
+	ErrNotAllowlisted               = errorsmod.Register(ModuleName, 1124, "address is not allowlisted")
+	ErrPurchaseLimitExceeded        = errorsmod.Register(ModuleName, 1125, "purchase limit exceeded")
+	ErrPlanCancelled                = errorsmod.Register(ModuleName, 1126, "plan is cancelled")
+	ErrPlanNotCancelled             = errorsmod.Register(ModuleName, 1127, "plan is not cancelled")
 )
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
@@ -435,6 +436,198 @@ func (m *EventSettle) GetGaugeId() uint64 {
 	return 0
 }
 
+type EventCancel struct {
+	PlanId    string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *EventCancel) Reset()         { *m = EventCancel{} }
+func (m *EventCancel) String() string { return proto.CompactTextString(m) }
+func (*EventCancel) ProtoMessage()    {}
+func (*EventCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{6}
+}
+func (m *EventCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancel.Merge(m, src)
+}
+func (m *EventCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancel proto.InternalMessageInfo
+
+func (m *EventCancel) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventCancel) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type EventReturnAllocation struct {
+	PlanId    string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Owner     string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The RA tokens allocated to the cancelled plan, returned to the owner
+	Allocation types.Coin `protobuf:"bytes,4,opt,name=allocation,proto3" json:"allocation"`
+}
+
+func (m *EventReturnAllocation) Reset()         { *m = EventReturnAllocation{} }
+func (m *EventReturnAllocation) String() string { return proto.CompactTextString(m) }
+func (*EventReturnAllocation) ProtoMessage()    {}
+func (*EventReturnAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{7}
+}
+func (m *EventReturnAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReturnAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReturnAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReturnAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReturnAllocation.Merge(m, src)
+}
+func (m *EventReturnAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReturnAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReturnAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReturnAllocation proto.InternalMessageInfo
+
+func (m *EventReturnAllocation) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventReturnAllocation) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventReturnAllocation) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventReturnAllocation) GetAllocation() types.Coin {
+	if m != nil {
+		return m.Allocation
+	}
+	return types.Coin{}
+}
+
+type EventRefund struct {
+	Refunder  string `protobuf:"bytes,1,opt,name=refunder,proto3" json:"refunder,omitempty"`
+	PlanId    string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// The amount of IRO tokens burnt
+	BurntAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=burnt_amount,json=burntAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"burnt_amount"`
+	// The refunded liquidity
+	Refund types.Coin `protobuf:"bytes,5,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *EventRefund) Reset()         { *m = EventRefund{} }
+func (m *EventRefund) String() string { return proto.CompactTextString(m) }
+func (*EventRefund) ProtoMessage()    {}
+func (*EventRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{8}
+}
+func (m *EventRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRefund.Merge(m, src)
+}
+func (m *EventRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRefund proto.InternalMessageInfo
+
+func (m *EventRefund) GetRefunder() string {
+	if m != nil {
+		return m.Refunder
+	}
+	return ""
+}
+
+func (m *EventRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventRefund) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRefund) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "dymensionxyz.dymension.iro.EventUpdateParams")
 	proto.RegisterType((*EventNewIROPlan)(nil), "dymensionxyz.dymension.iro.EventNewIROPlan")
@@ -442,6 +635,9 @@ func init() {
 	proto.RegisterType((*EventSell)(nil), "dymensionxyz.dymension.iro.EventSell")
 	proto.RegisterType((*EventClaim)(nil), "dymensionxyz.dymension.iro.EventClaim")
 	proto.RegisterType((*EventSettle)(nil), "dymensionxyz.dymension.iro.EventSettle")
+	proto.RegisterType((*EventCancel)(nil), "dymensionxyz.dymension.iro.EventCancel")
+	proto.RegisterType((*EventReturnAllocation)(nil), "dymensionxyz.dymension.iro.EventReturnAllocation")
+	proto.RegisterType((*EventRefund)(nil), "dymensionxyz.dymension.iro.EventRefund")
 }
 
 func init() {
@@ -449,47 +645,56 @@ func init() {
 }
 
 var fileDescriptor_9d7833031285167c = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6b, 0xd4, 0x4e,
-	0x14, 0xdf, 0x74, 0x7f, 0xe6, 0xf5, 0xfb, 0x45, 0x1c, 0x0a, 0xa6, 0x15, 0xb7, 0x65, 0x11, 0xed,
-	0xa5, 0x49, 0x69, 0xc1, 0xbb, 0x69, 0x6d, 0x8d, 0x82, 0x96, 0x14, 0x2f, 0x5e, 0x96, 0x6c, 0xf2,
-	0x9a, 0x86, 0x4e, 0x32, 0x61, 0x66, 0xd2, 0x76, 0x05, 0xff, 0x07, 0x4f, 0xfe, 0x25, 0xe2, 0xd5,
-	0x6b, 0x8f, 0xc5, 0x83, 0x88, 0x60, 0x91, 0xf6, 0x1f, 0x91, 0x99, 0xa4, 0xeb, 0x22, 0xf4, 0xd7,
-	0x5e, 0x8a, 0xa7, 0xcd, 0x67, 0xde, 0xe7, 0x7d, 0xde, 0xfb, 0xcc, 0x9b, 0xd9, 0x81, 0xc7, 0xd1,
-	0x30, 0xc5, 0x4c, 0x24, 0x2c, 0x3b, 0x1c, 0xbe, 0x73, 0x46, 0xc0, 0x49, 0x38, 0x73, 0x70, 0x1f,
-	0x33, 0x29, 0xec, 0x9c, 0x33, 0xc9, 0xc8, 0xdc, 0x38, 0xd1, 0x1e, 0x01, 0x3b, 0xe1, 0x6c, 0x6e,
-	0x26, 0x66, 0x31, 0xd3, 0x34, 0x47, 0x7d, 0x95, 0x19, 0x73, 0xb3, 0x21, 0x13, 0x29, 0x13, 0xfd,
-	0x32, 0x50, 0x82, 0x2a, 0x34, 0x1f, 0x33, 0x16, 0x53, 0x74, 0x34, 0x1a, 0x14, 0x3b, 0x8e, 0x4c,
-	0x52, 0x14, 0x32, 0x48, 0xf3, 0x8a, 0xf0, 0xf0, 0x92, 0xb6, 0x12, 0x5e, 0x55, 0xe8, 0xfd, 0x34,
-	0xe0, 0xee, 0x33, 0xd5, 0xe4, 0x9b, 0x3c, 0x0a, 0x24, 0x6e, 0x05, 0x3c, 0x48, 0x05, 0x79, 0x02,
-	0x66, 0x50, 0xc8, 0x5d, 0xc6, 0x13, 0x39, 0xb4, 0x8c, 0x05, 0x63, 0xd1, 0x74, 0xad, 0xaf, 0x9f,
-	0x96, 0x66, 0xaa, 0x0e, 0x9e, 0x46, 0x11, 0x47, 0x21, 0xb6, 0x25, 0x4f, 0xb2, 0xd8, 0xff, 0x43,
-	0x25, 0x9b, 0x00, 0x19, 0x1e, 0xf4, 0x73, 0xad, 0x62, 0x4d, 0x2d, 0x18, 0x8b, 0xd3, 0x2b, 0x3d,
-	0xfb, 0x62, 0xdb, 0x76, 0x59, 0xcf, 0x6d, 0x1c, 0x9d, 0xcc, 0xd7, 0x7c, 0x33, 0xc3, 0x83, 0xaa,
-	0x81, 0x4d, 0x00, 0x46, 0xa3, 0x73, 0xa1, 0xfa, 0x4d, 0x85, 0x18, 0x8d, 0xca, 0x85, 0xde, 0x7b,
-	0xb8, 0xa3, 0xed, 0xbd, 0xc2, 0x03, 0xcf, 0x7f, 0xbd, 0x45, 0x83, 0x8c, 0xac, 0x40, 0x3b, 0xe4,
-	0x18, 0x48, 0xc6, 0xaf, 0xb4, 0x76, 0x4e, 0x24, 0xf7, 0xa0, 0x9d, 0xd3, 0x20, 0xeb, 0x27, 0x91,
-	0x76, 0x65, 0xfa, 0x2d, 0x05, 0xbd, 0x88, 0x3c, 0x00, 0xe0, 0x8c, 0xd2, 0x20, 0xcf, 0x55, 0xac,
-	0xae, 0x63, 0x66, 0xb5, 0xe2, 0x45, 0xbd, 0xcf, 0x75, 0xe8, 0xe8, 0xfa, 0x6e, 0x31, 0x24, 0x36,
-	0x34, 0x07, 0xc5, 0x10, 0xaf, 0x2e, 0x5b, 0xd2, 0x26, 0x2d, 0x4a, 0x36, 0xa0, 0x15, 0xa4, 0xac,
-	0xc8, 0xa4, 0xd5, 0xd0, 0x85, 0x6c, 0xb5, 0x29, 0x3f, 0x4e, 0xe6, 0x1f, 0xc5, 0x89, 0xdc, 0x2d,
-	0x06, 0x76, 0xc8, 0xd2, 0xea, 0x2c, 0x55, 0x3f, 0x4b, 0x22, 0xda, 0x73, 0xe4, 0x30, 0x47, 0x61,
-	0x7b, 0x99, 0xf4, 0xab, 0x6c, 0xe2, 0x42, 0x23, 0x64, 0x42, 0x5a, 0xcd, 0x89, 0x54, 0x74, 0x2e,
-	0x79, 0x09, 0xa6, 0x0c, 0xf6, 0x90, 0xf7, 0x77, 0x10, 0xad, 0xd6, 0x44, 0x42, 0x1d, 0x2d, 0xb0,
-	0x81, 0x48, 0xb6, 0xe1, 0xff, 0x90, 0x32, 0x91, 0x64, 0x71, 0x3f, 0xe7, 0x49, 0x88, 0x56, 0xfb,
-	0xc6, 0x82, 0xeb, 0x18, 0xfa, 0xff, 0x55, 0x22, 0x5b, 0x4a, 0x83, 0xcc, 0x40, 0x33, 0xc2, 0x8c,
-	0xa5, 0x56, 0x47, 0xef, 0x63, 0x09, 0x7a, 0x5f, 0xea, 0x60, 0xea, 0xc1, 0x6d, 0x23, 0xa5, 0x64,
-	0x19, 0x5a, 0x02, 0x29, 0xbd, 0xc6, 0xe8, 0x2a, 0xde, 0xad, 0xcf, 0xee, 0x39, 0xb4, 0xb9, 0xfa,
-	0xf3, 0x29, 0x70, 0xc2, 0xf1, 0x9d, 0xa7, 0xff, 0xb3, 0x13, 0xfc, 0x66, 0x00, 0xe8, 0x09, 0xae,
-	0xd1, 0x20, 0x49, 0xf5, 0xad, 0x57, 0x1f, 0x78, 0x9d, 0x5b, 0x5f, 0x12, 0x6f, 0x7d, 0x88, 0x23,
-	0x63, 0xcd, 0x71, 0x63, 0x1f, 0x0d, 0x98, 0xae, 0x8e, 0xa6, 0x94, 0x14, 0xc7, 0xbb, 0x34, 0x2e,
-	0xe9, 0x72, 0xea, 0xef, 0x2e, 0xef, 0x83, 0xe9, 0xb9, 0x6b, 0xfd, 0xb2, 0x42, 0xe9, 0xa1, 0xe3,
-	0xb9, 0x6b, 0xeb, 0x0a, 0x6b, 0x51, 0xc6, 0xa8, 0x4a, 0x54, 0x1e, 0x1a, 0x7e, 0x4b, 0x41, 0x2f,
-	0x22, 0xb3, 0xd0, 0x89, 0x83, 0x22, 0x46, 0x15, 0x69, 0xea, 0x48, 0x5b, 0x63, 0x2f, 0x72, 0x5f,
-	0x1c, 0x9d, 0x76, 0x8d, 0xe3, 0xd3, 0xae, 0xf1, 0xeb, 0xb4, 0x6b, 0x7c, 0x38, 0xeb, 0xd6, 0x8e,
-	0xcf, 0xba, 0xb5, 0xef, 0x67, 0xdd, 0xda, 0xdb, 0xe5, 0x31, 0xe3, 0x17, 0x3c, 0x4b, 0xfb, 0xab,
-	0xce, 0xa1, 0x7e, 0x9b, 0xf4, 0x36, 0x0c, 0x5a, 0xfa, 0x79, 0x5a, 0xfd, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x62, 0xf3, 0xd3, 0x6a, 0x5d, 0x07, 0x00, 0x00,
+	// 771 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0x9f, 0x7e, 0x59, 0x84, 0x18, 0x15, 0xe1, 0x16, 0x91, 0xae, 0x2c, 0x04, 0x7b,
+	0x59, 0x7b, 0xb7, 0x8b, 0xe0, 0x88, 0x9a, 0xec, 0xb6, 0x18, 0x24, 0x28, 0xae, 0xb8, 0x70, 0x89,
+	0x26, 0xf6, 0xab, 0x6b, 0x75, 0x3c, 0x63, 0x8d, 0xc7, 0x4d, 0x83, 0xc4, 0x99, 0x2b, 0x27, 0xfe,
+	0x12, 0xc4, 0x15, 0x8e, 0x3d, 0x56, 0x1c, 0x10, 0x42, 0xa2, 0x42, 0xed, 0x3f, 0x82, 0x3c, 0x9e,
+	0x86, 0x08, 0xa9, 0x6d, 0x1a, 0x0e, 0x15, 0xa7, 0xf8, 0xf9, 0x7d, 0xef, 0x7b, 0xdf, 0xfb, 0xe1,
+	0xcc, 0xc0, 0xfb, 0xf1, 0x2c, 0x43, 0x5e, 0xa4, 0x82, 0x9f, 0xce, 0xbe, 0xf1, 0xe7, 0x86, 0x9f,
+	0x4a, 0xe1, 0xe3, 0x09, 0x72, 0x55, 0x78, 0xb9, 0x14, 0x4a, 0x90, 0xcd, 0x45, 0xa0, 0x37, 0x37,
+	0xbc, 0x54, 0x8a, 0xcd, 0xf5, 0x44, 0x24, 0x42, 0xc3, 0xfc, 0xea, 0xa9, 0x8e, 0xd8, 0xdc, 0x88,
+	0x44, 0x91, 0x89, 0x62, 0x5c, 0x3b, 0x6a, 0xc3, 0xb8, 0x06, 0xb5, 0xe5, 0x4f, 0x68, 0x81, 0xfe,
+	0xc9, 0xf3, 0x09, 0x2a, 0xfa, 0xdc, 0x8f, 0x44, 0xca, 0x8d, 0x7f, 0x2b, 0x11, 0x22, 0x61, 0xe8,
+	0x6b, 0x6b, 0x52, 0x1e, 0xfa, 0x2a, 0xcd, 0xb0, 0x50, 0x34, 0xcb, 0x0d, 0xe0, 0xdd, 0x5b, 0x64,
+	0xa7, 0xd2, 0x28, 0x70, 0xff, 0xb4, 0xe0, 0x8d, 0x57, 0x55, 0x11, 0x5f, 0xe5, 0x31, 0x55, 0xb8,
+	0x4f, 0x25, 0xcd, 0x0a, 0xf2, 0x21, 0xd8, 0xb4, 0x54, 0x47, 0x42, 0xa6, 0x6a, 0xe6, 0x58, 0x8f,
+	0xad, 0x27, 0xf6, 0xd0, 0xf9, 0xf5, 0xc7, 0xa7, 0xeb, 0x46, 0xe1, 0x4e, 0x1c, 0x4b, 0x2c, 0x8a,
+	0x03, 0x25, 0x53, 0x9e, 0x84, 0xff, 0x40, 0xc9, 0x1e, 0x00, 0xc7, 0xe9, 0x38, 0xd7, 0x2c, 0xce,
+	0xda, 0x63, 0xeb, 0x49, 0x7f, 0xdb, 0xf5, 0x6e, 0x6e, 0x8b, 0x57, 0xe7, 0x1b, 0xb6, 0xce, 0x2e,
+	0xb6, 0x1a, 0xa1, 0xcd, 0x71, 0x6a, 0x04, 0xec, 0x01, 0x08, 0x16, 0x5f, 0x13, 0x35, 0xef, 0x4b,
+	0x24, 0x58, 0x5c, 0xbf, 0x70, 0xbf, 0x85, 0xd7, 0x75, 0x79, 0x9f, 0xe3, 0x34, 0x08, 0xbf, 0xd8,
+	0x67, 0x94, 0x93, 0x6d, 0xe8, 0x46, 0x12, 0xa9, 0x12, 0xf2, 0xce, 0xd2, 0xae, 0x81, 0xe4, 0x2d,
+	0xe8, 0xe6, 0x8c, 0xf2, 0x71, 0x1a, 0xeb, 0xaa, 0xec, 0xb0, 0x53, 0x99, 0x41, 0x4c, 0xde, 0x01,
+	0x90, 0x82, 0x31, 0x9a, 0xe7, 0x95, 0xaf, 0xa9, 0x7d, 0xb6, 0x79, 0x13, 0xc4, 0xee, 0x4f, 0x4d,
+	0xe8, 0xe9, 0xfc, 0xc3, 0x72, 0x46, 0x3c, 0x68, 0x4f, 0xca, 0x19, 0xde, 0x9d, 0xb6, 0x86, 0xad,
+	0x9a, 0x94, 0xec, 0x42, 0x87, 0x66, 0xa2, 0xe4, 0xca, 0x69, 0xe9, 0x44, 0x5e, 0xd5, 0x94, 0x3f,
+	0x2e, 0xb6, 0xde, 0x4b, 0x52, 0x75, 0x54, 0x4e, 0xbc, 0x48, 0x64, 0x66, 0xd7, 0xcc, 0xcf, 0xd3,
+	0x22, 0x3e, 0xf6, 0xd5, 0x2c, 0xc7, 0xc2, 0x0b, 0xb8, 0x0a, 0x4d, 0x34, 0x19, 0x42, 0x2b, 0x12,
+	0x85, 0x72, 0xda, 0x2b, 0xb1, 0xe8, 0x58, 0xf2, 0x19, 0xd8, 0x8a, 0x1e, 0xa3, 0x1c, 0x1f, 0x22,
+	0x3a, 0x9d, 0x95, 0x88, 0x7a, 0x9a, 0x60, 0x17, 0x91, 0x1c, 0xc0, 0x6b, 0x11, 0x13, 0x45, 0xca,
+	0x93, 0x71, 0x2e, 0xd3, 0x08, 0x9d, 0xee, 0xbd, 0x09, 0x5f, 0x62, 0x14, 0x3e, 0x32, 0x24, 0xfb,
+	0x15, 0x07, 0x59, 0x87, 0x76, 0x8c, 0x5c, 0x64, 0x4e, 0x4f, 0xf7, 0xb1, 0x36, 0xdc, 0x9f, 0x9b,
+	0x60, 0xeb, 0xc1, 0x1d, 0x20, 0x63, 0xe4, 0x19, 0x74, 0x0a, 0x64, 0x6c, 0x89, 0xd1, 0x19, 0xdc,
+	0x83, 0xcf, 0xee, 0x13, 0xe8, 0xca, 0xea, 0xcf, 0xa9, 0xc4, 0x15, 0xc7, 0x77, 0x1d, 0xfe, 0xbf,
+	0x9d, 0xe0, 0x6f, 0x16, 0x80, 0x9e, 0xe0, 0x88, 0xd1, 0x34, 0xd3, 0x5f, 0x7d, 0xf5, 0x80, 0xcb,
+	0x7c, 0xf5, 0x35, 0xf0, 0xc1, 0x87, 0x38, 0x2f, 0xac, 0xbd, 0x58, 0xd8, 0x0f, 0x16, 0xf4, 0xcd,
+	0x6a, 0x2a, 0xc5, 0x70, 0x51, 0xa5, 0x75, 0x8b, 0xca, 0xb5, 0x7f, 0xab, 0x7c, 0x1b, 0xec, 0x60,
+	0x38, 0x1a, 0xd7, 0x19, 0xea, 0x1a, 0x7a, 0xc1, 0x70, 0xf4, 0xb2, 0xb2, 0x35, 0xa9, 0x10, 0xac,
+	0x0a, 0xac, 0x6a, 0x68, 0x85, 0x9d, 0xca, 0x0c, 0x62, 0xb2, 0x01, 0xbd, 0x84, 0x96, 0x09, 0x56,
+	0x9e, 0xb6, 0xf6, 0x74, 0xb5, 0x1d, 0xc4, 0xee, 0x2b, 0xa3, 0x6b, 0x44, 0x79, 0x84, 0x6c, 0x55,
+	0x5d, 0xee, 0x2f, 0x16, 0xbc, 0xa9, 0x79, 0x42, 0x54, 0xa5, 0xe4, 0x3b, 0x8c, 0x89, 0x88, 0xaa,
+	0x54, 0xf0, 0x95, 0x2b, 0xf5, 0xa0, 0x2d, 0xa6, 0x1c, 0xa5, 0xd3, 0xbc, 0x63, 0xf2, 0x35, 0x8c,
+	0x7c, 0x0c, 0x40, 0xe7, 0x59, 0x75, 0xfd, 0xfd, 0xed, 0x0d, 0xcf, 0x44, 0x54, 0x07, 0xb2, 0x67,
+	0x0e, 0x64, 0x6f, 0x24, 0x52, 0x6e, 0x0e, 0x9d, 0x85, 0x10, 0xf7, 0xbb, 0x35, 0xd3, 0x8a, 0x10,
+	0x0f, 0x4b, 0x1e, 0x93, 0x0f, 0xa0, 0x27, 0xf5, 0xd3, 0x12, 0xdb, 0x37, 0x47, 0xae, 0xbc, 0x7e,
+	0x5f, 0xc2, 0xa3, 0x49, 0x29, 0xb9, 0x1a, 0xff, 0xa7, 0x25, 0xec, 0x6b, 0x8e, 0x9d, 0x7a, 0x13,
+	0x3f, 0x82, 0x4e, 0x2d, 0xcb, 0x69, 0x2f, 0xd7, 0x0d, 0x03, 0x1f, 0x7e, 0x7a, 0x76, 0x39, 0xb0,
+	0xce, 0x2f, 0x07, 0xd6, 0x5f, 0x97, 0x03, 0xeb, 0xfb, 0xab, 0x41, 0xe3, 0xfc, 0x6a, 0xd0, 0xf8,
+	0xfd, 0x6a, 0xd0, 0xf8, 0xfa, 0xd9, 0x82, 0x8e, 0x1b, 0xae, 0x2a, 0x27, 0x2f, 0xfc, 0x53, 0x7d,
+	0x5f, 0xd1, 0xaa, 0x26, 0x1d, 0x7d, 0x65, 0x79, 0xf1, 0xf7, 0x00, 0xcc, 0x0b, 0xe7, 0xa7, 0x91,
+	0x09, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -883,93 +1088,248 @@ func (m *EventSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.NewParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.OldParams.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+
+func (m *EventCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNewIROPlan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBuy) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventReturnAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReturnAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReturnAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.Cost.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.TakerFee.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.ClosingPrice.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSell) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *EventRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BurntAmount.Size()
+		i -= size
+		if _, err := m.BurntAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refunder) > 0 {
+		i -= len(m.Refunder)
+		copy(dAtA[i:], m.Refunder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Refunder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.OldParams.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventNewIROPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBuy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ClosingPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSell) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Seller)
 	if l > 0 {
@@ -1052,6 +1412,71 @@ func (m *EventSettle) Size() (n int) {
 	return n
 }
 
+func (m *EventCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReturnAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Allocation.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Refunder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BurntAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1609,11 +2034,537 @@ func (m *EventBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSell) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -1666,7 +2617,7 @@ func (m *EventBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSell) Unmarshal(dAtA []byte) error {
+func (m *EventSettle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1689,15 +2640,15 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSell: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSettle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSell: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSettle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,11 +2676,11 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1757,11 +2708,11 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1789,13 +2740,13 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.IBCDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1805,31 +2756,16 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
 			}
-			var stringLen uint64
+			m.GaugeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1839,63 +2775,64 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.GaugeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClosingPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1923,13 +2860,11 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1957,7 +2892,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1980,7 +2915,7 @@ func (m *EventSell) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaim) Unmarshal(dAtA []byte) error {
+func (m *EventReturnAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,15 +2938,15 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReturnAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReturnAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2039,11 +2974,11 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claimer = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2071,11 +3006,11 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2103,13 +3038,13 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2119,58 +3054,25 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2192,7 +3094,7 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSettle) Unmarshal(dAtA []byte) error {
+func (m *EventRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2215,15 +3117,15 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSettle: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSettle: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2251,11 +3153,11 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			m.Refunder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2283,11 +3185,11 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2315,13 +3217,13 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCDenom = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurntAmount", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2331,16 +3233,31 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurntAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			m.GaugeId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2350,11 +3267,25 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -14,6 +14,7 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	rollapps := make(map[string]bool)
 	ids := make(map[uint64]bool)
+	planIds := make(map[string]bool)
 
 	for _, plan := range gs.Plans {
 		if err := plan.ValidateBasic(); err != nil {
@@ -29,6 +30,75 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicate plan ID %d", plan.Id)
 		}
 		ids[plan.Id] = true
+		planIds[fmt.Sprintf("%d", plan.Id)] = true
+	}
+
+	vestings := make(map[string]bool)
+	for _, vesting := range gs.Vestings {
+		if err := vesting.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !planIds[vesting.PlanId] {
+			return fmt.Errorf("vesting for unknown plan ID %s", vesting.PlanId)
+		}
+
+		key := string(ClaimerVestingKey(vesting.PlanId, vesting.Claimer))
+		if _, found := vestings[key]; found {
+			return fmt.Errorf("duplicate vesting for plan ID %s and claimer %s", vesting.PlanId, vesting.Claimer)
+		}
+		vestings[key] = true
+	}
+
+	purchases := make(map[string]bool)
+	for _, purchase := range gs.Purchases {
+		if err := purchase.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !planIds[purchase.PlanId] {
+			return fmt.Errorf("purchase for unknown plan ID %s", purchase.PlanId)
+		}
+
+		key := string(PurchaseKey(purchase.PlanId, purchase.Buyer))
+		if _, found := purchases[key]; found {
+			return fmt.Errorf("duplicate purchase for plan ID %s and buyer %s", purchase.PlanId, purchase.Buyer)
+		}
+		purchases[key] = true
+	}
+
+	trades := make(map[string]bool)
+	for _, trade := range gs.Trades {
+		if err := trade.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !planIds[trade.PlanId] {
+			return fmt.Errorf("trade for unknown plan ID %s", trade.PlanId)
+		}
+
+		key := string(TradeKey(trade.PlanId, trade.Seq%MaxTradesPerPlan))
+		if _, found := trades[key]; found {
+			return fmt.Errorf("duplicate trade slot for plan ID %s and sequence %d", trade.PlanId, trade.Seq)
+		}
+		trades[key] = true
+	}
+
+	candles := make(map[string]bool)
+	for _, candle := range gs.Candles {
+		if err := candle.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !planIds[candle.PlanId] {
+			return fmt.Errorf("candle for unknown plan ID %s", candle.PlanId)
+		}
+
+		key := string(CandleKey(candle.PlanId, candle.Interval, candle.StartTime))
+		if _, found := candles[key]; found {
+			return fmt.Errorf("duplicate candle for plan ID %s, interval %s and start time %s", candle.PlanId, candle.Interval, candle.StartTime)
+		}
+		candles[key] = true
 	}
 
 	return gs.Params.Validate()
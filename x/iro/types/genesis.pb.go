@@ -30,6 +30,14 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// VoterInfos hold information about voters.
 	Plans []Plan `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
+	// Vestings hold the vesting claims of the claimers.
+	Vestings []ClaimerVesting `protobuf:"bytes,3,rep,name=vestings,proto3" json:"vestings"`
+	// Purchases hold the amounts bought by the buyers.
+	Purchases []Purchase `protobuf:"bytes,4,rep,name=purchases,proto3" json:"purchases"`
+	// Trades hold the latest trades of the plans.
+	Trades []Trade `protobuf:"bytes,5,rep,name=trades,proto3" json:"trades"`
+	// Candles hold the OHLCV candles of the plans.
+	Candles []Candle `protobuf:"bytes,6,rep,name=candles,proto3" json:"candles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +87,34 @@ func (m *GenesisState) GetPlans() []Plan {
 	return nil
 }
 
+func (m *GenesisState) GetVestings() []ClaimerVesting {
+	if m != nil {
+		return m.Vestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPurchases() []Purchase {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.iro.GenesisState")
 }
@@ -88,22 +124,29 @@ func init() {
 }
 
 var fileDescriptor_7c6c6e7791476d37 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x33, 0x8b, 0xf2, 0xf5,
-	0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x90,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x99, 0x45, 0xf9, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x65,
-	0xfa, 0x20, 0x16, 0x44, 0x87, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02,
-	0xc2, 0x81, 0x4a, 0xa9, 0xe0, 0xb1, 0x36, 0xb3, 0x08, 0x6a, 0x80, 0x52, 0x1f, 0x23, 0x17, 0x8f,
-	0x3b, 0xc4, 0x11, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x0e, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89,
-	0xb9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x4a, 0x7a, 0xb8, 0x1d, 0xa5, 0x17, 0x00,
-	0x56, 0xe9, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x9f, 0x90, 0x0d, 0x17, 0x6b, 0x41,
-	0x4e, 0x62, 0x5e, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x02, 0x5e, 0x03, 0x72, 0x12,
-	0xf3, 0xa0, 0xda, 0x21, 0x9a, 0x9c, 0xbc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x87, 0xdf,
-	0xca, 0x8c, 0xf5, 0x2b, 0xc0, 0x1e, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xd1,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x5d, 0xf5, 0xaa, 0x54, 0x82, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x93, 0x9b, 0x36, 0xf7, 0x5e, 0xf7, 0x4e, 0xd6, 0x1d, 0x42, 0x87, 0x10, 0xaa, 0x0e,
+	0x15, 0x43, 0x82, 0xda, 0x15, 0x09, 0xd4, 0x0e, 0x20, 0xc4, 0x80, 0x00, 0x31, 0xb0, 0x20, 0x37,
+	0xb5, 0x52, 0x4b, 0x89, 0x1d, 0xf9, 0xb8, 0x55, 0xcb, 0x53, 0xf0, 0x1e, 0xbc, 0x48, 0xc7, 0x8e,
+	0x4c, 0x08, 0xb5, 0x2f, 0x82, 0x6a, 0xbb, 0x85, 0xa5, 0x61, 0xcb, 0xd1, 0xf9, 0xbf, 0x2f, 0x47,
+	0xe7, 0x18, 0x75, 0x46, 0xf3, 0x82, 0x72, 0x60, 0x82, 0xcf, 0xe6, 0xcf, 0xc9, 0xae, 0x48, 0x98,
+	0x14, 0x49, 0x46, 0x39, 0x05, 0x06, 0x71, 0x29, 0x85, 0x12, 0xb8, 0xf9, 0x3d, 0x19, 0xef, 0x8a,
+	0x98, 0x49, 0xd1, 0xfc, 0x9f, 0x89, 0x4c, 0xe8, 0x58, 0xb2, 0xf9, 0x32, 0x44, 0xf3, 0x20, 0x15,
+	0x50, 0x08, 0x78, 0x32, 0x0d, 0x53, 0xd8, 0x56, 0xbb, 0xe2, 0xb7, 0x4c, 0x5a, 0x41, 0xeb, 0xd5,
+	0x43, 0xff, 0x2e, 0xcc, 0x10, 0x77, 0x8a, 0x28, 0x8a, 0xcf, 0x91, 0x5f, 0x12, 0x49, 0x0a, 0x08,
+	0xdc, 0xc8, 0xed, 0x34, 0xba, 0xad, 0x78, 0xff, 0x50, 0xf1, 0x8d, 0x4e, 0xf6, 0x6b, 0x8b, 0xf7,
+	0x43, 0xe7, 0xd6, 0x72, 0xf8, 0x14, 0xd5, 0xcb, 0x9c, 0x70, 0x08, 0x7e, 0x45, 0x5e, 0xa7, 0xd1,
+	0x8d, 0x2a, 0x05, 0x39, 0xe1, 0x16, 0x37, 0x10, 0xbe, 0x46, 0x7f, 0xa6, 0x14, 0x14, 0xe3, 0x19,
+	0x04, 0x9e, 0x16, 0x1c, 0x57, 0x09, 0x06, 0x39, 0x61, 0x05, 0x95, 0x0f, 0x06, 0xb1, 0xaa, 0x9d,
+	0x01, 0x5f, 0xa2, 0xbf, 0xe5, 0x44, 0xa6, 0x63, 0x02, 0x14, 0x82, 0x9a, 0xd6, 0xb5, 0x2b, 0xe7,
+	0xb1, 0x61, 0x2b, 0xfa, 0x82, 0xf1, 0x19, 0xf2, 0x95, 0x24, 0x23, 0x0a, 0x41, 0x5d, 0x6b, 0x8e,
+	0xaa, 0x34, 0xf7, 0x9b, 0xe4, 0x76, 0x2d, 0x06, 0xc3, 0x7d, 0xf4, 0x3b, 0x25, 0x7c, 0x94, 0x53,
+	0x08, 0xfc, 0xc8, 0xfb, 0x69, 0xb3, 0x03, 0x1d, 0xb5, 0x8a, 0x2d, 0xd8, 0xbf, 0x5a, 0xac, 0x42,
+	0x77, 0xb9, 0x0a, 0xdd, 0x8f, 0x55, 0xe8, 0xbe, 0xac, 0x43, 0x67, 0xb9, 0x0e, 0x9d, 0xb7, 0x75,
+	0xe8, 0x3c, 0x9e, 0x64, 0x4c, 0x8d, 0x27, 0xc3, 0x38, 0x15, 0x45, 0xb2, 0xe7, 0xf0, 0xd3, 0x5e,
+	0x32, 0xd3, 0xd7, 0x57, 0xf3, 0x92, 0xc2, 0xd0, 0xd7, 0x0f, 0xa0, 0xf7, 0x39, 0x00, 0x8c, 0x82,
+	0xb3, 0xe7, 0x9f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +169,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Vestings) > 0 {
+		for iNdEx := len(m.Vestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Plans) > 0 {
 		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +277,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Vestings) > 0 {
+		for _, e := range m.Vestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -283,6 +406,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vestings = append(m.Vestings, ClaimerVesting{})
+			if err := m.Vestings[len(m.Vestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, Purchase{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
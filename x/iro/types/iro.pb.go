@@ -47,6 +47,16 @@ type Params struct {
 	IncentivesMinStartTimeAfterSettlement time.Duration `protobuf:"bytes,4,opt,name=incentives_min_start_time_after_settlement,json=incentivesMinStartTimeAfterSettlement,proto3,stdduration" json:"incentives_min_start_time_after_settlement"`
 	// The minimum number of epochs over which the incentives will be paid
 	IncentivesMinNumEpochsPaidOver uint64 `protobuf:"varint,5,opt,name=incentives_min_num_epochs_paid_over,json=incentivesMinNumEpochsPaidOver,proto3" json:"incentives_min_num_epochs_paid_over,omitempty"`
+	// The denoms a plan can raise liquidity in, set by governance
+	LiquidityDenoms []LiquidityDenom `protobuf:"bytes,6,rep,name=liquidity_denoms,json=liquidityDenoms,proto3" json:"liquidity_denoms"`
+	// The maximum time from the pre-launch time of a plan to its settlement.
+	// If the plan is not settled by then, it is cancelled and its raised
+	// liquidity is refunded. Zero for no limit.
+	MaxSettlementDuration time.Duration `protobuf:"bytes,7,opt,name=max_settlement_duration,json=maxSettlementDuration,proto3,stdduration" json:"max_settlement_duration"`
+	// The maximum vesting cliff of a plan
+	MaxVestingCliff time.Duration `protobuf:"bytes,8,opt,name=max_vesting_cliff,json=maxVestingCliff,proto3,stdduration" json:"max_vesting_cliff"`
+	// The maximum linear vesting duration of a plan
+	MaxVestingDuration time.Duration `protobuf:"bytes,9,opt,name=max_vesting_duration,json=maxVestingDuration,proto3,stdduration" json:"max_vesting_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -103,21 +113,117 @@ func (m *Params) GetIncentivesMinNumEpochsPaidOver() uint64 {
 	return 0
 }
 
+func (m *Params) GetLiquidityDenoms() []LiquidityDenom {
+	if m != nil {
+		return m.LiquidityDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxSettlementDuration() time.Duration {
+	if m != nil {
+		return m.MaxSettlementDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVestingCliff() time.Duration {
+	if m != nil {
+		return m.MaxVestingCliff
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVestingDuration() time.Duration {
+	if m != nil {
+		return m.MaxVestingDuration
+	}
+	return 0
+}
+
+// LiquidityDenom is a denom a plan can raise liquidity in.
+type LiquidityDenom struct {
+	// The base denom (e.g adym or an IBC denom)
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The exponent of the display denom (e.g 18 for DYM, 6 for USDC)
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *LiquidityDenom) Reset()         { *m = LiquidityDenom{} }
+func (m *LiquidityDenom) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDenom) ProtoMessage()    {}
+func (*LiquidityDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
+}
+func (m *LiquidityDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidityDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDenom.Merge(m, src)
+}
+func (m *LiquidityDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityDenom proto.InternalMessageInfo
+
+func (m *LiquidityDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LiquidityDenom) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
 // Bonding curve represents a bonding curve in the IRO module.
 // BondingCurve represents a bonding curve with parameters M, N, and C.
 // The price of the token is calculated as follows:
 // price = M * x^N + C
+// If a curve family is set, M, N and C are empty and the price is given by the
+// family instead.
 type BondingCurve struct {
 	M github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=M,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"M"`
 	N github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=N,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"N"`
 	C github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=C,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"C"`
+	// family is the curve family used instead of the power curve, if set.
+	//
+	// Types that are valid to be assigned to Family:
+	//	*BondingCurve_Logarithmic
+	//	*BondingCurve_CappedExponential
+	//	*BondingCurve_Sigmoid
+	//	*BondingCurve_PiecewiseLinear
+	Family isBondingCurve_Family `protobuf_oneof:"family"`
+	// The decimals of the liquidity denom the price is quoted in.
+	// Set on plan creation, 0 defaults to the DYM decimals.
+	LiquidityDenomDecimals uint32 `protobuf:"varint,8,opt,name=liquidity_denom_decimals,json=liquidityDenomDecimals,proto3" json:"liquidity_denom_decimals,omitempty"`
 }
 
 func (m *BondingCurve) Reset()         { *m = BondingCurve{} }
 func (m *BondingCurve) String() string { return proto.CompactTextString(m) }
 func (*BondingCurve) ProtoMessage()    {}
 func (*BondingCurve) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{1}
+	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
 }
 func (m *BondingCurve) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -146,13 +252,302 @@ func (m *BondingCurve) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BondingCurve proto.InternalMessageInfo
 
+type isBondingCurve_Family interface {
+	isBondingCurve_Family()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type BondingCurve_Logarithmic struct {
+	Logarithmic *LogarithmicCurve `protobuf:"bytes,4,opt,name=logarithmic,proto3,oneof" json:"logarithmic,omitempty"`
+}
+type BondingCurve_CappedExponential struct {
+	CappedExponential *CappedExponentialCurve `protobuf:"bytes,5,opt,name=capped_exponential,json=cappedExponential,proto3,oneof" json:"capped_exponential,omitempty"`
+}
+type BondingCurve_Sigmoid struct {
+	Sigmoid *SigmoidCurve `protobuf:"bytes,6,opt,name=sigmoid,proto3,oneof" json:"sigmoid,omitempty"`
+}
+type BondingCurve_PiecewiseLinear struct {
+	PiecewiseLinear *PiecewiseLinearCurve `protobuf:"bytes,7,opt,name=piecewise_linear,json=piecewiseLinear,proto3,oneof" json:"piecewise_linear,omitempty"`
+}
+
+func (*BondingCurve_Logarithmic) isBondingCurve_Family()       {}
+func (*BondingCurve_CappedExponential) isBondingCurve_Family() {}
+func (*BondingCurve_Sigmoid) isBondingCurve_Family()           {}
+func (*BondingCurve_PiecewiseLinear) isBondingCurve_Family()   {}
+
+func (m *BondingCurve) GetFamily() isBondingCurve_Family {
+	if m != nil {
+		return m.Family
+	}
+	return nil
+}
+
+func (m *BondingCurve) GetLogarithmic() *LogarithmicCurve {
+	if x, ok := m.GetFamily().(*BondingCurve_Logarithmic); ok {
+		return x.Logarithmic
+	}
+	return nil
+}
+
+func (m *BondingCurve) GetCappedExponential() *CappedExponentialCurve {
+	if x, ok := m.GetFamily().(*BondingCurve_CappedExponential); ok {
+		return x.CappedExponential
+	}
+	return nil
+}
+
+func (m *BondingCurve) GetSigmoid() *SigmoidCurve {
+	if x, ok := m.GetFamily().(*BondingCurve_Sigmoid); ok {
+		return x.Sigmoid
+	}
+	return nil
+}
+
+func (m *BondingCurve) GetPiecewiseLinear() *PiecewiseLinearCurve {
+	if x, ok := m.GetFamily().(*BondingCurve_PiecewiseLinear); ok {
+		return x.PiecewiseLinear
+	}
+	return nil
+}
+
+func (m *BondingCurve) GetLiquidityDenomDecimals() uint32 {
+	if m != nil {
+		return m.LiquidityDenomDecimals
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BondingCurve) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BondingCurve_Logarithmic)(nil),
+		(*BondingCurve_CappedExponential)(nil),
+		(*BondingCurve_Sigmoid)(nil),
+		(*BondingCurve_PiecewiseLinear)(nil),
+	}
+}
+
+// LogarithmicCurve is a concave curve, with the price growing slower as the
+// supply increases:
+// price = A * ln(1 + x / B) + C
+type LogarithmicCurve struct {
+	A github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=A,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"A"`
+	B github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=B,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"B"`
+	C github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=C,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"C"`
+}
+
+func (m *LogarithmicCurve) Reset()         { *m = LogarithmicCurve{} }
+func (m *LogarithmicCurve) String() string { return proto.CompactTextString(m) }
+func (*LogarithmicCurve) ProtoMessage()    {}
+func (*LogarithmicCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
+}
+func (m *LogarithmicCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogarithmicCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LogarithmicCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LogarithmicCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogarithmicCurve.Merge(m, src)
+}
+func (m *LogarithmicCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogarithmicCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogarithmicCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogarithmicCurve proto.InternalMessageInfo
+
+// CappedExponentialCurve is an exponential curve with a maximum price:
+// price = min(A * e^(K * x), Cap)
+type CappedExponentialCurve struct {
+	A   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=A,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"A"`
+	K   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=K,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"K"`
+	Cap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=Cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"Cap"`
+}
+
+func (m *CappedExponentialCurve) Reset()         { *m = CappedExponentialCurve{} }
+func (m *CappedExponentialCurve) String() string { return proto.CompactTextString(m) }
+func (*CappedExponentialCurve) ProtoMessage()    {}
+func (*CappedExponentialCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{4}
+}
+func (m *CappedExponentialCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CappedExponentialCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CappedExponentialCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CappedExponentialCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CappedExponentialCurve.Merge(m, src)
+}
+func (m *CappedExponentialCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *CappedExponentialCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_CappedExponentialCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CappedExponentialCurve proto.InternalMessageInfo
+
+// SigmoidCurve is an S-shaped curve, rising the fastest at X0 from a price of C
+// towards a price of C + L:
+// price = C + L / (1 + e^(-K * (x - X0)))
+type SigmoidCurve struct {
+	L  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=L,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"L"`
+	K  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=K,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"K"`
+	X0 github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=X0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"X0"`
+	C  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=C,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"C"`
+}
+
+func (m *SigmoidCurve) Reset()         { *m = SigmoidCurve{} }
+func (m *SigmoidCurve) String() string { return proto.CompactTextString(m) }
+func (*SigmoidCurve) ProtoMessage()    {}
+func (*SigmoidCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{5}
+}
+func (m *SigmoidCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigmoidCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigmoidCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigmoidCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigmoidCurve.Merge(m, src)
+}
+func (m *SigmoidCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigmoidCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigmoidCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigmoidCurve proto.InternalMessageInfo
+
+// PiecewiseLinearCurve interpolates the price linearly between points, and is
+// flat after the last point.
+type PiecewiseLinearCurve struct {
+	// The points of the curve, by increasing supply. The first point is at a
+	// supply of zero.
+	Points []PricePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *PiecewiseLinearCurve) Reset()         { *m = PiecewiseLinearCurve{} }
+func (m *PiecewiseLinearCurve) String() string { return proto.CompactTextString(m) }
+func (*PiecewiseLinearCurve) ProtoMessage()    {}
+func (*PiecewiseLinearCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{6}
+}
+func (m *PiecewiseLinearCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PiecewiseLinearCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PiecewiseLinearCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PiecewiseLinearCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PiecewiseLinearCurve.Merge(m, src)
+}
+func (m *PiecewiseLinearCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *PiecewiseLinearCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_PiecewiseLinearCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PiecewiseLinearCurve proto.InternalMessageInfo
+
+func (m *PiecewiseLinearCurve) GetPoints() []PricePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// PricePoint is the price of the token at a supply.
+type PricePoint struct {
+	X     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=x,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"x"`
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+}
+
+func (m *PricePoint) Reset()         { *m = PricePoint{} }
+func (m *PricePoint) String() string { return proto.CompactTextString(m) }
+func (*PricePoint) ProtoMessage()    {}
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{7}
+}
+func (m *PricePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricePoint.Merge(m, src)
+}
+func (m *PricePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricePoint proto.InternalMessageInfo
+
 // Plan represents a plan in the IRO module.
 type Plan struct {
 	// The ID of the plan.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The ID of the rollapp.
 	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
-	// The module account address to hold the raised DYM tokens.
+	// The module account address to hold the raised liquidity tokens.
 	ModuleAccAddress string `protobuf:"bytes,3,opt,name=module_acc_address,json=moduleAccAddress,proto3" json:"module_acc_address,omitempty"`
 	// The total amount of tokens allocated for the IRO.
 	TotalAllocation types.Coin   `protobuf:"bytes,4,opt,name=total_allocation,json=totalAllocation,proto3" json:"total_allocation"`
@@ -171,13 +566,27 @@ type Plan struct {
 	// The incentive plan parameters for the tokens left after the plan is
 	// settled.
 	IncentivePlanParams IncentivePlanParams `protobuf:"bytes,11,opt,name=incentive_plan_params,json=incentivePlanParams,proto3" json:"incentive_plan_params"`
+	// The denom the plan raises liquidity in (e.g adym)
+	LiquidityDenom string `protobuf:"bytes,12,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
+	// The vesting schedule of the claims, starting on settlement.
+	// If empty, the tokens are released on claim.
+	VestingPlan VestingPlan `protobuf:"bytes,13,opt,name=vesting_plan,json=vestingPlan,proto3" json:"vesting_plan"`
+	// The allowlist phase, from the start time to its end time, during which
+	// only the allowlisted addresses can buy. If empty, anyone can buy.
+	AllowlistPhase AllowlistPhase `protobuf:"bytes,14,opt,name=allowlist_phase,json=allowlistPhase,proto3" json:"allowlist_phase"`
+	// The maximum amount of tokens an address can buy after the allowlist
+	// phase, including the amount bought during it. Zero for no limit.
+	MaxAmountPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=max_amount_per_address,json=maxAmountPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_address"`
+	// If set, the plan was not settled in time and is cancelled. The trading is
+	// stopped, and the IRO tokens can be refunded for the raised liquidity.
+	Cancelled bool `protobuf:"varint,16,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
+	return fileDescriptor_e7d27cc6b5064d3f, []int{8}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,27 +678,63 @@ func (m *Plan) GetIncentivePlanParams() IncentivePlanParams {
 	return IncentivePlanParams{}
 }
 
-type IncentivePlanParams struct {
-	// start_time_after_settlement is the time after IRO settlement when the
-	// distribution of the remaining tokens as incentives will start
-	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// num_epochs_paid_over is the number of total epochs distribution will be
-	// completed over
-	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+func (m *Plan) GetLiquidityDenom() string {
+	if m != nil {
+		return m.LiquidityDenom
+	}
+	return ""
 }
 
-func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
-func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
-func (*IncentivePlanParams) ProtoMessage()    {}
-func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
+func (m *Plan) GetVestingPlan() VestingPlan {
+	if m != nil {
+		return m.VestingPlan
+	}
+	return VestingPlan{}
 }
-func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+
+func (m *Plan) GetAllowlistPhase() AllowlistPhase {
+	if m != nil {
+		return m.AllowlistPhase
+	}
+	return AllowlistPhase{}
+}
+
+func (m *Plan) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// AllowlistPhase is a phase at the start of a plan during which only the
+// allowlisted addresses can buy. The allowlist is either a merkle root of the
+// addresses or a list of the addresses.
+type AllowlistPhase struct {
+	// merkle_root is the root of the merkle tree of the allowlisted addresses.
+	// The leaves are the sha256 hashes of the bech32 addresses, and the nodes
+	// are the sha256 hashes of their sorted children.
+	MerkleRoot []byte `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// addresses are the allowlisted addresses, if no merkle root is set
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// end_time is the time the allowlist phase ends
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// max_amount_per_address is the maximum amount of tokens an address can buy
+	// during the allowlist phase. Zero for no limit.
+	MaxAmountPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_amount_per_address,json=maxAmountPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_address"`
+}
+
+func (m *AllowlistPhase) Reset()         { *m = AllowlistPhase{} }
+func (m *AllowlistPhase) String() string { return proto.CompactTextString(m) }
+func (*AllowlistPhase) ProtoMessage()    {}
+func (*AllowlistPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{9}
+}
+func (m *AllowlistPhase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AllowlistPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AllowlistPhase.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -299,330 +744,696 @@ func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+func (m *AllowlistPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowlistPhase.Merge(m, src)
 }
-func (m *IncentivePlanParams) XXX_Size() int {
+func (m *AllowlistPhase) XXX_Size() int {
 	return m.Size()
 }
-func (m *IncentivePlanParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+func (m *AllowlistPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowlistPhase.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+var xxx_messageInfo_AllowlistPhase proto.InternalMessageInfo
 
-func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+func (m *AllowlistPhase) GetMerkleRoot() []byte {
 	if m != nil {
-		return m.StartTimeAfterSettlement
+		return m.MerkleRoot
 	}
-	return 0
+	return nil
 }
 
-func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+func (m *AllowlistPhase) GetAddresses() []string {
 	if m != nil {
-		return m.NumEpochsPaidOver
+		return m.Addresses
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.iro.Params")
-	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
-	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
-	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
-}
+func (m *AllowlistPhase) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+// Purchase tracks the amount of tokens an address bought from a plan.
+type Purchase struct {
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The address of the buyer.
+	Buyer string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// The total amount of tokens bought, regardless of the sells.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-var fileDescriptor_e7d27cc6b5064d3f = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x8f, 0x52, 0x37, 0x8d, 0xe9, 0x64, 0x69, 0xd9, 0x0c, 0x50, 0x33, 0x4c, 0x0e, 0xdc, 0x6d,
-	0x30, 0x06, 0x54, 0x5a, 0xdb, 0xeb, 0x2e, 0xb6, 0xb3, 0x02, 0x6e, 0x9b, 0x3f, 0x93, 0x7b, 0xda,
-	0x85, 0xa0, 0x44, 0xc6, 0x21, 0x2a, 0x92, 0x02, 0x49, 0x19, 0xcd, 0x0e, 0xfb, 0x0c, 0x3d, 0xee,
-	0x33, 0xec, 0xbc, 0xcf, 0x30, 0xe4, 0x58, 0xec, 0x34, 0x0c, 0x58, 0x37, 0x24, 0x5f, 0x64, 0x20,
-	0x29, 0xb9, 0x6e, 0xda, 0x14, 0xad, 0x0f, 0x41, 0x4c, 0xbe, 0xf7, 0xfb, 0x3d, 0xf2, 0xfd, 0x1e,
-	0x7f, 0x02, 0x5f, 0x91, 0x53, 0x4e, 0x85, 0x66, 0x52, 0xbc, 0x38, 0xfd, 0x39, 0x99, 0x2f, 0x12,
-	0xa6, 0xa4, 0xfd, 0x8b, 0x4b, 0x25, 0x8d, 0x84, 0x3b, 0x8b, 0x59, 0xf1, 0x7c, 0x11, 0x33, 0x25,
-	0x77, 0xb6, 0xa7, 0x72, 0x2a, 0x5d, 0x5a, 0x62, 0x7f, 0x79, 0xc4, 0x4e, 0x77, 0x2a, 0xe5, 0xb4,
-	0xa0, 0x89, 0x5b, 0x65, 0xd5, 0x71, 0x62, 0x18, 0xa7, 0xda, 0x60, 0x5e, 0xd6, 0x09, 0xd1, 0xe5,
-	0x04, 0x52, 0x29, 0x6c, 0x2c, 0x69, 0x1d, 0xcf, 0xa5, 0xe6, 0x52, 0x27, 0x19, 0xd6, 0x34, 0x99,
-	0xdd, 0xcf, 0xa8, 0xc1, 0xf7, 0x93, 0x5c, 0xb2, 0x26, 0x7e, 0xc7, 0xc7, 0x91, 0xaf, 0xec, 0x17,
-	0x3e, 0xd4, 0xfb, 0xe3, 0x1a, 0x58, 0x3b, 0xc2, 0x0a, 0x73, 0x0d, 0x9f, 0x80, 0xb6, 0xc1, 0xcf,
-	0xa9, 0x42, 0xc7, 0x94, 0x86, 0xc1, 0x6e, 0xd0, 0x6f, 0x0f, 0xe3, 0xb3, 0xd7, 0xdd, 0x95, 0xbf,
-	0x5f, 0x77, 0xbf, 0x99, 0x32, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x79, 0x0d, 0xaf, 0xff, 0xdd, 0xd3,
-	0xe4, 0x79, 0x62, 0x4e, 0x4b, 0xaa, 0xe3, 0x3d, 0x9a, 0xa7, 0xeb, 0x8e, 0xe0, 0x11, 0xa5, 0xf0,
-	0x47, 0xb0, 0x91, 0x2b, 0xea, 0x0e, 0xe9, 0xf8, 0x56, 0x3f, 0x99, 0x6f, 0x2c, 0x4c, 0xda, 0x69,
-	0x38, 0x2c, 0xe5, 0x21, 0xb8, 0xc5, 0x99, 0x40, 0x65, 0x81, 0x05, 0x6a, 0x1a, 0x10, 0x5e, 0xdb,
-	0x0d, 0xfa, 0x9d, 0x07, 0x77, 0x62, 0xdf, 0xa1, 0xb8, 0xe9, 0x50, 0xbc, 0x57, 0x27, 0x0c, 0xd7,
-	0x6d, 0xc9, 0x5f, 0xff, 0xed, 0x06, 0xe9, 0x16, 0x67, 0xe2, 0xa8, 0xc0, 0xa2, 0x09, 0xc1, 0x5f,
-	0xc0, 0xb7, 0x4c, 0xe4, 0x54, 0x18, 0x36, 0xa3, 0x1a, 0x59, 0x6e, 0x6d, 0xb0, 0x32, 0xc8, 0xb6,
-	0x1f, 0xe1, 0x63, 0x43, 0x15, 0xd2, 0xd4, 0x98, 0x82, 0x72, 0x2a, 0x4c, 0xd8, 0xfa, 0xf8, 0x4a,
-	0x5f, 0xbf, 0xa1, 0xdd, 0x67, 0x62, 0x62, 0x49, 0x9f, 0x31, 0x4e, 0x07, 0x96, 0x72, 0x32, 0x67,
-	0x84, 0x4f, 0xc0, 0xdd, 0x4b, 0xf5, 0x45, 0xc5, 0x11, 0x2d, 0x65, 0x7e, 0xa2, 0x51, 0x89, 0x19,
-	0x41, 0x72, 0x46, 0x55, 0x78, 0x7d, 0x37, 0xe8, 0xb7, 0xd2, 0xe8, 0x2d, 0xce, 0x83, 0x8a, 0xff,
-	0xe0, 0xf2, 0x8e, 0x30, 0x23, 0x87, 0x33, 0xaa, 0x7a, 0x67, 0x01, 0xd8, 0x18, 0x4a, 0x41, 0x98,
-	0x98, 0x8e, 0x2a, 0x35, 0xa3, 0xf0, 0x7b, 0x10, 0xec, 0x2f, 0x29, 0x63, 0xb0, 0x6f, 0xd1, 0x07,
-	0x4b, 0x88, 0xe6, 0xd0, 0x07, 0x16, 0x3d, 0x72, 0xd2, 0x2c, 0x81, 0x1e, 0xf5, 0xfe, 0xb9, 0x0e,
-	0x5a, 0x56, 0x28, 0xf8, 0x19, 0x58, 0x65, 0xc4, 0xdd, 0xa1, 0x95, 0xae, 0x32, 0x02, 0xbf, 0x04,
-	0x40, 0xc9, 0xa2, 0xc0, 0x65, 0x89, 0x18, 0xf1, 0xa7, 0x4b, 0xdb, 0xf5, 0xce, 0x98, 0xc0, 0x47,
-	0x00, 0x72, 0x49, 0xaa, 0x82, 0x22, 0x9c, 0xe7, 0x08, 0x13, 0xa2, 0xa8, 0xd6, 0xf5, 0x31, 0xc2,
-	0x3f, 0x7f, 0xbf, 0xb7, 0x5d, 0x4f, 0xfe, 0xc0, 0x47, 0x26, 0x46, 0x31, 0x31, 0x4d, 0x6f, 0x7a,
-	0xcc, 0x20, 0xcf, 0xeb, 0x7d, 0xf8, 0x18, 0xdc, 0x34, 0xd2, 0xe0, 0x02, 0xe1, 0xa2, 0x90, 0xb9,
-	0x9f, 0xb3, 0x46, 0xfd, 0x9a, 0xc2, 0xbe, 0xb4, 0xb8, 0x7e, 0x69, 0xf1, 0x48, 0x32, 0x31, 0x6c,
-	0xd9, 0x7b, 0xa6, 0x5b, 0x0e, 0x38, 0x98, 0xe3, 0xe0, 0x04, 0x6c, 0x66, 0x5e, 0x15, 0x94, 0x5b,
-	0x59, 0x9c, 0x9a, 0x9d, 0x07, 0xfd, 0xf8, 0x6a, 0x97, 0x88, 0x17, 0x65, 0xac, 0x79, 0x37, 0xb2,
-	0x45, 0x69, 0xef, 0x82, 0x4d, 0x3f, 0x98, 0x04, 0x11, 0x2a, 0x24, 0x0f, 0xd7, 0x5c, 0x2b, 0x36,
-	0xea, 0xcd, 0x3d, 0xbb, 0x07, 0x47, 0x00, 0xbc, 0x19, 0xe7, 0xf0, 0x86, 0x2b, 0xbb, 0xf3, 0xce,
-	0xf4, 0x3e, 0x6b, 0xac, 0xc6, 0x8f, 0xef, 0x4b, 0x3b, 0xbe, 0x6d, 0xdd, 0x4c, 0x2c, 0x7c, 0x0a,
-	0xb6, 0x4a, 0x45, 0x51, 0x81, 0x2b, 0x91, 0x9f, 0x78, 0xa6, 0xf5, 0x4f, 0x60, 0xda, 0x2c, 0x15,
-	0x7d, 0xea, 0xb0, 0x8e, 0x6d, 0x0c, 0xd6, 0xb5, 0x2c, 0x08, 0xc2, 0xdc, 0x84, 0xed, 0xa5, 0x0c,
-	0xe1, 0x86, 0xc5, 0x0f, 0xb8, 0x81, 0x87, 0xa0, 0x93, 0x17, 0x98, 0x71, 0xea, 0xd9, 0xc0, 0x52,
-	0x6c, 0xa0, 0xa6, 0xb0, 0x84, 0x0c, 0x7c, 0x3e, 0x7f, 0x61, 0xde, 0x63, 0x4a, 0x67, 0x8b, 0x61,
-	0xc7, 0xdd, 0x37, 0xf9, 0x90, 0x60, 0xe3, 0x06, 0x68, 0xa7, 0xd6, 0xbb, 0x69, 0xad, 0xdb, 0x6d,
-	0xf6, 0x6e, 0xa8, 0xf7, 0x5b, 0x00, 0x6e, 0xbf, 0x07, 0x02, 0x33, 0xf0, 0xc5, 0x87, 0x0c, 0x28,
-	0xf8, 0x78, 0x03, 0x0a, 0xf5, 0x55, 0x9e, 0x93, 0x80, 0xed, 0xf7, 0x9a, 0xcc, 0xaa, 0x7b, 0x64,
-	0xb7, 0xc4, 0x65, 0x5f, 0x19, 0x3e, 0x3e, 0x3b, 0x8f, 0x82, 0x57, 0xe7, 0x51, 0xf0, 0xdf, 0x79,
-	0x14, 0xbc, 0xbc, 0x88, 0x56, 0x5e, 0x5d, 0x44, 0x2b, 0x7f, 0x5d, 0x44, 0x2b, 0x3f, 0x7d, 0xb7,
-	0xd0, 0xe5, 0x2b, 0xbe, 0x8c, 0xb3, 0x87, 0xc9, 0x0b, 0xf7, 0x79, 0x74, 0x3d, 0xcf, 0xd6, 0xdc,
-	0x99, 0x1f, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x95, 0xca, 0x8c, 0x25, 0x49, 0x07, 0x00, 0x00,
+func (m *Purchase) Reset()         { *m = Purchase{} }
+func (m *Purchase) String() string { return proto.CompactTextString(m) }
+func (*Purchase) ProtoMessage()    {}
+func (*Purchase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{10}
+}
+func (m *Purchase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Purchase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Purchase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Purchase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Purchase.Merge(m, src)
+}
+func (m *Purchase) XXX_Size() int {
+	return m.Size()
+}
+func (m *Purchase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Purchase.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Purchase proto.InternalMessageInfo
+
+func (m *Purchase) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Purchase) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IncentivesMinNumEpochsPaidOver != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.IncentivesMinNumEpochsPaidOver))
-		i--
-		dAtA[i] = 0x28
+// VestingPlan is the vesting schedule of the claims of a plan.
+// Nothing is vested before the cliff, and the tokens are vested linearly over
+// the duration after the cliff.
+type VestingPlan struct {
+	// cliff is the time after settlement before which nothing is vested
+	Cliff time.Duration `protobuf:"bytes,1,opt,name=cliff,proto3,stdduration" json:"cliff"`
+	// duration is the time after the cliff over which the tokens are vested
+	// linearly
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// start_time is the time the vesting starts, set on settlement
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *VestingPlan) Reset()         { *m = VestingPlan{} }
+func (m *VestingPlan) String() string { return proto.CompactTextString(m) }
+func (*VestingPlan) ProtoMessage()    {}
+func (*VestingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{11}
+}
+func (m *VestingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
-	if err1 != nil {
-		return 0, err1
+}
+func (m *VestingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPlan.Merge(m, src)
+}
+func (m *VestingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPlan proto.InternalMessageInfo
+
+func (m *VestingPlan) GetCliff() time.Duration {
+	if m != nil {
+		return m.Cliff
 	}
-	i -= n1
-	i = encodeVarintIro(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
-	if err2 != nil {
-		return 0, err2
+	return 0
+}
+
+func (m *VestingPlan) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	i -= n2
-	i = encodeVarintIro(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CreationFee.Size()
-		i -= size
-		if _, err := m.CreationFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *VestingPlan) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerFee.Size()
-		i -= size
-		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return time.Time{}
+}
+
+// ClaimerVesting tracks the vesting of the claims of a claimer in a plan.
+type ClaimerVesting struct {
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The address of the claimer.
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
+	// The total amount of tokens vesting, as the IRO tokens burnt on claims.
+	Total github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total"`
+	// The amount of vested tokens released to the claimer so far.
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
+}
+
+func (m *ClaimerVesting) Reset()         { *m = ClaimerVesting{} }
+func (m *ClaimerVesting) String() string { return proto.CompactTextString(m) }
+func (*ClaimerVesting) ProtoMessage()    {}
+func (*ClaimerVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{12}
+}
+func (m *ClaimerVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimerVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimerVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ClaimerVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimerVesting.Merge(m, src)
+}
+func (m *ClaimerVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimerVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimerVesting.DiscardUnknown(m)
 }
 
-func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClaimerVesting proto.InternalMessageInfo
+
+func (m *ClaimerVesting) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClaimerVesting) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
 }
 
-func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.C.Size()
-		i -= size
-		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.N.Size()
-		i -= size
-		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type IncentivePlanParams struct {
+	// start_time_after_settlement is the time after IRO settlement when the
+	// distribution of the remaining tokens as incentives will start
+	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// num_epochs_paid_over is the number of total epochs distribution will be
+	// completed over
+	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+}
+
+func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
+func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
+func (*IncentivePlanParams) ProtoMessage()    {}
+func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{13}
+}
+func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.M.Size()
-		i -= size
-		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+}
+func (m *IncentivePlanParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivePlanParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+
+func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+	if m != nil {
+		return m.StartTimeAfterSettlement
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Plan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+	if m != nil {
+		return m.NumEpochsPaidOver
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Trade is a buy or sell of a plan, kept in a bounded history of the latest
+// trades of the plan.
+type Trade struct {
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The sequence number of the trade in the plan, starting from 0.
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// The address of the buyer or seller.
+	Trader string `protobuf:"bytes,3,opt,name=trader,proto3" json:"trader,omitempty"`
+	// Whether the trade is a buy or a sell.
+	Buy bool `protobuf:"varint,4,opt,name=buy,proto3" json:"buy,omitempty"`
+	// The amount of tokens bought or sold.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// The cost or income of the trade in the liquidity denom, without the taker
+	// fee.
+	Liquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity"`
+	// The spot price of the plan after the trade.
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// The block time of the trade.
+	Time time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+	// The block height of the trade.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{14}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.ClaimedAmt.Size()
-		i -= size
-		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.SoldAmt.Size()
-		i -= size
-		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+func (m *Trade) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Trade) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *Trade) GetBuy() bool {
+	if m != nil {
+		return m.Buy
+	}
+	return false
+}
+
+func (m *Trade) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Trade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Candle is the OHLCV summary of the trades of a plan over an interval.
+type Candle struct {
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The interval of the candle, one of the fixed candle intervals.
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+	// The start time of the candle, aligned to the interval.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// The prices are the spot prices of the plan after each trade.
+	Open  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open"`
+	High  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high"`
+	Low   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low"`
+	Close github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close"`
+	// The amount of tokens traded.
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume"`
+	// The amount of liquidity traded, without the taker fees.
+	LiquidityVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=liquidity_volume,json=liquidityVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquidity_volume"`
+	// The number of trades.
+	NumTrades uint64 `protobuf:"varint,10,opt,name=num_trades,json=numTrades,proto3" json:"num_trades,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{15}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *Candle) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetNumTrades() uint64 {
+	if m != nil {
+		return m.NumTrades
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.iro.Params")
+	proto.RegisterType((*LiquidityDenom)(nil), "dymensionxyz.dymension.iro.LiquidityDenom")
+	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
+	proto.RegisterType((*LogarithmicCurve)(nil), "dymensionxyz.dymension.iro.LogarithmicCurve")
+	proto.RegisterType((*CappedExponentialCurve)(nil), "dymensionxyz.dymension.iro.CappedExponentialCurve")
+	proto.RegisterType((*SigmoidCurve)(nil), "dymensionxyz.dymension.iro.SigmoidCurve")
+	proto.RegisterType((*PiecewiseLinearCurve)(nil), "dymensionxyz.dymension.iro.PiecewiseLinearCurve")
+	proto.RegisterType((*PricePoint)(nil), "dymensionxyz.dymension.iro.PricePoint")
+	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
+	proto.RegisterType((*AllowlistPhase)(nil), "dymensionxyz.dymension.iro.AllowlistPhase")
+	proto.RegisterType((*Purchase)(nil), "dymensionxyz.dymension.iro.Purchase")
+	proto.RegisterType((*VestingPlan)(nil), "dymensionxyz.dymension.iro.VestingPlan")
+	proto.RegisterType((*ClaimerVesting)(nil), "dymensionxyz.dymension.iro.ClaimerVesting")
+	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
+	proto.RegisterType((*Trade)(nil), "dymensionxyz.dymension.iro.Trade")
+	proto.RegisterType((*Candle)(nil), "dymensionxyz.dymension.iro.Candle")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+}
+
+var fileDescriptor_e7d27cc6b5064d3f = []byte{
+	// 1710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x1b, 0x5b,
+	0x19, 0xcf, 0xf8, 0x15, 0xfb, 0xb3, 0xf3, 0xe8, 0x69, 0x6e, 0xee, 0xdc, 0xc0, 0x75, 0x22, 0x5f,
+	0xb8, 0x37, 0xaa, 0xa8, 0x9d, 0xa6, 0x9b, 0x22, 0x21, 0x8a, 0xed, 0xb4, 0x6a, 0x9a, 0xb4, 0x35,
+	0x93, 0x52, 0xb5, 0x14, 0x34, 0x3a, 0x9e, 0x39, 0xb1, 0x8f, 0x3a, 0x33, 0x67, 0x3a, 0x33, 0x76,
+	0x1d, 0x16, 0xac, 0x59, 0x76, 0x89, 0xc4, 0x96, 0x05, 0x62, 0x8d, 0xf8, 0x1b, 0xba, 0x40, 0xa8,
+	0x62, 0x81, 0x10, 0x8b, 0x82, 0xda, 0x0d, 0x1b, 0x96, 0x2c, 0xd8, 0x5d, 0x9d, 0xc7, 0xf8, 0xd5,
+	0xc4, 0x89, 0x27, 0x5d, 0x44, 0xf1, 0x79, 0xfc, 0x7e, 0xe7, 0x3b, 0xdf, 0xfb, 0x0c, 0x7c, 0xcf,
+	0x3e, 0x71, 0x89, 0x17, 0x52, 0xe6, 0x0d, 0x4e, 0x7e, 0x55, 0x1b, 0x0e, 0x6a, 0x34, 0x60, 0xfc,
+	0xaf, 0xea, 0x07, 0x2c, 0x62, 0x68, 0x63, 0x7c, 0x57, 0x75, 0x38, 0xa8, 0xd2, 0x80, 0x6d, 0xac,
+	0x75, 0x58, 0x87, 0x89, 0x6d, 0x35, 0xfe, 0x4b, 0x22, 0x36, 0x36, 0x3b, 0x8c, 0x75, 0x1c, 0x52,
+	0x13, 0xa3, 0x76, 0xef, 0xb8, 0x16, 0x51, 0x97, 0x84, 0x11, 0x76, 0x7d, 0xb5, 0xa1, 0x3c, 0xbd,
+	0xc1, 0xee, 0x05, 0x38, 0xe2, 0xa4, 0x6a, 0xdd, 0x62, 0xa1, 0xcb, 0xc2, 0x5a, 0x1b, 0x87, 0xa4,
+	0xd6, 0xbf, 0xd1, 0x26, 0x11, 0xbe, 0x51, 0xb3, 0x18, 0x8d, 0xd7, 0xbf, 0x90, 0xeb, 0xa6, 0x3c,
+	0x59, 0x0e, 0xe4, 0x52, 0xe5, 0x0f, 0x39, 0xc8, 0xb5, 0x70, 0x80, 0xdd, 0x10, 0x1d, 0x40, 0x21,
+	0xc2, 0x2f, 0x48, 0x60, 0x1e, 0x13, 0xa2, 0x6b, 0x5b, 0xda, 0x76, 0xa1, 0x51, 0x7d, 0xf3, 0x6e,
+	0x73, 0xe1, 0x9f, 0xef, 0x36, 0xbf, 0xee, 0xd0, 0xa8, 0xdb, 0x6b, 0x57, 0x2d, 0xe6, 0x2a, 0xb8,
+	0xfa, 0x77, 0x3d, 0xb4, 0x5f, 0xd4, 0xa2, 0x13, 0x9f, 0x84, 0xd5, 0x3d, 0x62, 0x19, 0x79, 0x41,
+	0x70, 0x97, 0x10, 0xf4, 0x53, 0x28, 0x59, 0x01, 0x11, 0x42, 0x0a, 0xbe, 0xd4, 0xdc, 0x7c, 0xfb,
+	0x5e, 0x64, 0x14, 0x63, 0x0e, 0x4e, 0xf9, 0x08, 0xae, 0xb8, 0xd4, 0x33, 0x7d, 0x07, 0x7b, 0x66,
+	0xac, 0x00, 0x3d, 0xbd, 0xa5, 0x6d, 0x17, 0x77, 0xbf, 0xa8, 0x4a, 0x0d, 0x55, 0x63, 0x0d, 0x55,
+	0xf7, 0xd4, 0x86, 0x46, 0x9e, 0x1f, 0xf9, 0xdb, 0x7f, 0x6d, 0x6a, 0xc6, 0x8a, 0x4b, 0xbd, 0x96,
+	0x83, 0xbd, 0x78, 0x09, 0xfd, 0x1a, 0xae, 0x51, 0xcf, 0x22, 0x5e, 0x44, 0xfb, 0x24, 0x34, 0x39,
+	0x77, 0x18, 0xe1, 0x20, 0x32, 0xb9, 0xfa, 0x4d, 0x7c, 0x1c, 0x91, 0xc0, 0x0c, 0x49, 0x14, 0x39,
+	0xc4, 0x25, 0x5e, 0xa4, 0x67, 0x2e, 0x7e, 0xd2, 0xf7, 0x47, 0xb4, 0x0f, 0xa8, 0x77, 0xc4, 0x49,
+	0x1f, 0x53, 0x97, 0xd4, 0x39, 0xe5, 0xd1, 0x90, 0x11, 0x1d, 0xc0, 0x57, 0x53, 0xe7, 0x7b, 0x3d,
+	0xd7, 0x24, 0x3e, 0xb3, 0xba, 0xa1, 0xe9, 0x63, 0x6a, 0x9b, 0xac, 0x4f, 0x02, 0x3d, 0xbb, 0xa5,
+	0x6d, 0x67, 0x8c, 0xf2, 0x04, 0xe7, 0xc3, 0x9e, 0x7b, 0x47, 0xec, 0x6b, 0x61, 0x6a, 0x3f, 0xea,
+	0x93, 0x00, 0x3d, 0x87, 0x55, 0x87, 0xbe, 0xec, 0x51, 0x9b, 0x46, 0x27, 0xa6, 0x4d, 0x3c, 0xe6,
+	0x86, 0x7a, 0x6e, 0x2b, 0xbd, 0x5d, 0xdc, 0xbd, 0x56, 0x3d, 0xdb, 0x23, 0xab, 0x87, 0x31, 0x66,
+	0x8f, 0x43, 0x1a, 0x19, 0x7e, 0x07, 0x63, 0xc5, 0x99, 0x98, 0x0d, 0xd1, 0x73, 0xf8, 0xdc, 0xc5,
+	0x83, 0x31, 0x6d, 0x8c, 0x0c, 0xb0, 0x78, 0x71, 0xb5, 0x7c, 0xe6, 0xe2, 0xc1, 0xe8, 0xfa, 0x43,
+	0x33, 0x70, 0xbb, 0xe2, 0x81, 0xd9, 0x27, 0x61, 0x44, 0xbd, 0x8e, 0x69, 0x39, 0xf4, 0xf8, 0x58,
+	0xcf, 0xcf, 0x63, 0x57, 0x3c, 0x78, 0x22, 0xc1, 0x4d, 0x8e, 0x45, 0x3f, 0x83, 0xb5, 0x71, 0xc2,
+	0xa1, 0xa8, 0x85, 0x8b, 0x73, 0xa2, 0x11, 0x67, 0xbc, 0x5a, 0x69, 0xc0, 0xf2, 0xa4, 0xb6, 0xd0,
+	0x1a, 0x64, 0x85, 0xa6, 0x65, 0xb4, 0x18, 0x72, 0x80, 0x36, 0x20, 0x4f, 0x06, 0x3e, 0xf3, 0xb8,
+	0xd3, 0x70, 0xb7, 0x5f, 0x32, 0x86, 0xe3, 0xca, 0x7f, 0x32, 0x50, 0x6a, 0x30, 0xcf, 0xe6, 0xb2,
+	0xf6, 0x82, 0x3e, 0x41, 0x3f, 0x02, 0xed, 0x41, 0xc2, 0x60, 0xd3, 0x1e, 0x70, 0xf4, 0x43, 0x3d,
+	0x95, 0x0c, 0xfd, 0x90, 0xa3, 0x9b, 0x7a, 0x3a, 0x19, 0xba, 0x89, 0x5a, 0x50, 0x74, 0x58, 0x07,
+	0x07, 0x34, 0xea, 0xba, 0xd4, 0x52, 0xe1, 0xf1, 0x83, 0x99, 0xbe, 0x36, 0xda, 0x2e, 0x2e, 0x7f,
+	0x6f, 0xc1, 0x18, 0xa7, 0x40, 0x16, 0x20, 0x0b, 0xfb, 0x3e, 0xb1, 0xcd, 0x58, 0x5f, 0x14, 0x3b,
+	0xc2, 0xfd, 0x8b, 0xbb, 0xbb, 0xb3, 0x88, 0x9b, 0x02, 0x75, 0x67, 0x04, 0x8a, 0xe9, 0xaf, 0x58,
+	0xd3, 0x2b, 0x68, 0x0f, 0x16, 0x43, 0xda, 0x71, 0x19, 0xb5, 0xf5, 0x9c, 0x60, 0xde, 0x9e, 0xc5,
+	0x7c, 0x24, 0xb7, 0xc6, 0x7c, 0x31, 0x14, 0xfd, 0x12, 0x56, 0x7d, 0x4a, 0x2c, 0xf2, 0x8a, 0x86,
+	0xc4, 0x74, 0xa8, 0x47, 0x70, 0xa0, 0x22, 0x61, 0x67, 0x16, 0x5d, 0x2b, 0xc6, 0x1c, 0x0a, 0x48,
+	0x4c, 0xbb, 0xe2, 0x4f, 0xce, 0xa3, 0x5b, 0xa0, 0x4f, 0x05, 0xb3, 0x69, 0x13, 0x8b, 0xba, 0xd8,
+	0x09, 0x45, 0x64, 0x2c, 0x19, 0xeb, 0x93, 0x21, 0xba, 0xa7, 0x56, 0x1b, 0x79, 0xc8, 0x1d, 0x63,
+	0x97, 0x3a, 0x27, 0x95, 0xbf, 0x68, 0xb0, 0x3a, 0xad, 0x71, 0x6e, 0xf2, 0x7a, 0x52, 0x77, 0xab,
+	0x73, 0x74, 0x23, 0xa9, 0xbb, 0x35, 0x2e, 0xe7, 0x6e, 0x95, 0xbf, 0x6b, 0xb0, 0x7e, 0xba, 0x9d,
+	0x2f, 0x7f, 0xa9, 0x83, 0xa4, 0x97, 0x3a, 0x40, 0x3f, 0x81, 0x74, 0x13, 0xfb, 0x09, 0xaf, 0xc5,
+	0xa1, 0x95, 0xdf, 0xa4, 0xa0, 0x34, 0xee, 0x66, 0x5c, 0xa0, 0xc3, 0xa4, 0xd7, 0x39, 0xbc, 0xe4,
+	0x75, 0x7e, 0x0c, 0xa9, 0xa7, 0x3b, 0x09, 0x6f, 0x93, 0x7a, 0xba, 0x23, 0x6d, 0x9c, 0x49, 0x6a,
+	0xe3, 0x5f, 0xc0, 0xda, 0x69, 0x11, 0x82, 0xf6, 0x20, 0xe7, 0x33, 0xea, 0x45, 0xa1, 0xae, 0x89,
+	0x8a, 0xf6, 0xf5, 0xcc, 0x18, 0x0b, 0xa8, 0x45, 0x5a, 0x7c, 0xbb, 0xaa, 0x66, 0x0a, 0x5b, 0x79,
+	0xad, 0x01, 0x8c, 0x16, 0xb9, 0xa8, 0x83, 0xa4, 0x6a, 0x1e, 0xa0, 0x3d, 0xc8, 0xfa, 0x9c, 0x2b,
+	0xa1, 0xaa, 0x25, 0xb8, 0xf2, 0xe7, 0x3c, 0x64, 0x78, 0x4b, 0x82, 0x96, 0x21, 0x45, 0x6d, 0x21,
+	0x4d, 0xc6, 0x48, 0x51, 0x1b, 0x7d, 0x09, 0x10, 0x30, 0xc7, 0xc1, 0xbe, 0x6f, 0x52, 0x5b, 0x9e,
+	0x61, 0x14, 0xd4, 0xcc, 0xbe, 0x8d, 0xee, 0x02, 0x72, 0x99, 0xdd, 0x73, 0x88, 0x89, 0x2d, 0xcb,
+	0xc4, 0xb6, 0x1d, 0x90, 0x30, 0x54, 0x66, 0xd3, 0xff, 0xf6, 0xa7, 0xeb, 0x6b, 0xf2, 0xd0, 0x6a,
+	0x5d, 0xae, 0x1c, 0x45, 0x01, 0xf5, 0x3a, 0xc6, 0xaa, 0xc4, 0xd4, 0x2d, 0x4b, 0xcd, 0xa3, 0xfb,
+	0xb0, 0x1a, 0xb1, 0x08, 0x3b, 0x26, 0x76, 0x1c, 0x66, 0xc9, 0x2a, 0x19, 0xf7, 0x39, 0x8a, 0x82,
+	0xf7, 0x94, 0x55, 0xd5, 0x53, 0x56, 0x9b, 0x8c, 0x7a, 0x71, 0x8f, 0x20, 0x80, 0xf5, 0x21, 0x0e,
+	0x1d, 0xc1, 0x52, 0x5b, 0x56, 0x36, 0xd3, 0xe2, 0x56, 0xd3, 0xb3, 0xe7, 0xa7, 0xd7, 0xf1, 0x52,
+	0xa8, 0x78, 0x4b, 0xed, 0xb1, 0x39, 0xf4, 0x15, 0x2c, 0xc9, 0xa6, 0xc3, 0x96, 0x69, 0x50, 0xe4,
+	0xec, 0x82, 0x51, 0x52, 0x93, 0xb2, 0x0c, 0x37, 0x01, 0x46, 0x8d, 0x9b, 0x4a, 0xc3, 0x1b, 0x1f,
+	0x55, 0xf9, 0xc7, 0x71, 0x53, 0x2d, 0xcb, 0xfc, 0x6b, 0x5e, 0xe6, 0x0b, 0x61, 0xdc, 0x9b, 0xa1,
+	0x43, 0x58, 0xf1, 0x03, 0x62, 0x3a, 0xb8, 0xe7, 0x59, 0x5d, 0xc9, 0x94, 0x9f, 0x83, 0x69, 0xc9,
+	0x0f, 0xc8, 0xa1, 0xc0, 0x0a, 0xb6, 0x7d, 0xc8, 0x87, 0xcc, 0xb1, 0x4d, 0xec, 0x46, 0x7a, 0x61,
+	0x6e, 0x0f, 0xe1, 0xad, 0xef, 0x22, 0xc7, 0xd7, 0xdd, 0x08, 0x3d, 0x82, 0xa2, 0xe5, 0x60, 0xea,
+	0x12, 0xc9, 0x06, 0x89, 0xd8, 0x40, 0x51, 0x70, 0x42, 0x0a, 0x9f, 0x0d, 0x7b, 0x49, 0xd9, 0x4d,
+	0xfb, 0xe2, 0x01, 0xa0, 0x17, 0xc5, 0x7d, 0x6b, 0xb3, 0x0c, 0xb6, 0x1f, 0x03, 0xb9, 0xd7, 0xca,
+	0x77, 0x83, 0xb2, 0xdb, 0x55, 0xfa, 0xf1, 0x12, 0xfa, 0x06, 0x56, 0xa6, 0xea, 0x98, 0x5e, 0x12,
+	0x06, 0x5c, 0x9e, 0x2c, 0x5f, 0xa8, 0x05, 0xa5, 0xb8, 0x5d, 0xe3, 0x12, 0xe9, 0x4b, 0x42, 0x94,
+	0x6f, 0x66, 0x89, 0xa2, 0xda, 0x33, 0x7e, 0x9a, 0x12, 0xa1, 0xd8, 0x1f, 0x4d, 0xa1, 0x67, 0xb0,
+	0xc2, 0x9d, 0xfa, 0x95, 0x43, 0xc3, 0xc8, 0xf4, 0xbb, 0x38, 0x24, 0xfa, 0xf2, 0x96, 0x76, 0x5e,
+	0x3b, 0x5c, 0x8f, 0x21, 0x2d, 0x8e, 0x50, 0xbc, 0xcb, 0x78, 0x62, 0x16, 0x59, 0xb0, 0xce, 0xfb,
+	0x4b, 0xec, 0xb2, 0x9e, 0x17, 0x99, 0x3e, 0x09, 0x86, 0x11, 0xb8, 0x92, 0xc8, 0x38, 0x57, 0x5d,
+	0x3c, 0xa8, 0x0b, 0xb2, 0x16, 0x09, 0xe2, 0xd0, 0xfc, 0x2e, 0x14, 0x2c, 0xec, 0x59, 0xc4, 0x71,
+	0x88, 0xad, 0xaf, 0x6e, 0x69, 0xdb, 0x79, 0x63, 0x34, 0x51, 0xf9, 0x9f, 0x06, 0xcb, 0x93, 0xb2,
+	0xa2, 0x4d, 0x28, 0xba, 0x24, 0x78, 0xe1, 0x10, 0x33, 0x60, 0x2c, 0x12, 0xb9, 0xa4, 0x64, 0x80,
+	0x9c, 0x32, 0x18, 0x8b, 0x38, 0xa3, 0x92, 0x93, 0x84, 0x7a, 0x6a, 0x2b, 0xcd, 0x53, 0xca, 0x70,
+	0x02, 0xdd, 0x86, 0x3c, 0xf1, 0x6c, 0xe9, 0xf8, 0xe9, 0x39, 0x1c, 0x7f, 0x91, 0x78, 0xb6, 0x70,
+	0xf9, 0xb3, 0xb5, 0x92, 0xf9, 0x64, 0x5a, 0xa9, 0xfc, 0x4e, 0x83, 0x7c, 0xab, 0x17, 0x58, 0xe2,
+	0xc6, 0x9f, 0xc3, 0xa2, 0x70, 0x5f, 0x95, 0x39, 0x0b, 0x46, 0x8e, 0x0f, 0xf7, 0x6d, 0x54, 0x85,
+	0x6c, 0xbb, 0x77, 0x42, 0x02, 0x3d, 0x75, 0x4e, 0x46, 0x94, 0xdb, 0xd0, 0x5d, 0xc8, 0x49, 0xb1,
+	0xf5, 0x74, 0x22, 0x51, 0x15, 0xba, 0xf2, 0x57, 0x0d, 0x8a, 0x63, 0x6e, 0x89, 0x7e, 0x08, 0x59,
+	0xf9, 0x9a, 0xd1, 0x2e, 0xfe, 0xf2, 0x90, 0x08, 0x6e, 0x8e, 0xe1, 0xbb, 0x25, 0x75, 0x71, 0xf4,
+	0x10, 0x34, 0x95, 0x14, 0xd3, 0x89, 0x92, 0x62, 0xe5, 0xbf, 0x1a, 0x2c, 0x37, 0x45, 0xe6, 0x08,
+	0xd4, 0xbd, 0xce, 0x56, 0xfa, 0x2e, 0x2c, 0xca, 0x24, 0x73, 0xbe, 0xda, 0xe3, 0x8d, 0xbc, 0x8a,
+	0x8a, 0x32, 0x92, 0x50, 0xef, 0x12, 0x8c, 0xee, 0xc5, 0x27, 0xdb, 0x09, 0x5d, 0x2d, 0x86, 0x57,
+	0xfe, 0xa8, 0xc1, 0xd5, 0x53, 0x52, 0x1c, 0x6a, 0xc3, 0x77, 0x66, 0x7d, 0x1a, 0x98, 0xc3, 0xbc,
+	0x7a, 0x78, 0xd6, 0xd7, 0x80, 0x1a, 0xac, 0x9d, 0xfa, 0xfc, 0x4f, 0x89, 0xa6, 0xe0, 0x8a, 0x37,
+	0xfd, 0xe2, 0xaf, 0xfc, 0x3e, 0x0d, 0xd9, 0xc7, 0x01, 0xb6, 0x67, 0x04, 0xc2, 0x2a, 0xa4, 0x43,
+	0xf2, 0x52, 0x51, 0xf0, 0x9f, 0x68, 0x07, 0x72, 0x11, 0xc7, 0x04, 0xe7, 0x76, 0x0b, 0x6a, 0x1f,
+	0xe7, 0x68, 0xf7, 0x4e, 0x84, 0x66, 0xf3, 0x06, 0xff, 0x39, 0x16, 0x2e, 0xd9, 0xcb, 0x84, 0x0b,
+	0x3a, 0x84, 0xc2, 0xb0, 0x0c, 0xe8, 0xb9, 0x44, 0x54, 0x23, 0x82, 0x51, 0x47, 0xb6, 0x78, 0x89,
+	0x8e, 0x0c, 0xdd, 0x82, 0xcc, 0xdc, 0xb5, 0x5f, 0x20, 0xd0, 0x3a, 0xe4, 0xba, 0x84, 0x76, 0xba,
+	0xb2, 0xe0, 0xa7, 0x0d, 0x35, 0xaa, 0xfc, 0x3f, 0x03, 0xb9, 0x26, 0xf6, 0x6c, 0x67, 0x86, 0x9d,
+	0x6e, 0x43, 0x9e, 0x7a, 0x11, 0x09, 0xfa, 0xd8, 0x99, 0x2b, 0xda, 0x63, 0xd0, 0x27, 0x89, 0x76,
+	0xd4, 0x80, 0x0c, 0xf3, 0x89, 0x97, 0xb0, 0x7f, 0x17, 0x58, 0xce, 0xd1, 0xa5, 0x9d, 0xae, 0x9e,
+	0x4d, 0xc6, 0xc1, 0xb1, 0xfc, 0x4d, 0xe5, 0xb0, 0x57, 0x7a, 0x2e, 0x11, 0x05, 0x87, 0x72, 0x5f,
+	0xb0, 0x1c, 0x16, 0x26, 0xf6, 0x05, 0x01, 0xe6, 0x7e, 0xde, 0x67, 0x4e, 0x4f, 0x79, 0x43, 0x02,
+	0x3f, 0x97, 0x68, 0xf4, 0x6c, 0xfc, 0xd3, 0x9c, 0x62, 0x4c, 0xd6, 0x14, 0x8e, 0xba, 0xa9, 0x27,
+	0x92, 0xfa, 0x4b, 0x00, 0x9e, 0x34, 0x44, 0xa8, 0x86, 0xa2, 0x37, 0xcc, 0x18, 0x05, 0xaf, 0xe7,
+	0x8a, 0xbc, 0x10, 0x36, 0xee, 0xbf, 0x79, 0x5f, 0xd6, 0xde, 0xbe, 0x2f, 0x6b, 0xff, 0x7e, 0x5f,
+	0xd6, 0x5e, 0x7f, 0x28, 0x2f, 0xbc, 0xfd, 0x50, 0x5e, 0xf8, 0xc7, 0x87, 0xf2, 0xc2, 0xcf, 0x77,
+	0xc6, 0x4e, 0x3c, 0xe3, 0xb3, 0x76, 0xff, 0x66, 0x6d, 0x20, 0xbe, 0x6d, 0x8b, 0xf3, 0xdb, 0x39,
+	0xe1, 0x46, 0x37, 0xbf, 0x1d, 0x00, 0xbb, 0x2c, 0xd2, 0x55, 0x06, 0x17, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxVestingDuration):])
+	if err1 != nil {
+		return 0, err1
 	}
+	i -= n1
+	i = encodeVarintIro(dAtA, i, uint64(n1))
 	i--
 	dAtA[i] = 0x4a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxVestingCliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxVestingCliff):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintIro(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxSettlementDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxSettlementDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintIro(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.LiquidityDenoms) > 0 {
+		for iNdEx := len(m.LiquidityDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIro(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.IncentivesMinNumEpochsPaidOver != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.IncentivesMinNumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintIro(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintIro(dAtA, i, uint64(n5))
 	i--
-	dAtA[i] = 0x3a
-	if len(m.SettledDenom) > 0 {
-		i -= len(m.SettledDenom)
-		copy(dAtA[i:], m.SettledDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
-		i--
-		dAtA[i] = 0x32
-	}
+	dAtA[i] = 0x1a
 	{
-		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.CreationFee.Size()
+		i -= size
+		if _, err := m.CreationFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x12
 	{
-		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if len(m.ModuleAccAddress) > 0 {
-		i -= len(m.ModuleAccAddress)
-		copy(dAtA[i:], m.ModuleAccAddress)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -632,137 +1443,3934 @@ func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityDenom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NumEpochsPaidOver != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
+	if m.Exponent != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Exponent))
 		i--
 		dAtA[i] = 0x10
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err8 != nil {
-		return 0, err8
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i -= n8
-	i = encodeVarintIro(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIro(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TakerFee.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.CreationFee.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	if m.IncentivesMinNumEpochsPaidOver != 0 {
-		n += 1 + sovIro(uint64(m.IncentivesMinNumEpochsPaidOver))
-	}
-	return n
+
+func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BondingCurve) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.M.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.N.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovIro(uint64(l))
-	return n
+	if m.LiquidityDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Family != nil {
+		{
+			size := m.Family.Size()
+			i -= size
+			if _, err := m.Family.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.N.Size()
+		i -= size
+		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.M.Size()
+		i -= size
+		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Plan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BondingCurve_Logarithmic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondingCurve_Logarithmic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Logarithmic != nil {
+		{
+			size, err := m.Logarithmic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BondingCurve_CappedExponential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondingCurve_CappedExponential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CappedExponential != nil {
+		{
+			size, err := m.CappedExponential.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BondingCurve_Sigmoid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondingCurve_Sigmoid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Sigmoid != nil {
+		{
+			size, err := m.Sigmoid.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BondingCurve_PiecewiseLinear) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondingCurve_PiecewiseLinear) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PiecewiseLinear != nil {
+		{
+			size, err := m.PiecewiseLinear.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *LogarithmicCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogarithmicCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogarithmicCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovIro(uint64(m.Id))
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.B.Size()
+		i -= size
+		if _, err := m.B.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	l = len(m.ModuleAccAddress)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.A.Size()
+		i -= size
+		if _, err := m.A.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	l = m.TotalAllocation.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.BondingCurve.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = len(m.SettledDenom)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CappedExponentialCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = m.SoldAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.ClaimedAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.IncentivePlanParams.Size()
-	n += 1 + l + sovIro(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *IncentivePlanParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CappedExponentialCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CappedExponentialCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.K.Size()
+		i -= size
+		if _, err := m.K.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.A.Size()
+		i -= size
+		if _, err := m.A.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SigmoidCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigmoidCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigmoidCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.X0.Size()
+		i -= size
+		if _, err := m.X0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.K.Size()
+		i -= size
+		if _, err := m.K.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.L.Size()
+		i -= size
+		if _, err := m.L.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PiecewiseLinearCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PiecewiseLinearCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PiecewiseLinearCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIro(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.X.Size()
+		i -= size
+		if _, err := m.X.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Plan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.MaxAmountPerAddress.Size()
+		i -= size
+		if _, err := m.MaxAmountPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.AllowlistPhase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.LiquidityDenom) > 0 {
+		i -= len(m.LiquidityDenom)
+		copy(dAtA[i:], m.LiquidityDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	{
+		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ClaimedAmt.Size()
+		i -= size
+		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SoldAmt.Size()
+		i -= size
+		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintIro(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x42
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintIro(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SettledDenom) > 0 {
+		i -= len(m.SettledDenom)
+		copy(dAtA[i:], m.SettledDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ModuleAccAddress) > 0 {
+		i -= len(m.ModuleAccAddress)
+		copy(dAtA[i:], m.ModuleAccAddress)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowlistPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowlistPhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowlistPhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmountPerAddress.Size()
+		i -= size
+		if _, err := m.MaxAmountPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintIro(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintIro(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Purchase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Purchase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Purchase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintIro(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintIro(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Cliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintIro(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimerVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimerVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimerVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x10
+	}
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintIro(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintIro(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Buy {
+		i--
+		if m.Buy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumTrades != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.NumTrades))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.LiquidityVolume.Size()
+		i -= size
+		if _, err := m.LiquidityVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintIro(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintIro(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIro(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerFee.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.CreationFee.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	if m.IncentivesMinNumEpochsPaidOver != 0 {
+		n += 1 + sovIro(uint64(m.IncentivesMinNumEpochsPaidOver))
+	}
+	if len(m.LiquidityDenoms) > 0 {
+		for _, e := range m.LiquidityDenoms {
+			l = e.Size()
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxSettlementDuration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxVestingCliff)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxVestingDuration)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *LiquidityDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovIro(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *BondingCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.M.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.N.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.Family != nil {
+		n += m.Family.Size()
+	}
+	if m.LiquidityDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
+	}
+	return n
+}
+
+func (m *BondingCurve_Logarithmic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Logarithmic != nil {
+		l = m.Logarithmic.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	return n
+}
+func (m *BondingCurve_CappedExponential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CappedExponential != nil {
+		l = m.CappedExponential.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	return n
+}
+func (m *BondingCurve_Sigmoid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sigmoid != nil {
+		l = m.Sigmoid.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	return n
+}
+func (m *BondingCurve_PiecewiseLinear) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PiecewiseLinear != nil {
+		l = m.PiecewiseLinear.Size()
+		n += 1 + l + sovIro(uint64(l))
+	}
+	return n
+}
+func (m *LogarithmicCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.A.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.B.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *CappedExponentialCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.A.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.K.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Cap.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *SigmoidCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.L.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.K.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.X0.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *PiecewiseLinearCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PricePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.X.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Plan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIro(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.ModuleAccAddress)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.TotalAllocation.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.BondingCurve.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = len(m.SettledDenom)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.SoldAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.ClaimedAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.IncentivePlanParams.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = len(m.LiquidityDenom)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.VestingPlan.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.AllowlistPhase.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxAmountPerAddress.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.Cancelled {
+		n += 3
+	}
+	return n
+}
+
+func (m *AllowlistPhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxAmountPerAddress.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Purchase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *VestingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *ClaimerVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *IncentivePlanParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
+	}
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovIro(uint64(m.Seq))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.Buy {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovIro(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovIro(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.LiquidityVolume.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.NumTrades != 0 {
+		n += 1 + sovIro(uint64(m.NumTrades))
+	}
+	return n
+}
+
+func sovIro(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIro(x uint64) (n int) {
+	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPlanDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesMinStartTimeAfterSettlement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IncentivesMinStartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesMinNumEpochsPaidOver", wireType)
+			}
+			m.IncentivesMinNumEpochsPaidOver = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentivesMinNumEpochsPaidOver |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDenoms = append(m.LiquidityDenoms, LiquidityDenom{})
+			if err := m.LiquidityDenoms[len(m.LiquidityDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettlementDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxSettlementDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVestingCliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxVestingCliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVestingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxVestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logarithmic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LogarithmicCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Family = &BondingCurve_Logarithmic{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedExponential", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CappedExponentialCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Family = &BondingCurve_CappedExponential{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigmoid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SigmoidCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Family = &BondingCurve_Sigmoid{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiecewiseLinear", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PiecewiseLinearCurve{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Family = &BondingCurve_PiecewiseLinear{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+			}
+			m.LiquidityDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityDenomDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogarithmicCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogarithmicCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogarithmicCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.B.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CappedExponentialCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CappedExponentialCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CappedExponentialCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.K.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigmoidCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigmoidCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigmoidCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.L.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.K.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.X0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PiecewiseLinearCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PiecewiseLinearCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PiecewiseLinearCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, PricePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.X.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistPhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowlistPhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowlistPhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowlistPhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowlistPhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Purchase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purchase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purchase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovIro(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIro(x uint64) (n int) {
-	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ClaimerVesting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -785,15 +5393,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimerVesting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimerVesting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -821,13 +5429,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -855,15 +5461,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPlanDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -873,28 +5477,113 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesMinStartTimeAfterSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivePlanParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivePlanParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -921,15 +5610,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IncentivesMinStartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesMinNumEpochsPaidOver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
 			}
-			m.IncentivesMinNumEpochsPaidOver = 0
+			m.NumEpochsPaidOver = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -939,7 +5628,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IncentivesMinNumEpochsPaidOver |= uint64(b&0x7F) << shift
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -965,7 +5654,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+func (m *Trade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,19 +5673,156 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Buy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1024,13 +5850,13 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1058,15 +5884,15 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1076,26 +5902,44 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIro(dAtA[iNdEx:])
@@ -1117,7 +5961,7 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *Candle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1140,66 +5984,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1227,11 +6020,11 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1258,13 +6051,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1291,13 +6084,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1325,46 +6118,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1374,28 +6136,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1423,13 +6186,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1457,15 +6220,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1475,80 +6238,31 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIro(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthIro
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIro
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IncentivePlanParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentivePlanParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityVolume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1558,30 +6272,31 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTrades", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			m.NumTrades = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1591,7 +6306,7 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				m.NumTrades |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1,6 +1,11 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -28,6 +33,27 @@ var (
 
 	// ParamsKey is the key to retrieve the module parameters
 	ParamsKey = []byte{0x4} // params
+
+	// ClaimerVestingKeyPrefix is the prefix to retrieve the vesting claims by plan ID and claimer
+	ClaimerVestingKeyPrefix = []byte{0x5} // prefix/planId/claimer
+
+	// PurchaseKeyPrefix is the prefix to retrieve the purchases by plan ID and buyer
+	PurchaseKeyPrefix = []byte{0x6} // prefix/planId/buyer
+
+	// TradeKeyPrefix is the prefix to retrieve the latest trades by plan ID and their slot in the plan's history
+	TradeKeyPrefix = []byte{0x7} // prefix/planId/slot
+
+	// TradeCountKeyPrefix is the prefix to retrieve the number of trades recorded by plan ID
+	TradeCountKeyPrefix = []byte{0x8} // prefix/planId
+
+	// CandleKeyPrefix is the prefix to retrieve the candles by plan ID, interval and start time
+	CandleKeyPrefix = []byte{0x9} // prefix/planId/interval/startTime
+
+	// UnsettledPlanKeyPrefix is the prefix to retrieve the plans which are neither settled nor cancelled by their pre-launch time
+	UnsettledPlanKeyPrefix = []byte{0xA} // prefix/preLaunchTime/planId
+
+	// CandlesPruningKeyPrefix is the prefix to retrieve the scheduled pruning of the candles by time, plan ID and interval
+	CandlesPruningKeyPrefix = []byte{0xB} // prefix/pruneTime/planId/interval
 )
 
 /* --------------------- specific plan ID keys -------------------- */
@@ -35,8 +61,63 @@ func PlanKey(planId string) []byte {
 	return []byte(fmt.Sprintf("%s%s%s", PlanKeyPrefix, KeySeparator, planId))
 }
 
+func ClaimerVestingKey(planId, claimer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", ClaimerVestingKeyPrefix, KeySeparator, planId, KeySeparator, claimer))
+}
+
+func PurchaseKey(planId, buyer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", PurchaseKeyPrefix, KeySeparator, planId, KeySeparator, buyer))
+}
+
+func TradeKey(planId string, slot uint64) []byte {
+	return append(TradesByPlanKey(planId), sdk.Uint64ToBigEndian(slot)...)
+}
+
+func TradeCountKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s", TradeCountKeyPrefix, KeySeparator, planId))
+}
+
+// CandleKey is ordered by the start time within the plan and interval, as the times are big endian encoded
+func CandleKey(planId string, interval time.Duration, startTime time.Time) []byte {
+	return append(CandlesByPlanIntervalKey(planId, interval), sdk.Uint64ToBigEndian(uint64(startTime.Unix()))...)
+}
+
+// UnsettledPlanKey is ordered by the pre-launch time, as the time is big endian encoded
+func UnsettledPlanKey(preLaunchTime time.Time, planId string) []byte {
+	return append(UnsettledPlansByPreLaunchTimeKey(preLaunchTime), []byte(planId)...)
+}
+
+// CandlesPruningKey is ordered by the pruning time, as the time is big endian encoded
+func CandlesPruningKey(pruneTime time.Time, planId string, interval time.Duration) []byte {
+	intervalBytes := sdk.Uint64ToBigEndian(uint64(interval / time.Second))
+	return []byte(fmt.Sprintf("%s%s%s%s", CandlesPruningByTimeKey(pruneTime), planId, KeySeparator, intervalBytes))
+}
+
 /* ------------------------- multiple plans keys ------------------------ */
 func PlansByRollappKey(rollappId string) []byte {
 	rollappIdBytes := []byte(rollappId)
 	return []byte(fmt.Sprintf("%s%s%s", PlansByRollappKeyPrefix, KeySeparator, rollappIdBytes))
 }
+
+func ClaimerVestingsByPlanKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", ClaimerVestingKeyPrefix, KeySeparator, planId, KeySeparator))
+}
+
+func TradesByPlanKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", TradeKeyPrefix, KeySeparator, planId, KeySeparator))
+}
+
+func CandlesByPlanIntervalKey(planId string, interval time.Duration) []byte {
+	intervalBytes := sdk.Uint64ToBigEndian(uint64(interval / time.Second))
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s", CandleKeyPrefix, KeySeparator, planId, KeySeparator, intervalBytes, KeySeparator))
+}
+
+func UnsettledPlansByPreLaunchTimeKey(preLaunchTime time.Time) []byte {
+	timeBytes := sdk.Uint64ToBigEndian(uint64(preLaunchTime.Unix()))
+	return []byte(fmt.Sprintf("%s%s%s%s", UnsettledPlanKeyPrefix, KeySeparator, timeBytes, KeySeparator))
+}
+
+func CandlesPruningByTimeKey(pruneTime time.Time) []byte {
+	timeBytes := sdk.Uint64ToBigEndian(uint64(pruneTime.Unix()))
+	return []byte(fmt.Sprintf("%s%s%s%s", CandlesPruningKeyPrefix, KeySeparator, timeBytes, KeySeparator))
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,6 +16,7 @@ const (
 	TypeMsgExactSpend = "buy_exact_spend"
 	TypeMsgSell       = "sell"
 	TypeMsgClaim      = "claim"
+	TypeMsgRefund     = "refund"
 	TypeUpdateParams  = "update_params"
 )
 
@@ -23,19 +26,22 @@ var (
 	_ sdk.Msg            = &MsgBuyExactSpend{}
 	_ sdk.Msg            = &MsgSell{}
 	_ sdk.Msg            = &MsgClaim{}
+	_ sdk.Msg            = &MsgRefund{}
 	_ sdk.Msg            = &MsgUpdateParams{}
 	_ legacytx.LegacyMsg = &MsgCreatePlan{}
 	_ legacytx.LegacyMsg = &MsgBuy{}
 	_ legacytx.LegacyMsg = &MsgBuyExactSpend{}
 	_ legacytx.LegacyMsg = &MsgSell{}
 	_ legacytx.LegacyMsg = &MsgClaim{}
+	_ legacytx.LegacyMsg = &MsgRefund{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
 
 // ValidateBasic performs basic validation checks on the MsgCreatePlan message.
 // It ensures that the owner address is valid, the bonding curve is valid, the allocated amount
 // is greater than the minimum token allocation, the pre-launch time is before the start time,
-// and the incentive plan parameters are valid.
+// the incentive plan parameters are valid, the liquidity denom, if set, is a valid denom, and the vesting plan
+// and the allowlist phase are valid.
 func (m *MsgCreatePlan) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Owner)
 	if err != nil {
@@ -59,6 +65,25 @@ func (m *MsgCreatePlan) ValidateBasic() error {
 		return errors.Join(ErrInvalidIncentivePlanParams, err)
 	}
 
+	if m.LiquidityDenom != "" {
+		if err := sdk.ValidateDenom(m.LiquidityDenom); err != nil {
+			return errors.Join(ErrInvalidLiquidityDenom, err)
+		}
+	}
+
+	if err := m.VestingPlan.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidVestingPlan, err)
+	}
+
+	// the start time of the plan might be delayed to the creation time, so the end time is validated on creation
+	if err := m.AllowlistPhase.ValidateBasic(time.Time{}); err != nil {
+		return errors.Join(ErrInvalidAllowlistPhase, err)
+	}
+
+	if !m.MaxAmountPerAddress.IsNil() && m.MaxAmountPerAddress.IsNegative() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("max amount per address %v must be non-negative", m.MaxAmountPerAddress)
+	}
+
 	return nil
 }
 
@@ -78,6 +103,10 @@ func (m *MsgClaim) Route() string {
 	return RouterKey
 }
 
+func (m *MsgRefund) Route() string {
+	return RouterKey
+}
+
 func (m *MsgBuyExactSpend) Route() string {
 	return RouterKey
 }
@@ -102,6 +131,10 @@ func (m *MsgClaim) Type() string {
 	return TypeMsgClaim
 }
 
+func (m *MsgRefund) Type() string {
+	return TypeMsgRefund
+}
+
 func (m *MsgBuyExactSpend) Type() string {
 	return TypeMsgExactSpend
 }
@@ -140,6 +173,11 @@ func (m *MsgClaim) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+func (m *MsgRefund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
 func (m *MsgUpdateParams) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(m)
 	return sdk.MustSortJSON(bz)
@@ -161,6 +199,10 @@ func (m *MsgBuy) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MaxCostAmount)
 	}
 
+	if err := validateAllowlistProof(m.AllowlistProof); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -208,6 +250,21 @@ func (m *MsgClaim) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (m *MsgRefund) ValidateBasic() error {
+	// refunder bech32
+	_, err := sdk.AccAddressFromBech32(m.Refunder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid refunder address: %s", err)
+	}
+
+	return nil
+}
+
+func (m *MsgRefund) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Refunder)
+	return []sdk.AccAddress{addr}
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -247,6 +304,19 @@ func (m *MsgBuyExactSpend) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MinOutTokensAmount)
 	}
 
+	if err := validateAllowlistProof(m.AllowlistProof); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateAllowlistProof(proof [][]byte) error {
+	for _, node := range proof {
+		if len(node) != sha256.Size {
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowlist proof node must be %d bytes: got %d", sha256.Size, len(node))
+		}
+	}
 	return nil
 }
 
@@ -5,6 +5,9 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/dymensionxyz/dymension/v3/app/params"
 )
 
 // Default parameter values
@@ -15,16 +18,27 @@ var (
 	DefaultMinPlanDuration                              = 0 * time.Hour               // no enforced minimum by default
 	DefaultIncentivePlanMinimumNumEpochsPaidOver        = uint64(364)                 // default: min 364 days (based on 1 day distribution epoch)
 	DefaultIncentivePlanMinimumStartTimeAfterSettlement = 60 * time.Minute            // default: min 1 hour after settlement
+	DefaultLiquidityDenoms                              = []LiquidityDenom{{Denom: appparams.BaseDenom, Exponent: DYMDecimals}}
+	DefaultMaxSettlementDuration                        = 0 * time.Hour            // no plan cancellation by default
+	DefaultMaxVestingCliff                              = 365 * 24 * time.Hour     // default: max 1 year
+	DefaultMaxVestingDuration                           = 4 * 365 * 24 * time.Hour // default: max 4 years
 )
 
+// MaxLiquidityDenomExponent is the maximum exponent of a liquidity denom, as the curve is computed with 18 decimals
+const MaxLiquidityDenomExponent = 18
+
 // NewParams creates a new Params object
-func NewParams(takerFee math.LegacyDec, creationFee math.Int, minPlanDuration time.Duration, minIncentivePlanParams IncentivePlanParams) Params {
+func NewParams(takerFee math.LegacyDec, creationFee math.Int, minPlanDuration time.Duration, minIncentivePlanParams IncentivePlanParams, liquidityDenoms []LiquidityDenom, maxSettlementDuration, maxVestingCliff, maxVestingDuration time.Duration) Params {
 	return Params{
 		TakerFee:                              takerFee,
 		CreationFee:                           creationFee,
 		MinPlanDuration:                       minPlanDuration,
 		IncentivesMinStartTimeAfterSettlement: minIncentivePlanParams.StartTimeAfterSettlement,
 		IncentivesMinNumEpochsPaidOver:        minIncentivePlanParams.NumEpochsPaidOver,
+		LiquidityDenoms:                       liquidityDenoms,
+		MaxSettlementDuration:                 maxSettlementDuration,
+		MaxVestingCliff:                       maxVestingCliff,
+		MaxVestingDuration:                    maxVestingDuration,
 	}
 }
 
@@ -36,6 +50,10 @@ func DefaultParams() Params {
 		MinPlanDuration:                       DefaultMinPlanDuration,
 		IncentivesMinStartTimeAfterSettlement: DefaultIncentivePlanMinimumStartTimeAfterSettlement,
 		IncentivesMinNumEpochsPaidOver:        DefaultIncentivePlanMinimumNumEpochsPaidOver,
+		LiquidityDenoms:                       DefaultLiquidityDenoms,
+		MaxSettlementDuration:                 DefaultMaxSettlementDuration,
+		MaxVestingCliff:                       DefaultMaxVestingCliff,
+		MaxVestingDuration:                    DefaultMaxVestingDuration,
 	}
 }
 
@@ -61,6 +79,60 @@ func (p Params) Validate() error {
 		return fmt.Errorf("incentive plan start time after settlement must be greater than 0: %v", p.IncentivesMinStartTimeAfterSettlement)
 	}
 
+	if err := validateLiquidityDenoms(p.LiquidityDenoms); err != nil {
+		return err
+	}
+
+	if p.MaxSettlementDuration < 0 {
+		return fmt.Errorf("maximum settlement duration must be non-negative: %v", p.MaxSettlementDuration)
+	}
+
+	if p.MaxVestingCliff < 0 {
+		return fmt.Errorf("maximum vesting cliff must be non-negative: %v", p.MaxVestingCliff)
+	}
+
+	if p.MaxVestingDuration < 0 {
+		return fmt.Errorf("maximum vesting duration must be non-negative: %v", p.MaxVestingDuration)
+	}
+
+	return nil
+}
+
+// ValidateVestingPlan checks that the cliff and the duration of the vesting plan do not exceed the maxima
+func (p Params) ValidateVestingPlan(v VestingPlan) error {
+	if v.Cliff > p.MaxVestingCliff {
+		return fmt.Errorf("vesting cliff exceeds the maximum: %v > %v", v.Cliff, p.MaxVestingCliff)
+	}
+	if v.Duration > p.MaxVestingDuration {
+		return fmt.Errorf("vesting duration exceeds the maximum: %v > %v", v.Duration, p.MaxVestingDuration)
+	}
+	return nil
+}
+
+// GetLiquidityDenom returns the whitelisted liquidity denom with the given denom
+func (p Params) GetLiquidityDenom(denom string) (LiquidityDenom, bool) {
+	for _, d := range p.LiquidityDenoms {
+		if d.Denom == denom {
+			return d, true
+		}
+	}
+	return LiquidityDenom{}, false
+}
+
+func validateLiquidityDenoms(denoms []LiquidityDenom) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return fmt.Errorf("invalid liquidity denom: %w", err)
+		}
+		if d.Exponent == 0 || d.Exponent > MaxLiquidityDenomExponent {
+			return fmt.Errorf("liquidity denom exponent must be between 1 and %d: %s: %d", MaxLiquidityDenomExponent, d.Denom, d.Exponent)
+		}
+		if _, ok := seen[d.Denom]; ok {
+			return fmt.Errorf("duplicate liquidity denom: %s", d.Denom)
+		}
+		seen[d.Denom] = struct{}{}
+	}
 	return nil
 }
 
@@ -23,7 +23,13 @@ func RollappIDFromIRODenom(denom string) (string, bool) {
 
 var MinTokenAllocation = math.LegacyNewDec(10) // min allocation in decimal representation
 
-func NewPlan(id uint64, rollappId string, allocation sdk.Coin, curve BondingCurve, start time.Time, end time.Time, incentivesParams IncentivePlanParams) Plan {
+func NewPlan(id uint64, rollappId string, allocation sdk.Coin, curve BondingCurve, start time.Time, end time.Time, incentivesParams IncentivePlanParams, liquidityDenom string, vestingPlan VestingPlan, allowlistPhase AllowlistPhase, maxAmountPerAddress math.Int) Plan {
+	if allowlistPhase.MaxAmountPerAddress.IsNil() {
+		allowlistPhase.MaxAmountPerAddress = math.ZeroInt()
+	}
+	if maxAmountPerAddress.IsNil() {
+		maxAmountPerAddress = math.ZeroInt()
+	}
 	plan := Plan{
 		Id:                  id,
 		RollappId:           rollappId,
@@ -34,6 +40,10 @@ func NewPlan(id uint64, rollappId string, allocation sdk.Coin, curve BondingCurv
 		IncentivePlanParams: incentivesParams,
 		SoldAmt:             math.ZeroInt(),
 		ClaimedAmt:          math.ZeroInt(),
+		LiquidityDenom:      liquidityDenom,
+		VestingPlan:         vestingPlan,
+		AllowlistPhase:      allowlistPhase,
+		MaxAmountPerAddress: maxAmountPerAddress,
 	}
 	plan.ModuleAccAddress = authtypes.NewModuleAddress(plan.ModuleAccName()).String()
 	return plan
@@ -66,6 +76,26 @@ func (p Plan) ValidateBasic() error {
 		return errors.Join(ErrInvalidIncentivePlanParams, err)
 	}
 
+	if err := sdk.ValidateDenom(p.LiquidityDenom); err != nil {
+		return errors.Join(ErrInvalidLiquidityDenom, err)
+	}
+
+	if err := p.VestingPlan.ValidateBasic(); err != nil {
+		return errors.Join(ErrInvalidVestingPlan, err)
+	}
+
+	if err := p.AllowlistPhase.ValidateBasic(p.StartTime); err != nil {
+		return errors.Join(ErrInvalidAllowlistPhase, err)
+	}
+
+	if p.MaxAmountPerAddress.IsNil() || p.MaxAmountPerAddress.IsNegative() {
+		return fmt.Errorf("max amount per address must be non-negative: %s", p.MaxAmountPerAddress)
+	}
+
+	if p.IsSettled() && p.IsCancelled() {
+		return fmt.Errorf("plan cannot be both settled and cancelled")
+	}
+
 	return nil
 }
 
@@ -74,10 +104,42 @@ func (p Plan) SpotPrice() math.LegacyDec {
 	return p.BondingCurve.SpotPrice(p.SoldAmt)
 }
 
+// MaxPurchaseAmount returns the maximum amount of tokens an address can buy at time t, if limited
+func (p Plan) MaxPurchaseAmount(t time.Time) (maxAmt math.Int, limited bool) {
+	maxAmt = p.MaxAmountPerAddress
+	if p.AllowlistPhase.IsActive(t) {
+		maxAmt = p.AllowlistPhase.MaxAmountPerAddress
+	}
+	if maxAmt.IsNil() || maxAmt.IsZero() {
+		return math.ZeroInt(), false
+	}
+	return maxAmt, true
+}
+
+// HasPurchaseLimits returns true if the amount of tokens an address can buy is limited at any time
+func (p Plan) HasPurchaseLimits() bool {
+	isLimited := func(i math.Int) bool { return !i.IsNil() && i.IsPositive() }
+	return isLimited(p.MaxAmountPerAddress) || (p.AllowlistPhase.IsEnabled() && isLimited(p.AllowlistPhase.MaxAmountPerAddress))
+}
+
 func (p Plan) IsSettled() bool {
 	return p.SettledDenom != ""
 }
 
+func (p Plan) IsCancelled() bool {
+	return p.Cancelled
+}
+
+// IsSettlementExpired returns true if the plan is neither settled nor cancelled,
+// and the maximum settlement duration since its pre-launch time has passed at time t.
+// A zero maximum duration means the plan never expires.
+func (p Plan) IsSettlementExpired(t time.Time, maxSettlementDuration time.Duration) bool {
+	if maxSettlementDuration <= 0 || p.IsSettled() || p.IsCancelled() {
+		return false
+	}
+	return !t.Before(p.PreLaunchTime.Add(maxSettlementDuration))
+}
+
 func (p Plan) ModuleAccName() string {
 	return ModuleName + "-" + p.RollappId
 }
@@ -763,870 +763,1089 @@ func (m *QueryClaimedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryClaimedResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.iro.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.iro.QueryParamsResponse")
-	proto.RegisterType((*QueryPlansRequest)(nil), "dymensionxyz.dymension.iro.QueryPlansRequest")
-	proto.RegisterType((*QueryPlansResponse)(nil), "dymensionxyz.dymension.iro.QueryPlansResponse")
-	proto.RegisterType((*QueryPlanRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanRequest")
-	proto.RegisterType((*QueryPlanResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanResponse")
-	proto.RegisterType((*QueryPlanByRollappRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappRequest")
-	proto.RegisterType((*QueryPlanByRollappResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappResponse")
-	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceRequest")
-	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceResponse")
-	proto.RegisterType((*QueryCostRequest)(nil), "dymensionxyz.dymension.iro.QueryCostRequest")
-	proto.RegisterType((*QueryCostResponse)(nil), "dymensionxyz.dymension.iro.QueryCostResponse")
-	proto.RegisterType((*QueryTokensForDYMRequest)(nil), "dymensionxyz.dymension.iro.QueryTokensForDYMRequest")
-	proto.RegisterType((*QueryTokensForDYMResponse)(nil), "dymensionxyz.dymension.iro.QueryTokensForDYMResponse")
-	proto.RegisterType((*QueryClaimedRequest)(nil), "dymensionxyz.dymension.iro.QueryClaimedRequest")
-	proto.RegisterType((*QueryClaimedResponse)(nil), "dymensionxyz.dymension.iro.QueryClaimedResponse")
+// QueryVestingRequest is the request type for the Query/QueryVesting RPC
+// method.
+type QueryVestingRequest struct {
+	PlanId  string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Claimer string `protobuf:"bytes,2,opt,name=claimer,proto3" json:"claimer,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/iro/query.proto", fileDescriptor_ae2c72bd0c23c1c0)
+func (m *QueryVestingRequest) Reset()         { *m = QueryVestingRequest{} }
+func (m *QueryVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingRequest) ProtoMessage()    {}
+func (*QueryVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{16}
 }
-
-var fileDescriptor_ae2c72bd0c23c1c0 = []byte{
-	// 946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0x26, 0x59, 0xc8, 0x0b, 0xaa, 0xca, 0x10, 0x60, 0x63, 0xc1, 0x36, 0x0c, 0x55,
-	0x08, 0x69, 0xd7, 0x93, 0x6c, 0x92, 0x4a, 0x40, 0x0f, 0xed, 0x26, 0x2a, 0x0a, 0x08, 0x08, 0x2e,
-	0x12, 0x82, 0x03, 0x96, 0x77, 0x3d, 0x2c, 0x56, 0xed, 0x19, 0xd7, 0x9e, 0x54, 0x35, 0x55, 0x0f,
-	0x20, 0x71, 0x47, 0x42, 0x70, 0x01, 0xee, 0x1c, 0xf8, 0x0c, 0x1c, 0x51, 0x8f, 0x95, 0xb8, 0x20,
-	0x90, 0x2a, 0x94, 0xf0, 0x41, 0x90, 0x67, 0xc6, 0x5e, 0x6f, 0x48, 0x6c, 0xaf, 0x10, 0xa7, 0x5d,
-	0xcf, 0xbc, 0x37, 0xef, 0xf7, 0x7f, 0xf3, 0xf6, 0xbf, 0x86, 0x55, 0x2f, 0x0d, 0x29, 0x4b, 0x7c,
-	0xce, 0xee, 0xa5, 0x9f, 0x93, 0xe2, 0x81, 0xf8, 0x31, 0x27, 0x77, 0x0e, 0x69, 0x9c, 0x5a, 0x51,
-	0xcc, 0x05, 0x47, 0x66, 0x39, 0xce, 0x2a, 0x1e, 0x2c, 0x3f, 0xe6, 0xe6, 0xd2, 0x88, 0x8f, 0xb8,
-	0x0c, 0x23, 0xd9, 0x37, 0x95, 0x61, 0x2e, 0x0f, 0x79, 0x12, 0xf2, 0xc4, 0x51, 0x1b, 0xea, 0x41,
-	0x6f, 0xbd, 0x30, 0xe2, 0x7c, 0x14, 0x50, 0xe2, 0x46, 0x3e, 0x71, 0x19, 0xe3, 0xc2, 0x15, 0x3e,
-	0x67, 0xf9, 0xee, 0xa5, 0x0a, 0x24, 0x3f, 0xce, 0x8f, 0xef, 0xa8, 0x13, 0xc9, 0xc0, 0x4d, 0x28,
-	0xb9, 0xbb, 0x39, 0xa0, 0xc2, 0xdd, 0x24, 0x43, 0xee, 0x33, 0xbd, 0xbf, 0x5e, 0xde, 0x97, 0x4a,
-	0x8a, 0xa8, 0xc8, 0x1d, 0xf9, 0x4c, 0x96, 0x54, 0xb1, 0x78, 0x09, 0xd0, 0xfb, 0x59, 0xc4, 0x81,
-	0x1b, 0xbb, 0x61, 0x62, 0xd3, 0x3b, 0x87, 0x34, 0x11, 0xf8, 0x43, 0x78, 0x66, 0x62, 0x35, 0x89,
-	0x38, 0x4b, 0x28, 0xba, 0x0e, 0xad, 0x48, 0xae, 0xb4, 0x8d, 0x15, 0x63, 0x6d, 0xb1, 0x87, 0xad,
-	0xb3, 0x5b, 0x63, 0xa9, 0xdc, 0xfe, 0xdc, 0xc3, 0xc7, 0x17, 0x67, 0x6c, 0x9d, 0x87, 0xbf, 0x32,
-	0xe0, 0x69, 0x75, 0x72, 0xe0, 0xb2, 0xbc, 0x1c, 0x5a, 0x83, 0x0b, 0x8c, 0x33, 0x27, 0xa1, 0x42,
-	0x04, 0xd4, 0x73, 0x38, 0x0b, 0x52, 0x59, 0xe1, 0x49, 0xfb, 0x3c, 0xe3, 0xec, 0x96, 0x5a, 0x7e,
-	0x8f, 0x05, 0x29, 0xba, 0x09, 0x30, 0x96, 0xd0, 0x3e, 0x27, 0x29, 0x56, 0x2d, 0xdd, 0xe1, 0x4c,
-	0xaf, 0xa5, 0x6e, 0x4e, 0xeb, 0xb5, 0x0e, 0xdc, 0x11, 0xd5, 0x55, 0xec, 0x52, 0x26, 0xfe, 0xde,
-	0xc8, 0x75, 0x2b, 0x0e, 0x2d, 0xf0, 0x1a, 0xcc, 0x47, 0xd9, 0x42, 0xdb, 0x58, 0x99, 0x5d, 0x5b,
-	0xec, 0xad, 0x54, 0xea, 0x0b, 0x5c, 0xa6, 0xd5, 0xa9, 0x24, 0xf4, 0xe6, 0x29, 0x70, 0xaf, 0xd4,
-	0xc2, 0xa9, 0xd2, 0x13, 0x74, 0x97, 0xe1, 0x42, 0x01, 0x97, 0xf7, 0xe8, 0x79, 0x78, 0x22, 0xab,
-	0xe2, 0xf8, 0x9e, 0x6c, 0xcd, 0x82, 0xdd, 0xca, 0x1e, 0xf7, 0x3d, 0xbc, 0x5f, 0xea, 0x68, 0x21,
-	0x64, 0x1b, 0xe6, 0xb2, 0x6d, 0x7d, 0x4f, 0xb5, 0x3a, 0x6c, 0x19, 0x8d, 0x5f, 0x87, 0xe5, 0xe2,
-	0xa8, 0x7e, 0x6a, 0xf3, 0x20, 0x70, 0xa3, 0x28, 0x07, 0x78, 0x11, 0x20, 0x56, 0x2b, 0x63, 0x86,
-	0x05, 0xbd, 0xb2, 0xef, 0x61, 0x1b, 0xcc, 0xd3, 0x72, 0xff, 0x13, 0xcf, 0x06, 0x3c, 0x2b, 0xcf,
-	0xbc, 0x15, 0x71, 0x71, 0x10, 0xfb, 0x43, 0x5a, 0xdb, 0x8c, 0x4f, 0xe0, 0xb9, 0x93, 0x19, 0x9a,
-	0x60, 0x0f, 0xe6, 0xa3, 0x6c, 0x41, 0x25, 0xf4, 0xad, 0xec, 0xe2, 0xfe, 0x78, 0x7c, 0x71, 0x75,
-	0xe4, 0x8b, 0xcf, 0x0e, 0x07, 0xd6, 0x90, 0x87, 0xfa, 0x87, 0xaa, 0x3f, 0xba, 0x89, 0x77, 0x9b,
-	0x88, 0x34, 0xa2, 0x89, 0xb5, 0x47, 0x87, 0xb6, 0x4a, 0xc6, 0x5f, 0x18, 0xfa, 0x6a, 0x76, 0x79,
-	0x22, 0xea, 0x68, 0xd0, 0x75, 0x98, 0x75, 0x43, 0x21, 0x27, 0x61, 0xba, 0x8a, 0xfb, 0x4c, 0xd8,
-	0x59, 0x2a, 0x42, 0x30, 0x97, 0xd0, 0x20, 0x68, 0xcf, 0xca, 0x5f, 0x83, 0xfc, 0x8e, 0xfb, 0xfa,
-	0xc2, 0x15, 0x82, 0x96, 0xd7, 0x85, 0xb9, 0x21, 0x4f, 0x84, 0x6e, 0xf0, 0xf2, 0xc4, 0xd4, 0xe5,
-	0xf3, 0xb6, 0xcb, 0x7d, 0x66, 0xcb, 0x30, 0x7c, 0x08, 0x6d, 0x79, 0xc6, 0x07, 0xfc, 0x36, 0x65,
-	0xc9, 0x4d, 0x1e, 0xef, 0x7d, 0xf4, 0xce, 0xff, 0x2f, 0x07, 0xbf, 0xab, 0x07, 0x6c, 0xb2, 0xac,
-	0x96, 0xb0, 0x09, 0x2d, 0x21, 0xd7, 0xeb, 0x45, 0xe8, 0x40, 0x6c, 0x69, 0x9f, 0xda, 0x0d, 0x5c,
-	0x3f, 0xa4, 0x5e, 0xed, 0x78, 0x0c, 0x61, 0x69, 0x32, 0x5e, 0x97, 0x7e, 0x1b, 0x16, 0x87, 0x6a,
-	0xc9, 0xc9, 0x14, 0xaa, 0x11, 0x59, 0x9f, 0x42, 0x1d, 0xe8, 0xf4, 0x1b, 0xa1, 0xe8, 0xfd, 0x09,
-	0x30, 0x2f, 0xab, 0xa0, 0x6f, 0x0d, 0x68, 0x29, 0x1b, 0x44, 0x56, 0xd5, 0xc8, 0xff, 0xdb, 0x81,
-	0x4d, 0xd2, 0x38, 0x5e, 0x49, 0xc0, 0xeb, 0x5f, 0xfe, 0xf6, 0xf7, 0x37, 0xe7, 0x2e, 0x21, 0x4c,
-	0x2a, 0xfe, 0x43, 0x94, 0x0b, 0xa3, 0xef, 0x0c, 0x80, 0xb1, 0xfb, 0xa1, 0x6e, 0x7d, 0xad, 0x92,
-	0x5b, 0x9b, 0x56, 0xd3, 0x70, 0x4d, 0xf6, 0xaa, 0x24, 0x7b, 0x19, 0xbd, 0x54, 0x49, 0x26, 0x49,
-	0x7e, 0x34, 0x60, 0xa1, 0x38, 0x01, 0x5d, 0x69, 0x54, 0x28, 0xc7, 0xea, 0x36, 0x8c, 0xd6, 0x54,
-	0x5b, 0x92, 0xaa, 0x8b, 0x2e, 0xd7, 0x52, 0x91, 0xfb, 0x7a, 0x98, 0x1e, 0xa0, 0x5f, 0xcb, 0x7f,
-	0x1b, 0x85, 0xcb, 0xa1, 0x9d, 0x46, 0xa5, 0x4f, 0x3a, 0xaa, 0x79, 0x75, 0xda, 0x34, 0x8d, 0x7e,
-	0x43, 0xa2, 0xbf, 0x81, 0x5e, 0xab, 0x45, 0x77, 0x06, 0xa9, 0xa3, 0x2d, 0x9a, 0xdc, 0x1f, 0xbb,
-	0xf7, 0x03, 0xf4, 0xb3, 0x01, 0xe7, 0x27, 0x8d, 0x12, 0x6d, 0xd6, 0xd2, 0x9c, 0xb4, 0x61, 0xb3,
-	0x37, 0x4d, 0xca, 0x54, 0x7d, 0xcf, 0x52, 0x4a, 0x7d, 0xff, 0x21, 0x9f, 0x8b, 0xcc, 0xf3, 0x1a,
-	0xcc, 0x45, 0xc9, 0x9d, 0x1b, 0xcc, 0x45, 0xd9, 0x48, 0x71, 0x4f, 0xf2, 0x5d, 0x41, 0xeb, 0x55,
-	0x7c, 0x99, 0x87, 0x96, 0xf0, 0x7e, 0xc9, 0xdf, 0x6a, 0xca, 0xbe, 0x86, 0xb6, 0x6b, 0x0b, 0x9f,
-	0xe2, 0xbe, 0xe6, 0xce, 0x94, 0x59, 0x1a, 0xfb, 0x9a, 0xc4, 0xbe, 0x8a, 0xb6, 0xab, 0xb0, 0x95,
-	0x6b, 0x3a, 0x9f, 0xf2, 0xd8, 0xf1, 0xd2, 0xb0, 0x24, 0xe0, 0x27, 0x03, 0x9e, 0x2a, 0x1b, 0x23,
-	0xaa, 0xb7, 0x9f, 0x49, 0xcb, 0x35, 0x37, 0x9a, 0x27, 0x68, 0xe2, 0x1d, 0x49, 0x4c, 0x50, 0xb7,
-	0xb2, 0xd1, 0x2a, 0x69, 0x8c, 0xda, 0x7f, 0xeb, 0xe1, 0x51, 0xc7, 0x78, 0x74, 0xd4, 0x31, 0xfe,
-	0x3a, 0xea, 0x18, 0x5f, 0x1f, 0x77, 0x66, 0x1e, 0x1d, 0x77, 0x66, 0x7e, 0x3f, 0xee, 0xcc, 0x7c,
-	0xbc, 0x51, 0xf2, 0xea, 0x33, 0x8e, 0xbc, 0xbb, 0x45, 0xee, 0xa9, 0x4e, 0x64, 0xce, 0x3d, 0x68,
-	0xc9, 0x77, 0xe0, 0xad, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x65, 0xc4, 0xb3, 0xe4, 0x0a, 0x0c,
-	0x00, 0x00,
+func (m *QueryVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingRequest.Merge(m, src)
+}
+func (m *QueryVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryVestingRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the IRO module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryPlans retrieves all available plans.
-	QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
-	// QueryPlan retrieves the plan for the specified plan ID.
-	QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
-	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
-	QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error)
-	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
-	// The result is the price of 1 IRO token (not iro's base denom)
-	QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	// QueryCost retrieves the expected cost for buying or selling the specified amount of shares.
-	QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error)
-	QueryTokensForDYM(ctx context.Context, in *QueryTokensForDYMRequest, opts ...grpc.CallOption) (*QueryTokensForDYMResponse, error)
-	// QueryClaimed retrieves the claimed amount thus far for the specified plan ID.
-	QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error)
+func (m *QueryVestingRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVestingRequest) GetClaimer() string {
+	if m != nil {
+		return m.Claimer
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryVestingResponse is the response type for the Query/QueryVesting RPC
+// method. The amounts include the IRO tokens of the claimer which are not
+// claimed yet.
+type QueryVestingResponse struct {
+	// The amount of vested tokens, including the claimed ones.
+	Vested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=vested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vested"`
+	// The amount of tokens not vested yet.
+	Unvested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=unvested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unvested"`
+	// The amount of vested tokens released to the claimer so far.
+	Claimed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=claimed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimed"`
+	// The amount of vested tokens which can be claimed now.
+	Claimable github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=claimable,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVestingResponse) Reset()         { *m = QueryVestingResponse{} }
+func (m *QueryVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingResponse) ProtoMessage()    {}
+func (*QueryVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{17}
+}
+func (m *QueryVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingResponse.Merge(m, src)
+}
+func (m *QueryVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
-	out := new(QueryPlansResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlans", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingResponse proto.InternalMessageInfo
+
+// QueryAllowanceRequest is the request type for the Query/QueryAllowance RPC
+// method.
+type QueryAllowanceRequest struct {
+	PlanId  string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The merkle proof of the address, for a merkle root allowlist.
+	AllowlistProof [][]byte `protobuf:"bytes,3,rep,name=allowlist_proof,json=allowlistProof,proto3" json:"allowlist_proof,omitempty"`
+}
+
+func (m *QueryAllowanceRequest) Reset()         { *m = QueryAllowanceRequest{} }
+func (m *QueryAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceRequest) ProtoMessage()    {}
+func (*QueryAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{18}
+}
+func (m *QueryAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceRequest.Merge(m, src)
+}
+func (m *QueryAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
-	out := new(QueryPlanResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryAllowanceRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error) {
-	out := new(QueryPlanByRollappResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllowanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
-	out := new(QuerySpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QuerySpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllowanceRequest) GetAllowlistProof() [][]byte {
+	if m != nil {
+		return m.AllowlistProof
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error) {
-	out := new(QueryCostResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCost", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryAllowanceResponse is the response type for the Query/QueryAllowance
+// RPC method.
+type QueryAllowanceResponse struct {
+	// Whether the plan is in its allowlist phase.
+	AllowlistPhase bool `protobuf:"varint,1,opt,name=allowlist_phase,json=allowlistPhase,proto3" json:"allowlist_phase,omitempty"`
+	// Whether the address is allowlisted. With a merkle root allowlist, it is
+	// known only if the proof of the address is given.
+	Allowlisted bool `protobuf:"varint,2,opt,name=allowlisted,proto3" json:"allowlisted,omitempty"`
+	// The amount of tokens the address bought so far.
+	Purchased github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=purchased,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"purchased"`
+	// The remaining amount of tokens the address can buy, if limited.
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining"`
+	// Whether the amount the address can buy is unlimited.
+	Unlimited bool `protobuf:"varint,5,opt,name=unlimited,proto3" json:"unlimited,omitempty"`
+	// Whether the plan has a merkle root allowlist and no proof was given, so
+	// it is unknown if the address is allowlisted.
+	RequiresProof bool `protobuf:"varint,6,opt,name=requires_proof,json=requiresProof,proto3" json:"requires_proof,omitempty"`
+}
+
+func (m *QueryAllowanceResponse) Reset()         { *m = QueryAllowanceResponse{} }
+func (m *QueryAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowanceResponse) ProtoMessage()    {}
+func (*QueryAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{19}
+}
+func (m *QueryAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowanceResponse.Merge(m, src)
+}
+func (m *QueryAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowanceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryTokensForDYM(ctx context.Context, in *QueryTokensForDYMRequest, opts ...grpc.CallOption) (*QueryTokensForDYMResponse, error) {
-	out := new(QueryTokensForDYMResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTokensForDYM", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryAllowanceResponse) GetAllowlistPhase() bool {
+	if m != nil {
+		return m.AllowlistPhase
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error) {
-	out := new(QueryClaimedResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryClaimed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllowanceResponse) GetAllowlisted() bool {
+	if m != nil {
+		return m.Allowlisted
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the IRO module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryPlans retrieves all available plans.
-	QueryPlans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
-	// QueryPlan retrieves the plan for the specified plan ID.
-	QueryPlan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
-	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
-	QueryPlanByRollapp(context.Context, *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error)
-	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
-	// The result is the price of 1 IRO token (not iro's base denom)
-	QuerySpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	// QueryCost retrieves the expected cost for buying or selling the specified amount of shares.
-	QueryCost(context.Context, *QueryCostRequest) (*QueryCostResponse, error)
-	QueryTokensForDYM(context.Context, *QueryTokensForDYMRequest) (*QueryTokensForDYMResponse, error)
-	// QueryClaimed retrieves the claimed amount thus far for the specified plan ID.
-	QueryClaimed(context.Context, *QueryClaimedRequest) (*QueryClaimedResponse, error)
+func (m *QueryAllowanceResponse) GetUnlimited() bool {
+	if m != nil {
+		return m.Unlimited
+	}
+	return false
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllowanceResponse) GetRequiresProof() bool {
+	if m != nil {
+		return m.RequiresProof
+	}
+	return false
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryTradesRequest is the request type for the Query/QueryTrades RPC method.
+type QueryTradesRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryPlans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPlans not implemented")
+
+func (m *QueryTradesRequest) Reset()         { *m = QueryTradesRequest{} }
+func (m *QueryTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesRequest) ProtoMessage()    {}
+func (*QueryTradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{20}
 }
-func (*UnimplementedQueryServer) QueryPlan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPlan not implemented")
+func (m *QueryTradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryPlanByRollapp(ctx context.Context, req *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPlanByRollapp not implemented")
+func (m *QueryTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QuerySpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySpotPrice not implemented")
+func (m *QueryTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryCost(ctx context.Context, req *QueryCostRequest) (*QueryCostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCost not implemented")
+func (m *QueryTradesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryTokensForDYM(ctx context.Context, req *QueryTokensForDYMRequest) (*QueryTokensForDYMResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryTokensForDYM not implemented")
+func (m *QueryTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryClaimed(ctx context.Context, req *QueryClaimedRequest) (*QueryClaimedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryClaimed not implemented")
+
+var xxx_messageInfo_QueryTradesRequest proto.InternalMessageInfo
+
+func (m *QueryTradesRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryTradesResponse is the response type for the Query/QueryTrades RPC
+// method.
+type QueryTradesResponse struct {
+	// The latest trades, oldest first.
+	Trades []Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryTradesResponse) Reset()         { *m = QueryTradesResponse{} }
+func (m *QueryTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesResponse) ProtoMessage()    {}
+func (*QueryTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{21}
 }
-
-func _Query_QueryPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPlans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPlans(ctx, req.(*QueryPlansRequest))
+func (m *QueryTradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesResponse.Merge(m, src)
+}
+func (m *QueryTradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPlan(ctx, req.(*QueryPlanRequest))
+var xxx_messageInfo_QueryTradesResponse proto.InternalMessageInfo
+
+func (m *QueryTradesResponse) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryPlanByRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanByRollappRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPlanByRollapp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPlanByRollapp(ctx, req.(*QueryPlanByRollappRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryCandlesRequest is the request type for the Query/QueryCandles RPC
+// method.
+type QueryCandlesRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// The interval of the candles, in seconds (e.g 300 for 5 minutes).
+	IntervalSeconds uint64             `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_QuerySpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QuerySpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySpotPrice(ctx, req.(*QuerySpotPriceRequest))
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{22}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCost(ctx, req.(*QueryCostRequest))
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+func (m *QueryCandlesRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryTokensForDYM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokensForDYMRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryTokensForDYM(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTokensForDYM",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryTokensForDYM(ctx, req.(*QueryTokensForDYMRequest))
+func (m *QueryCandlesRequest) GetIntervalSeconds() uint64 {
+	if m != nil {
+		return m.IntervalSeconds
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryClaimed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryClaimed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryClaimed(ctx, req.(*QueryClaimedRequest))
+func (m *QueryCandlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.iro.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryPlans",
-			Handler:    _Query_QueryPlans_Handler,
-		},
-		{
-			MethodName: "QueryPlan",
-			Handler:    _Query_QueryPlan_Handler,
-		},
-		{
-			MethodName: "QueryPlanByRollapp",
-			Handler:    _Query_QueryPlanByRollapp_Handler,
-		},
-		{
-			MethodName: "QuerySpotPrice",
-			Handler:    _Query_QuerySpotPrice_Handler,
-		},
-		{
-			MethodName: "QueryCost",
-			Handler:    _Query_QueryCost_Handler,
-		},
-		{
-			MethodName: "QueryTokensForDYM",
-			Handler:    _Query_QueryTokensForDYM_Handler,
-		},
-		{
-			MethodName: "QueryClaimed",
-			Handler:    _Query_QueryClaimed_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/iro/query.proto",
+// QueryCandlesResponse is the response type for the Query/QueryCandles RPC
+// method.
+type QueryCandlesResponse struct {
+	// The candles, oldest first.
+	Candles    []Candle            `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{23}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+func (m *QueryCandlesResponse) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCandlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.iro.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.iro.QueryParamsResponse")
+	proto.RegisterType((*QueryPlansRequest)(nil), "dymensionxyz.dymension.iro.QueryPlansRequest")
+	proto.RegisterType((*QueryPlansResponse)(nil), "dymensionxyz.dymension.iro.QueryPlansResponse")
+	proto.RegisterType((*QueryPlanRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanRequest")
+	proto.RegisterType((*QueryPlanResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanResponse")
+	proto.RegisterType((*QueryPlanByRollappRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappRequest")
+	proto.RegisterType((*QueryPlanByRollappResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappResponse")
+	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceRequest")
+	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceResponse")
+	proto.RegisterType((*QueryCostRequest)(nil), "dymensionxyz.dymension.iro.QueryCostRequest")
+	proto.RegisterType((*QueryCostResponse)(nil), "dymensionxyz.dymension.iro.QueryCostResponse")
+	proto.RegisterType((*QueryTokensForDYMRequest)(nil), "dymensionxyz.dymension.iro.QueryTokensForDYMRequest")
+	proto.RegisterType((*QueryTokensForDYMResponse)(nil), "dymensionxyz.dymension.iro.QueryTokensForDYMResponse")
+	proto.RegisterType((*QueryClaimedRequest)(nil), "dymensionxyz.dymension.iro.QueryClaimedRequest")
+	proto.RegisterType((*QueryClaimedResponse)(nil), "dymensionxyz.dymension.iro.QueryClaimedResponse")
+	proto.RegisterType((*QueryVestingRequest)(nil), "dymensionxyz.dymension.iro.QueryVestingRequest")
+	proto.RegisterType((*QueryVestingResponse)(nil), "dymensionxyz.dymension.iro.QueryVestingResponse")
+	proto.RegisterType((*QueryAllowanceRequest)(nil), "dymensionxyz.dymension.iro.QueryAllowanceRequest")
+	proto.RegisterType((*QueryAllowanceResponse)(nil), "dymensionxyz.dymension.iro.QueryAllowanceResponse")
+	proto.RegisterType((*QueryTradesRequest)(nil), "dymensionxyz.dymension.iro.QueryTradesRequest")
+	proto.RegisterType((*QueryTradesResponse)(nil), "dymensionxyz.dymension.iro.QueryTradesResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "dymensionxyz.dymension.iro.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "dymensionxyz.dymension.iro.QueryCandlesResponse")
 }
 
-func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/iro/query.proto", fileDescriptor_ae2c72bd0c23c1c0)
 }
 
-func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_ae2c72bd0c23c1c0 = []byte{
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0xdc, 0xd4,
+	0x16, 0xc7, 0xe3, 0x4c, 0x32, 0x6d, 0x4e, 0xfa, 0xd2, 0xf4, 0x36, 0xaf, 0x6f, 0x62, 0xf5, 0x4d,
+	0x53, 0x53, 0xda, 0x34, 0xed, 0xd8, 0x49, 0x9a, 0x16, 0x15, 0x2a, 0xb5, 0x4d, 0xab, 0xd2, 0x94,
+	0x5f, 0xc1, 0xad, 0x8a, 0x60, 0xc1, 0xc8, 0x19, 0xdf, 0x4e, 0xad, 0x7a, 0xee, 0x9d, 0xfa, 0x7a,
+	0x42, 0x87, 0x2a, 0x0b, 0x90, 0xd8, 0x23, 0x21, 0xd8, 0x00, 0x42, 0x08, 0x16, 0x2c, 0x58, 0xb1,
+	0x64, 0xc1, 0x02, 0x21, 0xd4, 0x65, 0x25, 0x36, 0x88, 0x45, 0x85, 0x5a, 0xfe, 0x10, 0xe4, 0x7b,
+	0x8f, 0x3d, 0x76, 0x48, 0xc7, 0x9e, 0x01, 0x56, 0x1d, 0x1f, 0x9f, 0xef, 0xb9, 0x9f, 0x73, 0xee,
+	0xb5, 0xfd, 0x6d, 0xe0, 0xa8, 0xdb, 0x6d, 0x51, 0x26, 0x3c, 0xce, 0xee, 0x75, 0xdf, 0xb5, 0x92,
+	0x0b, 0xcb, 0x0b, 0xb8, 0x75, 0xb7, 0x43, 0x83, 0xae, 0xd9, 0x0e, 0x78, 0xc8, 0x89, 0x9e, 0xce,
+	0x33, 0x93, 0x0b, 0xd3, 0x0b, 0xb8, 0x3e, 0xd3, 0xe4, 0x4d, 0x2e, 0xd3, 0xac, 0xe8, 0x97, 0x52,
+	0xe8, 0xb3, 0x0d, 0x2e, 0x5a, 0x5c, 0xd4, 0xd5, 0x0d, 0x75, 0x81, 0xb7, 0x0e, 0x36, 0x39, 0x6f,
+	0xfa, 0xd4, 0x72, 0xda, 0x9e, 0xe5, 0x30, 0xc6, 0x43, 0x27, 0xf4, 0x38, 0x8b, 0xef, 0x1e, 0xe9,
+	0x83, 0xe4, 0x05, 0x71, 0xf9, 0xaa, 0xaa, 0x68, 0x6d, 0x38, 0x82, 0x5a, 0x9b, 0x4b, 0x1b, 0x34,
+	0x74, 0x96, 0xac, 0x06, 0xf7, 0x18, 0xde, 0x5f, 0x48, 0xdf, 0x97, 0x9d, 0x24, 0x59, 0x6d, 0xa7,
+	0xe9, 0x31, 0xb9, 0xa4, 0xca, 0x35, 0x66, 0x80, 0xbc, 0x1e, 0x65, 0xac, 0x3b, 0x81, 0xd3, 0x12,
+	0x36, 0xbd, 0xdb, 0xa1, 0x22, 0x34, 0xde, 0x80, 0xfd, 0x99, 0xa8, 0x68, 0x73, 0x26, 0x28, 0xb9,
+	0x00, 0xe5, 0xb6, 0x8c, 0x54, 0xb4, 0x39, 0x6d, 0x7e, 0x72, 0xd9, 0x30, 0x9f, 0x3e, 0x1a, 0x53,
+	0x69, 0x57, 0xc7, 0x1e, 0x3c, 0x3a, 0x34, 0x62, 0xa3, 0xce, 0xf8, 0x40, 0x83, 0x7d, 0xaa, 0xb2,
+	0xef, 0xb0, 0x78, 0x39, 0x32, 0x0f, 0xd3, 0x8c, 0xb3, 0xba, 0xa0, 0x61, 0xe8, 0x53, 0xb7, 0xce,
+	0x99, 0xdf, 0x95, 0x2b, 0xec, 0xb6, 0xa7, 0x18, 0x67, 0xd7, 0x55, 0xf8, 0x35, 0xe6, 0x77, 0xc9,
+	0x15, 0x80, 0x5e, 0x0b, 0x95, 0x51, 0x49, 0x71, 0xd4, 0xc4, 0x09, 0x47, 0xfd, 0x9a, 0x6a, 0xe7,
+	0xb0, 0x5f, 0x73, 0xdd, 0x69, 0x52, 0x5c, 0xc5, 0x4e, 0x29, 0x8d, 0x4f, 0x35, 0x20, 0x69, 0x0e,
+	0x6c, 0xf0, 0x1c, 0x8c, 0xb7, 0xa3, 0x40, 0x45, 0x9b, 0x2b, 0xcd, 0x4f, 0x2e, 0xcf, 0xf5, 0xed,
+	0xcf, 0x77, 0x18, 0x76, 0xa7, 0x44, 0xe4, 0xc5, 0x1d, 0xe0, 0x8e, 0xe5, 0xc2, 0xa9, 0xa5, 0x33,
+	0x74, 0x27, 0x60, 0x3a, 0x81, 0x8b, 0x67, 0xf4, 0x3f, 0xd8, 0x15, 0xad, 0x52, 0xf7, 0x5c, 0x39,
+	0x9a, 0x09, 0xbb, 0x1c, 0x5d, 0xae, 0xb9, 0xc6, 0x5a, 0x6a, 0xa2, 0x49, 0x23, 0x2b, 0x30, 0x16,
+	0xdd, 0xc6, 0x7d, 0xca, 0xed, 0xc3, 0x96, 0xd9, 0xc6, 0xf3, 0x30, 0x9b, 0x94, 0x5a, 0xed, 0xda,
+	0xdc, 0xf7, 0x9d, 0x76, 0x3b, 0x06, 0xf8, 0x3f, 0x40, 0xa0, 0x22, 0x3d, 0x86, 0x09, 0x8c, 0xac,
+	0xb9, 0x86, 0x0d, 0xfa, 0x4e, 0xda, 0xbf, 0xc5, 0xb3, 0x08, 0xff, 0x95, 0x35, 0xaf, 0xb7, 0x79,
+	0xb8, 0x1e, 0x78, 0x0d, 0x9a, 0x3b, 0x8c, 0xb7, 0xe1, 0xc0, 0x76, 0x05, 0x12, 0x5c, 0x86, 0xf1,
+	0x76, 0x14, 0x50, 0x82, 0x55, 0x33, 0xda, 0xb8, 0xdf, 0x1e, 0x1d, 0x3a, 0xda, 0xf4, 0xc2, 0xdb,
+	0x9d, 0x0d, 0xb3, 0xc1, 0x5b, 0xf8, 0xa0, 0xe2, 0x3f, 0x35, 0xe1, 0xde, 0xb1, 0xc2, 0x6e, 0x9b,
+	0x0a, 0xf3, 0x32, 0x6d, 0xd8, 0x4a, 0x6c, 0xbc, 0xa7, 0xe1, 0xd6, 0x5c, 0xe2, 0x22, 0xcc, 0xa3,
+	0x21, 0x17, 0xa0, 0xe4, 0xb4, 0xc2, 0xca, 0xe8, 0xc0, 0x2b, 0xae, 0xb1, 0xd0, 0x8e, 0xa4, 0x84,
+	0xc0, 0x98, 0xa0, 0xbe, 0x5f, 0x29, 0xc9, 0xa7, 0x41, 0xfe, 0x36, 0x56, 0x61, 0x5f, 0x0a, 0x01,
+	0xdb, 0xab, 0xc1, 0x58, 0x83, 0x8b, 0x10, 0x07, 0x3c, 0x9b, 0x39, 0x75, 0xf1, 0x79, 0xbb, 0xc4,
+	0x3d, 0x66, 0xcb, 0x34, 0xa3, 0x03, 0x15, 0x59, 0xe3, 0x06, 0xbf, 0x43, 0x99, 0xb8, 0xc2, 0x83,
+	0xcb, 0x6f, 0xbe, 0xf2, 0xef, 0xb7, 0x63, 0xbc, 0x0a, 0xb3, 0x3b, 0x2c, 0x8b, 0x2d, 0x2c, 0x41,
+	0x39, 0x94, 0xf1, 0xfc, 0x26, 0x30, 0xd1, 0x30, 0xf1, 0x3d, 0x75, 0xc9, 0x77, 0xbc, 0x16, 0x75,
+	0x73, 0x8f, 0x47, 0x03, 0x66, 0xb2, 0xf9, 0xb8, 0xf4, 0x4b, 0x30, 0xd9, 0x50, 0xa1, 0x7a, 0xd4,
+	0xa1, 0x3a, 0x22, 0x0b, 0x03, 0x74, 0x07, 0x28, 0xbf, 0xd8, 0x0a, 0x8d, 0xab, 0x08, 0x75, 0x93,
+	0x8a, 0xd0, 0x63, 0xcd, 0xdc, 0xb1, 0x56, 0x60, 0x97, 0x52, 0x07, 0x6a, 0xb4, 0x76, 0x7c, 0x69,
+	0xfc, 0x38, 0x0a, 0x33, 0xd9, 0x52, 0xc8, 0x7b, 0x05, 0xca, 0x9b, 0x54, 0x84, 0xd4, 0x1d, 0xe2,
+	0x34, 0x47, 0xb8, 0xa8, 0x26, 0xd7, 0x60, 0x77, 0x87, 0x61, 0xa5, 0xe1, 0xb6, 0x35, 0xd1, 0x93,
+	0xab, 0x71, 0x1b, 0x6e, 0xa5, 0x34, 0x54, 0xa9, 0x58, 0x4e, 0x5e, 0x86, 0x09, 0xf9, 0xd3, 0xd9,
+	0xf0, 0x69, 0x65, 0x6c, 0xa8, 0x5a, 0xbd, 0x02, 0x86, 0xc0, 0x97, 0xc8, 0x45, 0xdf, 0xe7, 0xef,
+	0x38, 0xac, 0x41, 0x8b, 0x6c, 0x88, 0xe3, 0xba, 0x01, 0x15, 0x22, 0xde, 0x10, 0xbc, 0x24, 0xc7,
+	0x60, 0xaf, 0x13, 0x95, 0xf1, 0x3d, 0x11, 0x46, 0x5f, 0x77, 0x7e, 0xab, 0x52, 0x9a, 0x2b, 0xcd,
+	0xef, 0xb1, 0xa7, 0x92, 0xf0, 0x7a, 0x14, 0x35, 0x7e, 0x1a, 0x85, 0x03, 0xdb, 0x57, 0xc5, 0xbd,
+	0xcb, 0xd6, 0xb8, 0xed, 0x08, 0x1a, 0x7f, 0xeb, 0x7a, 0x35, 0xa2, 0x28, 0x99, 0x83, 0xc9, 0x24,
+	0x82, 0xfb, 0xb3, 0xdb, 0x4e, 0x87, 0xa2, 0x41, 0xb5, 0x3b, 0x41, 0x23, 0xca, 0x1e, 0x76, 0xe8,
+	0xbd, 0x02, 0x51, 0xb5, 0x80, 0xb6, 0x1c, 0x8f, 0x79, 0xac, 0x39, 0xec, 0xd8, 0x93, 0x02, 0xe4,
+	0x20, 0x4c, 0x74, 0x98, 0xef, 0xb5, 0xbc, 0x88, 0x7d, 0x5c, 0xb2, 0xf7, 0x02, 0xe4, 0x59, 0x98,
+	0x0a, 0xe8, 0xdd, 0x8e, 0x17, 0x50, 0x81, 0x73, 0x2c, 0xcb, 0x94, 0xff, 0xc4, 0x51, 0x35, 0xc6,
+	0x1a, 0x7e, 0xa5, 0x6f, 0x04, 0x8e, 0x4b, 0x45, 0xee, 0xe3, 0x7d, 0x13, 0xf6, 0x67, 0xd2, 0x71,
+	0xe2, 0xe7, 0xa1, 0x1c, 0xca, 0x08, 0x7e, 0xd6, 0x0f, 0xf7, 0xfb, 0xfc, 0x48, 0x6d, 0xec, 0x5a,
+	0x94, 0xcc, 0xf8, 0x52, 0x8b, 0xdf, 0x33, 0x0e, 0x73, 0xfd, 0x7c, 0x10, 0x72, 0x1c, 0xa6, 0x3d,
+	0x16, 0xd2, 0x60, 0xd3, 0xf1, 0xeb, 0x82, 0x36, 0x38, 0x73, 0xd5, 0x51, 0x1a, 0xb3, 0xf7, 0xc6,
+	0xf1, 0xeb, 0x2a, 0xbc, 0xcd, 0xd1, 0x94, 0x86, 0x76, 0x34, 0x5f, 0x69, 0x30, 0x93, 0x65, 0xc4,
+	0xee, 0x57, 0x61, 0x57, 0x43, 0x85, 0xb0, 0xfd, 0xbe, 0xae, 0x4d, 0xa9, 0xb1, 0xff, 0x58, 0xf8,
+	0x8f, 0x39, 0x9b, 0xe5, 0x2f, 0xa6, 0x61, 0x5c, 0x52, 0x92, 0x8f, 0x35, 0x28, 0x2b, 0x8b, 0x48,
+	0xcc, 0x7e, 0x40, 0x7f, 0x75, 0xa7, 0xba, 0x55, 0x38, 0x5f, 0x11, 0x18, 0x0b, 0xef, 0xff, 0xf2,
+	0xc7, 0x47, 0xa3, 0x47, 0x88, 0x61, 0xf5, 0xf1, 0xd7, 0xca, 0xa1, 0x92, 0x4f, 0x34, 0x80, 0x9e,
+	0x33, 0x24, 0xb5, 0xfc, 0xb5, 0x52, 0x4e, 0x56, 0x37, 0x8b, 0xa6, 0x23, 0xd9, 0x71, 0x49, 0xf6,
+	0x0c, 0x39, 0xdc, 0x97, 0x4c, 0x92, 0x7c, 0xae, 0xc1, 0x44, 0x52, 0x81, 0x9c, 0x2c, 0xb4, 0x50,
+	0x8c, 0x55, 0x2b, 0x98, 0x8d, 0x54, 0xa7, 0x24, 0x55, 0x8d, 0x9c, 0xc8, 0xa5, 0xb2, 0xee, 0xe3,
+	0x03, 0xb0, 0x45, 0x7e, 0x4e, 0x5b, 0xea, 0xc4, 0x01, 0x92, 0xd3, 0x85, 0x96, 0xde, 0xee, 0x36,
+	0xf5, 0x33, 0x83, 0xca, 0x10, 0xfd, 0xa2, 0x44, 0x7f, 0x81, 0x9c, 0xcd, 0x45, 0xaf, 0x6f, 0x74,
+	0xeb, 0x68, 0x5f, 0xad, 0xfb, 0x3d, 0x67, 0xbb, 0x45, 0xbe, 0xd5, 0x60, 0x2a, 0x6b, 0x22, 0xc9,
+	0x52, 0x2e, 0xcd, 0x76, 0x8b, 0xaa, 0x2f, 0x0f, 0x22, 0x19, 0x68, 0xee, 0x91, 0x24, 0x35, 0xf7,
+	0xcf, 0xe2, 0x73, 0x11, 0xf9, 0xc1, 0x02, 0xe7, 0x22, 0xe5, 0x5c, 0xf5, 0x5a, 0xc1, 0x6c, 0xe4,
+	0x5b, 0x96, 0x7c, 0x27, 0xc9, 0x42, 0x3f, 0xbe, 0xc8, 0x5f, 0xa6, 0xf0, 0x7e, 0x88, 0xff, 0xc7,
+	0x97, 0xf6, 0x7c, 0x64, 0x25, 0x77, 0xe1, 0x1d, 0x9c, 0xa9, 0x7e, 0x7a, 0x40, 0x15, 0x62, 0x9f,
+	0x93, 0xd8, 0x67, 0xc8, 0x4a, 0x3f, 0x6c, 0xe5, 0x28, 0xeb, 0xb7, 0x78, 0x50, 0x77, 0xbb, 0xad,
+	0x54, 0x03, 0xdf, 0x68, 0xb0, 0x27, 0x6d, 0x1a, 0x49, 0xfe, 0xeb, 0x27, 0x6b, 0x47, 0xf5, 0xc5,
+	0xe2, 0x02, 0x24, 0x3e, 0x2d, 0x89, 0x2d, 0x52, 0xeb, 0x3b, 0x68, 0x25, 0x4a, 0xa1, 0x7e, 0x17,
+	0xa3, 0xa2, 0x5f, 0x2c, 0x80, 0x9a, 0x35, 0xa9, 0xfa, 0x62, 0x71, 0x01, 0xa2, 0x9e, 0x97, 0xa8,
+	0x67, 0xc9, 0x73, 0xfd, 0x50, 0x37, 0x95, 0xa8, 0x87, 0x6a, 0xdd, 0x47, 0x8f, 0xbb, 0x45, 0xbe,
+	0x8f, 0x1f, 0xb7, 0xc4, 0x2a, 0x15, 0x78, 0xdc, 0xb6, 0x9b, 0x39, 0x7d, 0x79, 0x10, 0xc9, 0x20,
+	0xef, 0x0a, 0x27, 0x96, 0xa5, 0xe1, 0xd1, 0x0f, 0x6e, 0x91, 0xaf, 0x35, 0x98, 0x4c, 0x59, 0x8e,
+	0x02, 0x9f, 0xb2, 0x8c, 0x95, 0xd1, 0xad, 0xc2, 0xf9, 0xc8, 0xbc, 0x22, 0x99, 0x4d, 0x72, 0xb2,
+	0xef, 0x59, 0x96, 0x9a, 0x1d, 0xcf, 0x30, 0x7e, 0xd0, 0x0b, 0x9c, 0xe1, 0x8c, 0xd5, 0xd1, 0x17,
+	0x8b, 0x0b, 0x06, 0x3a, 0xc3, 0x4a, 0xd4, 0x43, 0x5d, 0xbd, 0xf6, 0xe0, 0x71, 0x55, 0x7b, 0xf8,
+	0xb8, 0xaa, 0xfd, 0xfe, 0xb8, 0xaa, 0x7d, 0xf8, 0xa4, 0x3a, 0xf2, 0xf0, 0x49, 0x75, 0xe4, 0xd7,
+	0x27, 0xd5, 0x91, 0xb7, 0x16, 0x53, 0x26, 0xf4, 0x29, 0x25, 0x37, 0x4f, 0x59, 0xf7, 0xd4, 0x04,
+	0xba, 0x6d, 0x2a, 0x36, 0xca, 0xf2, 0x6f, 0x5c, 0xa7, 0xfe, 0x1c, 0x00, 0x87, 0xa2, 0x9f, 0x1e,
+	0xea, 0x13, 0x00, 0x00,
 }
 
-func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.NonSettledOnly {
-		i--
-		if m.NonSettledOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the IRO module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryPlans retrieves all available plans.
+	QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
+	// QueryPlan retrieves the plan for the specified plan ID.
+	QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
+	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
+	QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error)
+	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
+	// The result is the price of 1 IRO token (not iro's base denom)
+	QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// QueryCost retrieves the expected cost for buying or selling the specified amount of shares.
+	QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error)
+	QueryTokensForDYM(ctx context.Context, in *QueryTokensForDYMRequest, opts ...grpc.CallOption) (*QueryTokensForDYMResponse, error)
+	// QueryClaimed retrieves the claimed amount thus far for the specified plan ID.
+	QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error)
+	// QueryVesting retrieves the vested and unvested amounts of the claimer in
+	// the specified plan ID.
+	QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error)
+	// QueryAllowance retrieves the remaining amount of tokens the address can
+	// buy from the specified plan ID.
+	QueryAllowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
+	// QueryTrades retrieves the latest trades of the specified plan ID.
+	QueryTrades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error)
+	// QueryCandles retrieves the OHLCV candles of the specified plan ID over
+	// one of the fixed candle intervals.
+	QueryCandles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
 }
 
-func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
+	out := new(QueryPlansResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
+	out := new(QueryPlanResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Plans) > 0 {
-		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error) {
+	out := new(QueryPlanByRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
+	out := new(QuerySpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QuerySpotPrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error) {
+	out := new(QueryCostResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryTokensForDYM(ctx context.Context, in *QueryTokensForDYMRequest, opts ...grpc.CallOption) (*QueryTokensForDYMResponse, error) {
+	out := new(QueryTokensForDYMResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTokensForDYM", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error) {
+	out := new(QueryClaimedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryClaimed", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error) {
+	out := new(QueryVestingResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryAllowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error) {
+	out := new(QueryAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryTrades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error) {
+	out := new(QueryTradesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTrades", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPlanByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryCandles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPlanByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the IRO module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryPlans retrieves all available plans.
+	QueryPlans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
+	// QueryPlan retrieves the plan for the specified plan ID.
+	QueryPlan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
+	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
+	QueryPlanByRollapp(context.Context, *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error)
+	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
+	// The result is the price of 1 IRO token (not iro's base denom)
+	QuerySpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// QueryCost retrieves the expected cost for buying or selling the specified amount of shares.
+	QueryCost(context.Context, *QueryCostRequest) (*QueryCostResponse, error)
+	QueryTokensForDYM(context.Context, *QueryTokensForDYMRequest) (*QueryTokensForDYMResponse, error)
+	// QueryClaimed retrieves the claimed amount thus far for the specified plan ID.
+	QueryClaimed(context.Context, *QueryClaimedRequest) (*QueryClaimedResponse, error)
+	// QueryVesting retrieves the vested and unvested amounts of the claimer in
+	// the specified plan ID.
+	QueryVesting(context.Context, *QueryVestingRequest) (*QueryVestingResponse, error)
+	// QueryAllowance retrieves the remaining amount of tokens the address can
+	// buy from the specified plan ID.
+	QueryAllowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
+	// QueryTrades retrieves the latest trades of the specified plan ID.
+	QueryTrades(context.Context, *QueryTradesRequest) (*QueryTradesResponse, error)
+	// QueryCandles retrieves the OHLCV candles of the specified plan ID over
+	// one of the fixed candle intervals.
+	QueryCandles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
 }
 
-func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPlanByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryPlanByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QueryPlans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPlans not implemented")
 }
-
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryPlan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPlan not implemented")
 }
-
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) QueryPlanByRollapp(ctx context.Context, req *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPlanByRollapp not implemented")
 }
-
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) QuerySpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySpotPrice not implemented")
 }
-
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) QueryCost(ctx context.Context, req *QueryCostRequest) (*QueryCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCost not implemented")
+}
+func (*UnimplementedQueryServer) QueryTokensForDYM(ctx context.Context, req *QueryTokensForDYMRequest) (*QueryTokensForDYMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTokensForDYM not implemented")
+}
+func (*UnimplementedQueryServer) QueryClaimed(ctx context.Context, req *QueryClaimedRequest) (*QueryClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryClaimed not implemented")
+}
+func (*UnimplementedQueryServer) QueryVesting(ctx context.Context, req *QueryVestingRequest) (*QueryVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVesting not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllowance(ctx context.Context, req *QueryAllowanceRequest) (*QueryAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllowance not implemented")
+}
+func (*UnimplementedQueryServer) QueryTrades(ctx context.Context, req *QueryTradesRequest) (*QueryTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTrades not implemented")
+}
+func (*UnimplementedQueryServer) QueryCandles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCandles not implemented")
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlansRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPlans(ctx, req.(*QueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPlan(ctx, req.(*QueryPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sell {
-		i--
-		if m.Sell {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func _Query_QueryPlanByRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanByRollappRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Amt.Size()
-		i -= size
-		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPlanByRollapp(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPlanByRollapp(ctx, req.(*QueryPlanByRollappRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QuerySpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySpotPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QuerySpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySpotPrice(ctx, req.(*QuerySpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func _Query_QueryCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCost(ctx, req.(*QueryCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Cost != nil {
-		{
-			size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryTokensForDYM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokensForDYMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTokensForDYM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTokensForDYM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTokensForDYM(ctx, req.(*QueryTokensForDYMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTokensForDYMRequest) Marshal() (dAtA []byte, err error) {
+func _Query_QueryClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryClaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryClaimed(ctx, req.(*QueryClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVesting(ctx, req.(*QueryVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllowance(ctx, req.(*QueryAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTrades(ctx, req.(*QueryTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCandles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.iro.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryPlans",
+			Handler:    _Query_QueryPlans_Handler,
+		},
+		{
+			MethodName: "QueryPlan",
+			Handler:    _Query_QueryPlan_Handler,
+		},
+		{
+			MethodName: "QueryPlanByRollapp",
+			Handler:    _Query_QueryPlanByRollapp_Handler,
+		},
+		{
+			MethodName: "QuerySpotPrice",
+			Handler:    _Query_QuerySpotPrice_Handler,
+		},
+		{
+			MethodName: "QueryCost",
+			Handler:    _Query_QueryCost_Handler,
+		},
+		{
+			MethodName: "QueryTokensForDYM",
+			Handler:    _Query_QueryTokensForDYM_Handler,
+		},
+		{
+			MethodName: "QueryClaimed",
+			Handler:    _Query_QueryClaimed_Handler,
+		},
+		{
+			MethodName: "QueryVesting",
+			Handler:    _Query_QueryVesting_Handler,
+		},
+		{
+			MethodName: "QueryAllowance",
+			Handler:    _Query_QueryAllowance_Handler,
+		},
+		{
+			MethodName: "QueryTrades",
+			Handler:    _Query_QueryTrades_Handler,
+		},
+		{
+			MethodName: "QueryCandles",
+			Handler:    _Query_QueryCandles_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/iro/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1636,37 +1855,20 @@ func (m *QueryTokensForDYMRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForDYMRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensForDYMRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amt.Size()
-		i -= size
-		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForDYMResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1676,32 +1878,30 @@ func (m *QueryTokensForDYMResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForDYMResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensForDYMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tokens != nil {
-		{
-			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1711,27 +1911,42 @@ func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.NonSettledOnly {
+		i--
+		if m.NonSettledOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1741,293 +1956,2213 @@ func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ClaimedAmt != nil {
+	if m.Pagination != nil {
 		{
-			size := m.ClaimedAmt.Size()
-			i -= size
-			if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NonSettledOnly {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanByRollappRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanByRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Amt.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Sell {
-		n += 2
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCostResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cost != nil {
-		l = m.Cost.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForDYMRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Sell {
+		i--
+		if m.Sell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.Amt.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForDYMResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Tokens != nil {
-		l = m.Tokens.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Cost != nil {
+		{
+			size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTokensForDYMRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensForDYMRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensForDYMRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTokensForDYMResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensForDYMResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensForDYMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClaimedAmt != nil {
-		l = m.ClaimedAmt.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Tokens != nil {
+		{
+			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimedAmt != nil {
+		{
+			size := m.ClaimedAmt.Size()
+			i -= size
+			if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimer) > 0 {
+		i -= len(m.Claimer)
+		copy(dAtA[i:], m.Claimer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claimer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimable.Size()
+		i -= size
+		if _, err := m.Claimable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Unvested.Size()
+		i -= size
+		if _, err := m.Unvested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowlistProof) > 0 {
+		for iNdEx := len(m.AllowlistProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistProof[iNdEx])
+			copy(dAtA[i:], m.AllowlistProof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowlistProof[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiresProof {
+		i--
+		if m.RequiresProof {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Unlimited {
+		i--
+		if m.Unlimited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Purchased.Size()
+		i -= size
+		if _, err := m.Purchased.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Allowlisted {
+		i--
+		if m.Allowlisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.AllowlistPhase {
+		i--
+		if m.AllowlistPhase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NonSettledOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanByRollappRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanByRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Sell {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cost != nil {
+		l = m.Cost.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokensForDYMRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokensForDYMResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tokens != nil {
+		l = m.Tokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedAmt != nil {
+		l = m.ClaimedAmt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Claimer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unvested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllowlistProof) > 0 {
+		for _, b := range m.AllowlistProof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowlistPhase {
+		n += 2
+	}
+	if m.Allowlisted {
+		n += 2
+	}
+	l = m.Purchased.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unlimited {
+		n += 2
+	}
+	if m.RequiresProof {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.IntervalSeconds))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonSettledOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonSettledOnly = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanByRollappRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanByRollappRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanByRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanByRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sell = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2049,7 +4184,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2072,15 +4207,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2107,7 +4242,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cost == nil {
+				m.Cost = &types.Coin{}
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2132,7 +4270,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTokensForDYMRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2155,17 +4293,17 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokensForDYMRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokensForDYMRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonSettledOnly", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2175,17 +4313,29 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NonSettledOnly = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2195,25 +4345,23 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2238,7 +4386,7 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokensForDYMResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2261,15 +4409,15 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokensForDYMResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokensForDYMResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2296,16 +4444,68 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plans = append(m.Plans, Plan{})
-			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tokens == nil {
+				m.Tokens = &types.Coin{}
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2315,27 +4515,23 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2358,7 +4554,7 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2381,15 +4577,15 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2417,7 +4613,11 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ClaimedAmt = &v
+			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2440,7 +4640,7 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2463,17 +4663,49 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2483,27 +4715,23 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &Plan{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Claimer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2526,7 +4754,7 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2549,15 +4777,15 @@ func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanByRollappRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanByRollappRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2585,63 +4813,83 @@ func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanByRollappResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanByRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2651,25 +4899,23 @@ func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &Plan{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2694,7 +4940,7 @@ func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2717,10 +4963,10 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2755,59 +5001,9 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2835,9 +5031,39 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistProof = append(m.AllowlistProof, make([]byte, postIndex-iNdEx))
+			copy(m.AllowlistProof[len(m.AllowlistProof)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2860,7 +5086,7 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,19 +5105,59 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCostRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistPhase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowlistPhase = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowlisted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchased", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2919,11 +5185,13 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			if err := m.Purchased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2951,13 +5219,13 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlimited", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2974,62 +5242,12 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Sell = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCostResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			m.Unlimited = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiresProof", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3039,28 +5257,12 @@ func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cost == nil {
-				m.Cost = &types.Coin{}
-			}
-			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.RequiresProof = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3082,7 +5284,7 @@ func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensForDYMRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTradesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3105,10 +5307,10 @@ func (m *QueryTokensForDYMRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensForDYMRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensForDYMRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3143,40 +5345,6 @@ func (m *QueryTokensForDYMRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3198,7 +5366,7 @@ func (m *QueryTokensForDYMRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensForDYMResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTradesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3221,15 +5389,15 @@ func (m *QueryTokensForDYMResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensForDYMResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensForDYMResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3256,10 +5424,8 @@ func (m *QueryTokensForDYMResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tokens == nil {
-				m.Tokens = &types.Coin{}
-			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3284,7 +5450,7 @@ func (m *QueryTokensForDYMResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3307,10 +5473,10 @@ func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3345,6 +5511,61 @@ func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3366,7 +5587,7 @@ func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3389,17 +5610,17 @@ func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3409,25 +5630,59 @@ func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ClaimedAmt = &v
-			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTradesPerPlan is the number of the latest trades kept for each plan
+const MaxTradesPerPlan uint64 = 100
+
+// CandleInterval is a fixed interval of the plan candles
+type CandleInterval struct {
+	Interval time.Duration
+	// Retention is the time the candles are kept for. While trading, the candles which ended before the retention
+	// are pruned, so the plan has at most Retention/Interval+1 candles of the interval. Once the plan is settled or cancelled,
+	// all the candles of the interval are pruned after the retention.
+	Retention time.Duration
+}
+
+// CandleIntervals are the intervals the trades are rolled up into candles at
+var CandleIntervals = []CandleInterval{
+	{Interval: 5 * time.Minute, Retention: 24 * time.Hour},
+	{Interval: time.Hour, Retention: 30 * 24 * time.Hour},
+	{Interval: 24 * time.Hour, Retention: 365 * 24 * time.Hour},
+}
+
+// IsCandleInterval returns true if the interval is one of the fixed candle intervals
+func IsCandleInterval(interval time.Duration) bool {
+	for _, i := range CandleIntervals {
+		if i.Interval == interval {
+			return true
+		}
+	}
+	return false
+}
+
+// CandleStartTime returns the start time of the candle of the interval containing t
+func CandleStartTime(t time.Time, interval time.Duration) time.Time {
+	return t.UTC().Truncate(interval)
+}
+
+func NewTrade(planId string, seq uint64, trader sdk.AccAddress, buy bool, amount, liquidity math.Int, price math.LegacyDec, t time.Time, height int64) Trade {
+	return Trade{
+		PlanId:    planId,
+		Seq:       seq,
+		Trader:    trader.String(),
+		Buy:       buy,
+		Amount:    amount,
+		Liquidity: liquidity,
+		Price:     price,
+		Time:      t,
+		Height:    height,
+	}
+}
+
+func (t Trade) ValidateBasic() error {
+	if t.PlanId == "" {
+		return fmt.Errorf("plan id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Trader); err != nil {
+		return fmt.Errorf("invalid trader address: %w", err)
+	}
+	if t.Amount.IsNil() || !t.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", t.Amount)
+	}
+	if t.Liquidity.IsNil() || t.Liquidity.IsNegative() {
+		return fmt.Errorf("liquidity must be non-negative: %s", t.Liquidity)
+	}
+	if t.Price.IsNil() || t.Price.IsNegative() {
+		return fmt.Errorf("price must be non-negative: %s", t.Price)
+	}
+	return nil
+}
+
+// NewCandle returns a candle of the interval containing the time of the trade, with the trade only
+func NewCandle(trade Trade, interval time.Duration) Candle {
+	return Candle{
+		PlanId:          trade.PlanId,
+		Interval:        interval,
+		StartTime:       CandleStartTime(trade.Time, interval),
+		Open:            trade.Price,
+		High:            trade.Price,
+		Low:             trade.Price,
+		Close:           trade.Price,
+		Volume:          trade.Amount,
+		LiquidityVolume: trade.Liquidity,
+		NumTrades:       1,
+	}
+}
+
+// AddTrade rolls up the trade into the candle
+func (c *Candle) AddTrade(trade Trade) {
+	c.High = math.LegacyMaxDec(c.High, trade.Price)
+	c.Low = math.LegacyMinDec(c.Low, trade.Price)
+	c.Close = trade.Price
+	c.Volume = c.Volume.Add(trade.Amount)
+	c.LiquidityVolume = c.LiquidityVolume.Add(trade.Liquidity)
+	c.NumTrades++
+}
+
+// EndTime returns the end time of the candle, exclusive
+func (c Candle) EndTime() time.Time {
+	return c.StartTime.Add(c.Interval)
+}
+
+func (c Candle) ValidateBasic() error {
+	if c.PlanId == "" {
+		return fmt.Errorf("plan id cannot be empty")
+	}
+	if !IsCandleInterval(c.Interval) {
+		return fmt.Errorf("invalid candle interval: %s", c.Interval)
+	}
+	if !c.StartTime.Equal(CandleStartTime(c.StartTime, c.Interval)) {
+		return fmt.Errorf("candle start time is not aligned to the interval: %s: %s", c.StartTime, c.Interval)
+	}
+	for _, p := range []math.LegacyDec{c.Open, c.High, c.Low, c.Close} {
+		if p.IsNil() || p.IsNegative() {
+			return fmt.Errorf("candle prices must be non-negative: %s", p)
+		}
+	}
+	if c.Low.GT(c.High) || c.Open.GT(c.High) || c.Open.LT(c.Low) || c.Close.GT(c.High) || c.Close.LT(c.Low) {
+		return fmt.Errorf("candle prices must be within the low and high: open: %s, high: %s, low: %s, close: %s", c.Open, c.High, c.Low, c.Close)
+	}
+	if c.Volume.IsNil() || c.Volume.IsNegative() {
+		return fmt.Errorf("candle volume must be non-negative: %s", c.Volume)
+	}
+	if c.LiquidityVolume.IsNil() || c.LiquidityVolume.IsNegative() {
+		return fmt.Errorf("candle liquidity volume must be non-negative: %s", c.LiquidityVolume)
+	}
+	if c.NumTrades == 0 {
+		return fmt.Errorf("candle must have at least one trade")
+	}
+	return nil
+}
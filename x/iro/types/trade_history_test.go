@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestCandle_AddTrade(t *testing.T) {
+	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	trader := sample.Acc()
+	newTrade := func(seq uint64, price string, at time.Time) types.Trade {
+		return types.NewTrade("1", seq, trader, true, math.NewInt(10), math.NewInt(5), math.LegacyMustNewDecFromStr(price), at, 1)
+	}
+
+	candle := types.NewCandle(newTrade(0, "1.5", start.Add(7*time.Minute)), 5*time.Minute)
+	require.True(t, start.Add(5*time.Minute).Equal(candle.StartTime))
+	require.NoError(t, candle.ValidateBasic())
+
+	candle.AddTrade(newTrade(1, "2", start.Add(8*time.Minute)))
+	candle.AddTrade(newTrade(2, "1", start.Add(9*time.Minute)))
+	candle.AddTrade(newTrade(3, "1.2", start.Add(9*time.Minute)))
+	require.NoError(t, candle.ValidateBasic())
+
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.5"), candle.Open)
+	require.Equal(t, math.LegacyMustNewDecFromStr("2"), candle.High)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1"), candle.Low)
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.2"), candle.Close)
+	require.Equal(t, math.NewInt(40), candle.Volume)
+	require.Equal(t, math.NewInt(20), candle.LiquidityVolume)
+	require.Equal(t, uint64(4), candle.NumTrades)
+	require.True(t, start.Add(10*time.Minute).Equal(candle.EndTime()))
+
+	// not one of the fixed intervals
+	candle.Interval = time.Minute
+	require.Error(t, candle.ValidateBasic())
+}
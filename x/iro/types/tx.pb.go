@@ -144,6 +144,17 @@ type MsgCreatePlan struct {
 	IroPlanDuration time.Duration `protobuf:"bytes,6,opt,name=iro_plan_duration,json=iroPlanDuration,proto3,stdduration" json:"iro_plan_duration"`
 	// The incentive plan parameters for the tokens left after the plan is settled.
 	IncentivePlanParams IncentivePlanParams `protobuf:"bytes,7,opt,name=incentive_plan_params,json=incentivePlanParams,proto3" json:"incentive_plan_params"`
+	// The denom to raise liquidity in. Must be whitelisted in the module params.
+	// Defaults to DYM if empty.
+	LiquidityDenom string `protobuf:"bytes,8,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
+	// The vesting schedule of the claims after settlement. The start time is
+	// set on settlement. If empty, the tokens are released on claim.
+	VestingPlan VestingPlan `protobuf:"bytes,9,opt,name=vesting_plan,json=vestingPlan,proto3" json:"vesting_plan"`
+	// The allowlist phase at the start of the plan. If empty, anyone can buy.
+	AllowlistPhase AllowlistPhase `protobuf:"bytes,10,opt,name=allowlist_phase,json=allowlistPhase,proto3" json:"allowlist_phase"`
+	// The maximum amount of tokens an address can buy after the allowlist phase.
+	// Zero for no limit.
+	MaxAmountPerAddress github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_amount_per_address,json=maxAmountPerAddress,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_per_address"`
 }
 
 func (m *MsgCreatePlan) Reset()         { *m = MsgCreatePlan{} }
@@ -221,6 +232,27 @@ func (m *MsgCreatePlan) GetIncentivePlanParams() IncentivePlanParams {
 	return IncentivePlanParams{}
 }
 
+func (m *MsgCreatePlan) GetLiquidityDenom() string {
+	if m != nil {
+		return m.LiquidityDenom
+	}
+	return ""
+}
+
+func (m *MsgCreatePlan) GetVestingPlan() VestingPlan {
+	if m != nil {
+		return m.VestingPlan
+	}
+	return VestingPlan{}
+}
+
+func (m *MsgCreatePlan) GetAllowlistPhase() AllowlistPhase {
+	if m != nil {
+		return m.AllowlistPhase
+	}
+	return AllowlistPhase{}
+}
+
 type MsgCreatePlanResponse struct {
 	// The ID of the plan.
 	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -275,6 +307,9 @@ type MsgBuy struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 	// The maximum cost this buy action can incur.
 	MaxCostAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_cost_amount,json=maxCostAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_cost_amount"`
+	// The merkle proof of the buyer in the allowlist, required during the
+	// allowlist phase of a plan with a merkle root.
+	AllowlistProof [][]byte `protobuf:"bytes,5,rep,name=allowlist_proof,json=allowlistProof,proto3" json:"allowlist_proof,omitempty"`
 }
 
 func (m *MsgBuy) Reset()         { *m = MsgBuy{} }
@@ -324,6 +359,13 @@ func (m *MsgBuy) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuy) GetAllowlistProof() [][]byte {
+	if m != nil {
+		return m.AllowlistProof
+	}
+	return nil
+}
+
 type MsgBuyExactSpend struct {
 	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	// The ID of the plan.
@@ -332,6 +374,9 @@ type MsgBuyExactSpend struct {
 	Spend github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=spend,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"spend"`
 	// The minimum tokens this buy action can provide.
 	MinOutTokensAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_out_tokens_amount,json=minOutTokensAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_out_tokens_amount"`
+	// The merkle proof of the buyer in the allowlist, required during the
+	// allowlist phase of a plan with a merkle root.
+	AllowlistProof [][]byte `protobuf:"bytes,5,rep,name=allowlist_proof,json=allowlistProof,proto3" json:"allowlist_proof,omitempty"`
 }
 
 func (m *MsgBuyExactSpend) Reset()         { *m = MsgBuyExactSpend{} }
@@ -381,6 +426,13 @@ func (m *MsgBuyExactSpend) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuyExactSpend) GetAllowlistProof() [][]byte {
+	if m != nil {
+		return m.AllowlistProof
+	}
+	return nil
+}
+
 type MsgBuyResponse struct {
 }
 
@@ -601,6 +653,98 @@ func (m *MsgClaimResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
 
+// MsgRefund defines the message for refunding the IRO tokens of a cancelled
+// plan. The refunded liquidity includes a share of the creation fee paid by
+// the rollapp owner.
+type MsgRefund struct {
+	Refunder string `protobuf:"bytes,1,opt,name=refunder,proto3" json:"refunder,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgRefund) Reset()         { *m = MsgRefund{} }
+func (m *MsgRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgRefund) ProtoMessage()    {}
+func (*MsgRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{11}
+}
+func (m *MsgRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefund.Merge(m, src)
+}
+func (m *MsgRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefund proto.InternalMessageInfo
+
+func (m *MsgRefund) GetRefunder() string {
+	if m != nil {
+		return m.Refunder
+	}
+	return ""
+}
+
+func (m *MsgRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+type MsgRefundResponse struct {
+}
+
+func (m *MsgRefundResponse) Reset()         { *m = MsgRefundResponse{} }
+func (m *MsgRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundResponse) ProtoMessage()    {}
+func (*MsgRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{12}
+}
+func (m *MsgRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundResponse.Merge(m, src)
+}
+func (m *MsgRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.iro.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.iro.MsgUpdateParamsResponse")
@@ -613,6 +757,8 @@ func init() {
 	proto.RegisterType((*MsgSellResponse)(nil), "dymensionxyz.dymension.iro.MsgSellResponse")
 	proto.RegisterType((*MsgClaim)(nil), "dymensionxyz.dymension.iro.MsgClaim")
 	proto.RegisterType((*MsgClaimResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimResponse")
+	proto.RegisterType((*MsgRefund)(nil), "dymensionxyz.dymension.iro.MsgRefund")
+	proto.RegisterType((*MsgRefundResponse)(nil), "dymensionxyz.dymension.iro.MsgRefundResponse")
 }
 
 func init() {
@@ -620,66 +766,77 @@ func init() {
 }
 
 var fileDescriptor_41b9ae3e091bbd60 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0xd3, 0xcd, 0xa6, 0xfb, 0x9a, 0x34, 0xc9, 0xd0, 0x28, 0x1b, 0x4b, 0x6c, 0x2a, 0xb7,
-	0x42, 0x21, 0x6d, 0xed, 0xfc, 0x90, 0x38, 0xe4, 0xd6, 0x4d, 0x01, 0x2d, 0xd2, 0xaa, 0x68, 0xd3,
-	0x22, 0x28, 0x07, 0x6b, 0xd6, 0x1e, 0xdc, 0xa1, 0xf6, 0x8c, 0xe5, 0x19, 0x6f, 0x76, 0x39, 0x21,
-	0x24, 0xee, 0x39, 0x22, 0x71, 0xe3, 0x2f, 0xe8, 0x81, 0x3f, 0xa2, 0xc7, 0x8a, 0x13, 0xe2, 0x50,
-	0x50, 0x72, 0xe8, 0x5f, 0x81, 0x84, 0x66, 0xc6, 0xde, 0x6c, 0x52, 0x92, 0xdd, 0x36, 0x70, 0xb2,
-	0xc7, 0xef, 0x7b, 0xdf, 0x7b, 0xf3, 0xcd, 0x37, 0x33, 0x86, 0x5b, 0xe1, 0x20, 0x21, 0x4c, 0x50,
-	0xce, 0xfa, 0x83, 0xef, 0xbc, 0xe1, 0xc0, 0xa3, 0x19, 0xf7, 0x64, 0xdf, 0x4d, 0x33, 0x2e, 0x39,
-	0xb2, 0x47, 0x41, 0xee, 0x70, 0xe0, 0xd2, 0x8c, 0xdb, 0x37, 0x22, 0x1e, 0x71, 0x0d, 0xf3, 0xd4,
-	0x9b, 0xc9, 0xb0, 0x57, 0x03, 0x2e, 0x12, 0x2e, 0x7c, 0x13, 0x30, 0x83, 0x22, 0xb4, 0x62, 0x46,
-	0x5e, 0x22, 0x22, 0xaf, 0xb7, 0xa5, 0x1e, 0x45, 0xe0, 0xf6, 0x05, 0xad, 0xd0, 0xac, 0x64, 0x6e,
-	0x44, 0x9c, 0x47, 0x31, 0xf1, 0xf4, 0xa8, 0x9b, 0x7f, 0xe3, 0x85, 0x79, 0x86, 0xa5, 0xea, 0xc6,
-	0xc4, 0xd7, 0xce, 0xc6, 0x25, 0x4d, 0x88, 0x90, 0x38, 0x49, 0x4b, 0x82, 0xa2, 0x7e, 0x17, 0x0b,
-	0xe2, 0xf5, 0xb6, 0xba, 0x44, 0xe2, 0x2d, 0x2f, 0xe0, 0xb4, 0x20, 0x70, 0x7e, 0xb1, 0x60, 0xa1,
-	0x2d, 0xa2, 0xc7, 0x69, 0x88, 0x25, 0xf9, 0x1c, 0x67, 0x38, 0x11, 0xe8, 0x23, 0xa8, 0xe1, 0x5c,
-	0x3e, 0xe5, 0x19, 0x95, 0x83, 0xba, 0x75, 0xd3, 0x5a, 0xaf, 0x35, 0xeb, 0xbf, 0xfd, 0x7a, 0xef,
-	0x46, 0x31, 0xb1, 0xfb, 0x61, 0x98, 0x11, 0x21, 0xf6, 0x65, 0x46, 0x59, 0xd4, 0x39, 0x81, 0xa2,
-	0x4f, 0x01, 0x18, 0x39, 0xf0, 0x53, 0xcd, 0x52, 0x9f, 0xbe, 0x69, 0xad, 0x5f, 0xdb, 0x76, 0xdc,
-	0xf3, 0xd5, 0x74, 0x4d, 0xbd, 0x66, 0xe5, 0xc5, 0xab, 0xb5, 0xa9, 0x4e, 0x8d, 0x91, 0x03, 0xf3,
-	0x61, 0xf7, 0xfa, 0x0f, 0xaf, 0x9f, 0x6f, 0x9c, 0x10, 0x3b, 0xab, 0xb0, 0x72, 0xa6, 0xc7, 0x0e,
-	0x11, 0x29, 0x67, 0x82, 0x38, 0x3f, 0x57, 0x60, 0xbe, 0x2d, 0xa2, 0xbd, 0x8c, 0xa8, 0x58, 0x8c,
-	0x19, 0x72, 0x61, 0x86, 0x1f, 0x30, 0x92, 0x8d, 0xed, 0xdc, 0xc0, 0xd0, 0xfb, 0x00, 0x19, 0x8f,
-	0x63, 0x9c, 0xa6, 0x3e, 0x0d, 0x75, 0xd7, 0xb5, 0x4e, 0xad, 0xf8, 0xd2, 0x0a, 0xd1, 0x57, 0xb0,
-	0x88, 0xe3, 0x98, 0x07, 0x58, 0x92, 0xd0, 0xc7, 0x09, 0xcf, 0x99, 0xac, 0x5f, 0xd1, 0xcc, 0xae,
-	0x6a, 0xfb, 0x8f, 0x57, 0x6b, 0x1f, 0x44, 0x54, 0x3e, 0xcd, 0xbb, 0x6e, 0xc0, 0x93, 0x62, 0xed,
-	0x8b, 0xc7, 0x3d, 0x11, 0x3e, 0xf3, 0xe4, 0x20, 0x25, 0xc2, 0x6d, 0x31, 0xd9, 0x59, 0x18, 0xf2,
-	0xdc, 0xd7, 0x34, 0x68, 0x1f, 0xe6, 0xbb, 0x9c, 0x85, 0x94, 0x45, 0x7e, 0x90, 0x67, 0x3d, 0x52,
-	0xaf, 0x68, 0xc9, 0xd6, 0x2f, 0x92, 0xac, 0x69, 0x12, 0xf6, 0x14, 0xbe, 0x10, 0x6e, 0xae, 0x3b,
-	0xf2, 0x0d, 0xed, 0x01, 0x08, 0x89, 0x33, 0xe9, 0x2b, 0x27, 0xd4, 0x67, 0x34, 0xa3, 0xed, 0x1a,
-	0x9b, 0xb8, 0xa5, 0x4d, 0xdc, 0x47, 0xa5, 0x4d, 0x9a, 0x57, 0x15, 0xc7, 0xe1, 0x9f, 0x6b, 0x56,
-	0xa7, 0xa6, 0xf3, 0x54, 0x04, 0x3d, 0x84, 0x25, 0x9a, 0x71, 0x3f, 0x8d, 0x31, 0xf3, 0x4b, 0xc7,
-	0xd5, 0xab, 0x9a, 0x6b, 0xf5, 0x0d, 0xae, 0x07, 0x05, 0xc0, 0x50, 0xfd, 0xa4, 0xa8, 0x16, 0x68,
-	0xc6, 0xd5, 0x62, 0x94, 0x21, 0x44, 0x61, 0x99, 0xb2, 0x80, 0x30, 0x49, 0x7b, 0xc4, 0xd0, 0x16,
-	0x2e, 0x99, 0xd5, 0xa4, 0xde, 0x45, 0x53, 0x6e, 0x95, 0x89, 0x8a, 0xf1, 0x94, 0x65, 0xde, 0xa3,
-	0x6f, 0x86, 0x76, 0x41, 0x99, 0xc7, 0xac, 0xad, 0xb3, 0x09, 0xcb, 0xa7, 0xcc, 0x51, 0xda, 0x06,
-	0xad, 0xc0, 0xac, 0xee, 0x82, 0x86, 0xc6, 0x26, 0x9d, 0xaa, 0x1a, 0xb6, 0x42, 0xe7, 0x6f, 0x0b,
-	0xaa, 0x6d, 0x11, 0x35, 0xf3, 0x81, 0x32, 0x52, 0x37, 0x1f, 0x4c, 0x62, 0x24, 0x0d, 0x1b, 0xe5,
-	0x9c, 0x1e, 0xe5, 0x44, 0x9f, 0x40, 0xf5, 0x52, 0xc6, 0x29, 0xb2, 0xd1, 0x17, 0xb0, 0x90, 0xe0,
-	0xbe, 0x1f, 0x70, 0x21, 0x4b, 0x27, 0x56, 0xde, 0x89, 0x70, 0x3e, 0xc1, 0xfd, 0x3d, 0x2e, 0xa4,
-	0xf1, 0x61, 0xa1, 0x98, 0x9e, 0x84, 0x73, 0x38, 0x0d, 0x8b, 0x66, 0xfe, 0x1f, 0xf7, 0x71, 0x20,
-	0xf7, 0x53, 0xc2, 0xc2, 0xff, 0x4e, 0x89, 0x07, 0x30, 0x23, 0x14, 0xe3, 0x3b, 0x0a, 0x61, 0x92,
-	0x11, 0x86, 0xe5, 0x84, 0x32, 0x9f, 0xe7, 0xd2, 0x97, 0xfc, 0x19, 0x61, 0xe2, 0x72, 0x6a, 0xa0,
-	0x84, 0xb2, 0x87, 0xb9, 0x7c, 0xa4, 0xa9, 0xfe, 0x45, 0x92, 0x45, 0xb8, 0x6e, 0x14, 0x19, 0x1e,
-	0x3a, 0x3f, 0x4e, 0xc3, 0x6c, 0x5b, 0x44, 0xfb, 0x24, 0x8e, 0xd1, 0x26, 0x54, 0x05, 0x89, 0xe3,
-	0x09, 0xc4, 0x29, 0x70, 0xff, 0xbf, 0x4f, 0x9e, 0xc0, 0x92, 0xd2, 0x87, 0xb2, 0x80, 0x27, 0xe4,
-	0x72, 0xda, 0x2c, 0x24, 0x94, 0xb5, 0x34, 0x4f, 0x21, 0xcc, 0x35, 0x25, 0x4c, 0x31, 0x13, 0x67,
-	0x49, 0xdf, 0x1d, 0x4a, 0x86, 0xa1, 0x34, 0x04, 0xae, 0xaa, 0x1d, 0x17, 0x63, 0x9a, 0xa0, 0x6d,
-	0x98, 0x0d, 0xd4, 0xcb, 0x04, 0xda, 0x94, 0xc0, 0x73, 0xc5, 0xd9, 0x9d, 0x53, 0x85, 0x4b, 0x98,
-	0x83, 0xb4, 0x4b, 0x75, 0x99, 0xb2, 0xf4, 0xf6, 0x71, 0x05, 0xae, 0xb4, 0x45, 0x84, 0x52, 0x98,
-	0x3b, 0x75, 0x9d, 0xdd, 0xb9, 0xe8, 0x70, 0x39, 0x73, 0xaf, 0xd8, 0x3b, 0x6f, 0x01, 0x1e, 0x9e,
-	0x26, 0xdf, 0x02, 0x8c, 0x5c, 0x40, 0x1f, 0x8e, 0xa1, 0x38, 0x81, 0xda, 0x5b, 0x13, 0x43, 0x87,
-	0xb5, 0x1e, 0xc3, 0x15, 0x75, 0x38, 0x39, 0x63, 0x32, 0x9b, 0xf9, 0xc0, 0xde, 0x18, 0x8f, 0x19,
-	0xd2, 0x52, 0x98, 0x3f, 0xbd, 0xe7, 0xef, 0x8e, 0x4f, 0x3e, 0x41, 0xbf, 0x55, 0xa9, 0x2f, 0xa1,
-	0xa2, 0x77, 0xce, 0xad, 0x31, 0x39, 0x0a, 0x64, 0xdf, 0x99, 0x00, 0x34, 0x64, 0xfe, 0x1a, 0x66,
-	0x8c, 0xf3, 0x6e, 0x8f, 0xd3, 0x55, 0xa1, 0xec, 0xbb, 0x93, 0xa0, 0x4a, 0x72, 0x7b, 0xe6, 0xfb,
-	0xd7, 0xcf, 0x37, 0xac, 0xe6, 0x67, 0x2f, 0x8e, 0x1a, 0xd6, 0xcb, 0xa3, 0x86, 0xf5, 0xd7, 0x51,
-	0xc3, 0x3a, 0x3c, 0x6e, 0x4c, 0xbd, 0x3c, 0x6e, 0x4c, 0xfd, 0x7e, 0xdc, 0x98, 0x7a, 0xb2, 0x39,
-	0xb2, 0xa7, 0xce, 0xf9, 0xb9, 0xeb, 0xed, 0x78, 0x7d, 0xf3, 0xb3, 0xa9, 0x76, 0x58, 0xb7, 0xaa,
-	0xef, 0xd0, 0x9d, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x02, 0x59, 0x4e, 0x84, 0x97, 0x0a, 0x00,
+	// 1121 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xc6, 0x80, 0x0d, 0x7e, 0xfc, 0x30, 0x0c, 0xa1, 0x2c, 0x96, 0x6a, 0x90, 0x93, 0x16, 0x4a,
+	0xc2, 0x2e, 0x3f, 0xaa, 0x1e, 0xb8, 0x61, 0x68, 0x2b, 0x2a, 0x59, 0x41, 0x26, 0x89, 0x9a, 0x54,
+	0xea, 0x6a, 0xec, 0x1d, 0x96, 0x69, 0x76, 0x77, 0xb6, 0x3b, 0xb3, 0xc6, 0xee, 0xa9, 0xaa, 0xd4,
+	0x7b, 0x8e, 0x3d, 0xf7, 0xd0, 0x73, 0x0e, 0xbd, 0xf4, 0xdc, 0x4b, 0x8e, 0x51, 0x0f, 0x55, 0xd5,
+	0x43, 0x5a, 0xc1, 0x21, 0xff, 0x46, 0x35, 0x3b, 0xbb, 0x8b, 0x4d, 0x84, 0xed, 0x40, 0x73, 0xf2,
+	0xce, 0x7b, 0xdf, 0xfb, 0xde, 0xdb, 0x6f, 0xde, 0x1b, 0xcf, 0xc2, 0x6d, 0xab, 0xed, 0x12, 0x8f,
+	0x53, 0xe6, 0xb5, 0xda, 0xdf, 0x19, 0xe9, 0xc2, 0xa0, 0x01, 0x33, 0x44, 0x4b, 0xf7, 0x03, 0x26,
+	0x18, 0x2a, 0x76, 0x82, 0xf4, 0x74, 0xa1, 0xd3, 0x80, 0x15, 0x6f, 0xd9, 0xcc, 0x66, 0x11, 0xcc,
+	0x90, 0x4f, 0x2a, 0xa2, 0xb8, 0xd8, 0x60, 0xdc, 0x65, 0xdc, 0x54, 0x0e, 0xb5, 0x88, 0x5d, 0x0b,
+	0x6a, 0x65, 0xb8, 0xdc, 0x36, 0x9a, 0x9b, 0xf2, 0x27, 0x76, 0xdc, 0xe9, 0x51, 0x0a, 0x0d, 0x12,
+	0xe6, 0x92, 0xcd, 0x98, 0xed, 0x10, 0x23, 0x5a, 0xd5, 0xc3, 0x63, 0xc3, 0x0a, 0x03, 0x2c, 0x64,
+	0x35, 0xca, 0xbf, 0x74, 0xd9, 0x2f, 0xa8, 0x4b, 0xb8, 0xc0, 0xae, 0x9f, 0x10, 0xc4, 0xf9, 0xeb,
+	0x98, 0x13, 0xa3, 0xb9, 0x59, 0x27, 0x02, 0x6f, 0x1a, 0x0d, 0x46, 0x63, 0x82, 0xf2, 0xcf, 0x19,
+	0x28, 0x54, 0xb9, 0xfd, 0xd0, 0xb7, 0xb0, 0x20, 0x87, 0x38, 0xc0, 0x2e, 0x47, 0x9f, 0x40, 0x1e,
+	0x87, 0xe2, 0x84, 0x05, 0x54, 0xb4, 0xb5, 0xcc, 0x72, 0x66, 0x35, 0x5f, 0xd1, 0xfe, 0xf8, 0x75,
+	0xfd, 0x56, 0xfc, 0x62, 0xbb, 0x96, 0x15, 0x10, 0xce, 0x8f, 0x44, 0x40, 0x3d, 0xbb, 0x76, 0x01,
+	0x45, 0x9f, 0x03, 0x78, 0xe4, 0xd4, 0xf4, 0x23, 0x16, 0x6d, 0x78, 0x39, 0xb3, 0x3a, 0xb1, 0x55,
+	0xd6, 0xaf, 0x56, 0x53, 0x57, 0xf9, 0x2a, 0xa3, 0x2f, 0x5e, 0x2d, 0x0d, 0xd5, 0xf2, 0x1e, 0x39,
+	0x55, 0x86, 0x9d, 0xe9, 0x1f, 0x5e, 0x3f, 0x5f, 0xbb, 0x20, 0x2e, 0x2f, 0xc2, 0xc2, 0xa5, 0x1a,
+	0x6b, 0x84, 0xfb, 0xcc, 0xe3, 0xa4, 0xfc, 0x67, 0x0e, 0xa6, 0xaa, 0xdc, 0xde, 0x0b, 0x88, 0xf4,
+	0x39, 0xd8, 0x43, 0x3a, 0x64, 0xd9, 0xa9, 0x47, 0x82, 0xbe, 0x95, 0x2b, 0x18, 0x7a, 0x1f, 0x20,
+	0x60, 0x8e, 0x83, 0x7d, 0xdf, 0xa4, 0x56, 0x54, 0x75, 0xbe, 0x96, 0x8f, 0x2d, 0x07, 0x16, 0x7a,
+	0x0c, 0x33, 0xd8, 0x71, 0x58, 0x03, 0x0b, 0x62, 0x99, 0xd8, 0x65, 0xa1, 0x27, 0xb4, 0x91, 0x88,
+	0x59, 0x97, 0x65, 0xff, 0xfd, 0x6a, 0xe9, 0x43, 0x9b, 0x8a, 0x93, 0xb0, 0xae, 0x37, 0x98, 0x1b,
+	0xef, 0x7d, 0xfc, 0xb3, 0xce, 0xad, 0xa7, 0x86, 0x68, 0xfb, 0x84, 0xeb, 0x07, 0x9e, 0xa8, 0x15,
+	0x52, 0x9e, 0xdd, 0x88, 0x06, 0x1d, 0xc1, 0x54, 0x9d, 0x79, 0x16, 0xf5, 0x6c, 0xb3, 0x11, 0x06,
+	0x4d, 0xa2, 0x8d, 0x46, 0x92, 0xad, 0xf6, 0x92, 0xac, 0xa2, 0x02, 0xf6, 0x24, 0x3e, 0x16, 0x6e,
+	0xb2, 0xde, 0x61, 0x43, 0x7b, 0x00, 0x5c, 0xe0, 0x40, 0x98, 0xb2, 0x13, 0xb4, 0x6c, 0xc4, 0x58,
+	0xd4, 0x55, 0x9b, 0xe8, 0x49, 0x9b, 0xe8, 0x0f, 0x92, 0x36, 0xa9, 0x8c, 0x4b, 0x8e, 0x67, 0xff,
+	0x2c, 0x65, 0x6a, 0xf9, 0x28, 0x4e, 0x7a, 0xd0, 0x7d, 0x98, 0xa5, 0x01, 0x33, 0x7d, 0x07, 0x7b,
+	0x66, 0xd2, 0x71, 0x5a, 0x2e, 0xe2, 0x5a, 0x7c, 0x83, 0x6b, 0x3f, 0x06, 0x28, 0xaa, 0x9f, 0x24,
+	0x55, 0x81, 0x06, 0x4c, 0x6e, 0x46, 0xe2, 0x42, 0x14, 0xe6, 0xa9, 0xd7, 0x20, 0x9e, 0xa0, 0x4d,
+	0xa2, 0x68, 0xe3, 0x2e, 0x19, 0x8b, 0x48, 0x8d, 0x5e, 0xaf, 0x7c, 0x90, 0x04, 0x4a, 0xc6, 0xae,
+	0x96, 0x99, 0xa3, 0x6f, 0xba, 0xd0, 0x0a, 0x14, 0x1c, 0xfa, 0x6d, 0x48, 0x2d, 0x2a, 0xda, 0xa6,
+	0x45, 0x3c, 0xe6, 0x6a, 0xe3, 0xd1, 0xa6, 0x4e, 0xa7, 0xe6, 0x7d, 0x69, 0x45, 0x87, 0x30, 0xd9,
+	0x24, 0x5c, 0x48, 0xf9, 0x65, 0x45, 0x5a, 0x3e, 0x2a, 0x65, 0xa5, 0x57, 0x29, 0x8f, 0x14, 0x5e,
+	0x66, 0x8b, 0x4b, 0x98, 0x68, 0x5e, 0x98, 0xd0, 0x63, 0x88, 0xf6, 0xf8, 0xd4, 0xa1, 0x5c, 0x98,
+	0xfe, 0x09, 0xe6, 0x44, 0x83, 0x88, 0x74, 0xad, 0x17, 0xe9, 0x6e, 0x12, 0x72, 0x28, 0x23, 0x62,
+	0xde, 0x69, 0xdc, 0x65, 0x45, 0x0d, 0x78, 0xcf, 0xc5, 0xad, 0xb8, 0x01, 0x4d, 0x9f, 0x04, 0x26,
+	0x56, 0xcd, 0xac, 0x4d, 0x5c, 0xab, 0x19, 0xe7, 0x5c, 0xdc, 0x52, 0x6d, 0x78, 0x48, 0x82, 0x78,
+	0x2e, 0x76, 0x40, 0xce, 0x9d, 0x1a, 0x8b, 0xf2, 0x06, 0xcc, 0x77, 0xcd, 0x55, 0x32, 0x71, 0x68,
+	0x01, 0xc6, 0xa2, 0x0d, 0xa4, 0x96, 0x9a, 0xb0, 0x5a, 0x4e, 0x2e, 0x0f, 0xac, 0xf2, 0x2f, 0xc3,
+	0x90, 0xab, 0x72, 0xbb, 0x12, 0xb6, 0xe5, 0x0c, 0xd6, 0xc3, 0xf6, 0x20, 0x33, 0x18, 0xc1, 0x3a,
+	0x39, 0x87, 0x3b, 0x39, 0xd1, 0x67, 0x90, 0xbb, 0xd1, 0xcc, 0xc5, 0xd1, 0xe8, 0x11, 0x14, 0xa4,
+	0x7c, 0x0d, 0xc6, 0x45, 0x32, 0xc4, 0xa3, 0xd7, 0x22, 0x9c, 0x72, 0x71, 0x6b, 0x8f, 0x71, 0x11,
+	0x8f, 0xf0, 0x4a, 0xd7, 0x8e, 0x07, 0x8c, 0x1d, 0x6b, 0xd9, 0xe5, 0x91, 0xd5, 0xc9, 0xce, 0xfd,
+	0x93, 0xd6, 0x58, 0xda, 0xe8, 0x6d, 0xcb, 0xbf, 0x0d, 0xc3, 0x8c, 0x12, 0xea, 0xd3, 0x16, 0x6e,
+	0x88, 0x23, 0x9f, 0x78, 0xd6, 0xff, 0x27, 0xd9, 0x3e, 0x64, 0xb9, 0x64, 0xbc, 0xa6, 0x62, 0x2a,
+	0x18, 0x61, 0x98, 0x77, 0xa9, 0x67, 0xb2, 0x50, 0x98, 0x82, 0x3d, 0x25, 0x1e, 0xbf, 0x99, 0x6c,
+	0xc8, 0xa5, 0xde, 0xfd, 0x50, 0x3c, 0x88, 0xa8, 0x6e, 0xa2, 0xdd, 0x0c, 0x4c, 0x2b, 0xe9, 0xd2,
+	0x7f, 0x80, 0x1f, 0x87, 0x61, 0xac, 0xca, 0xed, 0x23, 0xe2, 0x38, 0x68, 0x03, 0x72, 0x9c, 0x38,
+	0xce, 0x00, 0x2a, 0xc6, 0xb8, 0x77, 0xdf, 0x79, 0x4f, 0x60, 0x56, 0x0a, 0x49, 0xbd, 0x06, 0x73,
+	0xc9, 0xcd, 0x44, 0x2c, 0xb8, 0xd4, 0x3b, 0x88, 0x78, 0x94, 0x82, 0x3b, 0x13, 0x52, 0x98, 0xf8,
+	0x4d, 0xca, 0xb3, 0x50, 0x88, 0x65, 0x48, 0xa5, 0x21, 0x30, 0x2e, 0x67, 0xd8, 0xc1, 0xd4, 0x45,
+	0x5b, 0x30, 0xd6, 0x90, 0x0f, 0x03, 0x68, 0x93, 0x00, 0xaf, 0x14, 0x67, 0x67, 0x52, 0x26, 0x4e,
+	0x60, 0x65, 0x04, 0x33, 0x49, 0x9a, 0x34, 0x35, 0x85, 0x7c, 0x95, 0xdb, 0x35, 0x72, 0x1c, 0x7a,
+	0x16, 0xfa, 0x18, 0xc6, 0x83, 0xe8, 0x69, 0x80, 0xe4, 0x29, 0xf2, 0xea, 0xec, 0x53, 0x32, 0x7b,
+	0x8a, 0x2b, 0xcf, 0xc1, 0x6c, 0x9a, 0x2a, 0xc9, 0xbf, 0xf5, 0x7b, 0x16, 0x46, 0xaa, 0xdc, 0x46,
+	0x3e, 0x4c, 0x76, 0xdd, 0x6d, 0xee, 0xf6, 0x3a, 0x89, 0x2f, 0x5d, 0x32, 0x8a, 0xdb, 0x6f, 0x01,
+	0x4e, 0xcf, 0xc7, 0x6f, 0x00, 0x3a, 0x6e, 0x23, 0x1f, 0xf5, 0xa1, 0xb8, 0x80, 0x16, 0x37, 0x07,
+	0x86, 0xa6, 0xb9, 0x1e, 0xc2, 0x88, 0x3c, 0x6e, 0xcb, 0x7d, 0x22, 0x2b, 0x61, 0xbb, 0xb8, 0xd6,
+	0x1f, 0x93, 0xd2, 0x52, 0x98, 0xea, 0x3e, 0x9c, 0xee, 0xf5, 0x0f, 0xbe, 0x40, 0xbf, 0x55, 0xaa,
+	0x2f, 0x61, 0x34, 0x9a, 0xdc, 0xdb, 0x7d, 0x62, 0x24, 0xa8, 0x78, 0x77, 0x00, 0x50, 0xca, 0xfc,
+	0x15, 0x64, 0x55, 0xe7, 0xdf, 0xe9, 0xa7, 0xab, 0x44, 0x15, 0xef, 0x0d, 0x82, 0x4a, 0xc9, 0xbf,
+	0x86, 0x5c, 0xdc, 0xdb, 0x1f, 0xf4, 0x89, 0x53, 0xb0, 0xe2, 0xfa, 0x40, 0xb0, 0x84, 0xbf, 0x98,
+	0xfd, 0xfe, 0xf5, 0xf3, 0xb5, 0x4c, 0xe5, 0x8b, 0x17, 0x67, 0xa5, 0xcc, 0xcb, 0xb3, 0x52, 0xe6,
+	0xdf, 0xb3, 0x52, 0xe6, 0xd9, 0x79, 0x69, 0xe8, 0xe5, 0x79, 0x69, 0xe8, 0xaf, 0xf3, 0xd2, 0xd0,
+	0x93, 0x8d, 0x8e, 0x33, 0xe3, 0x8a, 0x2f, 0x89, 0xe6, 0xb6, 0xd1, 0x52, 0x5f, 0x36, 0xf2, 0x04,
+	0xa9, 0xe7, 0xa2, 0x0b, 0xdb, 0xf6, 0x7f, 0x03, 0x00, 0x8f, 0xa9, 0xe6, 0x00, 0x04, 0x0d, 0x00,
 	0x00,
 }
 
@@ -706,6 +863,9 @@ type MsgClient interface {
 	Sell(ctx context.Context, in *MsgSell, opts ...grpc.CallOption) (*MsgSellResponse, error)
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	// Refund is used to burn IRO tokens of a cancelled plan for a pro-rata
+	// share of the raised liquidity.
+	Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error)
 }
 
 type msgClient struct {
@@ -770,6 +930,15 @@ func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *msgClient) Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error) {
+	out := new(MsgRefundResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -783,6 +952,9 @@ type MsgServer interface {
 	Sell(context.Context, *MsgSell) (*MsgSellResponse, error)
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	// Refund is used to burn IRO tokens of a cancelled plan for a pro-rata
+	// share of the raised liquidity.
+	Refund(context.Context, *MsgRefund) (*MsgRefundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -807,6 +979,9 @@ func (*UnimplementedMsgServer) Sell(ctx context.Context, req *MsgSell) (*MsgSell
 func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
 }
+func (*UnimplementedMsgServer) Refund(ctx context.Context, req *MsgRefund) (*MsgRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -920,6 +1095,24 @@ func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Refund(ctx, req.(*MsgRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.iro.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -948,6 +1141,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Claim",
 			Handler:    _Msg_Claim_Handler,
 		},
+		{
+			MethodName: "Refund",
+			Handler:    _Msg_Refund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/iro/tx.proto",
@@ -1036,6 +1233,43 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxAmountPerAddress.Size()
+		i -= size
+		if _, err := m.MaxAmountPerAddress.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.AllowlistPhase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.LiquidityDenom) > 0 {
+		i -= len(m.LiquidityDenom)
+		copy(dAtA[i:], m.LiquidityDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidityDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
 	{
 		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1046,20 +1280,20 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x32
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -1149,6 +1383,15 @@ func (m *MsgBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowlistProof) > 0 {
+		for iNdEx := len(m.AllowlistProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistProof[iNdEx])
+			copy(dAtA[i:], m.AllowlistProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowlistProof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.MaxCostAmount.Size()
 		i -= size
@@ -1206,6 +1449,15 @@ func (m *MsgBuyExactSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowlistProof) > 0 {
+		for iNdEx := len(m.AllowlistProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistProof[iNdEx])
+			copy(dAtA[i:], m.AllowlistProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowlistProof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.MinOutTokensAmount.Size()
 		i -= size
@@ -1406,6 +1658,66 @@ func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refunder) > 0 {
+		i -= len(m.Refunder)
+		copy(dAtA[i:], m.Refunder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Refunder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1465,6 +1777,16 @@ func (m *MsgCreatePlan) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.IncentivePlanParams.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LiquidityDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.VestingPlan.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AllowlistPhase.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmountPerAddress.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1499,6 +1821,12 @@ func (m *MsgBuy) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MaxCostAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.AllowlistProof) > 0 {
+		for _, b := range m.AllowlistProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1520,6 +1848,12 @@ func (m *MsgBuyExactSpend) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinOutTokensAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.AllowlistProof) > 0 {
+		for _, b := range m.AllowlistProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1588,6 +1922,32 @@ func (m *MsgClaimResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Refunder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2018,32 +2378,164 @@ func (m *MsgCreatePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistPhase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllowlistPhase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountPerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountPerAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2282,6 +2774,38 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistProof = append(m.AllowlistProof, make([]byte, postIndex-iNdEx))
+			copy(m.AllowlistProof[len(m.AllowlistProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2464,6 +2988,38 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistProof = append(m.AllowlistProof, make([]byte, postIndex-iNdEx))
+			copy(m.AllowlistProof[len(m.AllowlistProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2931,6 +3487,170 @@ func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewVestingPlan returns a vesting plan with the given cliff and linear vesting duration
+func NewVestingPlan(cliff, duration time.Duration) VestingPlan {
+	return VestingPlan{
+		Cliff:    cliff,
+		Duration: duration,
+	}
+}
+
+func (v VestingPlan) ValidateBasic() error {
+	if v.Cliff < 0 {
+		return fmt.Errorf("vesting cliff must be non-negative: %v", v.Cliff)
+	}
+	if v.Duration < 0 {
+		return fmt.Errorf("vesting duration must be non-negative: %v", v.Duration)
+	}
+	return nil
+}
+
+// IsVesting returns true if the claims are vested, false if they are released on claim
+func (v VestingPlan) IsVesting() bool {
+	return v.Cliff > 0 || v.Duration > 0
+}
+
+// VestedAmount returns the amount vested out of total at time t
+// Nothing is vested before the cliff, then the total is vested linearly over the duration
+func (v VestingPlan) VestedAmount(total math.Int, t time.Time) math.Int {
+	if !v.IsVesting() {
+		return total
+	}
+
+	cliffEnd := v.StartTime.Add(v.Cliff)
+	if t.Before(cliffEnd) {
+		return math.ZeroInt()
+	}
+
+	elapsed := t.Sub(cliffEnd)
+	if elapsed >= v.Duration {
+		return total
+	}
+
+	return total.MulRaw(int64(elapsed)).QuoRaw(int64(v.Duration))
+}
+
+func NewClaimerVesting(planId string, claimer sdk.AccAddress) ClaimerVesting {
+	return ClaimerVesting{
+		PlanId:  planId,
+		Claimer: claimer.String(),
+		Total:   math.ZeroInt(),
+		Claimed: math.ZeroInt(),
+	}
+}
+
+func (c ClaimerVesting) ValidateBasic() error {
+	if c.PlanId == "" {
+		return fmt.Errorf("plan id cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Claimer); err != nil {
+		return fmt.Errorf("invalid claimer address: %w", err)
+	}
+	if c.Total.IsNil() || c.Total.IsNegative() {
+		return fmt.Errorf("total must be non-negative: %s", c.Total)
+	}
+	if c.Claimed.IsNil() || c.Claimed.IsNegative() {
+		return fmt.Errorf("claimed must be non-negative: %s", c.Claimed)
+	}
+	if c.Claimed.GT(c.Total) {
+		return fmt.Errorf("claimed greater than total: claimed: %s, total: %s", c.Claimed, c.Total)
+	}
+	return nil
+}
+
+// Unreleased returns the amount of the claimer's tokens which are not released yet
+func (c ClaimerVesting) Unreleased() math.Int {
+	return c.Total.Sub(c.Claimed)
+}
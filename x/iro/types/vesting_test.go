@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestVestingPlan_VestedAmount(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	total := math.NewInt(1_000)
+
+	tests := []struct {
+		name     string
+		plan     types.VestingPlan
+		at       time.Time
+		expected math.Int
+	}{
+		{
+			name:     "no vesting",
+			plan:     types.VestingPlan{},
+			at:       start,
+			expected: total,
+		},
+		{
+			name:     "before cliff",
+			plan:     types.VestingPlan{Cliff: time.Hour, Duration: 10 * time.Hour, StartTime: start},
+			at:       start.Add(59 * time.Minute),
+			expected: math.ZeroInt(),
+		},
+		{
+			name:     "at cliff",
+			plan:     types.VestingPlan{Cliff: time.Hour, Duration: 10 * time.Hour, StartTime: start},
+			at:       start.Add(time.Hour),
+			expected: math.ZeroInt(),
+		},
+		{
+			name:     "linear after cliff",
+			plan:     types.VestingPlan{Cliff: time.Hour, Duration: 10 * time.Hour, StartTime: start},
+			at:       start.Add(4 * time.Hour),
+			expected: math.NewInt(300),
+		},
+		{
+			name:     "after duration",
+			plan:     types.VestingPlan{Cliff: time.Hour, Duration: 10 * time.Hour, StartTime: start},
+			at:       start.Add(12 * time.Hour),
+			expected: total,
+		},
+		{
+			name:     "cliff only",
+			plan:     types.VestingPlan{Cliff: time.Hour, StartTime: start},
+			at:       start.Add(time.Hour),
+			expected: total,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.plan.VestedAmount(total, tc.at))
+		})
+	}
+}
+
+func TestVestingPlan_ValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewVestingPlan(0, 0).ValidateBasic())
+	require.NoError(t, types.NewVestingPlan(time.Hour, time.Hour).ValidateBasic())
+	require.Error(t, types.NewVestingPlan(-time.Hour, time.Hour).ValidateBasic())
+	require.Error(t, types.NewVestingPlan(time.Hour, -time.Hour).ValidateBasic())
+}
@@ -7,10 +7,12 @@ import (
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
+// HandleMsgSubmitMisbehaviour prevents ibc from freezing canonical clients. Misbehaviour on a canonical
+// client is instead handled as fraud evidence by the lightclient MsgSubmitMisbehaviour.
 func (i IBCMessagesDecorator) HandleMsgSubmitMisbehaviour(ctx sdk.Context, msg *ibcclienttypes.MsgSubmitMisbehaviour) error {
 	_, ok := i.k.GetRollappForClientID(ctx, msg.ClientId)
 	if ok {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "cannot submit misbehavour for a canonical client")
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "cannot submit misbehavour for a canonical client: use lightclient submit misbehaviour")
 	}
 	return nil
 }
@@ -70,6 +70,12 @@ func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibccli
 	}
 
 	h := header.GetHeight().GetRevisionHeight()
+
+	// a prospective canonical client must be validated again if a consensus state is added below the validated height
+	if err := i.k.ResetClientValidation(ctx, msg.ClientId, rollapp.RollappId, h); err != nil {
+		return errorsmod.Wrap(err, "reset client validation")
+	}
+
 	stateInfos, err := i.getStateInfos(ctx, rollapp.RollappId, h)
 	if err != nil {
 		return errorsmod.Wrap(err, "get state infos")
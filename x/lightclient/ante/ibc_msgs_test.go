@@ -1,15 +1,12 @@
 package ante_test
 
 import (
-	"context"
-
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
-	"github.com/cosmos/ibc-go/v7/modules/core/exported"
-
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	ibcconnectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
@@ -81,6 +78,11 @@ func (m *MockIBCClientKeeper) ClientStore(ctx sdk.Context, clientID string) type
 	panic("unimplemented")
 }
 
+// ClientUpdateProposal implements types.IBCClientKeeperExpected.
+func (m *MockIBCClientKeeper) ClientUpdateProposal(ctx sdk.Context, p *ibcclienttypes.ClientUpdateProposal) error {
+	panic("unimplemented")
+}
+
 func NewMockIBCClientKeeper(cs map[string]exported.ClientState) *MockIBCClientKeeper {
 	return &MockIBCClientKeeper{
 		clientStates: cs,
@@ -99,10 +101,6 @@ func (m *MockIBCClientKeeper) GetClientState(ctx sdk.Context, clientID string) (
 func (m *MockIBCClientKeeper) IterateClientStates(ctx sdk.Context, prefix []byte, cb func(clientID string, cs exported.ClientState) bool) {
 }
 
-func (m *MockIBCClientKeeper) ConsensusStateHeights(c context.Context, req *ibcclienttypes.QueryConsensusStateHeightsRequest) (*ibcclienttypes.QueryConsensusStateHeightsResponse, error) {
-	return nil, nil
-}
-
 type MockIBCChannelKeeper struct {
 	channelConnections map[string]ibcconnectiontypes.ConnectionEnd
 }
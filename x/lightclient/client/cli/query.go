@@ -26,6 +26,11 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(
 		CmdGetExpectedClientState(),
 		CmdGetLightClient(),
+		CmdGetClientValidation(),
+		CmdGetClientHealth(),
+		CmdQueryParams(),
+		CmdGetHeaderSigners(),
+		CmdGetUnbondBlockers(),
 	)
 
 	return cmd
@@ -101,3 +106,159 @@ func CmdGetLightClient() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetClientValidation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-validation [client-id]",
+		Short: "Get the validation progress of a prospective canonical light client.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &types.QueryClientValidationRequest{
+				ClientId: args[0],
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientValidation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetClientHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "client-health [rollapp-id]",
+		Short: "Get the time left before the canonical light client of the rollapp expires.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &types.QueryClientHealthRequest{
+				RollappId: args[0],
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClientHealth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the module params.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdGetHeaderSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header-signers [client-id]",
+		Short: "Get the sequencers who signed the client headers which are pending verification.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.HeaderSigners(cmd.Context(), &types.QueryHeaderSignersRequest{
+				ClientId:   args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+func CmdGetUnbondBlockers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbond-blockers [sequencer-address]",
+		Short: "Get the canonical client header heights which prevent the sequencer from unbonding.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondBlockers(cmd.Context(), &types.QueryUnbondBlockersRequest{
+				SequencerAddress: args[0],
+				Pagination:       pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
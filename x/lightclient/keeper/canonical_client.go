@@ -3,57 +3,176 @@ package keeper
 import (
 	"errors"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 // intended to be called by relayer, but can be called by anyone
 // verifies that the suggested client is safe to designate canonical and matches state updates from the sequencer
-func (k *Keeper) TrySetCanonicalClient(ctx sdk.Context, clientID string) error {
+// Consensus states are validated in bounded chunks: if validation does not complete within the call, it is pending
+// and will continue in end blocks and subsequent calls.
+func (k *Keeper) TrySetCanonicalClient(ctx sdk.Context, clientID string) (pending bool, err error) {
+	clientState, rollapp, err := k.prospectiveClient(ctx, clientID)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := k.GetCanonicalClient(ctx, rollapp.RollappId)
+	if ok {
+		return false, gerrc.ErrAlreadyExists.Wrap("canonical client for rollapp")
+	}
+
+	return k.validateAndPromote(ctx, clientID, clientState, rollapp, types.ValidationChunkSize)
+}
+
+// TryReplaceCanonicalClient replaces the expired or frozen canonical client of a rollapp.
+// The replacement client must pass the same checks as when setting a canonical client.
+// Its state is then substituted into the canonical client using the ibc client substitute
+// mechanism, so the canonical client ID, and therefore the canonical channel and everything
+// keyed by it in rollapp, delayedack and eibc, stays the same.
+// Like setting, the replacement can be pending until all consensus states are validated.
+func (k *Keeper) TryReplaceCanonicalClient(ctx sdk.Context, substituteID string) (pending bool, err error) {
+	clientState, rollapp, err := k.prospectiveClient(ctx, substituteID)
+	if err != nil {
+		return false, err
+	}
+
+	subjectID, ok := k.GetCanonicalClient(ctx, rollapp.RollappId)
+	if !ok {
+		return false, gerrc.ErrNotFound.Wrap("canonical client for rollapp")
+	}
+	if subjectID == substituteID {
+		return false, gerrc.ErrInvalidArgument.Wrap("client is already canonical")
+	}
+
+	if err := k.canReplaceCanonicalClient(ctx, rollapp, subjectID); err != nil {
+		return false, err
+	}
+
+	return k.validateAndPromote(ctx, substituteID, clientState, rollapp, types.ValidationChunkSize)
+}
+
+// prospectiveClient returns the client state and rollapp of a client which is a candidate to become canonical
+func (k *Keeper) prospectiveClient(ctx sdk.Context, clientID string) (*ibctm.ClientState, rollapptypes.Rollapp, error) {
 	clientStateI, ok := k.ibcClientKeeper.GetClientState(ctx, clientID)
 	if !ok {
-		return gerrc.ErrNotFound.Wrap("client")
+		return nil, rollapptypes.Rollapp{}, gerrc.ErrNotFound.Wrap("client")
 	}
 
 	clientState, ok := clientStateI.(*ibctm.ClientState)
 	if !ok {
-		return gerrc.ErrInvalidArgument.Wrap("not tm client")
+		return nil, rollapptypes.Rollapp{}, gerrc.ErrInvalidArgument.Wrap("not tm client")
 	}
 
-	chainID := clientState.ChainId
-	_, ok = k.rollappKeeper.GetRollapp(ctx, chainID)
+	rollapp, ok := k.rollappKeeper.GetRollapp(ctx, clientState.ChainId)
 	if !ok {
-		return gerrc.ErrNotFound.Wrap("rollapp")
+		return nil, rollapptypes.Rollapp{}, gerrc.ErrNotFound.Wrap("rollapp")
 	}
-	rollappID := chainID
+	return clientState, rollapp, nil
+}
 
-	_, ok = k.GetCanonicalClient(ctx, rollappID)
-	if ok {
-		return gerrc.ErrAlreadyExists.Wrap("canonical client for rollapp")
+// canReplaceCanonicalClient checks that the current canonical client is not usable anymore
+func (k *Keeper) canReplaceCanonicalClient(ctx sdk.Context, rollapp rollapptypes.Rollapp, subjectID string) error {
+	subjectStore := k.ibcClientKeeper.ClientStore(ctx, subjectID)
+	subject := getClientStateTM(subjectStore, k.cdc)
+	if subject == nil {
+		return gerrc.ErrInternal.Wrap("canonical client is not tm client")
+	}
+	status := subject.Status(ctx, subjectStore, k.cdc)
+	if status != exported.Expired && status != exported.Frozen {
+		return gerrc.ErrFailedPrecondition.Wrapf("canonical client is not expired or frozen: status: %s", status)
 	}
 
-	latestHeight, ok := k.rollappKeeper.GetLatestHeight(ctx, rollappID)
+	latestHeight, ok := k.rollappKeeper.GetLatestHeight(ctx, rollapp.RollappId)
 	if !ok {
 		return gerrc.ErrNotFound.Wrap("latest rollapp height")
 	}
 
-	err := k.validClient(ctx, clientID, clientState, rollappID, latestHeight)
+	// the canonical client is frozen on purpose while a hard fork is in progress, and will be
+	// unfrozen by the first state update of the new revision
+	if rollapp.DidFork() && latestHeight < rollapp.LatestRevision().StartHeight {
+		return types.ErrorHardForkInProgress
+	}
+	return nil
+}
+
+// validateAndPromote validates up to limit consensus states of the client and, if the validation is complete,
+// makes the client canonical: either directly, or by substituting it into the current canonical client.
+func (k *Keeper) validateAndPromote(ctx sdk.Context, clientID string, cs *ibctm.ClientState, rollapp rollapptypes.Rollapp, limit int) (pending bool, err error) {
+	latestHeight, ok := k.rollappKeeper.GetLatestHeight(ctx, rollapp.RollappId)
+	if !ok {
+		return false, gerrc.ErrNotFound.Wrap("latest rollapp height")
+	}
+
+	done, err := k.validClient(ctx, clientID, cs, rollapp, latestHeight, limit)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "unsafe to mark client canonical: check that sequencer has posted a recent state update")
+	}
+	if !done {
+		return true, nil
+	}
+
+	// a validation completed within a single call was never stored
+	if err := k.clientValidations.Remove(ctx, clientID); err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, errorsmod.Wrap(err, "remove client validation")
+	}
+
+	return false, k.promoteClient(ctx, clientID, rollapp)
+}
+
+// promoteClient makes a validated client canonical
+func (k *Keeper) promoteClient(ctx sdk.Context, clientID string, rollapp rollapptypes.Rollapp) error {
+	rollappID := rollapp.RollappId
+
+	subjectID, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		k.SetCanonicalClient(ctx, rollappID, clientID)
+
+		if err := uevent.EmitTypedEvent(ctx, &types.EventSetCanonicalClient{
+			RollappId: rollappID,
+			ClientId:  clientID,
+		}); err != nil {
+			return errorsmod.Wrap(err, "emit typed event")
+		}
+		return nil
+	}
+
+	if subjectID == clientID {
+		return gerrc.ErrInvalidArgument.Wrap("client is already canonical")
+	}
+
+	// the canonical client may have changed since the validation started
+	if err := k.canReplaceCanonicalClient(ctx, rollapp, subjectID); err != nil {
+		return err
+	}
+
+	err := k.ibcClientKeeper.ClientUpdateProposal(ctx, &ibcclienttypes.ClientUpdateProposal{
+		SubjectClientId:    subjectID,
+		SubstituteClientId: clientID,
+	})
 	if err != nil {
-		return errorsmod.Wrap(err, "unsafe to mark client canonical: check that sequencer has posted a recent state update")
+		return errorsmod.Wrap(err, "substitute client")
 	}
 
-	k.SetCanonicalClient(ctx, rollappID, clientID)
+	// the substitute's consensus states now belong to the canonical client, so the headers
+	// which are still pending verification need to be attributed to it
+	if err := k.moveSigners(ctx, clientID, subjectID); err != nil {
+		return errorsmod.Wrap(err, "move signers")
+	}
 
-	if err := uevent.EmitTypedEvent(ctx, &types.EventSetCanonicalClient{
-		RollappId: rollappID,
-		ClientId:  clientID,
+	if err := uevent.EmitTypedEvent(ctx, &types.EventReplaceCanonicalClient{
+		RollappId:          rollappID,
+		ClientId:           subjectID,
+		SubstituteClientId: clientID,
 	}); err != nil {
 		return errorsmod.Wrap(err, "emit typed event")
 	}
@@ -98,69 +217,3 @@ var (
 	ErrMismatch       = gerrc.ErrInvalidArgument.Wrap("consensus state mismatch")
 	ErrParamsMismatch = gerrc.ErrInvalidArgument.Wrap("params")
 )
-
-// The canonical client criteria are:
-// 1. The client must be a tendermint client.
-// 2. The client state must match the expected client params as configured by the module
-// 3. All the existing consensus states much match the corresponding height rollapp block descriptors
-func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientState, rollappId string, maxHeight uint64) error {
-	log := k.Logger(ctx).With("component", "valid client func", "rollapp", rollappId, "client", clientID)
-
-	log.Debug("top of func", "max height", maxHeight, "gas", ctx.GasMeter().GasConsumed())
-
-	expClient := k.expectedClient()
-
-	if err := types.IsCanonicalClientParamsValid(cs, &expClient); err != nil {
-		return errors.Join(err, ErrParamsMismatch)
-	}
-
-	// FIXME: No need to get all consensus states. should iterate over the consensus states
-	res, err := k.ibcClientKeeper.ConsensusStateHeights(ctx, &ibcclienttypes.QueryConsensusStateHeightsRequest{
-		ClientId:   clientID,
-		Pagination: &query.PageRequest{Limit: maxHeight},
-	})
-	log.Debug("after fetch heights", "max height", maxHeight, "gas", ctx.GasMeter().GasConsumed())
-	if err != nil {
-		return errorsmod.Wrap(err, "cons state heights")
-	}
-	atLeastOneMatch := false
-	for _, consensusHeight := range res.ConsensusStateHeights {
-		log.Debug("after fetch heights", "cons state height", consensusHeight.RevisionHeight, "gas", ctx.GasMeter().GasConsumed())
-		h := consensusHeight.GetRevisionHeight()
-		if maxHeight <= h {
-			break
-		}
-		consensusState, _ := k.ibcClientKeeper.GetClientConsensusState(ctx, clientID, consensusHeight)
-		tmConsensusState, _ := consensusState.(*ibctm.ConsensusState)
-		stateInfoH, err := k.rollappKeeper.FindStateInfoByHeight(ctx, rollappId, h)
-		if err != nil {
-			return errorsmod.Wrapf(err, "find state info by height h: %d", h)
-		}
-		stateInfoHplus1, err := k.rollappKeeper.FindStateInfoByHeight(ctx, rollappId, h+1)
-		if err != nil {
-			return errorsmod.Wrapf(err, "find state info by height h+1: %d", h+1)
-		}
-		bd, _ := stateInfoH.GetBlockDescriptor(h)
-
-		nextSeq, err := k.SeqK.RealSequencer(ctx, stateInfoHplus1.Sequencer)
-		if err != nil {
-			return errorsmod.Wrap(err, "get sequencer")
-		}
-		rollappState := types.RollappState{
-			BlockDescriptor:    bd,
-			NextBlockSequencer: nextSeq,
-		}
-		err = types.CheckCompatibility(*tmConsensusState, rollappState)
-		if err != nil {
-			return errorsmod.Wrapf(errors.Join(ErrMismatch, err), "check compatibility: height: %d", h)
-		}
-		atLeastOneMatch = true
-	}
-	// Need to be sure that at least one consensus state agrees with a state update
-	// (There are also no disagreeing consensus states. There may be some consensus states
-	// for future state updates, which will incur a fraud if they disagree.)
-	if !atLeastOneMatch {
-		return ErrNoMatch
-	}
-	return nil
-}
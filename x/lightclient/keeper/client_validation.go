@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// The canonical client criteria are:
+// 1. The client must be a tendermint client.
+// 2. The client state must match the expected client params as configured by the module
+// 3. All the existing consensus states much match the corresponding height rollapp block descriptors
+//
+// Consensus states are validated in height order, at most limit at a time. Progress is saved so that
+// validation can continue where it stopped. Returns true when all consensus states below maxHeight are validated.
+func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientState, rollapp rollapptypes.Rollapp, maxHeight uint64, limit int) (bool, error) {
+	log := k.Logger(ctx).With("component", "valid client func", "rollapp", rollapp.RollappId, "client", clientID)
+
+	expClient := k.expectedClient()
+
+	if err := types.IsCanonicalClientParamsValid(cs, &expClient); err != nil {
+		return false, errors.Join(err, ErrParamsMismatch)
+	}
+
+	progress, err := k.clientValidations.Get(ctx, clientID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, errorsmod.Wrap(err, "get client validation")
+	}
+	// a fork reverts state updates, so what was validated before may not hold anymore
+	if errors.Is(err, collections.ErrNotFound) {
+		if err := k.checkPendingValidationsLimit(ctx, rollapp.RollappId); err != nil {
+			return false, err
+		}
+	}
+	if errors.Is(err, collections.ErrNotFound) || progress.RollappRevision != rollapp.LatestRevision().Number {
+		progress = types.ClientValidationProgress{
+			ClientId:        clientID,
+			RollappId:       rollapp.RollappId,
+			RollappRevision: rollapp.LatestRevision().Number,
+		}
+	}
+
+	log.Debug("continue validation", "from", progress.ValidatedHeight, "max height", maxHeight, "gas", ctx.GasMeter().GasConsumed())
+
+	// iteration keys are ordered by height, unlike consensus state keys
+	revision := cs.GetLatestHeight().GetRevisionNumber()
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, clientID)
+	iterator := clientStore.Iterator(
+		ibctm.IterationKey(ibcclienttypes.NewHeight(revision, progress.ValidatedHeight+1)),
+		ibctm.IterationKey(ibcclienttypes.NewHeight(revision, maxHeight)),
+	)
+	defer iterator.Close() // nolint: errcheck
+
+	n := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if n == limit {
+			return false, errorsmod.Wrap(k.clientValidations.Set(ctx, clientID, progress), "set client validation")
+		}
+		n++
+
+		height := ibctm.GetHeightFromIterationKey(iterator.Key())
+		if err := k.validConsensusState(ctx, clientID, rollapp.RollappId, height); err != nil {
+			return false, err
+		}
+		progress.ValidatedHeight = height.GetRevisionHeight()
+		progress.Matched = true
+	}
+
+	// Need to be sure that at least one consensus state agrees with a state update
+	// (There are also no disagreeing consensus states. There may be some consensus states
+	// for future state updates, which will incur a fraud if they disagree.)
+	if !progress.Matched {
+		return false, ErrNoMatch
+	}
+	return true, nil
+}
+
+// validConsensusState checks the consensus state at the given height against the rollapp state update
+func (k Keeper) validConsensusState(ctx sdk.Context, clientID string, rollappID string, height exported.Height) error {
+	h := height.GetRevisionHeight()
+	consensusState, _ := k.ibcClientKeeper.GetClientConsensusState(ctx, clientID, height)
+	tmConsensusState, ok := consensusState.(*ibctm.ConsensusState)
+	if !ok {
+		return gerrc.ErrInternal.Wrapf("consensus state not tm: height: %d", h)
+	}
+	stateInfoH, err := k.rollappKeeper.FindStateInfoByHeight(ctx, rollappID, h)
+	if err != nil {
+		return errorsmod.Wrapf(err, "find state info by height h: %d", h)
+	}
+	stateInfoHplus1, err := k.rollappKeeper.FindStateInfoByHeight(ctx, rollappID, h+1)
+	if err != nil {
+		return errorsmod.Wrapf(err, "find state info by height h+1: %d", h+1)
+	}
+	bd, _ := stateInfoH.GetBlockDescriptor(h)
+
+	nextSeq, err := k.SeqK.RealSequencer(ctx, stateInfoHplus1.Sequencer)
+	if err != nil {
+		return errorsmod.Wrap(err, "get sequencer")
+	}
+	rollappState := types.RollappState{
+		BlockDescriptor:    bd,
+		NextBlockSequencer: nextSeq,
+	}
+	err = types.CheckCompatibility(*tmConsensusState, rollappState)
+	if err != nil {
+		return errorsmod.Wrapf(errors.Join(ErrMismatch, err), "check compatibility: height: %d", h)
+	}
+	return nil
+}
+
+// checkPendingValidationsLimit fails if a new validation of a client of the rollapp cannot be pending,
+// as too many are already. The limit is per rollapp, so that clients of one rollapp cannot hold back others.
+func (k Keeper) checkPendingValidationsLimit(ctx sdk.Context, rollappID string) error {
+	iter, err := k.clientValidations.Indexes.Rollapp.MatchExact(ctx, rollappID)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate client validations of rollapp")
+	}
+	defer iter.Close() // nolint: errcheck
+
+	n := 0
+	for ; iter.Valid() && n < types.MaxPendingValidationsPerRollapp; iter.Next() {
+		n++
+	}
+	if n == types.MaxPendingValidationsPerRollapp {
+		return gerrc.ErrResourceExhausted.Wrapf("too many pending client validations for rollapp: max: %d", types.MaxPendingValidationsPerRollapp)
+	}
+	return nil
+}
+
+// ContinueClientValidations continues the validation of prospective canonical clients which did not complete
+// within a message. Clients which fail validation, or can no longer become canonical, are dropped.
+// The clients are continued in a round-robin, from where the previous block stopped, so that all of them progress.
+func (k *Keeper) ContinueClientValidations(ctx sdk.Context) {
+	clients, err := k.nextPendingValidations(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Get next pending client validations.", "err", err)
+		return
+	}
+	if len(clients) == 0 {
+		return
+	}
+	if err := k.validationsCursor.Set(ctx, clients[len(clients)-1]); err != nil {
+		k.Logger(ctx).Error("Set client validations cursor.", "err", err)
+		return
+	}
+
+	for _, clientID := range clients {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.continueClientValidation(ctx, clientID)
+		})
+		if err != nil {
+			k.Logger(ctx).Info("Drop prospective canonical client.", "client", clientID, "err", err)
+			if err := k.clientValidations.Remove(ctx, clientID); err != nil {
+				k.Logger(ctx).Error("Remove client validation.", "client", clientID, "err", err)
+			}
+		}
+	}
+}
+
+// nextPendingValidations returns up to MaxPendingValidationsPerBlock clients whose validation is pending,
+// starting after the cursor and wrapping around
+func (k *Keeper) nextPendingValidations(ctx sdk.Context) ([]string, error) {
+	cursor, err := k.validationsCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "get cursor")
+	}
+
+	var clients []string
+	collect := func(clientID string, _ types.ClientValidationProgress) (stop bool, err error) {
+		clients = append(clients, clientID)
+		return len(clients) == types.MaxPendingValidationsPerBlock, nil
+	}
+
+	if err := k.clientValidations.Walk(ctx, new(collections.Range[string]).StartExclusive(cursor), collect); err != nil {
+		return nil, errorsmod.Wrap(err, "walk client validations after cursor")
+	}
+	if len(clients) < types.MaxPendingValidationsPerBlock && cursor != "" {
+		// wrap around, up to the cursor included
+		if err := k.clientValidations.Walk(ctx, new(collections.Range[string]).EndInclusive(cursor), collect); err != nil {
+			return nil, errorsmod.Wrap(err, "walk client validations before cursor")
+		}
+	}
+	return clients, nil
+}
+
+func (k *Keeper) continueClientValidation(ctx sdk.Context, clientID string) error {
+	clientState, rollapp, err := k.prospectiveClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	// fail early instead of validating a client which cannot replace the canonical one
+	if subjectID, ok := k.GetCanonicalClient(ctx, rollapp.RollappId); ok {
+		if subjectID == clientID {
+			return gerrc.ErrInvalidArgument.Wrap("client is already canonical")
+		}
+		if err := k.canReplaceCanonicalClient(ctx, rollapp, subjectID); err != nil {
+			return err
+		}
+	}
+
+	_, err = k.validateAndPromote(ctx, clientID, clientState, rollapp, types.ValidationChunkSize)
+	return err
+}
+
+// ResetClientValidation restarts the validation of a prospective canonical client of the rollapp if a
+// consensus state is added at or below the height already validated
+func (k Keeper) ResetClientValidation(ctx sdk.Context, clientID, rollappID string, h uint64) error {
+	progress, err := k.clientValidations.Get(ctx, clientID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get client validation")
+	}
+	if progress.RollappId != rollappID || progress.ValidatedHeight < h {
+		return nil
+	}
+	progress.ValidatedHeight = 0
+	progress.Matched = false
+	return errorsmod.Wrap(k.clientValidations.Set(ctx, clientID, progress), "set client validation")
+}
+
+func (k Keeper) ClientValidation(goCtx context.Context, req *types.QueryClientValidationRequest) (*types.QueryClientValidationResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	progress, err := k.clientValidations.Get(ctx, req.ClientId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, gerrc.ErrNotFound.Wrap("no pending validation for client")
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "get client validation")
+	}
+
+	res := &types.QueryClientValidationResponse{Progress: progress}
+	res.LatestRollappHeight, _ = k.rollappKeeper.GetLatestHeight(ctx, progress.RollappId)
+	if cs, ok := k.ibcClientKeeper.GetClientState(ctx, req.ClientId); ok {
+		res.LatestClientHeight = cs.GetLatestHeight().GetRevisionHeight()
+	}
+	return res, nil
+}
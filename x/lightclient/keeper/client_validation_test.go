@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+func pendingValidations(n int) []types.ClientValidationProgress {
+	ret := make([]types.ClientValidationProgress, n)
+	for i := range ret {
+		ret[i] = types.ClientValidationProgress{
+			ClientId:        fmt.Sprintf("client-%d", i),
+			RollappId:       "rollapp-1",
+			RollappRevision: 0,
+			ValidatedHeight: 10,
+			Matched:         true,
+		}
+	}
+	return ret
+}
+
+func TestNextPendingValidations(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+	k.InitGenesis(ctx, types.GenesisState{ClientValidations: pendingValidations(6)})
+
+	clients, err := k.NextPendingValidations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"client-0", "client-1", "client-2", "client-3"}, clients)
+
+	// continues after the cursor, and wraps around
+	require.NoError(t, k.SetValidationsCursor(ctx, "client-3"))
+	clients, err = k.NextPendingValidations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"client-4", "client-5", "client-0", "client-1"}, clients)
+
+	// the cursor client itself is included last when wrapping around
+	k, ctx = keepertest.LightClientKeeper(t)
+	k.InitGenesis(ctx, types.GenesisState{ClientValidations: pendingValidations(3)})
+	require.NoError(t, k.SetValidationsCursor(ctx, "client-1"))
+	clients, err = k.NextPendingValidations(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"client-2", "client-0", "client-1"}, clients)
+}
+
+func TestPendingValidationsLimit(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+
+	k.InitGenesis(ctx, types.GenesisState{ClientValidations: pendingValidations(types.MaxPendingValidationsPerRollapp - 1)})
+	require.NoError(t, k.CheckPendingValidationsLimit(ctx, "rollapp-1"))
+
+	k.InitGenesis(ctx, types.GenesisState{ClientValidations: pendingValidations(types.MaxPendingValidationsPerRollapp)})
+	require.ErrorIs(t, k.CheckPendingValidationsLimit(ctx, "rollapp-1"), gerrc.ErrResourceExhausted)
+
+	// clients of another rollapp do not count towards the limit
+	other := pendingValidations(types.MaxPendingValidationsPerRollapp - 1)
+	for i := range other {
+		other[i].ClientId = fmt.Sprintf("other-client-%d", i)
+		other[i].RollappId = "rollapp-2"
+	}
+	k.InitGenesis(ctx, types.GenesisState{ClientValidations: other})
+	require.NoError(t, k.CheckPendingValidationsLimit(ctx, "rollapp-2"))
+	require.ErrorIs(t, k.CheckPendingValidationsLimit(ctx, "rollapp-1"), gerrc.ErrResourceExhausted)
+}
+
+func TestResetClientValidation(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+	k.InitGenesis(ctx, types.GenesisState{ClientValidations: pendingValidations(1)})
+
+	progress := func() types.ClientValidationProgress {
+		validations := k.ExportGenesis(ctx).ClientValidations
+		require.Len(t, validations, 1)
+		return validations[0]
+	}
+
+	// a header of another rollapp does not reset the validation
+	require.NoError(t, k.ResetClientValidation(ctx, "client-0", "rollapp-2", 5))
+	require.Equal(t, uint64(10), progress().ValidatedHeight)
+
+	// a header above the validated height does not reset the validation
+	require.NoError(t, k.ResetClientValidation(ctx, "client-0", "rollapp-1", 11))
+	require.Equal(t, uint64(10), progress().ValidatedHeight)
+
+	require.NoError(t, k.ResetClientValidation(ctx, "client-0", "rollapp-1", 5))
+	require.Equal(t, uint64(0), progress().ValidatedHeight)
+	require.False(t, progress().Matched)
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// GetClientHealth returns how close the canonical client of the rollapp is to expiry.
+// A tm client expires when the trusting period has passed since the timestamp of its latest consensus state.
+func (k Keeper) GetClientHealth(ctx sdk.Context, rollappID string) (rollapptypes.ClientHealth, bool) {
+	clientID, ok := k.GetCanonicalClient(ctx, rollappID)
+	if !ok {
+		return rollapptypes.ClientHealth{}, false
+	}
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, clientID)
+	cs := getClientStateTM(clientStore, k.cdc)
+	if cs == nil {
+		return rollapptypes.ClientHealth{}, false
+	}
+	consState, ok := ibctm.GetConsensusState(clientStore, k.cdc, cs.GetLatestHeight())
+	if !ok {
+		return rollapptypes.ClientHealth{}, false
+	}
+
+	expiry := consState.Timestamp.Add(cs.TrustingPeriod)
+	return rollapptypes.ClientHealth{
+		ClientId: clientID,
+		Status:   string(cs.Status(ctx, clientStore, k.cdc)),
+		Expiry:   expiry,
+		TimeLeft: max(expiry.Sub(ctx.BlockTime()), 0),
+	}, true
+}
+
+// CheckClientExpiry emits a warning event for each canonical client whose time left before expiry
+// has dropped below an expiry warning threshold. A warning is emitted once per threshold, until the
+// client is updated. The clients are checked in a round-robin, up to MaxExpiryChecksPerBlock per block.
+func (k Keeper) CheckClientExpiry(ctx sdk.Context) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("Get params.", "err", err)
+		return
+	}
+	if len(params.ExpiryWarningThresholds) == 0 {
+		return
+	}
+
+	cursor, err := k.expiryCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		k.Logger(ctx).Error("Get client expiry cursor.", "err", err)
+		return
+	}
+	rollapps := k.nextExpiryChecks(ctx, cursor)
+	if len(rollapps) == 0 {
+		return
+	}
+	if last := rollapps[len(rollapps)-1]; last != cursor {
+		if err := k.expiryCursor.Set(ctx, last); err != nil {
+			k.Logger(ctx).Error("Set client expiry cursor.", "err", err)
+			return
+		}
+	}
+
+	for _, rollappID := range rollapps {
+		if err := k.checkClientExpiry(ctx, rollappID, params.ExpiryWarningThresholds); err != nil {
+			k.Logger(ctx).Error("Check client expiry.", "rollapp", rollappID, "err", err)
+		}
+	}
+}
+
+// nextExpiryChecks returns up to MaxExpiryChecksPerBlock rollapps with a canonical client, starting after
+// the cursor and wrapping around
+func (k Keeper) nextExpiryChecks(ctx sdk.Context, cursor string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RollappClientKey)
+	var rollapps []string
+	collect := func(start, end []byte) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close() // nolint: errcheck
+		for ; iterator.Valid() && len(rollapps) < types.MaxExpiryChecksPerBlock; iterator.Next() {
+			rollapps = append(rollapps, string(iterator.Key()))
+		}
+	}
+
+	// the key right after the cursor
+	next := append([]byte(cursor), 0)
+	collect(next, nil)
+	if cursor != "" {
+		// wrap around, up to the cursor included
+		collect(nil, next)
+	}
+	return rollapps
+}
+
+func (k Keeper) checkClientExpiry(ctx sdk.Context, rollappID string, thresholds []time.Duration) error {
+	health, ok := k.GetClientHealth(ctx, rollappID)
+	if !ok {
+		return nil
+	}
+
+	// the lowest threshold which the time left is below
+	crossed, ok := time.Duration(0), false
+	for _, t := range thresholds {
+		if health.TimeLeft <= t && (!ok || t < crossed) {
+			crossed, ok = t, true
+		}
+	}
+	prev, err := k.expiryWarnings.Get(ctx, rollappID)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(err, "get expiry warning")
+	}
+	found := err == nil
+
+	if !ok {
+		// the client has enough time left, for example because it was updated
+		if !found {
+			return nil
+		}
+		return k.expiryWarnings.Remove(ctx, rollappID)
+	}
+
+	if found && time.Duration(prev) == crossed {
+		return nil
+	}
+	if err := k.expiryWarnings.Set(ctx, rollappID, int64(crossed)); err != nil {
+		return errorsmod.Wrap(err, "set expiry warning")
+	}
+	if found && time.Duration(prev) < crossed {
+		// already warned for a lower threshold
+		return nil
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventClientExpiryWarning{
+		RollappId: rollappID,
+		ClientId:  health.ClientId,
+		Threshold: crossed,
+		TimeLeft:  health.TimeLeft,
+		Expiry:    health.Expiry,
+	})
+}
+
+func (k Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
+
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+func (k Keeper) ClientHealth(goCtx context.Context, req *types.QueryClientHealthRequest) (*types.QueryClientHealthResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	health, ok := k.GetClientHealth(ctx, req.RollappId)
+	if !ok {
+		return nil, gerrc.ErrNotFound.Wrap("canonical client")
+	}
+	return &types.QueryClientHealthResponse{Health: health}, nil
+}
+
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "get params")
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
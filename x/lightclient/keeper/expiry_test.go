@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+func TestNextExpiryChecks(t *testing.T) {
+	k, ctx := keepertest.LightClientKeeper(t)
+
+	n := types.MaxExpiryChecksPerBlock + 5
+	rollapps := make([]string, n)
+	for i := range rollapps {
+		rollapps[i] = fmt.Sprintf("rollapp_%02d-1", i)
+		k.SetCanonicalClient(ctx, rollapps[i], fmt.Sprintf("client-%d", i))
+	}
+
+	require.Equal(t, rollapps[:types.MaxExpiryChecksPerBlock], k.NextExpiryChecks(ctx, ""))
+
+	// continues after the cursor, and wraps around
+	cursor := rollapps[types.MaxExpiryChecksPerBlock-1]
+	expected := append(append([]string{}, rollapps[types.MaxExpiryChecksPerBlock:]...), rollapps[:types.MaxExpiryChecksPerBlock-5]...)
+	require.Equal(t, expected, k.NextExpiryChecks(ctx, cursor))
+
+	// the cursor rollapp itself is included last when wrapping around
+	k, ctx = keepertest.LightClientKeeper(t)
+	for _, rollappID := range rollapps[:3] {
+		k.SetCanonicalClient(ctx, rollappID, rollappID)
+	}
+	require.Equal(t, []string{rollapps[2], rollapps[0], rollapps[1]}, k.NextExpiryChecks(ctx, rollapps[1]))
+}
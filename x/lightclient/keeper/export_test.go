@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextPendingValidations returns the clients whose validation is continued in the next end block.
+func (k *Keeper) NextPendingValidations(ctx sdk.Context) ([]string, error) {
+	return k.nextPendingValidations(ctx)
+}
+
+// SetValidationsCursor sets the last client whose validation was continued in an end block.
+func (k *Keeper) SetValidationsCursor(ctx sdk.Context, clientID string) error {
+	return k.validationsCursor.Set(ctx, clientID)
+}
+
+// CheckPendingValidationsLimit fails if a new validation of a client of the rollapp cannot be pending.
+func (k Keeper) CheckPendingValidationsLimit(ctx sdk.Context, rollappID string) error {
+	return k.checkPendingValidationsLimit(ctx, rollappID)
+}
+
+// NextExpiryChecks returns the rollapps whose canonical client expiry is checked after the cursor.
+func (k Keeper) NextExpiryChecks(ctx sdk.Context, cursor string) []string {
+	return k.nextExpiryChecks(ctx, cursor)
+}
@@ -11,6 +11,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesisState types.GenesisState) {
 	if err := genesisState.Validate(); err != nil {
 		panic(err)
 	}
+	if err := k.SetParams(ctx, genesisState.Params); err != nil {
+		panic(err)
+	}
 	for _, client := range genesisState.GetCanonicalClients() {
 		k.SetCanonicalClient(ctx, client.RollappId, client.IbcClientId)
 	}
@@ -19,13 +22,29 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesisState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, progress := range genesisState.ClientValidations {
+		if err := k.clientValidations.Set(ctx, progress.ClientId, progress); err != nil {
+			panic(err)
+		}
+	}
+	for _, warning := range genesisState.ExpiryWarnings {
+		if err := k.expiryWarnings.Set(ctx, warning.RollappId, warning.Threshold); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 	clients := k.GetAllCanonicalClients(ctx)
 
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	ret := types.GenesisState{
 		CanonicalClients: clients,
+		Params:           params,
 	}
 
 	if err := k.headerSigners.Walk(ctx, nil,
@@ -39,5 +58,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) types.GenesisState {
 		}); err != nil {
 		panic(err)
 	}
+	if err := k.clientValidations.Walk(ctx, nil,
+		func(_ string, progress types.ClientValidationProgress) (stop bool, err error) {
+			ret.ClientValidations = append(ret.ClientValidations, progress)
+			return false, nil
+		}); err != nil {
+		panic(err)
+	}
+	if err := k.expiryWarnings.Walk(ctx, nil,
+		func(rollappID string, threshold int64) (stop bool, err error) {
+			ret.ExpiryWarnings = append(ret.ExpiryWarnings, types.ExpiryWarning{
+				RollappId: rollappID,
+				Threshold: threshold,
+			})
+			return false, nil
+		}); err != nil {
+		panic(err)
+	}
 	return ret
 }
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -70,6 +71,22 @@ func TestImportExportGenesis(t *testing.T) {
 				Height:           43,
 			},
 		},
+		ClientValidations: []types.ClientValidationProgress{
+			{
+				ClientId:        "client-3",
+				RollappId:       "rollapp-3",
+				RollappRevision: 1,
+				ValidatedHeight: 44,
+				Matched:         true,
+			},
+		},
+		Params: types.DefaultParams(),
+		ExpiryWarnings: []types.ExpiryWarning{
+			{
+				RollappId: "rollapp-1",
+				Threshold: int64(time.Hour),
+			},
+		},
 	}
 
 	k.InitGenesis(ctx, g)
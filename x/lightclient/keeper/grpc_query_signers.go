@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+)
+
+func (k Keeper) HeaderSigners(goCtx context.Context, req *types.QueryHeaderSignersRequest) (*types.QueryHeaderSignersResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	if req.ClientId == "" {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty client id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signers, pageRes, err := collcompat.CollectionPaginate(ctx, k.clientHeightToSigner, req.Pagination,
+		func(key collections.Pair[string, uint64], seqAddr string) (types.HeaderSignerEntry, error) {
+			return types.HeaderSignerEntry{
+				SequencerAddress: seqAddr,
+				ClientId:         key.K1(),
+				Height:           key.K2(),
+			}, nil
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](req.ClientId),
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "paginate signers")
+	}
+	return &types.QueryHeaderSignersResponse{Signers: signers, Pagination: pageRes}, nil
+}
+
+// UnbondBlockers returns the heights which make CanUnbond fail for the sequencer
+func (k Keeper) UnbondBlockers(goCtx context.Context, req *types.QueryUnbondBlockersRequest) (*types.QueryUnbondBlockersResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	seq, err := k.SeqK.RealSequencer(ctx, req.SequencerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get sequencer")
+	}
+
+	client, ok := k.GetCanonicalClient(ctx, seq.RollappId)
+	if !ok {
+		// nothing blocks unbonding
+		return &types.QueryUnbondBlockersResponse{}, nil
+	}
+
+	heights, pageRes, err := collcompat.CollectionPaginate(ctx, k.headerSigners, req.Pagination,
+		func(key collections.Triple[string, string, uint64], _ collections.NoValue) (uint64, error) {
+			return key.K3(), nil
+		}, collcompat.WithCollectionPaginationTripleSuperPrefix[string, string, uint64](seq.Address, client),
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInternal, err), "paginate heights")
+	}
+	return &types.QueryUnbondBlockersResponse{ClientId: client, Heights: heights, Pagination: pageRes}, nil
+}
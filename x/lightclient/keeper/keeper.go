@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -29,6 +30,15 @@ type enabled struct {
 	enabled bool
 }
 
+type clientValidationsIndex struct {
+	// <rollapp ID, client ID>
+	Rollapp *indexes.Multi[string, string, types.ClientValidationProgress]
+}
+
+func (i clientValidationsIndex) IndexesList() []collections.Index[string, types.ClientValidationProgress] {
+	return []collections.Index[string, types.ClientValidationProgress]{i.Rollapp}
+}
+
 type Keeper struct {
 	// if false, will not run the msg update client ante handler. Very hacky
 	// use to avoid problems in ibctesting.
@@ -41,10 +51,22 @@ type Keeper struct {
 	SeqK            types.SequencerKeeperExpected
 	rollappKeeper   types.RollappKeeperExpected
 
+	// the address capable of updating the module params, usually the gov module account
+	authority string
+	params    collections.Item[types.Params]
+	// <rollapp ID> -> lowest expiry warning threshold crossed by the canonical client, in nanoseconds
+	expiryWarnings collections.Map[string, int64]
+
 	// <sequencer addr,client ID, height>
 	headerSigners collections.KeySet[collections.Triple[string, string, uint64]]
 	// <client ID, height> -> <sequencer addr>
 	clientHeightToSigner collections.Map[collections.Pair[string, uint64], string]
+	// <client ID> -> validation progress of a prospective canonical client, indexed by rollapp ID
+	clientValidations *collections.IndexedMap[string, types.ClientValidationProgress, clientValidationsIndex]
+	// <client ID> of the last pending validation continued in an end block
+	validationsCursor collections.Item[string]
+	// <rollapp ID> of the last canonical client whose expiry was checked in a begin block
+	expiryCursor collections.Item[string]
 }
 
 func (k Keeper) Enabled() bool {
@@ -62,6 +84,7 @@ func NewKeeper(
 	ibcChannelK types.IBCChannelKeeperExpected,
 	sequencerKeeper types.SequencerKeeperExpected,
 	rollappKeeper types.RollappKeeperExpected,
+	authority string,
 ) *Keeper {
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
@@ -73,6 +96,20 @@ func NewKeeper(
 		ibcChannelK:     ibcChannelK,
 		SeqK:            sequencerKeeper,
 		rollappKeeper:   rollappKeeper,
+		authority:       authority,
+		params: collections.NewItem(
+			sb,
+			types.ParamsKey,
+			"params",
+			collcompat.ProtoValue[types.Params](cdc),
+		),
+		expiryWarnings: collections.NewMap(
+			sb,
+			types.ExpiryWarningsKey,
+			"expiry_warnings",
+			collections.StringKey,
+			collections.Int64Value,
+		),
 		headerSigners: collections.NewKeySet(
 			sb,
 			types.HeaderSignersPrefixKey,
@@ -86,6 +123,37 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 			collections.StringValue,
 		),
+		clientValidations: collections.NewIndexedMap(
+			sb,
+			types.ClientValidationsKey,
+			"client_validations",
+			collections.StringKey,
+			collcompat.ProtoValue[types.ClientValidationProgress](cdc),
+			clientValidationsIndex{
+				Rollapp: indexes.NewMulti(
+					sb,
+					types.ClientValidationsByRollappKey,
+					"client_validations_by_rollapp",
+					collections.StringKey,
+					collections.StringKey,
+					func(_ string, v types.ClientValidationProgress) (string, error) {
+						return v.RollappId, nil
+					},
+				),
+			},
+		),
+		validationsCursor: collections.NewItem(
+			sb,
+			types.ValidationsCursorKey,
+			"validations_cursor",
+			collections.StringValue,
+		),
+		expiryCursor: collections.NewItem(
+			sb,
+			types.ExpiryCursorKey,
+			"expiry_cursor",
+			collections.StringValue,
+		),
 	}
 	return k
 }
@@ -136,6 +204,39 @@ func (k Keeper) RemoveSigner(ctx sdk.Context, seqAddr string, client string, h u
 	)
 }
 
+// moveSigners moves the signer bookkeeping of all heights from one client to another
+func (k Keeper) moveSigners(ctx sdk.Context, from, to string) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](from)
+
+	seqs := make([]string, 0)
+	heights := make([]uint64, 0)
+
+	// collect first to avoid del while iterating
+	if err := k.clientHeightToSigner.Walk(ctx, rng, func(key collections.Pair[string, uint64], value string) (stop bool, err error) {
+		seqs = append(seqs, value)
+		heights = append(heights, key.K2())
+		return false, nil
+	}); err != nil {
+		return errorsmod.Wrap(err, "walk signers")
+	}
+
+	for i := 0; i < len(seqs); i++ {
+		if err := k.RemoveSigner(ctx, seqs[i], from, heights[i]); err != nil {
+			return errorsmod.Wrap(err, "remove signer")
+		}
+		// the destination may have a signer for the same height, which is now overwritten
+		if prev, err := k.GetSigner(ctx, to, heights[i]); err == nil {
+			if err := k.RemoveSigner(ctx, prev, to, heights[i]); err != nil {
+				return errorsmod.Wrap(err, "remove previous signer")
+			}
+		}
+		if err := k.SaveSigner(ctx, seqs[i], to, heights[i]); err != nil {
+			return errorsmod.Wrap(err, "save signer")
+		}
+	}
+	return nil
+}
+
 func (k Keeper) GetRollappForClientID(ctx sdk.Context, clientID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.CanonicalClientKey(clientID))
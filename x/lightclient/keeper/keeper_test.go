@@ -4,9 +4,11 @@ import (
 	"testing"
 
 	cometbftproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/dymensionxyz/sdk-utils/utils/utest"
 	"github.com/stretchr/testify/suite"
@@ -113,3 +115,62 @@ func (s *TestSuite) TestPruneBelow() {
 	err = s.k().CanUnbond(s.Ctx, seq)
 	s.Require().NoError(err)
 }
+
+func (s *TestSuite) TestQuerySigners() {
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	other := s.CreateDefaultSequencer(s.Ctx, rollappID)
+	client := keepertest.CanonClientID
+
+	for h := range 10 {
+		seq := proposer
+		if h%2 == 1 {
+			seq = other
+		}
+		err := s.k().SaveSigner(s.Ctx, seq, client, uint64(h))
+		s.Require().NoError(err)
+	}
+	// another client with a common prefix
+	err := s.k().SaveSigner(s.Ctx, proposer, client+"1", 1)
+	s.Require().NoError(err)
+
+	signers, err := s.k().HeaderSigners(s.Ctx, &types.QueryHeaderSignersRequest{
+		ClientId:   client,
+		Pagination: &query.PageRequest{Limit: 4},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(signers.Signers, 4)
+	for i, signer := range signers.Signers {
+		s.Equal(uint64(i), signer.Height)
+		s.Equal(client, signer.ClientId)
+	}
+	s.Equal(proposer, signers.Signers[0].SequencerAddress)
+	s.Equal(other, signers.Signers[1].SequencerAddress)
+
+	signers, err = s.k().HeaderSigners(s.Ctx, &types.QueryHeaderSignersRequest{
+		ClientId:   client,
+		Pagination: &query.PageRequest{Key: signers.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(signers.Signers, 6)
+	s.Equal(uint64(4), signers.Signers[0].Height)
+
+	// no canonical client, nothing blocks unbonding
+	blockers, err := s.k().UnbondBlockers(s.Ctx, &types.QueryUnbondBlockersRequest{SequencerAddress: other})
+	s.Require().NoError(err)
+	s.Empty(blockers.Heights)
+
+	s.k().SetCanonicalClient(s.Ctx, rollappID, client)
+
+	blockers, err = s.k().UnbondBlockers(s.Ctx, &types.QueryUnbondBlockersRequest{SequencerAddress: other})
+	s.Require().NoError(err)
+	s.Equal(client, blockers.ClientId)
+	s.Equal([]uint64{1, 3, 5, 7, 9}, blockers.Heights)
+
+	blockers, err = s.k().UnbondBlockers(s.Ctx, &types.QueryUnbondBlockersRequest{
+		SequencerAddress: proposer,
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Equal([]uint64{0, 2}, blockers.Heights)
+	s.Equal(uint64(5), blockers.Pagination.Total)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+var (
+	ErrNoMisbehaviour       = gerrc.ErrInvalidArgument.Wrap("headers do not constitute misbehaviour")
+	ErrAmbiguousAttribution = gerrc.ErrInvalidArgument.Wrap("conflicting headers are not signed by the same sequencer")
+)
+
+// HandleMisbehaviour treats misbehaviour on a canonical client as fraud evidence.
+// Rather than freezing the client (as ibc would do), the misbehaviour is verified against the client,
+// the sequencer who signed the conflicting headers is punished and the rollapp is hard forked to
+// the last height before the conflict. The rewardee receives part of the slashed bond.
+func (k Keeper) HandleMisbehaviour(ctx sdk.Context, clientID string, misbehaviour *ibctm.Misbehaviour, rewardee sdk.AccAddress) error {
+	rollappID, ok := k.GetRollappForClientID(ctx, clientID)
+	if !ok {
+		return gerrc.ErrInvalidArgument.Wrap("client is not canonical")
+	}
+
+	if err := misbehaviour.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "validate basic")
+	}
+
+	clientStore := k.ibcClientKeeper.ClientStore(ctx, clientID)
+	cs := getClientStateTM(clientStore, k.cdc)
+	if cs == nil {
+		return gerrc.ErrInternal.Wrap("canonical client is not tm client")
+	}
+
+	if status := cs.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return gerrc.ErrFailedPrecondition.Wrapf("client status: %s", status)
+	}
+
+	// checks the headers are correctly signed and trusted by the client
+	if err := cs.VerifyClientMessage(ctx, k.cdc, clientStore, misbehaviour); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "verify client message")
+	}
+
+	if !cs.CheckForMisbehaviour(ctx, k.cdc, clientStore, misbehaviour) {
+		return ErrNoMisbehaviour
+	}
+
+	seq, err := k.attributeMisbehaviour(ctx, rollappID, misbehaviour)
+	if err != nil {
+		return errorsmod.Wrap(err, "attribute misbehaviour")
+	}
+
+	// header 2 is guaranteed by validate basic to be not higher than header 1
+	h := misbehaviour.Header2.GetHeight().GetRevisionHeight()
+	lastValidHeight := h - 1
+
+	if err := k.SeqK.PunishSequencer(ctx, seq.Address, &rewardee); err != nil {
+		return errorsmod.Wrap(err, "punish sequencer")
+	}
+
+	// reverts pending states, bumps the revision and rolls back the canonical client
+	// will fail if the contested height is already finalized
+	if err := k.rollappKeeper.HardFork(ctx, rollappID, lastValidHeight); err != nil {
+		return errorsmod.Wrap(err, "hard fork")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventSequencerMisbehaviour{
+		RollappId:       rollappID,
+		ClientId:        clientID,
+		Sequencer:       seq.Address,
+		Height:          h,
+		LastValidHeight: lastValidHeight,
+	}); err != nil {
+		return errorsmod.Wrap(err, "emit typed event")
+	}
+
+	return nil
+}
+
+// attributeMisbehaviour finds the sequencer who signed both of the conflicting headers
+func (k Keeper) attributeMisbehaviour(ctx sdk.Context, rollappID string, misbehaviour *ibctm.Misbehaviour) (sequencertypes.Sequencer, error) {
+	rollapp, ok := k.rollappKeeper.GetRollapp(ctx, rollappID)
+	if !ok {
+		return sequencertypes.Sequencer{}, gerrc.ErrInternal.Wrapf("get rollapp: %s", rollappID)
+	}
+
+	var signers []sequencertypes.Sequencer
+	for _, header := range []*ibctm.Header{misbehaviour.Header1, misbehaviour.Header2} {
+		// evidence for previous revisions is stale: those heights were already reverted or finalized
+		if header.Header.Version.App != rollapp.LatestRevision().Number {
+			return sequencertypes.Sequencer{}, gerrc.ErrFailedPrecondition.Wrapf(
+				"header revision mismatch: h: %d", header.GetHeight().GetRevisionHeight())
+		}
+		seq, err := k.headerSigner(ctx, header)
+		if err != nil {
+			return sequencertypes.Sequencer{}, errorsmod.Wrapf(err, "header signer: h: %d", header.GetHeight().GetRevisionHeight())
+		}
+		if seq.RollappId != rollappID {
+			return sequencertypes.Sequencer{}, gerrc.ErrInvalidArgument.Wrap("header signer is not a sequencer of the rollapp")
+		}
+		signers = append(signers, seq)
+	}
+
+	if signers[0].Address != signers[1].Address {
+		return sequencertypes.Sequencer{}, ErrAmbiguousAttribution
+	}
+	return signers[0], nil
+}
+
+// headerSigner returns the sequencer who proposed and signed the header
+func (k Keeper) headerSigner(ctx sdk.Context, header *ibctm.Header) (sequencertypes.Sequencer, error) {
+	if header.ValidatorSet == nil || header.ValidatorSet.Proposer == nil || header.SignedHeader == nil || header.Header == nil {
+		return sequencertypes.Sequencer{}, gerrc.ErrInvalidArgument.Wrap("incomplete header")
+	}
+	proposerBySignature := header.ValidatorSet.Proposer.GetAddress()
+	proposerByData := header.Header.ProposerAddress
+	if !bytes.Equal(proposerBySignature, proposerByData) {
+		return sequencertypes.Sequencer{}, gerrc.ErrInvalidArgument.Wrap("validator set proposer not equal header proposer field")
+	}
+	return k.SeqK.SequencerByDymintAddr(ctx, proposerByData)
+}
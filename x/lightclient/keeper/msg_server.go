@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
 	"github.com/dymensionxyz/dymension/v3/x/lightclient/types"
 )
 
@@ -22,8 +25,54 @@ var _ types.MsgServer = msgServer{}
 func (m msgServer) SetCanonicalClient(goCtx context.Context, msg *types.MsgSetCanonicalClient) (*types.MsgSetCanonicalClientResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if err := m.Keeper.TrySetCanonicalClient(ctx, msg.ClientId); err != nil {
+	pending, err := m.Keeper.TrySetCanonicalClient(ctx, msg.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgSetCanonicalClientResponse{Pending: pending}, nil
+}
+
+func (m msgServer) SubmitMisbehaviour(goCtx context.Context, msg *types.MsgSubmitMisbehaviour) (*types.MsgSubmitMisbehaviourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
 	}
-	return &types.MsgSetCanonicalClientResponse{}, nil
+
+	misbehaviour, err := msg.GetTMMisbehaviour()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.Keeper.HandleMisbehaviour(ctx, msg.ClientId, misbehaviour, msg.GetSigners()[0]); err != nil {
+		return nil, err
+	}
+	return &types.MsgSubmitMisbehaviourResponse{}, nil
+}
+
+func (m msgServer) ReplaceCanonicalClient(goCtx context.Context, msg *types.MsgReplaceCanonicalClient) (*types.MsgReplaceCanonicalClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending, err := m.Keeper.TryReplaceCanonicalClient(ctx, msg.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgReplaceCanonicalClientResponse{Pending: pending}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != m.authority {
+		return nil, gerrc.ErrUnauthenticated.Wrapf("invalid authority; expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := m.SetParams(ctx, msg.NewParams); err != nil {
+		return nil, errorsmod.Wrap(err, "set params")
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
 }
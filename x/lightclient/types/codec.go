@@ -11,11 +11,17 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetCanonicalClient{}, "lightclient/SetCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgReplaceCanonicalClient{}, "lightclient/ReplaceCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgSubmitMisbehaviour{}, "lightclient/SubmitMisbehaviour", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "lightclient/UpdateParams", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetCanonicalClient{},
+		&MsgReplaceCanonicalClient{},
+		&MsgSubmitMisbehaviour{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 	registry.RegisterInterface(
@@ -7,15 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -129,9 +134,233 @@ func (m *EventSetCanonicalClient) GetClientId() string {
 	return ""
 }
 
+// When misbehaviour on a canonical client is accepted as evidence against a sequencer
+type EventSequencerMisbehaviour struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	ClientId  string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the punished sequencer
+	Sequencer string `protobuf:"bytes,3,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// the lowest height of the conflicting headers
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// the rollapp was hard forked to this height
+	LastValidHeight uint64 `protobuf:"varint,5,opt,name=last_valid_height,json=lastValidHeight,proto3" json:"last_valid_height,omitempty"`
+}
+
+func (m *EventSequencerMisbehaviour) Reset()         { *m = EventSequencerMisbehaviour{} }
+func (m *EventSequencerMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*EventSequencerMisbehaviour) ProtoMessage()    {}
+func (*EventSequencerMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95574d182027cfd8, []int{2}
+}
+func (m *EventSequencerMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSequencerMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSequencerMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSequencerMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSequencerMisbehaviour.Merge(m, src)
+}
+func (m *EventSequencerMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSequencerMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSequencerMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSequencerMisbehaviour proto.InternalMessageInfo
+
+func (m *EventSequencerMisbehaviour) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventSequencerMisbehaviour) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *EventSequencerMisbehaviour) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *EventSequencerMisbehaviour) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventSequencerMisbehaviour) GetLastValidHeight() uint64 {
+	if m != nil {
+		return m.LastValidHeight
+	}
+	return 0
+}
+
+// When an expired or frozen canonical client is replaced
+type EventReplaceCanonicalClient struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// the canonical client, which now has the state of the substitute
+	ClientId           string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	SubstituteClientId string `protobuf:"bytes,3,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty"`
+}
+
+func (m *EventReplaceCanonicalClient) Reset()         { *m = EventReplaceCanonicalClient{} }
+func (m *EventReplaceCanonicalClient) String() string { return proto.CompactTextString(m) }
+func (*EventReplaceCanonicalClient) ProtoMessage()    {}
+func (*EventReplaceCanonicalClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95574d182027cfd8, []int{3}
+}
+func (m *EventReplaceCanonicalClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReplaceCanonicalClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReplaceCanonicalClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReplaceCanonicalClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReplaceCanonicalClient.Merge(m, src)
+}
+func (m *EventReplaceCanonicalClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReplaceCanonicalClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReplaceCanonicalClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReplaceCanonicalClient proto.InternalMessageInfo
+
+func (m *EventReplaceCanonicalClient) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventReplaceCanonicalClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *EventReplaceCanonicalClient) GetSubstituteClientId() string {
+	if m != nil {
+		return m.SubstituteClientId
+	}
+	return ""
+}
+
+// When the time left before the canonical client of the rollapp expires drops below a warning threshold
+type EventClientExpiryWarning struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	ClientId  string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the crossed threshold
+	Threshold time.Duration `protobuf:"bytes,3,opt,name=threshold,proto3,stdduration" json:"threshold"`
+	TimeLeft  time.Duration `protobuf:"bytes,4,opt,name=time_left,json=timeLeft,proto3,stdduration" json:"time_left"`
+	// the client expires at this time unless it is updated
+	Expiry time.Time `protobuf:"bytes,5,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *EventClientExpiryWarning) Reset()         { *m = EventClientExpiryWarning{} }
+func (m *EventClientExpiryWarning) String() string { return proto.CompactTextString(m) }
+func (*EventClientExpiryWarning) ProtoMessage()    {}
+func (*EventClientExpiryWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95574d182027cfd8, []int{4}
+}
+func (m *EventClientExpiryWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClientExpiryWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClientExpiryWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClientExpiryWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClientExpiryWarning.Merge(m, src)
+}
+func (m *EventClientExpiryWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClientExpiryWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClientExpiryWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClientExpiryWarning proto.InternalMessageInfo
+
+func (m *EventClientExpiryWarning) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventClientExpiryWarning) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *EventClientExpiryWarning) GetThreshold() time.Duration {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *EventClientExpiryWarning) GetTimeLeft() time.Duration {
+	if m != nil {
+		return m.TimeLeft
+	}
+	return 0
+}
+
+func (m *EventClientExpiryWarning) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*EventSetCanonicalChannel)(nil), "dymensionxyz.dymension.lightclient.EventSetCanonicalChannel")
 	proto.RegisterType((*EventSetCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.EventSetCanonicalClient")
+	proto.RegisterType((*EventSequencerMisbehaviour)(nil), "dymensionxyz.dymension.lightclient.EventSequencerMisbehaviour")
+	proto.RegisterType((*EventReplaceCanonicalClient)(nil), "dymensionxyz.dymension.lightclient.EventReplaceCanonicalClient")
+	proto.RegisterType((*EventClientExpiryWarning)(nil), "dymensionxyz.dymension.lightclient.EventClientExpiryWarning")
 }
 
 func init() {
@@ -139,22 +368,38 @@ func init() {
 }
 
 var fileDescriptor_95574d182027cfd8 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4f, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0x42, 0x70, 0xf4, 0x73, 0x32, 0xd3, 0x33, 0x4a,
-	0x92, 0x73, 0x32, 0x53, 0xf3, 0x4a, 0xf4, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x94, 0x90, 0x35, 0xe8, 0xc1, 0x39, 0x7a, 0x48, 0x1a, 0xa4, 0x44, 0xd2,
-	0xf3, 0xd3, 0xf3, 0xc1, 0xca, 0xf5, 0x41, 0x2c, 0x88, 0x4e, 0xa5, 0x08, 0x2e, 0x09, 0x57, 0x90,
-	0x49, 0xc1, 0xa9, 0x25, 0xce, 0x89, 0x79, 0xf9, 0x79, 0x99, 0xc9, 0x89, 0x39, 0xce, 0x19, 0x89,
-	0x79, 0x79, 0xa9, 0x39, 0x42, 0xb2, 0x5c, 0x5c, 0x45, 0xf9, 0x39, 0x39, 0x89, 0x05, 0x05, 0xf1,
-	0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x9c, 0x50, 0x11, 0xcf, 0x14, 0x90, 0x74,
-	0x32, 0x44, 0x25, 0x48, 0x9a, 0x09, 0x22, 0x0d, 0x15, 0xf1, 0x4c, 0x51, 0x0a, 0xe5, 0x12, 0xc7,
-	0x34, 0x19, 0xec, 0x14, 0x42, 0x06, 0x4b, 0x73, 0x71, 0x42, 0xdc, 0x8c, 0x30, 0x97, 0x03, 0x22,
-	0xe0, 0x99, 0xe2, 0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x16,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xb8, 0x02, 0xb0, 0xcc, 0x58, 0xbf,
-	0x02, 0x25, 0x14, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x61, 0x61, 0x0c, 0x08, 0x00,
-	0x00, 0xff, 0xff, 0x42, 0x10, 0x45, 0x95, 0x78, 0x01, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0xc6, 0xa8, 0x1a, 0xef, 0x80, 0xb0, 0x26, 0x28, 0x1d, 0xa4, 0x53, 0x4e, 0x13, 0x87,
+	0x04, 0x6d, 0x17, 0x0e, 0x1c, 0x60, 0x65, 0x12, 0x95, 0xe0, 0x12, 0xfe, 0x8a, 0x4b, 0xe4, 0x24,
+	0xaf, 0x89, 0x25, 0xd7, 0x0e, 0xb1, 0x53, 0xb5, 0x7c, 0x05, 0x2e, 0x3b, 0xc2, 0x57, 0xe1, 0x13,
+	0xec, 0xb8, 0x23, 0x27, 0x40, 0xed, 0x17, 0x41, 0xb6, 0xd3, 0x65, 0x30, 0x21, 0x90, 0xb6, 0x5b,
+	0xde, 0xef, 0x5f, 0xfc, 0xec, 0xf7, 0x50, 0x98, 0x2d, 0xa6, 0xc0, 0x25, 0x15, 0x7c, 0xbe, 0xf8,
+	0xd8, 0x16, 0x21, 0xa3, 0x79, 0xa1, 0x52, 0x46, 0x81, 0xab, 0x10, 0x66, 0xc0, 0x95, 0x0c, 0xca,
+	0x4a, 0x28, 0x81, 0xfd, 0xf3, 0x86, 0xe0, 0xac, 0x08, 0xce, 0x19, 0x06, 0xdb, 0xb9, 0xc8, 0x85,
+	0x91, 0x87, 0xfa, 0xcb, 0x3a, 0x07, 0x5e, 0x2e, 0x44, 0xce, 0x20, 0x34, 0x55, 0x52, 0x4f, 0xc2,
+	0xac, 0xae, 0x88, 0xd2, 0x5e, 0xcb, 0x0f, 0xff, 0xe4, 0x15, 0x9d, 0x82, 0x54, 0x64, 0x5a, 0x5a,
+	0x81, 0xff, 0x0e, 0xf5, 0x8f, 0xf4, 0x51, 0x5e, 0x82, 0x1a, 0x11, 0x2e, 0x38, 0x4d, 0x09, 0x1b,
+	0x15, 0x84, 0x73, 0x60, 0xf8, 0x1e, 0x42, 0x95, 0x60, 0x8c, 0x94, 0x65, 0x4c, 0xb3, 0xbe, 0xb3,
+	0xeb, 0xec, 0xb9, 0x91, 0xdb, 0x20, 0xe3, 0x4c, 0xd3, 0xa9, 0x55, 0x6a, 0x7a, 0xc3, 0xd2, 0x0d,
+	0x32, 0xce, 0xfc, 0xd7, 0xe8, 0xf6, 0xc5, 0x64, 0xd3, 0xcb, 0xbf, 0x82, 0x77, 0x90, 0x6b, 0x9b,
+	0x6e, 0x73, 0x7b, 0x16, 0x18, 0x67, 0xfe, 0x57, 0x07, 0x0d, 0x9a, 0xdc, 0x0f, 0x35, 0xf0, 0x14,
+	0xaa, 0x17, 0x54, 0x26, 0x50, 0x90, 0x19, 0x15, 0x75, 0x75, 0x99, 0x68, 0x7c, 0x17, 0xb9, 0x72,
+	0x1d, 0xda, 0xbf, 0x66, 0xad, 0x67, 0x00, 0xbe, 0x85, 0xba, 0x05, 0xe8, 0x07, 0xe9, 0x6f, 0xee,
+	0x3a, 0x7b, 0x9b, 0x51, 0x53, 0xe1, 0xfb, 0xe8, 0x26, 0x23, 0x52, 0xc5, 0x33, 0xc2, 0x68, 0x16,
+	0x37, 0x92, 0xeb, 0x46, 0x72, 0x43, 0x13, 0x6f, 0x34, 0xfe, 0xcc, 0xc0, 0xfe, 0x27, 0x07, 0xed,
+	0x98, 0xc3, 0x47, 0x50, 0x32, 0x92, 0xc2, 0x15, 0x5e, 0x0c, 0x7e, 0x80, 0xb6, 0x65, 0x9d, 0x48,
+	0x45, 0x55, 0xad, 0x20, 0x6e, 0x75, 0xb6, 0x11, 0xdc, 0x72, 0xa3, 0xf5, 0x55, 0x7e, 0xd9, 0x68,
+	0x1e, 0xdf, 0x22, 0x47, 0xf3, 0x92, 0x56, 0x8b, 0xb7, 0xa4, 0xe2, 0x94, 0xe7, 0x97, 0x3a, 0xca,
+	0x13, 0xe4, 0xaa, 0xa2, 0x02, 0x59, 0x08, 0x66, 0xff, 0xbf, 0xb5, 0x7f, 0x27, 0xb0, 0x93, 0x18,
+	0xac, 0x27, 0x31, 0x78, 0xda, 0x4c, 0xea, 0x61, 0xef, 0xe4, 0xfb, 0xb0, 0xf3, 0xf9, 0xc7, 0xd0,
+	0x89, 0x5a, 0x17, 0x7e, 0x8c, 0x5c, 0x3d, 0xaa, 0x31, 0x83, 0x89, 0xbd, 0xf0, 0xff, 0x8c, 0xe8,
+	0x69, 0xd7, 0x73, 0x98, 0x28, 0xfc, 0x08, 0x75, 0xc1, 0x74, 0x64, 0x1e, 0x63, 0x6b, 0x7f, 0x70,
+	0xc1, 0xfe, 0x6a, 0xbd, 0x0b, 0xd6, 0x7f, 0xac, 0xfd, 0x8d, 0xe7, 0x30, 0x3a, 0x59, 0x7a, 0xce,
+	0xe9, 0xd2, 0x73, 0x7e, 0x2e, 0x3d, 0xe7, 0x78, 0xe5, 0x75, 0x4e, 0x57, 0x5e, 0xe7, 0xdb, 0xca,
+	0xeb, 0xbc, 0x7f, 0x98, 0x53, 0x55, 0xd4, 0x49, 0x90, 0x8a, 0xe9, 0xdf, 0x16, 0x7d, 0x76, 0x10,
+	0xce, 0x7f, 0xdb, 0x76, 0xb5, 0x28, 0x41, 0x26, 0x5d, 0xf3, 0xe7, 0x83, 0x5f, 0x03, 0x00, 0xbb,
+	0x4a, 0xa7, 0x26, 0x20, 0x04, 0x00, 0x00,
 }
 
 func (m *EventSetCanonicalChannel) Marshal() (dAtA []byte, err error) {
@@ -231,6 +476,165 @@ func (m *EventSetCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSequencerMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSequencerMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequencerMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastValidHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LastValidHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReplaceCanonicalClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReplaceCanonicalClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReplaceCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClientExpiryWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClientExpiryWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClientExpiryWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvents(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeLeft, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeLeft):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Threshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Threshold):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvents(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -256,33 +660,516 @@ func (m *EventSetCanonicalChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventSetCanonicalClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSequencerMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if m.LastValidHeight != 0 {
+		n += 1 + sovEvents(uint64(m.LastValidHeight))
+	}
+	return n
+}
+
+func (m *EventReplaceCanonicalClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SubstituteClientId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClientExpiryWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Threshold)
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeLeft)
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSetCanonicalChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetCanonicalChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetCanonicalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetCanonicalClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetCanonicalClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSequencerMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSequencerMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSequencerMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValidHeight", wireType)
+			}
+			m.LastValidHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastValidHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventSetCanonicalClient) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventSetCanonicalChannel) Unmarshal(dAtA []byte) error {
+func (m *EventReplaceCanonicalClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -305,10 +1192,10 @@ func (m *EventSetCanonicalChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetCanonicalChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReplaceCanonicalClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetCanonicalChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReplaceCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -345,7 +1232,7 @@ func (m *EventSetCanonicalChannel) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -373,7 +1260,39 @@ func (m *EventSetCanonicalChannel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -396,7 +1315,7 @@ func (m *EventSetCanonicalChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetCanonicalClient) Unmarshal(dAtA []byte) error {
+func (m *EventClientExpiryWarning) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -419,10 +1338,10 @@ func (m *EventSetCanonicalClient) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetCanonicalClient: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClientExpiryWarning: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClientExpiryWarning: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -489,6 +1408,105 @@ func (m *EventSetCanonicalClient) Unmarshal(dAtA []byte) error {
 			}
 			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Threshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeLeft, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1,8 +1,6 @@
 package types
 
 import (
-	"context"
-
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -16,6 +14,7 @@ import (
 type SequencerKeeperExpected interface {
 	SequencerByDymintAddr(ctx sdk.Context, addr cryptotypes.Address) (sequencertypes.Sequencer, error)
 	RealSequencer(ctx sdk.Context, addr string) (sequencertypes.Sequencer, error)
+	PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.AccAddress) error
 }
 
 type RollappKeeperExpected interface {
@@ -25,13 +24,14 @@ type RollappKeeperExpected interface {
 	GetLatestStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool)
 	SetRollapp(ctx sdk.Context, rollapp rollapptypes.Rollapp)
 	IsFirstHeightOfLatestFork(ctx sdk.Context, rollappId string, revision, height uint64) bool
+	HardFork(ctx sdk.Context, rollappID string, lastValidHeight uint64) error
 }
 
 type IBCClientKeeperExpected interface {
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
-	ConsensusStateHeights(c context.Context, req *ibcclienttypes.QueryConsensusStateHeightsRequest) (*ibcclienttypes.QueryConsensusStateHeightsResponse, error)
 	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	ClientUpdateProposal(ctx sdk.Context, p *ibcclienttypes.ClientUpdateProposal) error
 }
 
 type IBCChannelKeeperExpected interface {
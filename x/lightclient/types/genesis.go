@@ -5,10 +5,15 @@ import "fmt"
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		CanonicalClients: []CanonicalClient{},
+		Params:           DefaultParams(),
 	}
 }
 
 func (g GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return fmt.Errorf("params: %w", err)
+	}
+
 	for _, client := range g.CanonicalClients {
 		if client.RollappId == "" {
 			return fmt.Errorf("invalid rollapp id: %v", client)
@@ -18,5 +23,28 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	validations := make(map[string]struct{})
+	for _, progress := range g.ClientValidations {
+		if progress.ClientId == "" {
+			return fmt.Errorf("invalid client validation client id: %v", progress)
+		}
+		if progress.RollappId == "" {
+			return fmt.Errorf("invalid client validation rollapp id: %v", progress)
+		}
+		if _, ok := validations[progress.ClientId]; ok {
+			return fmt.Errorf("duplicate client validation: %v", progress)
+		}
+		validations[progress.ClientId] = struct{}{}
+	}
+
+	for _, warning := range g.ExpiryWarnings {
+		if warning.RollappId == "" {
+			return fmt.Errorf("invalid expiry warning rollapp id: %v", warning)
+		}
+		if warning.Threshold <= 0 {
+			return fmt.Errorf("invalid expiry warning threshold: %v", warning)
+		}
+	}
+
 	return nil
 }
@@ -23,7 +23,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Used for genesis import/export only
+// Used for genesis import/export and queries
 type HeaderSignerEntry struct {
 	// acc addr
 	SequencerAddress string `protobuf:"bytes,1,opt,name=sequencer_address,json=sequencerAddress,proto3" json:"sequencer_address,omitempty"`
@@ -86,8 +86,11 @@ func (m *HeaderSignerEntry) GetHeight() uint64 {
 }
 
 type GenesisState struct {
-	CanonicalClients []CanonicalClient   `protobuf:"bytes,1,rep,name=canonical_clients,json=canonicalClients,proto3" json:"canonical_clients"`
-	HeaderSigners    []HeaderSignerEntry `protobuf:"bytes,3,rep,name=header_signers,json=headerSigners,proto3" json:"header_signers"`
+	CanonicalClients  []CanonicalClient          `protobuf:"bytes,1,rep,name=canonical_clients,json=canonicalClients,proto3" json:"canonical_clients"`
+	HeaderSigners     []HeaderSignerEntry        `protobuf:"bytes,3,rep,name=header_signers,json=headerSigners,proto3" json:"header_signers"`
+	ClientValidations []ClientValidationProgress `protobuf:"bytes,4,rep,name=client_validations,json=clientValidations,proto3" json:"client_validations"`
+	Params            Params                     `protobuf:"bytes,5,opt,name=params,proto3" json:"params"`
+	ExpiryWarnings    []ExpiryWarning            `protobuf:"bytes,6,rep,name=expiry_warnings,json=expiryWarnings,proto3" json:"expiry_warnings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -137,6 +140,27 @@ func (m *GenesisState) GetHeaderSigners() []HeaderSignerEntry {
 	return nil
 }
 
+func (m *GenesisState) GetClientValidations() []ClientValidationProgress {
+	if m != nil {
+		return m.ClientValidations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetExpiryWarnings() []ExpiryWarning {
+	if m != nil {
+		return m.ExpiryWarnings
+	}
+	return nil
+}
+
 type CanonicalClient struct {
 	RollappId   string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 	IbcClientId string `protobuf:"bytes,2,opt,name=ibc_client_id,json=ibcClientId,proto3" json:"ibc_client_id,omitempty"`
@@ -189,10 +213,148 @@ func (m *CanonicalClient) GetIbcClientId() string {
 	return ""
 }
 
+// Progress of the incremental validation of a prospective canonical client.
+// Consensus states are validated in bounded chunks, across messages and end blocks.
+type ClientValidationProgress struct {
+	ClientId  string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// validation restarts if the rollapp forks
+	RollappRevision uint64 `protobuf:"varint,3,opt,name=rollapp_revision,json=rollappRevision,proto3" json:"rollapp_revision,omitempty"`
+	// all consensus states up to and including this height are validated
+	ValidatedHeight uint64 `protobuf:"varint,4,opt,name=validated_height,json=validatedHeight,proto3" json:"validated_height,omitempty"`
+	// at least one consensus state matched a state update
+	Matched bool `protobuf:"varint,5,opt,name=matched,proto3" json:"matched,omitempty"`
+}
+
+func (m *ClientValidationProgress) Reset()         { *m = ClientValidationProgress{} }
+func (m *ClientValidationProgress) String() string { return proto.CompactTextString(m) }
+func (*ClientValidationProgress) ProtoMessage()    {}
+func (*ClientValidationProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5520440548912168, []int{3}
+}
+func (m *ClientValidationProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientValidationProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientValidationProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientValidationProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientValidationProgress.Merge(m, src)
+}
+func (m *ClientValidationProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientValidationProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientValidationProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientValidationProgress proto.InternalMessageInfo
+
+func (m *ClientValidationProgress) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientValidationProgress) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ClientValidationProgress) GetRollappRevision() uint64 {
+	if m != nil {
+		return m.RollappRevision
+	}
+	return 0
+}
+
+func (m *ClientValidationProgress) GetValidatedHeight() uint64 {
+	if m != nil {
+		return m.ValidatedHeight
+	}
+	return 0
+}
+
+func (m *ClientValidationProgress) GetMatched() bool {
+	if m != nil {
+		return m.Matched
+	}
+	return false
+}
+
+// The lowest expiry warning threshold crossed by the canonical client of a rollapp.
+// Used to emit a warning only once per threshold.
+type ExpiryWarning struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// nanoseconds
+	Threshold int64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *ExpiryWarning) Reset()         { *m = ExpiryWarning{} }
+func (m *ExpiryWarning) String() string { return proto.CompactTextString(m) }
+func (*ExpiryWarning) ProtoMessage()    {}
+func (*ExpiryWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5520440548912168, []int{4}
+}
+func (m *ExpiryWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiryWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiryWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiryWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiryWarning.Merge(m, src)
+}
+func (m *ExpiryWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpiryWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiryWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiryWarning proto.InternalMessageInfo
+
+func (m *ExpiryWarning) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ExpiryWarning) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*HeaderSignerEntry)(nil), "dymensionxyz.dymension.lightclient.HeaderSignerEntry")
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.lightclient.GenesisState")
 	proto.RegisterType((*CanonicalClient)(nil), "dymensionxyz.dymension.lightclient.CanonicalClient")
+	proto.RegisterType((*ClientValidationProgress)(nil), "dymensionxyz.dymension.lightclient.ClientValidationProgress")
+	proto.RegisterType((*ExpiryWarning)(nil), "dymensionxyz.dymension.lightclient.ExpiryWarning")
 }
 
 func init() {
@@ -200,30 +362,42 @@ func init() {
 }
 
 var fileDescriptor_5520440548912168 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x4b, 0xfb, 0x40,
-	0x14, 0x4c, 0x7e, 0x2d, 0xe5, 0xd7, 0xad, 0xd5, 0x76, 0x11, 0x09, 0x8a, 0xb1, 0xe4, 0x54, 0x10,
-	0x12, 0xb1, 0x08, 0x5e, 0x6d, 0x11, 0xed, 0x35, 0xf5, 0xe4, 0x25, 0x24, 0x9b, 0x67, 0xb2, 0x90,
-	0xee, 0xc6, 0xec, 0x56, 0x1a, 0x3f, 0x85, 0x1f, 0xab, 0xc7, 0x1e, 0xc5, 0x83, 0x48, 0xfb, 0x45,
-	0x24, 0x7f, 0x2c, 0x6d, 0x45, 0xf4, 0xb6, 0x33, 0xfb, 0x86, 0x79, 0x33, 0x3c, 0x74, 0xe6, 0xa7,
-	0x63, 0x60, 0x82, 0x72, 0x36, 0x4d, 0x9f, 0xad, 0x15, 0xb0, 0x22, 0x1a, 0x84, 0x92, 0x44, 0x14,
-	0x98, 0xb4, 0x02, 0x60, 0x20, 0xa8, 0x30, 0xe3, 0x84, 0x4b, 0x8e, 0x8d, 0x75, 0x85, 0xb9, 0x02,
-	0xe6, 0x9a, 0xe2, 0x70, 0x3f, 0xe0, 0x01, 0xcf, 0xc7, 0xad, 0xec, 0x55, 0x28, 0x8d, 0x09, 0x6a,
-	0xdf, 0x82, 0xeb, 0x43, 0x32, 0xa2, 0x01, 0x83, 0xe4, 0x9a, 0xc9, 0x24, 0xc5, 0xa7, 0xa8, 0x2d,
-	0xe0, 0x71, 0x02, 0x8c, 0x40, 0xe2, 0xb8, 0xbe, 0x9f, 0x80, 0x10, 0x9a, 0xda, 0x51, 0xbb, 0x75,
-	0xbb, 0xb5, 0xfa, 0xb8, 0x2a, 0x78, 0x7c, 0x84, 0xea, 0x85, 0x83, 0x43, 0x7d, 0xed, 0x5f, 0x3e,
-	0xf4, 0xbf, 0x20, 0x86, 0x3e, 0x3e, 0x40, 0xb5, 0x10, 0xb2, 0x25, 0xb4, 0x4a, 0x47, 0xed, 0x56,
-	0xed, 0x12, 0x19, 0x6f, 0x2a, 0xda, 0xb9, 0x29, 0x22, 0x8c, 0xa4, 0x2b, 0x01, 0x3f, 0xa0, 0x36,
-	0x71, 0x19, 0x67, 0x94, 0xb8, 0x91, 0x53, 0xc8, 0x33, 0xcb, 0x4a, 0xb7, 0x71, 0xde, 0x33, 0x7f,
-	0x4f, 0x67, 0x0e, 0xbe, 0xc4, 0x83, 0x1c, 0xf7, 0xab, 0xb3, 0xf7, 0x13, 0xc5, 0x6e, 0x91, 0x4d,
-	0x5a, 0x60, 0x0f, 0xed, 0x86, 0x79, 0x5e, 0x47, 0xe4, 0x81, 0x85, 0x56, 0xc9, 0x4d, 0x2e, 0xfe,
-	0x62, 0xf2, 0xad, 0xa9, 0xd2, 0xa6, 0x19, 0xae, 0x7d, 0x08, 0xe3, 0x0e, 0xed, 0x6d, 0xad, 0x83,
-	0x8f, 0x11, 0x4a, 0x78, 0x14, 0xb9, 0x71, 0x9c, 0xb5, 0x54, 0x54, 0x59, 0x2f, 0x99, 0xa1, 0x8f,
-	0x0d, 0xd4, 0xa4, 0x1e, 0x71, 0xb6, 0x7b, 0x6c, 0x50, 0x8f, 0x0c, 0xca, 0x2a, 0xfb, 0xf6, 0x6c,
-	0xa1, 0xab, 0xf3, 0x85, 0xae, 0x7e, 0x2c, 0x74, 0xf5, 0x65, 0xa9, 0x2b, 0xf3, 0xa5, 0xae, 0xbc,
-	0x2e, 0x75, 0xe5, 0xfe, 0x32, 0xa0, 0x32, 0x9c, 0x78, 0x26, 0xe1, 0x63, 0xeb, 0x87, 0xd3, 0x79,
-	0xea, 0x59, 0xd3, 0x8d, 0xfb, 0x91, 0x69, 0x0c, 0xc2, 0xab, 0xe5, 0x47, 0xd0, 0xfb, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0x25, 0x65, 0x00, 0x72, 0x02, 0x00, 0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x0b, 0x62, 0x19, 0xa4, 0xc0, 0xc4, 0x98, 0x4d, 0xd5, 0x95, 0xec, 0x09, 0x35, 0xd9,
+	0xd5, 0x12, 0x13, 0x0f, 0x5e, 0x2c, 0x69, 0xa4, 0x89, 0x87, 0x66, 0x6b, 0x34, 0xf1, 0xb2, 0x0e,
+	0xbb, 0xe3, 0xee, 0x24, 0xcb, 0xcc, 0x76, 0x66, 0x40, 0xf0, 0x57, 0xf8, 0xab, 0x4c, 0x8f, 0x3d,
+	0x7a, 0x32, 0x06, 0x7e, 0x85, 0x37, 0xb3, 0x33, 0x03, 0x02, 0x4d, 0x53, 0x4e, 0xf0, 0xbe, 0xf7,
+	0xbe, 0xf7, 0xed, 0x7b, 0xef, 0xcb, 0x80, 0x17, 0xf1, 0x6c, 0x84, 0xa9, 0x20, 0x8c, 0x4e, 0x67,
+	0xdf, 0xfd, 0x55, 0xe0, 0x67, 0x24, 0x49, 0x65, 0x94, 0x11, 0x4c, 0xa5, 0x9f, 0x60, 0x8a, 0x05,
+	0x11, 0x5e, 0xce, 0x99, 0x64, 0xd0, 0x5d, 0x67, 0x78, 0xab, 0xc0, 0x5b, 0x63, 0x1c, 0xde, 0x4f,
+	0x58, 0xc2, 0x54, 0xb9, 0x5f, 0xfc, 0xd3, 0xcc, 0x43, 0x7f, 0x07, 0xad, 0x1c, 0x71, 0x34, 0x32,
+	0x52, 0xee, 0x18, 0xb4, 0x07, 0x18, 0xc5, 0x98, 0x9f, 0x93, 0x84, 0x62, 0x7e, 0x42, 0x25, 0x9f,
+	0xc1, 0xe7, 0xa0, 0x2d, 0xf0, 0xc5, 0x18, 0xd3, 0x08, 0xf3, 0x10, 0xc5, 0x31, 0xc7, 0x42, 0xd8,
+	0x56, 0xc7, 0xea, 0xd6, 0x82, 0xd6, 0x2a, 0xf1, 0x56, 0xe3, 0xf0, 0x21, 0xa8, 0xe9, 0xc6, 0x21,
+	0x89, 0xed, 0x3d, 0x55, 0xb4, 0xaf, 0x81, 0xd3, 0x18, 0x3e, 0x00, 0xd5, 0x14, 0x17, 0xda, 0x76,
+	0xb9, 0x63, 0x75, 0x2b, 0x81, 0x89, 0xdc, 0xbf, 0x65, 0x70, 0xef, 0x9d, 0x9e, 0xf9, 0x5c, 0x22,
+	0x89, 0xe1, 0x57, 0xd0, 0x8e, 0x10, 0x65, 0x94, 0x44, 0x28, 0x0b, 0x35, 0xbd, 0x90, 0x2c, 0x77,
+	0xeb, 0x47, 0x3d, 0xef, 0xf6, 0x75, 0x78, 0xfd, 0x25, 0xb9, 0xaf, 0xe2, 0xe3, 0xca, 0xe5, 0xef,
+	0x27, 0xa5, 0xa0, 0x15, 0x6d, 0xc2, 0x02, 0x0e, 0xc1, 0x41, 0xaa, 0xe6, 0x0d, 0x85, 0x1a, 0x58,
+	0xd8, 0x65, 0x25, 0xf2, 0x6a, 0x17, 0x91, 0x6b, 0x9b, 0x32, 0x32, 0x8d, 0x74, 0x2d, 0x21, 0xe0,
+	0x05, 0x80, 0x66, 0x23, 0x13, 0x94, 0x91, 0x18, 0x49, 0xc2, 0xa8, 0xb0, 0x2b, 0x4a, 0xe7, 0xcd,
+	0x4e, 0xc3, 0xa8, 0x9f, 0x8f, 0x2b, 0xf2, 0x19, 0x67, 0x49, 0xb1, 0x6b, 0x23, 0xd7, 0x8e, 0xb6,
+	0xf2, 0x02, 0x0e, 0x40, 0x55, 0x9f, 0xd5, 0xbe, 0xd3, 0xb1, 0xba, 0xf5, 0xa3, 0x67, 0xbb, 0xc8,
+	0x9c, 0x29, 0x86, 0x69, 0x6a, 0xf8, 0xf0, 0x0b, 0x68, 0xe2, 0x69, 0x4e, 0xf8, 0x2c, 0xfc, 0x86,
+	0x38, 0x25, 0x34, 0x11, 0x76, 0x55, 0x7d, 0xf9, 0xcb, 0x5d, 0x5a, 0x9e, 0x28, 0xea, 0x27, 0xcd,
+	0x34, 0x9d, 0x0f, 0xf0, 0x3a, 0x28, 0xdc, 0x0f, 0xa0, 0xb9, 0x75, 0x2d, 0xf8, 0x18, 0x00, 0xce,
+	0xb2, 0x0c, 0xe5, 0x79, 0x61, 0x22, 0xed, 0xb4, 0x9a, 0x41, 0x4e, 0x63, 0xe8, 0x82, 0x06, 0x19,
+	0x46, 0xe1, 0xb6, 0xcd, 0xea, 0x64, 0x18, 0xf5, 0x8d, 0xd3, 0xdc, 0x9f, 0x16, 0xb0, 0x6f, 0xda,
+	0xdb, 0xa6, 0x47, 0xad, 0x2d, 0x8f, 0x6e, 0x8a, 0xef, 0x6d, 0x8b, 0x3f, 0x05, 0xad, 0x65, 0x9a,
+	0xe3, 0x09, 0x29, 0x46, 0x36, 0x66, 0x6e, 0x1a, 0x3c, 0x30, 0x70, 0x51, 0x6a, 0x2e, 0x8e, 0xe3,
+	0xd0, 0xf8, 0xbe, 0xa2, 0x4b, 0x57, 0xf8, 0x40, 0xc1, 0xd0, 0x06, 0x77, 0x47, 0x48, 0x46, 0x29,
+	0x8e, 0xd5, 0xc5, 0xf6, 0x83, 0x65, 0xe8, 0xbe, 0x07, 0x8d, 0x8d, 0x2d, 0xde, 0xb6, 0x9c, 0x47,
+	0xa0, 0x26, 0x53, 0x8e, 0x45, 0xca, 0x32, 0xfd, 0xf5, 0xe5, 0xe0, 0x3f, 0x70, 0x1c, 0x5c, 0xce,
+	0x1d, 0xeb, 0x6a, 0xee, 0x58, 0x7f, 0xe6, 0x8e, 0xf5, 0x63, 0xe1, 0x94, 0xae, 0x16, 0x4e, 0xe9,
+	0xd7, 0xc2, 0x29, 0x7d, 0x7e, 0x9d, 0x10, 0x99, 0x8e, 0x87, 0x5e, 0xc4, 0x46, 0x37, 0xbd, 0x1a,
+	0x93, 0x9e, 0x3f, 0xdd, 0x78, 0x3a, 0xe4, 0x2c, 0xc7, 0x62, 0x58, 0x55, 0x4f, 0x47, 0xef, 0xdf,
+	0x00, 0x3e, 0x48, 0x71, 0x16, 0xd9, 0x04, 0x00, 0x00,
 }
 
 func (m *HeaderSignerEntry) Marshal() (dAtA []byte, err error) {
@@ -288,6 +462,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExpiryWarnings) > 0 {
+		for iNdEx := len(m.ExpiryWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiryWarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClientValidations) > 0 {
+		for iNdEx := len(m.ClientValidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientValidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.HeaderSigners) > 0 {
 		for iNdEx := len(m.HeaderSigners) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +568,98 @@ func (m *CanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientValidationProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientValidationProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientValidationProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Matched {
+		i--
+		if m.Matched {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ValidatedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValidatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RollappRevision != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RollappRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpiryWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiryWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiryWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -405,6 +709,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClientValidations) > 0 {
+		for _, e := range m.ClientValidations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExpiryWarnings) > 0 {
+		for _, e := range m.ExpiryWarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +743,48 @@ func (m *CanonicalClient) Size() (n int) {
 	return n
 }
 
+func (m *ClientValidationProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RollappRevision != 0 {
+		n += 1 + sovGenesis(uint64(m.RollappRevision))
+	}
+	if m.ValidatedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ValidatedHeight))
+	}
+	if m.Matched {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExpiryWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovGenesis(uint64(m.Threshold))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -661,19 +1021,120 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientValidations", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientValidations = append(m.ClientValidations, ClientValidationProgress{})
+			if err := m.ClientValidations[len(m.ClientValidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryWarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryWarnings = append(m.ExpiryWarnings, ExpiryWarning{})
+			if err := m.ExpiryWarnings[len(m.ExpiryWarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -796,6 +1257,279 @@ func (m *CanonicalClient) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClientValidationProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientValidationProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientValidationProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappRevision", wireType)
+			}
+			m.RollappRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollappRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatedHeight", wireType)
+			}
+			m.ValidatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matched", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Matched = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpiryWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiryWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiryWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
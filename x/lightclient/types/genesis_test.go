@@ -41,7 +41,16 @@ func TestGenesisValidate(t *testing.T) {
 			},
 			valid: false,
 		},
-
+		{
+			name: "duplicate client validation",
+			g: types.GenesisState{
+				ClientValidations: []types.ClientValidationProgress{
+					{ClientId: "client-1", RollappId: "rollapp-1"},
+					{ClientId: "client-1", RollappId: "rollapp-1", ValidatedHeight: 2},
+				},
+			},
+			valid: false,
+		},
 		{
 			name:  "empty",
 			g:     types.GenesisState{},
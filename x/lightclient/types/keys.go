@@ -16,6 +16,13 @@ var (
 	_                      = []byte{0x05}
 	HeaderSignersPrefixKey = collections.NewPrefix("headerSigners/")
 	ClientHeightToSigner   = collections.NewPrefix("clientHeightToSigner/")
+	ClientValidationsKey   = collections.NewPrefix("clientValidations/")
+	ValidationsCursorKey   = collections.NewPrefix("validationsCursor/")
+	ParamsKey              = collections.NewPrefix("params/")
+	ExpiryWarningsKey      = collections.NewPrefix("expiryWarnings/")
+	ExpiryCursorKey        = collections.NewPrefix("expiryCursor/")
+
+	ClientValidationsByRollappKey = collections.NewPrefix("clientValidationsByRollapp/")
 )
 
 func GetRollappClientKey(rollappId string) []byte {
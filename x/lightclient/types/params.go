@@ -148,3 +148,42 @@ func EqualICS23ProofSpecs(proofSpecs1, proofSpecs2 ics23.ProofSpec) bool {
 
 	return true
 }
+
+const (
+	// ValidationChunkSize is the max number of consensus states of a prospective canonical client
+	// validated by a single message, or for a single client in an end block
+	ValidationChunkSize = 64
+	// MaxPendingValidationsPerBlock is the max number of prospective canonical clients whose
+	// validation is continued in an end block
+	MaxPendingValidationsPerBlock = 4
+	// MaxPendingValidationsPerRollapp is the max number of prospective canonical clients of a rollapp
+	// whose validation is pending at the same time
+	MaxPendingValidationsPerRollapp = 10
+	// MaxExpiryChecksPerBlock is the max number of canonical clients whose expiry is checked in a
+	// begin block
+	MaxExpiryChecksPerBlock = 20
+)
+
+func DefaultParams() Params {
+	return Params{
+		ExpiryWarningThresholds: []time.Duration{
+			time.Hour * 24 * 3,
+			time.Hour * 24,
+			time.Hour * 6,
+		},
+	}
+}
+
+func (p Params) Validate() error {
+	seen := make(map[time.Duration]struct{})
+	for _, t := range p.ExpiryWarningThresholds {
+		if t <= 0 {
+			return fmt.Errorf("expiry warning threshold must be positive: %s", t)
+		}
+		if _, ok := seen[t]; ok {
+			return fmt.Errorf("duplicate expiry warning threshold: %s", t)
+		}
+		seen[t] = struct{}{}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/math"
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -99,3 +100,25 @@ func TestIsCanonicalClientParamsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		valid  bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"empty", types.Params{}, true},
+		{"zero threshold", types.Params{ExpiryWarningThresholds: []time.Duration{0}}, false},
+		{"negative threshold", types.Params{ExpiryWarningThresholds: []time.Duration{-time.Hour}}, false},
+		{"duplicate threshold", types.Params{ExpiryWarningThresholds: []time.Duration{time.Hour, time.Hour}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if err == nil != tc.valid {
+				t.Errorf("expected valid: %v, got: %v", tc.valid, err)
+			}
+		})
+	}
+}
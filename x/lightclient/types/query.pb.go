@@ -7,9 +7,11 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -298,490 +300,2529 @@ func (m *QueryRollappCanonChannelResponse) GetRollappChannelId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryGetLightClientRequest)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientRequest")
-	proto.RegisterType((*QueryGetLightClientResponse)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientResponse")
-	proto.RegisterType((*QueryExpectedClientStateRequest)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateRequest")
-	proto.RegisterType((*QueryExpectedClientStateResponse)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateResponse")
-	proto.RegisterType((*QueryRollappCanonChannelRequest)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelRequest")
-	proto.RegisterType((*QueryRollappCanonChannelResponse)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelResponse")
+type QueryClientValidationRequest struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/lightclient/query.proto", fileDescriptor_a51f5810cc34625d)
+func (m *QueryClientValidationRequest) Reset()         { *m = QueryClientValidationRequest{} }
+func (m *QueryClientValidationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientValidationRequest) ProtoMessage()    {}
+func (*QueryClientValidationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{6}
 }
-
-var fileDescriptor_a51f5810cc34625d = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
-	0x18, 0x6f, 0x26, 0x86, 0x98, 0x3b, 0x21, 0xe4, 0x4e, 0x02, 0xb2, 0x91, 0x0e, 0x8b, 0x03, 0x07,
-	0x14, 0x4b, 0xdb, 0x01, 0xa8, 0x60, 0xd5, 0xd6, 0x4d, 0xa8, 0x12, 0x07, 0x08, 0x37, 0x2e, 0x93,
-	0x93, 0x98, 0x24, 0x52, 0x6a, 0x67, 0x8d, 0x33, 0x35, 0xa0, 0x5d, 0x78, 0x02, 0x24, 0x5e, 0x80,
-	0x07, 0xe0, 0x41, 0x38, 0x4e, 0x82, 0x03, 0x27, 0x04, 0x2d, 0x12, 0x9c, 0x79, 0x02, 0x14, 0xdb,
-	0x6d, 0x82, 0x68, 0xa0, 0x83, 0x5b, 0xfc, 0xf9, 0xfb, 0x7e, 0x7f, 0x3e, 0xff, 0x02, 0x6c, 0x3f,
-	0x1f, 0x50, 0x96, 0x46, 0x9c, 0x8d, 0xf2, 0xe7, 0x78, 0x76, 0xc0, 0x71, 0x14, 0x84, 0xc2, 0x8b,
-	0x23, 0xca, 0x04, 0x3e, 0xca, 0xe8, 0x30, 0xb7, 0x93, 0x21, 0x17, 0x1c, 0xa2, 0x6a, 0x7f, 0x39,
-	0x6c, 0x57, 0xfa, 0xcd, 0xb5, 0x80, 0x07, 0x5c, 0xb6, 0xe3, 0xe2, 0x4b, 0x4d, 0x9a, 0x1b, 0x01,
-	0xe7, 0x41, 0x4c, 0x31, 0x49, 0x22, 0x4c, 0x18, 0xe3, 0x82, 0x88, 0x88, 0xb3, 0x54, 0xdf, 0x5e,
-	0xd5, 0xb7, 0xf2, 0xe4, 0x66, 0xcf, 0x30, 0x61, 0x9a, 0x12, 0xed, 0x02, 0xf3, 0x71, 0xa1, 0xe0,
-	0x01, 0x15, 0x0f, 0x0b, 0x96, 0x9e, 0x64, 0x71, 0xe8, 0x51, 0x46, 0x53, 0x01, 0xaf, 0x01, 0x30,
-	0xe4, 0x71, 0x4c, 0x92, 0xe4, 0x30, 0xf2, 0xaf, 0x18, 0x9b, 0xc6, 0xcd, 0x15, 0x67, 0x45, 0x57,
-	0xfa, 0x7e, 0xe7, 0xdc, 0xf7, 0x37, 0xed, 0x06, 0xea, 0x80, 0xf5, 0xb9, 0x10, 0x69, 0xc2, 0x59,
-	0x4a, 0xe1, 0x3a, 0x58, 0x51, 0xd2, 0x0b, 0x88, 0x25, 0x09, 0x71, 0x41, 0x15, 0xfa, 0x3e, 0xba,
-	0x0e, 0xda, 0x72, 0xf6, 0x60, 0x94, 0x50, 0x4f, 0x50, 0x5f, 0xcd, 0x3e, 0x11, 0x44, 0x50, 0xad,
-	0x01, 0x09, 0xb0, 0x59, 0xdf, 0xa2, 0x39, 0x1e, 0x81, 0x55, 0xcd, 0x91, 0x16, 0x75, 0x49, 0xd3,
-	0xdc, 0x5a, 0xb3, 0x95, 0x6f, 0x7b, 0xea, 0xdb, 0xde, 0x65, 0xf9, 0xde, 0xe5, 0x1f, 0x9f, 0xda,
-	0xad, 0x9c, 0x0c, 0xe2, 0x0e, 0xaa, 0xce, 0x20, 0xa7, 0xe9, 0x95, 0xc8, 0xa8, 0xab, 0x85, 0x39,
-	0xca, 0x6c, 0x8f, 0x30, 0xce, 0x7a, 0x21, 0x61, 0x8c, 0xc6, 0xd3, 0xe5, 0x6c, 0x80, 0x72, 0x15,
-	0xbf, 0xed, 0x06, 0x1d, 0x6b, 0xd9, 0x73, 0x01, 0xb4, 0xec, 0x1b, 0xe0, 0x62, 0x98, 0xb9, 0x87,
-	0x9e, 0x2a, 0x97, 0x2b, 0x5e, 0x0d, 0x33, 0x57, 0xf7, 0xf6, 0x7d, 0x78, 0x0b, 0xc0, 0xe9, 0x23,
-	0x54, 0x3a, 0xd5, 0x26, 0x2f, 0xe9, 0x9b, 0x59, 0xf7, 0xd6, 0xdb, 0x65, 0xb0, 0x2c, 0x89, 0xe1,
-	0x07, 0x03, 0x34, 0x2b, 0x0f, 0x02, 0x77, 0xec, 0xbf, 0xc7, 0xcb, 0xae, 0x0f, 0x83, 0xd9, 0xfd,
-	0xe7, 0x79, 0x65, 0x17, 0xed, 0xbf, 0x7c, 0xff, 0xf5, 0xf5, 0xd2, 0x0e, 0xbc, 0x87, 0x17, 0xf8,
-	0x2f, 0xaa, 0xdf, 0x2f, 0xca, 0x10, 0x9e, 0xc0, 0x2f, 0x06, 0x68, 0xcd, 0xc9, 0x02, 0xec, 0x2d,
-	0x2c, 0xaf, 0x3e, 0x6c, 0xe6, 0xfe, 0xff, 0x81, 0x68, 0xa3, 0x5d, 0x69, 0xf4, 0x2e, 0xbc, 0xbd,
-	0x88, 0x51, 0xaa, 0x81, 0xd4, 0x51, 0x66, 0x11, 0x7e, 0x33, 0x40, 0x6b, 0x4e, 0x70, 0xce, 0xe0,
-	0xb1, 0x3e, 0xb7, 0x67, 0xf0, 0xf8, 0x87, 0xec, 0xa2, 0x03, 0xe9, 0xb1, 0x0b, 0xef, 0x2f, 0xe2,
-	0xd1, 0x2b, 0x10, 0xa6, 0xe9, 0x9d, 0x3d, 0x67, 0xdf, 0x3f, 0xd9, 0x73, 0xde, 0x8d, 0x2d, 0xe3,
-	0x74, 0x6c, 0x19, 0x9f, 0xc7, 0x96, 0xf1, 0x6a, 0x62, 0x35, 0x4e, 0x27, 0x56, 0xe3, 0xe3, 0xc4,
-	0x6a, 0x3c, 0xbd, 0x13, 0x44, 0x22, 0xcc, 0x5c, 0xdb, 0xe3, 0x83, 0x3a, 0x8a, 0xe3, 0x6d, 0x3c,
-	0xfa, 0x85, 0x47, 0xe4, 0x09, 0x4d, 0xdd, 0xf3, 0xf2, 0x7f, 0xdf, 0xfe, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x03, 0xd6, 0x9a, 0xf1, 0x7f, 0x05, 0x00, 0x00,
+func (m *QueryClientValidationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientValidationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientValidationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClientValidationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientValidationRequest.Merge(m, src)
+}
+func (m *QueryClientValidationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientValidationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientValidationRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryClientValidationRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error)
-	ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error)
-	RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error)
+func (m *QueryClientValidationRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryClientValidationResponse struct {
+	Progress ClientValidationProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress"`
+	// validation continues until all consensus states below this height are validated
+	LatestRollappHeight uint64 `protobuf:"varint,2,opt,name=latest_rollapp_height,json=latestRollappHeight,proto3" json:"latest_rollapp_height,omitempty"`
+	// latest height of the client
+	LatestClientHeight uint64 `protobuf:"varint,3,opt,name=latest_client_height,json=latestClientHeight,proto3" json:"latest_client_height,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryClientValidationResponse) Reset()         { *m = QueryClientValidationResponse{} }
+func (m *QueryClientValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientValidationResponse) ProtoMessage()    {}
+func (*QueryClientValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{7}
 }
-
-func (c *queryClient) LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error) {
-	out := new(QueryGetLightClientResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/LightClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClientValidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientValidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryClientValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientValidationResponse.Merge(m, src)
+}
+func (m *QueryClientValidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientValidationResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error) {
-	out := new(QueryExpectedClientStateResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientValidationResponse proto.InternalMessageInfo
+
+func (m *QueryClientValidationResponse) GetProgress() ClientValidationProgress {
+	if m != nil {
+		return m.Progress
 	}
-	return out, nil
+	return ClientValidationProgress{}
 }
 
-func (c *queryClient) RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error) {
-	out := new(QueryRollappCanonChannelResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryClientValidationResponse) GetLatestRollappHeight() uint64 {
+	if m != nil {
+		return m.LatestRollappHeight
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	LightClient(context.Context, *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error)
-	ExpectedClientState(context.Context, *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error)
-	RollappCanonChannel(context.Context, *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error)
+func (m *QueryClientValidationResponse) GetLatestClientHeight() uint64 {
+	if m != nil {
+		return m.LatestClientHeight
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryClientHealthRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) LightClient(ctx context.Context, req *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LightClient not implemented")
+func (m *QueryClientHealthRequest) Reset()         { *m = QueryClientHealthRequest{} }
+func (m *QueryClientHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClientHealthRequest) ProtoMessage()    {}
+func (*QueryClientHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{8}
 }
-func (*UnimplementedQueryServer) ExpectedClientState(ctx context.Context, req *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExpectedClientState not implemented")
+func (m *QueryClientHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RollappCanonChannel(ctx context.Context, req *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RollappCanonChannel not implemented")
+func (m *QueryClientHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryClientHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientHealthRequest.Merge(m, src)
+}
+func (m *QueryClientHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientHealthRequest.DiscardUnknown(m)
 }
 
-func _Query_LightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLightClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LightClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Query/LightClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LightClient(ctx, req.(*QueryGetLightClientRequest))
+var xxx_messageInfo_QueryClientHealthRequest proto.InternalMessageInfo
+
+func (m *QueryClientHealthRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ExpectedClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExpectedClientStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExpectedClientState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExpectedClientState(ctx, req.(*QueryExpectedClientStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClientHealthResponse struct {
+	Health types1.ClientHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health"`
 }
 
-func _Query_RollappCanonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRollappCanonChannelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RollappCanonChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RollappCanonChannel(ctx, req.(*QueryRollappCanonChannelRequest))
+func (m *QueryClientHealthResponse) Reset()         { *m = QueryClientHealthResponse{} }
+func (m *QueryClientHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClientHealthResponse) ProtoMessage()    {}
+func (*QueryClientHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{9}
+}
+func (m *QueryClientHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClientHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClientHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.lightclient.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LightClient",
-			Handler:    _Query_LightClient_Handler,
-		},
-		{
-			MethodName: "ExpectedClientState",
-			Handler:    _Query_ExpectedClientState_Handler,
-		},
-		{
-			MethodName: "RollappCanonChannel",
-			Handler:    _Query_RollappCanonChannel_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/lightclient/query.proto",
+func (m *QueryClientHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClientHealthResponse.Merge(m, src)
+}
+func (m *QueryClientHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClientHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClientHealthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetLightClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClientHealthResponse proto.InternalMessageInfo
+
+func (m *QueryClientHealthResponse) GetHealth() types1.ClientHealth {
+	if m != nil {
+		return m.Health
 	}
-	return dAtA[:n], nil
+	return types1.ClientHealth{}
 }
 
-func (m *QueryGetLightClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryParamsRequest struct {
 }
 
-func (m *QueryGetLightClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{10}
 }
-
-func (m *QueryGetLightClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryGetLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{11}
 }
-
-func (m *QueryExpectedClientStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryExpectedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-
-func (m *QueryExpectedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExpectedClientStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryExpectedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryHeaderSignersRequest struct {
+	ClientId   string             `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryExpectedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ClientState != nil {
-		{
-			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryHeaderSignersRequest) Reset()         { *m = QueryHeaderSignersRequest{} }
+func (m *QueryHeaderSignersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderSignersRequest) ProtoMessage()    {}
+func (*QueryHeaderSignersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{12}
+}
+func (m *QueryHeaderSignersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderSignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderSignersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRollappCanonChannelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHeaderSignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderSignersRequest.Merge(m, src)
 }
-
-func (m *QueryRollappCanonChannelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHeaderSignersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderSignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderSignersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRollappCanonChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryHeaderSignersRequest proto.InternalMessageInfo
+
+func (m *QueryHeaderSignersRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRollappCanonChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHeaderSignersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRollappCanonChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryHeaderSignersResponse struct {
+	Signers    []HeaderSignerEntry `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRollappCanonChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappChannelId) > 0 {
-		i -= len(m.RollappChannelId)
-		copy(dAtA[i:], m.RollappChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappChannelId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.HubChannelId) > 0 {
-		i -= len(m.HubChannelId)
-		copy(dAtA[i:], m.HubChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HubChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryHeaderSignersResponse) Reset()         { *m = QueryHeaderSignersResponse{} }
+func (m *QueryHeaderSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderSignersResponse) ProtoMessage()    {}
+func (*QueryHeaderSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{13}
+}
+func (m *QueryHeaderSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHeaderSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderSignersResponse.Merge(m, src)
+}
+func (m *QueryHeaderSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderSignersResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryHeaderSignersResponse proto.InternalMessageInfo
+
+func (m *QueryHeaderSignersResponse) GetSigners() []HeaderSignerEntry {
+	if m != nil {
+		return m.Signers
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryGetLightClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryHeaderSignersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryGetLightClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryUnbondBlockersRequest struct {
+	SequencerAddress string             `protobuf:"bytes,1,opt,name=sequencer_address,json=sequencerAddress,proto3" json:"sequencer_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryExpectedClientStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondBlockersRequest) Reset()         { *m = QueryUnbondBlockersRequest{} }
+func (m *QueryUnbondBlockersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondBlockersRequest) ProtoMessage()    {}
+func (*QueryUnbondBlockersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{14}
+}
+func (m *QueryUnbondBlockersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondBlockersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondBlockersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryUnbondBlockersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondBlockersRequest.Merge(m, src)
+}
+func (m *QueryUnbondBlockersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondBlockersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondBlockersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExpectedClientStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryUnbondBlockersRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondBlockersRequest) GetSequencerAddress() string {
+	if m != nil {
+		return m.SequencerAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRollappCanonChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUnbondBlockersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryRollappCanonChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HubChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RollappChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryUnbondBlockersResponse struct {
+	// the canonical client of the sequencer's rollapp, empty if there is none
+	ClientId   string              `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Heights    []uint64            `protobuf:"varint,2,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryUnbondBlockersResponse) Reset()         { *m = QueryUnbondBlockersResponse{} }
+func (m *QueryUnbondBlockersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondBlockersResponse) ProtoMessage()    {}
+func (*QueryUnbondBlockersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a51f5810cc34625d, []int{15}
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryUnbondBlockersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondBlockersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondBlockersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondBlockersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondBlockersResponse.Merge(m, src)
+}
+func (m *QueryUnbondBlockersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondBlockersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondBlockersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondBlockersResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondBlockersResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryUnbondBlockersResponse) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (m *QueryUnbondBlockersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryGetLightClientRequest)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientRequest")
+	proto.RegisterType((*QueryGetLightClientResponse)(nil), "dymensionxyz.dymension.lightclient.QueryGetLightClientResponse")
+	proto.RegisterType((*QueryExpectedClientStateRequest)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateRequest")
+	proto.RegisterType((*QueryExpectedClientStateResponse)(nil), "dymensionxyz.dymension.lightclient.QueryExpectedClientStateResponse")
+	proto.RegisterType((*QueryRollappCanonChannelRequest)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelRequest")
+	proto.RegisterType((*QueryRollappCanonChannelResponse)(nil), "dymensionxyz.dymension.lightclient.QueryRollappCanonChannelResponse")
+	proto.RegisterType((*QueryClientValidationRequest)(nil), "dymensionxyz.dymension.lightclient.QueryClientValidationRequest")
+	proto.RegisterType((*QueryClientValidationResponse)(nil), "dymensionxyz.dymension.lightclient.QueryClientValidationResponse")
+	proto.RegisterType((*QueryClientHealthRequest)(nil), "dymensionxyz.dymension.lightclient.QueryClientHealthRequest")
+	proto.RegisterType((*QueryClientHealthResponse)(nil), "dymensionxyz.dymension.lightclient.QueryClientHealthResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.lightclient.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.lightclient.QueryParamsResponse")
+	proto.RegisterType((*QueryHeaderSignersRequest)(nil), "dymensionxyz.dymension.lightclient.QueryHeaderSignersRequest")
+	proto.RegisterType((*QueryHeaderSignersResponse)(nil), "dymensionxyz.dymension.lightclient.QueryHeaderSignersResponse")
+	proto.RegisterType((*QueryUnbondBlockersRequest)(nil), "dymensionxyz.dymension.lightclient.QueryUnbondBlockersRequest")
+	proto.RegisterType((*QueryUnbondBlockersResponse)(nil), "dymensionxyz.dymension.lightclient.QueryUnbondBlockersResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/lightclient/query.proto", fileDescriptor_a51f5810cc34625d)
+}
+
+var fileDescriptor_a51f5810cc34625d = []byte{
+	// 1097 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x61, 0x6f, 0xdb, 0x44,
+	0x18, 0xae, 0xdb, 0xd2, 0xad, 0x6f, 0xbb, 0xa9, 0x5c, 0x8a, 0xc8, 0xdc, 0x2e, 0x0d, 0x16, 0x82,
+	0xaa, 0x54, 0xf6, 0x96, 0x09, 0xc6, 0x4a, 0xd7, 0x2c, 0x4d, 0xbb, 0x36, 0x08, 0x89, 0xe2, 0x69,
+	0x7c, 0xe0, 0x03, 0xd1, 0x25, 0x3e, 0x1c, 0x0b, 0xe7, 0xec, 0xd9, 0x4e, 0xd5, 0x50, 0x55, 0x42,
+	0xfc, 0x02, 0x10, 0x9f, 0x91, 0xf8, 0x03, 0xfc, 0x03, 0x7e, 0xc0, 0x3e, 0x4e, 0x1a, 0x20, 0x3e,
+	0x4d, 0x90, 0x82, 0xe0, 0x33, 0xbf, 0x00, 0xe5, 0xee, 0x9c, 0xd8, 0x99, 0xad, 0x39, 0xe9, 0x3e,
+	0xc5, 0xbe, 0xbb, 0xe7, 0x79, 0x9f, 0xe7, 0x3d, 0xdf, 0x3d, 0x2d, 0xa8, 0x46, 0xb7, 0x4d, 0xa8,
+	0x6f, 0x39, 0xf4, 0xa4, 0xfb, 0x95, 0x36, 0x78, 0xd1, 0x6c, 0xcb, 0x6c, 0x05, 0x4d, 0xdb, 0x22,
+	0x34, 0xd0, 0x1e, 0x75, 0x88, 0xd7, 0x55, 0x5d, 0xcf, 0x09, 0x1c, 0xa4, 0x44, 0xd7, 0x0f, 0xc1,
+	0x6a, 0x64, 0xbd, 0xbc, 0x6c, 0x3a, 0xa6, 0xc3, 0x96, 0x6b, 0xfd, 0x27, 0x8e, 0x94, 0x57, 0x4d,
+	0xc7, 0x31, 0x6d, 0xa2, 0x61, 0xd7, 0xd2, 0x30, 0xa5, 0x4e, 0x80, 0x03, 0xcb, 0xa1, 0xbe, 0x98,
+	0xbd, 0x26, 0x66, 0xd9, 0x5b, 0xa3, 0xf3, 0x85, 0x86, 0xa9, 0x28, 0x29, 0x6f, 0x34, 0x1d, 0xbf,
+	0xed, 0xf8, 0x5a, 0x03, 0xfb, 0x84, 0x6b, 0xd1, 0x8e, 0x6f, 0x36, 0x48, 0x80, 0x6f, 0x6a, 0x2e,
+	0x36, 0x2d, 0xca, 0x78, 0xc4, 0xda, 0x1b, 0x19, 0xec, 0x98, 0x84, 0x12, 0xdf, 0x0a, 0x0b, 0x6b,
+	0x19, 0x10, 0x2e, 0xf6, 0x70, 0x3b, 0x04, 0x6c, 0xa6, 0x00, 0x3c, 0xc7, 0xb6, 0xb1, 0xeb, 0x86,
+	0xbf, 0x7c, 0xb5, 0x52, 0x01, 0xf9, 0x93, 0xbe, 0xe4, 0x03, 0x12, 0x7c, 0xd4, 0x67, 0xac, 0x32,
+	0x46, 0x9d, 0x3c, 0xea, 0x10, 0x3f, 0x40, 0xd7, 0x01, 0xc4, 0xf2, 0xba, 0x65, 0xe4, 0xa5, 0xa2,
+	0xb4, 0x3e, 0xaf, 0xcf, 0x8b, 0x91, 0x9a, 0xb1, 0x35, 0xfb, 0xef, 0x8f, 0x6b, 0x53, 0xca, 0x16,
+	0xac, 0x24, 0x52, 0xf8, 0xae, 0x43, 0x7d, 0x82, 0x56, 0x60, 0x9e, 0xcb, 0xec, 0x53, 0x4c, 0x33,
+	0x8a, 0xcb, 0x7c, 0xa0, 0x66, 0x28, 0x6f, 0xc0, 0x1a, 0xc3, 0xee, 0x9f, 0xb8, 0xa4, 0x19, 0x10,
+	0x83, 0x63, 0x1f, 0x04, 0x38, 0x20, 0x42, 0x83, 0x12, 0x40, 0x31, 0x7d, 0x89, 0xa8, 0x71, 0x04,
+	0x8b, 0xa2, 0x86, 0xdf, 0x1f, 0x67, 0x65, 0x16, 0x4a, 0xcb, 0x2a, 0xdf, 0x34, 0x35, 0xdc, 0x34,
+	0xb5, 0x42, 0xbb, 0xbb, 0xaf, 0xff, 0xf7, 0x6c, 0x2d, 0xd7, 0xc5, 0x6d, 0x7b, 0x4b, 0x89, 0x62,
+	0x14, 0x7d, 0xa1, 0x39, 0x64, 0x56, 0xca, 0x42, 0x98, 0xce, 0xcd, 0x56, 0x31, 0x75, 0x68, 0xb5,
+	0x85, 0x29, 0x25, 0x76, 0xd8, 0x9c, 0x55, 0x18, 0xb6, 0xe2, 0xb9, 0xde, 0x28, 0xc7, 0x50, 0x4c,
+	0x27, 0x10, 0xb2, 0xdf, 0x84, 0xab, 0xad, 0x4e, 0xa3, 0xde, 0xe4, 0xc3, 0xc3, 0x16, 0x2f, 0xb6,
+	0x3a, 0x0d, 0xb1, 0xb6, 0x66, 0xa0, 0x4d, 0x40, 0xe1, 0x26, 0x44, 0x56, 0xf2, 0x4e, 0x2e, 0x89,
+	0x99, 0xc1, 0x6a, 0xe5, 0x03, 0x58, 0x65, 0x75, 0x79, 0x9b, 0x3e, 0xc5, 0xb6, 0x65, 0xb0, 0x0f,
+	0x30, 0x54, 0x1d, 0xdb, 0x0e, 0x69, 0x64, 0x3b, 0xfe, 0x96, 0xe0, 0x7a, 0x0a, 0x5a, 0x48, 0xfe,
+	0x1c, 0x2e, 0xbb, 0x9e, 0x63, 0x7a, 0xc4, 0xf7, 0x19, 0x7a, 0xa1, 0xb4, 0xad, 0xbe, 0xf8, 0xc8,
+	0xa9, 0xa3, 0x7c, 0x47, 0x82, 0x63, 0x77, 0xf6, 0xf1, 0xb3, 0xb5, 0x29, 0x7d, 0xc0, 0x89, 0x4a,
+	0xf0, 0x9a, 0x8d, 0x03, 0xe2, 0x07, 0xf5, 0xd0, 0x73, 0x8b, 0xf4, 0x79, 0x98, 0xdf, 0x59, 0x3d,
+	0xc7, 0x27, 0x45, 0x53, 0x0f, 0xd9, 0x14, 0xba, 0x01, 0xcb, 0x02, 0x23, 0x9c, 0x09, 0xc8, 0x0c,
+	0x83, 0x20, 0x3e, 0xc7, 0x15, 0x70, 0x84, 0x72, 0x07, 0xf2, 0x11, 0x9b, 0x87, 0x04, 0xdb, 0x41,
+	0x2b, 0xdb, 0x37, 0xaf, 0x98, 0x70, 0x2d, 0x01, 0x2a, 0xba, 0xf3, 0x21, 0xcc, 0xb5, 0xd8, 0x88,
+	0xe8, 0xcd, 0x66, 0x5a, 0x6f, 0xc2, 0x43, 0x18, 0x65, 0x11, 0xbd, 0x10, 0x0c, 0xca, 0x32, 0x20,
+	0x56, 0xe8, 0x88, 0x1d, 0xee, 0xf0, 0x34, 0xd4, 0x21, 0x17, 0x1b, 0x15, 0x85, 0x0f, 0x61, 0x8e,
+	0x5f, 0x02, 0xa2, 0xf0, 0x46, 0x96, 0x4d, 0xe1, 0x1c, 0x61, 0x59, 0x8e, 0x57, 0xbe, 0x96, 0x84,
+	0xc1, 0x43, 0x82, 0x0d, 0xe2, 0x3d, 0xb0, 0x4c, 0x4a, 0x3c, 0x3f, 0xcb, 0xd7, 0x83, 0xee, 0x03,
+	0x0c, 0x2f, 0x3c, 0x71, 0x06, 0xdf, 0x52, 0xf9, 0xed, 0xa8, 0xf6, 0x6f, 0x47, 0x95, 0xdf, 0xd4,
+	0xe2, 0x76, 0x54, 0x8f, 0xb0, 0x19, 0x9e, 0x72, 0x3d, 0x82, 0x54, 0x7e, 0x96, 0x40, 0x4e, 0x92,
+	0x20, 0xbc, 0x3e, 0x84, 0x4b, 0x3e, 0x1f, 0xca, 0x4b, 0xc5, 0x99, 0xf5, 0x85, 0xd2, 0xbb, 0x59,
+	0xcc, 0x46, 0xb9, 0xf6, 0x69, 0xe0, 0x75, 0x85, 0xef, 0x90, 0x0b, 0x1d, 0x24, 0xa8, 0x7f, 0xfb,
+	0x85, 0xea, 0xb9, 0xa6, 0x98, 0xfc, 0xef, 0x42, 0xf9, 0x0f, 0x69, 0xc3, 0xa1, 0xc6, 0xae, 0xed,
+	0x34, 0xbf, 0x8c, 0xb4, 0xf0, 0x1d, 0x78, 0xd5, 0xef, 0x3f, 0xd2, 0x26, 0xf1, 0xea, 0xd8, 0x30,
+	0x06, 0x47, 0x69, 0x5e, 0x5f, 0x1a, 0x4c, 0x54, 0xf8, 0xf8, 0x4b, 0x6b, 0xe9, 0x0f, 0x12, 0xac,
+	0x24, 0x6a, 0x4a, 0xba, 0xa4, 0x47, 0xf7, 0x35, 0x0f, 0x97, 0xf8, 0x89, 0xf2, 0xf3, 0xd3, 0xc5,
+	0x99, 0xf5, 0x59, 0x3d, 0x7c, 0x1d, 0xe9, 0xd9, 0xcc, 0xc4, 0x3d, 0x2b, 0xfd, 0x7a, 0x05, 0x5e,
+	0x61, 0xfa, 0xd0, 0x2f, 0x12, 0x2c, 0x44, 0x62, 0x04, 0xed, 0x64, 0xd9, 0xdc, 0xf4, 0x08, 0x93,
+	0xcb, 0x13, 0xe3, 0xb9, 0x4c, 0x65, 0xef, 0x9b, 0xa7, 0x7f, 0x7d, 0x3f, 0xbd, 0x83, 0xb6, 0xb3,
+	0x24, 0x71, 0xf4, 0xf9, 0x74, 0x78, 0x8d, 0x9c, 0xa1, 0x3f, 0x25, 0xc8, 0x25, 0x24, 0x18, 0xaa,
+	0x66, 0x96, 0x97, 0x1e, 0x91, 0xf2, 0xde, 0xc5, 0x48, 0x84, 0xd1, 0x32, 0x33, 0x7a, 0x07, 0xdd,
+	0xce, 0x62, 0x94, 0x08, 0x22, 0xfe, 0xca, 0x12, 0x14, 0xfd, 0x23, 0x41, 0x2e, 0x21, 0xee, 0xc6,
+	0xf0, 0x98, 0x9e, 0xb6, 0xf2, 0xde, 0xc5, 0x48, 0x84, 0xc7, 0x7d, 0xe6, 0xb1, 0x8c, 0xee, 0x66,
+	0xf1, 0xd8, 0xec, 0x33, 0x84, 0x99, 0x3b, 0xd8, 0xce, 0x9a, 0x71, 0x86, 0x7a, 0x12, 0x2c, 0x8d,
+	0x46, 0x1a, 0xba, 0x97, 0x59, 0x61, 0x4a, 0x36, 0xcb, 0x95, 0x0b, 0x30, 0x08, 0x83, 0x35, 0x66,
+	0xb0, 0x8a, 0x2a, 0x99, 0x0c, 0xb2, 0x9f, 0xfa, 0xf1, 0x80, 0x46, 0x3b, 0x1d, 0xdc, 0x02, 0x67,
+	0xe8, 0xa9, 0x04, 0x8b, 0xd1, 0x7c, 0x42, 0xdb, 0x63, 0xca, 0x8b, 0xe5, 0xaa, 0x7c, 0x77, 0x42,
+	0xb4, 0x30, 0x76, 0x9f, 0x19, 0xbb, 0x87, 0x76, 0xc6, 0x30, 0xc6, 0x93, 0x34, 0x7e, 0x10, 0x7f,
+	0x92, 0x60, 0x8e, 0x07, 0x1f, 0x7a, 0x2f, 0xb3, 0xa2, 0x58, 0x06, 0xcb, 0xb7, 0xc7, 0xc6, 0x09,
+	0x0f, 0x25, 0xe6, 0x61, 0x13, 0x6d, 0x64, 0xff, 0xa3, 0x1e, 0xfd, 0x26, 0xc1, 0x95, 0x58, 0x0e,
+	0xa2, 0xec, 0x8d, 0x4c, 0x8a, 0x70, 0x79, 0x67, 0x52, 0xf8, 0x24, 0x1b, 0xd1, 0x62, 0x14, 0x75,
+	0x91, 0xb1, 0xb1, 0xcf, 0xab, 0x27, 0xc1, 0xd5, 0x78, 0x1a, 0x8d, 0x71, 0xd7, 0x27, 0x46, 0xab,
+	0x5c, 0x9e, 0x18, 0x2f, 0xbc, 0x7d, 0xcc, 0xbc, 0xd5, 0xd0, 0x41, 0x16, 0x6f, 0x1d, 0xc6, 0x51,
+	0x6f, 0x08, 0x12, 0xed, 0xf4, 0xb9, 0x58, 0x3f, 0xdb, 0xd5, 0x1f, 0xf7, 0x0a, 0xd2, 0x93, 0x5e,
+	0x41, 0xfa, 0xa3, 0x57, 0x90, 0xbe, 0x3d, 0x2f, 0x4c, 0x3d, 0x39, 0x2f, 0x4c, 0xfd, 0x7e, 0x5e,
+	0x98, 0xfa, 0xec, 0x7d, 0xd3, 0x0a, 0x5a, 0x9d, 0x86, 0xda, 0x74, 0xda, 0x69, 0xc5, 0x8e, 0x6f,
+	0x69, 0x27, 0xb1, 0x8a, 0x41, 0xd7, 0x25, 0x7e, 0x63, 0x8e, 0xfd, 0x3b, 0x73, 0xeb, 0xff, 0x01,
+	0x00, 0xef, 0x1b, 0x5d, 0xd0, 0x1b, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error)
+	ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error)
+	RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error)
+	ClientValidation(ctx context.Context, in *QueryClientValidationRequest, opts ...grpc.CallOption) (*QueryClientValidationResponse, error)
+	ClientHealth(ctx context.Context, in *QueryClientHealthRequest, opts ...grpc.CallOption) (*QueryClientHealthResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Which sequencer signed each header of the client which is still pending verification
+	HeaderSigners(ctx context.Context, in *QueryHeaderSignersRequest, opts ...grpc.CallOption) (*QueryHeaderSignersResponse, error)
+	// Heights of the canonical client headers, signed by the sequencer and pending verification,
+	// which prevent the sequencer from unbonding
+	UnbondBlockers(ctx context.Context, in *QueryUnbondBlockersRequest, opts ...grpc.CallOption) (*QueryUnbondBlockersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) LightClient(ctx context.Context, in *QueryGetLightClientRequest, opts ...grpc.CallOption) (*QueryGetLightClientResponse, error) {
+	out := new(QueryGetLightClientResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/LightClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExpectedClientState(ctx context.Context, in *QueryExpectedClientStateRequest, opts ...grpc.CallOption) (*QueryExpectedClientStateResponse, error) {
+	out := new(QueryExpectedClientStateResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RollappCanonChannel(ctx context.Context, in *QueryRollappCanonChannelRequest, opts ...grpc.CallOption) (*QueryRollappCanonChannelResponse, error) {
+	out := new(QueryRollappCanonChannelResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientValidation(ctx context.Context, in *QueryClientValidationRequest, opts ...grpc.CallOption) (*QueryClientValidationResponse, error) {
+	out := new(QueryClientValidationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/ClientValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClientHealth(ctx context.Context, in *QueryClientHealthRequest, opts ...grpc.CallOption) (*QueryClientHealthResponse, error) {
+	out := new(QueryClientHealthResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/ClientHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeaderSigners(ctx context.Context, in *QueryHeaderSignersRequest, opts ...grpc.CallOption) (*QueryHeaderSignersResponse, error) {
+	out := new(QueryHeaderSignersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/HeaderSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondBlockers(ctx context.Context, in *QueryUnbondBlockersRequest, opts ...grpc.CallOption) (*QueryUnbondBlockersResponse, error) {
+	out := new(QueryUnbondBlockersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.lightclient.Query/UnbondBlockers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	LightClient(context.Context, *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error)
+	ExpectedClientState(context.Context, *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error)
+	RollappCanonChannel(context.Context, *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error)
+	ClientValidation(context.Context, *QueryClientValidationRequest) (*QueryClientValidationResponse, error)
+	ClientHealth(context.Context, *QueryClientHealthRequest) (*QueryClientHealthResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Which sequencer signed each header of the client which is still pending verification
+	HeaderSigners(context.Context, *QueryHeaderSignersRequest) (*QueryHeaderSignersResponse, error)
+	// Heights of the canonical client headers, signed by the sequencer and pending verification,
+	// which prevent the sequencer from unbonding
+	UnbondBlockers(context.Context, *QueryUnbondBlockersRequest) (*QueryUnbondBlockersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) LightClient(ctx context.Context, req *QueryGetLightClientRequest) (*QueryGetLightClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LightClient not implemented")
+}
+func (*UnimplementedQueryServer) ExpectedClientState(ctx context.Context, req *QueryExpectedClientStateRequest) (*QueryExpectedClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpectedClientState not implemented")
+}
+func (*UnimplementedQueryServer) RollappCanonChannel(ctx context.Context, req *QueryRollappCanonChannelRequest) (*QueryRollappCanonChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollappCanonChannel not implemented")
+}
+func (*UnimplementedQueryServer) ClientValidation(ctx context.Context, req *QueryClientValidationRequest) (*QueryClientValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientValidation not implemented")
+}
+func (*UnimplementedQueryServer) ClientHealth(ctx context.Context, req *QueryClientHealthRequest) (*QueryClientHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientHealth not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) HeaderSigners(ctx context.Context, req *QueryHeaderSignersRequest) (*QueryHeaderSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderSigners not implemented")
+}
+func (*UnimplementedQueryServer) UnbondBlockers(ctx context.Context, req *QueryUnbondBlockersRequest) (*QueryUnbondBlockersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondBlockers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_LightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLightClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LightClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/LightClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LightClient(ctx, req.(*QueryGetLightClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExpectedClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpectedClientStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpectedClientState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/ExpectedClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpectedClientState(ctx, req.(*QueryExpectedClientStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RollappCanonChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRollappCanonChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RollappCanonChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/RollappCanonChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RollappCanonChannel(ctx, req.(*QueryRollappCanonChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientValidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/ClientValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientValidation(ctx, req.(*QueryClientValidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClientHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClientHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClientHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/ClientHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClientHealth(ctx, req.(*QueryClientHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeaderSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderSignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/HeaderSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderSigners(ctx, req.(*QueryHeaderSignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondBlockers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondBlockersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondBlockers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.lightclient.Query/UnbondBlockers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondBlockers(ctx, req.(*QueryUnbondBlockersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.lightclient.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LightClient",
+			Handler:    _Query_LightClient_Handler,
+		},
+		{
+			MethodName: "ExpectedClientState",
+			Handler:    _Query_ExpectedClientState_Handler,
+		},
+		{
+			MethodName: "RollappCanonChannel",
+			Handler:    _Query_RollappCanonChannel_Handler,
+		},
+		{
+			MethodName: "ClientValidation",
+			Handler:    _Query_ClientValidation_Handler,
+		},
+		{
+			MethodName: "ClientHealth",
+			Handler:    _Query_ClientHealth_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HeaderSigners",
+			Handler:    _Query_HeaderSigners_Handler,
+		},
+		{
+			MethodName: "UnbondBlockers",
+			Handler:    _Query_UnbondBlockers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/lightclient/query.proto",
+}
+
+func (m *QueryGetLightClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLightClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLightClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetLightClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpectedClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpectedClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpectedClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		{
+			size, err := m.ClientState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappCanonChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappCanonChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappCanonChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRollappCanonChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRollappCanonChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRollappCanonChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappChannelId) > 0 {
+		i -= len(m.RollappChannelId)
+		copy(dAtA[i:], m.RollappChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HubChannelId) > 0 {
+		i -= len(m.HubChannelId)
+		copy(dAtA[i:], m.HubChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HubChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientValidationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientValidationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientValidationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LatestClientHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestClientHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LatestRollappHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestRollappHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClientHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClientHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClientHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderSignersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderSignersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderSignersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondBlockersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondBlockersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondBlockersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SequencerAddress) > 0 {
+		i -= len(m.SequencerAddress)
+		copy(dAtA[i:], m.SequencerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SequencerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondBlockersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondBlockersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondBlockersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Heights) > 0 {
+		dAtA10 := make([]byte, len(m.Heights)*10)
+		var j9 int
+		for _, num := range m.Heights {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetLightClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLightClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpectedClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExpectedClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappCanonChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappCanonChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HubChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RollappChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientValidationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Progress.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LatestRollappHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LatestRollappHeight))
+	}
+	if m.LatestClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LatestClientHeight))
+	}
+	return n
+}
+
+func (m *QueryClientHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClientHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Health.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeaderSignersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeaderSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondBlockersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SequencerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondBlockersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLightClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLightClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpectedClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpectedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &types.Any{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientValidationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientValidationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientValidationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientValidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientValidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestRollappHeight", wireType)
+			}
+			m.LatestRollappHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestRollappHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestClientHeight", wireType)
+			}
+			m.LatestClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestClientHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClientHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClientHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClientHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClientHealthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -804,17 +2845,17 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLightClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClientHealthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClientHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -824,23 +2865,24 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -863,7 +2905,7 @@ func (m *QueryGetLightClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -886,17 +2928,67 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLightClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -906,23 +2998,24 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -945,7 +3038,7 @@ func (m *QueryGetLightClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderSignersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -968,12 +3061,80 @@ func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExpectedClientStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderSignersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExpectedClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderSignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -995,7 +3156,7 @@ func (m *QueryExpectedClientStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderSignersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1018,15 +3179,49 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExpectedClientStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderSignersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExpectedClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, HeaderSignerEntry{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1053,10 +3248,10 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &types.Any{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1081,7 +3276,7 @@ func (m *QueryExpectedClientStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondBlockersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,15 +3299,15 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondBlockersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondBlockersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1140,7 +3335,43 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.SequencerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1163,7 +3394,7 @@ func (m *QueryRollappCanonChannelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnbondBlockersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1186,15 +3417,15 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnbondBlockersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRollappCanonChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnbondBlockersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,13 +3453,89 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HubChannelId = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1238,23 +3545,27 @@ func (m *QueryRollappCanonChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappChannelId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,19 +1,33 @@
 package types
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 )
 
 const (
-	TypeMsgSetCanonicalClient = "set_canonical_client"
+	TypeMsgSetCanonicalClient     = "set_canonical_client"
+	TypeMsgReplaceCanonicalClient = "replace_canonical_client"
+	TypeMsgUpdateParams           = "update_params"
 )
 
 var (
-	_ sdk.Msg            = &MsgSetCanonicalClient{}
-	_ legacytx.LegacyMsg = &MsgSetCanonicalClient{}
+	_ sdk.Msg                            = &MsgSetCanonicalClient{}
+	_ legacytx.LegacyMsg                 = &MsgSetCanonicalClient{}
+	_ sdk.Msg                            = &MsgReplaceCanonicalClient{}
+	_ legacytx.LegacyMsg                 = &MsgReplaceCanonicalClient{}
+	_ sdk.Msg                            = &MsgSubmitMisbehaviour{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg                 = &MsgUpdateParams{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitMisbehaviour{}
 )
 
 func NewMsgUpdateState(signer, client string) *MsgSetCanonicalClient {
@@ -54,3 +68,128 @@ func (msg *MsgSetCanonicalClient) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgReplaceCanonicalClient(signer, client string) *MsgReplaceCanonicalClient {
+	return &MsgReplaceCanonicalClient{
+		Signer:   signer,
+		ClientId: client,
+	}
+}
+
+func (msg *MsgReplaceCanonicalClient) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgReplaceCanonicalClient) Type() string {
+	return TypeMsgReplaceCanonicalClient
+}
+
+func (msg *MsgReplaceCanonicalClient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgReplaceCanonicalClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgReplaceCanonicalClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid creator address (%s)", err)
+	}
+	if msg.ClientId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty client id")
+	}
+	return nil
+}
+
+func NewMsgSubmitMisbehaviour(signer, client string, misbehaviour *ibctm.Misbehaviour) (*MsgSubmitMisbehaviour, error) {
+	anyMisbehaviour, err := ibcclienttypes.PackClientMessage(misbehaviour)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitMisbehaviour{
+		Signer:       signer,
+		ClientId:     client,
+		Misbehaviour: anyMisbehaviour,
+	}, nil
+}
+
+func (msg *MsgSubmitMisbehaviour) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitMisbehaviour) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid creator address (%s)", err)
+	}
+	if msg.ClientId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("empty client id")
+	}
+	m, err := msg.GetTMMisbehaviour()
+	if err != nil {
+		return err
+	}
+	if err := m.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "misbehaviour")
+	}
+	return nil
+}
+
+// GetTMMisbehaviour returns the unpacked tendermint misbehaviour
+func (msg *MsgSubmitMisbehaviour) GetTMMisbehaviour() (*ibctm.Misbehaviour, error) {
+	clientMessage, err := ibcclienttypes.UnpackClientMessage(msg.Misbehaviour)
+	if err != nil {
+		return nil, errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "unpack client message")
+	}
+	m, ok := clientMessage.(*ibctm.Misbehaviour)
+	if !ok {
+		return nil, gerrc.ErrInvalidArgument.Wrap("not tm misbehaviour")
+	}
+	return m, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitMisbehaviour) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var misbehaviour exported.ClientMessage
+	return unpacker.UnpackAny(msg.Misbehaviour, &misbehaviour)
+}
+
+func (msg *MsgUpdateParams) Route() string {
+	return ModuleName
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "invalid authority address (%s)", err)
+	}
+	if err := msg.NewParams.Validate(); err != nil {
+		return errorsmod.Wrap(errors.Join(gerrc.ErrInvalidArgument, err), "params")
+	}
+	return nil
+}
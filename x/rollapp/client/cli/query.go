@@ -27,6 +27,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowLatestHeight())
 	cmd.AddCommand(CmdShowLatestStateIndex())
 	cmd.AddCommand(CmdQueryRegisteredDenoms())
+	cmd.AddCommand(CmdQueryRateLimits())
+	cmd.AddCommand(CmdQueryPausedBridges())
 
 	return cmd
 }
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdQueryPausedBridges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paused-bridges",
+		Short: "Lists the rollapps whose bridge is paused by a circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PausedBridges(cmd.Context(), &types.QueryPausedBridgesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
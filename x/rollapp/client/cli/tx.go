@@ -25,6 +25,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddApp())
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
+	cmd.AddCommand(CmdSetRateLimit())
+	cmd.AddCommand(CmdRemoveRateLimit())
+	cmd.AddCommand(CmdPauseBridge())
+	cmd.AddCommand(CmdUnpauseBridge())
 
 	return cmd
 }
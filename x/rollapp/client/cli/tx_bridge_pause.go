@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdPauseBridge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-bridge [rollapp-id] [duration]",
+		Short:   "Pause IBC transfers and eIBC fulfillment for a rollapp. Must be signed by a circuit breaker",
+		Example: "dymd tx rollapp pause-bridge ROLLAPP_CHAIN_ID 12h",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid duration: %w", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPauseBridge{
+				Authority: clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Duration:  duration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnpauseBridge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause-bridge [rollapp-id]",
+		Short:   "Lift the pause of the bridge of a rollapp before it expires. Must be signed by a circuit breaker",
+		Example: "dymd tx rollapp unpause-bridge ROLLAPP_CHAIN_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnpauseBridge{
+				Authority: clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rate-limit [rollapp-id] [denom] [max-inflow] [max-outflow] [window]",
+		Short:   "Set the IBC transfer rate limit of a rollapp denom. Zero max inflow or outflow means no limit",
+		Example: "dymd tx rollapp set-rate-limit ROLLAPP_CHAIN_ID ibc/9A1EACD53A6A197ADC81DF9A49F0C4A26F7FF685ACF415EE726D7D59796E71A7 1000000000 0 24h",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argRollappId, argDenom := args[0], args[1]
+
+			maxInflow, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max inflow: %s", args[2])
+			}
+			maxOutflow, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid max outflow: %s", args[3])
+			}
+			window, err := time.ParseDuration(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid window: %w", err)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetRateLimit{
+				Authority: clientCtx.GetFromAddress().String(),
+				RateLimit: types.NewRateLimit(argRollappId, argDenom, maxInflow, maxOutflow, window),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rate-limit [rollapp-id] [denom]",
+		Short:   "Remove the IBC transfer rate limit of a rollapp denom",
+		Example: "dymd tx rollapp remove-rate-limit ROLLAPP_CHAIN_ID ibc/9A1EACD53A6A197ADC81DF9A49F0C4A26F7FF685ACF415EE726D7D59796E71A7",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveRateLimit{
+				Authority: clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Denom:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -59,6 +59,23 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	// Set all the rate limits and their flows
+	for _, elem := range genState.RateLimits {
+		if err := k.SetRateLimit(ctx, elem.RateLimit); err != nil {
+			panic(err)
+		}
+		if err := k.SetRateLimitFlow(ctx, elem.RateLimit.RollappId, elem.RateLimit.Denom, elem.Flow); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the bridge pauses
+	for _, elem := range genState.BridgePauses {
+		if err := k.SetBridgePause(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
 	k.SetParams(ctx, genState.Params)
 }
 
@@ -108,5 +125,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.ObsoleteDrsVersions = drsVersions
 
+	genesis.RateLimits, err = k.GetAllRateLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	genesis.BridgePauses, err = k.GetAllBridgePauses(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
@@ -17,6 +17,7 @@ type RollappKeeper interface {
 
 type RollappKeeperMinimal interface {
 	GetRollappByPortChan(ctx sdk.Context, raPortOnHub, raChanOnHub string) (*rollapptypes.Rollapp, error)
+	ValidateBridgeNotPaused(ctx sdk.Context, rollappID string) error
 }
 
 type DenomMetadataKeeper interface {
@@ -25,6 +25,8 @@ import (
 // Before the genesis bridge protocol completes, no transfers are allowed to the rollapp.
 // The Hub will block transfers Hub->RA to enforce this.
 //
+// No transfers are allowed in either direction while the rollapp bridge is paused by a circuit breaker.
+//
 // Important: it is now WRONG to open an ibc connection in the Rollapp->Hub direction.
 // Connections should be opened in the Hub->Rollapp direction only
 type IBCModule struct {
@@ -87,6 +89,11 @@ func (w IBCModule) OnRecvPacket(
 		return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "get rollapp id"))
 	}
 
+	// no transfers while the bridge is paused by a circuit breaker, including the genesis transfer
+	if err := w.rollappKeeper.ValidateBridgeNotPaused(ctx, ra.RollappId); err != nil {
+		return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "genesis bridge"))
+	}
+
 	// skip the genesis bridge if the rollapp already has transfers enabled
 	if ra.IsTransferEnabled() {
 		return w.IBCModule.OnRecvPacket(ctx, packet, relayer)
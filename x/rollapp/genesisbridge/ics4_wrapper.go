@@ -75,5 +75,8 @@ func (w *ICS4Wrapper) transferAllowed(ctx sdk.Context, sourcePort string, source
 	if !ra.GenesisState.IsTransferEnabled() {
 		return gerrc.ErrFailedPrecondition.Wrap("transfers disabled for rollapp")
 	}
+	if err := w.rollappK.ValidateBridgeNotPaused(ctx, ra.RollappId); err != nil {
+		return err
+	}
 	return nil
 }
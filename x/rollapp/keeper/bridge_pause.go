@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// SetBridgePause creates or replaces the pause of the bridge of the rollapp.
+func (k Keeper) SetBridgePause(ctx sdk.Context, pause types.BridgePause) error {
+	return k.bridgePauses.Set(ctx, pause.RollappId, pause)
+}
+
+// GetBridgePause returns the pause of the bridge of the rollapp, even if it expired.
+func (k Keeper) GetBridgePause(ctx sdk.Context, rollappID string) (types.BridgePause, error) {
+	pause, err := k.bridgePauses.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.BridgePause{}, errorsmod.Wrapf(gerrc.ErrNotFound, "bridge pause: rollapp: %s", rollappID)
+	}
+	return pause, err
+}
+
+// RemoveBridgePause lifts the pause of the bridge of the rollapp.
+func (k Keeper) RemoveBridgePause(ctx sdk.Context, rollappID string) error {
+	return k.bridgePauses.Remove(ctx, rollappID)
+}
+
+// IsBridgePaused returns true if the bridge of the rollapp is paused and the pause has not expired.
+func (k Keeper) IsBridgePaused(ctx sdk.Context, rollappID string) bool {
+	pause, err := k.bridgePauses.Get(ctx, rollappID)
+	return err == nil && pause.Active(ctx.BlockTime())
+}
+
+// ValidateBridgeNotPaused returns ErrBridgePaused if the bridge of the rollapp is paused.
+func (k Keeper) ValidateBridgeNotPaused(ctx sdk.Context, rollappID string) error {
+	pause, err := k.bridgePauses.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if pause.Active(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrBridgePaused, "rollapp: %s: until: %s", rollappID, pause.PausedUntil.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// GetPausedBridges returns the bridge pauses which have not expired.
+func (k Keeper) GetPausedBridges(ctx sdk.Context) ([]types.BridgePause, error) {
+	var pauses []types.BridgePause
+	err := k.bridgePauses.Walk(ctx, nil, func(_ string, pause types.BridgePause) (bool, error) {
+		if pause.Active(ctx.BlockTime()) {
+			pauses = append(pauses, pause)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get paused bridges: %w", err)
+	}
+	return pauses, nil
+}
+
+// GetAllBridgePauses returns all the stored bridge pauses, including the expired ones not pruned yet.
+func (k Keeper) GetAllBridgePauses(ctx sdk.Context) ([]types.BridgePause, error) {
+	var pauses []types.BridgePause
+	err := k.bridgePauses.Walk(ctx, nil, func(_ string, pause types.BridgePause) (bool, error) {
+		pauses = append(pauses, pause)
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get all bridge pauses: %w", err)
+	}
+	return pauses, nil
+}
+
+// PruneExpiredBridgePauses removes the expired bridge pauses. Expired pauses are already ignored,
+// pruning them only keeps the store small and notifies clients that the bridge is open again.
+func (k Keeper) PruneExpiredBridgePauses(ctx sdk.Context) error {
+	pauses, err := k.GetAllBridgePauses(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pause := range pauses {
+		if pause.Active(ctx.BlockTime()) {
+			continue
+		}
+		if err := k.RemoveBridgePause(ctx, pause.RollappId); err != nil {
+			return fmt.Errorf("remove bridge pause: %w", err)
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventBridgeUnpaused{RollappId: pause.RollappId}); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestPauseBridge() {
+	const rollappId = "rollapp_1234-1"
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	k := s.k()
+
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	k.SetRollapp(s.Ctx, types.Rollapp{
+		RollappId:   rollappId,
+		Owner:       alice,
+		GenesisInfo: *mockGenesisInfo,
+	})
+	params := k.GetParams(s.Ctx)
+	params.CircuitBreakers = []string{bob}
+	params.MaxBridgePause = 24 * time.Hour
+	k.SetParams(s.Ctx, params)
+
+	tests := []struct {
+		name     string
+		request  *types.MsgPauseBridge
+		expError error
+	}{
+		{
+			name:    "circuit breaker: success",
+			request: &types.MsgPauseBridge{Authority: bob, RollappId: rollappId, Duration: time.Hour},
+		},
+		{
+			name:    "gov: success",
+			request: &types.MsgPauseBridge{Authority: govModule, RollappId: rollappId, Duration: 2 * time.Hour},
+		},
+		{
+			name:     "rollapp owner is not a circuit breaker",
+			request:  &types.MsgPauseBridge{Authority: alice, RollappId: rollappId, Duration: time.Hour},
+			expError: types.ErrUnauthorizedSigner,
+		},
+		{
+			name:     "unknown rollapp",
+			request:  &types.MsgPauseBridge{Authority: bob, RollappId: "rollapp_1235-2", Duration: time.Hour},
+			expError: types.ErrUnknownRollappID,
+		},
+		{
+			name:     "longer than max pause",
+			request:  &types.MsgPauseBridge{Authority: bob, RollappId: rollappId, Duration: 25 * time.Hour},
+			expError: gerrc.ErrInvalidArgument,
+		},
+		{
+			name:     "no duration",
+			request:  &types.MsgPauseBridge{Authority: bob, RollappId: rollappId},
+			expError: gerrc.ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.PauseBridge(sdk.WrapSDKContext(s.Ctx), tc.request)
+			if tc.expError != nil {
+				s.Require().ErrorIs(err, tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+
+			pause, err := k.GetBridgePause(s.Ctx, rollappId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.request.Authority, pause.PausedBy)
+			s.Require().Equal(s.Ctx.BlockTime().Add(tc.request.Duration), pause.PausedUntil)
+			s.Require().True(k.IsBridgePaused(s.Ctx, rollappId))
+			s.Require().ErrorIs(k.ValidateBridgeNotPaused(s.Ctx, rollappId), types.ErrBridgePaused)
+		})
+	}
+
+	// unpause
+	_, err := s.msgServer.UnpauseBridge(sdk.WrapSDKContext(s.Ctx), &types.MsgUnpauseBridge{Authority: alice, RollappId: rollappId})
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	_, err = s.msgServer.UnpauseBridge(sdk.WrapSDKContext(s.Ctx), &types.MsgUnpauseBridge{Authority: bob, RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().False(k.IsBridgePaused(s.Ctx, rollappId))
+	_, err = s.msgServer.UnpauseBridge(sdk.WrapSDKContext(s.Ctx), &types.MsgUnpauseBridge{Authority: bob, RollappId: rollappId})
+	s.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+}
+
+func (s *RollappTestSuite) TestBridgePauseExpiry() {
+	const rollappId = "rollapp_1234-1"
+	k := s.k()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	err := k.SetBridgePause(s.Ctx, types.NewBridgePause(rollappId, bob, s.Ctx.BlockTime().Add(time.Hour)))
+	s.Require().NoError(err)
+	err = k.SetBridgePause(s.Ctx, types.NewBridgePause("rollapp_1235-2", bob, s.Ctx.BlockTime().Add(2*time.Hour)))
+	s.Require().NoError(err)
+
+	paused, err := k.GetPausedBridges(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(paused, 2)
+
+	// the pause expires on its own, before it is pruned
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().False(k.IsBridgePaused(s.Ctx, rollappId))
+	s.Require().NoError(k.ValidateBridgeNotPaused(s.Ctx, rollappId))
+	paused, err = k.GetPausedBridges(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(paused, 1)
+	s.Require().Equal("rollapp_1235-2", paused[0].RollappId)
+
+	s.Require().NoError(k.PruneExpiredBridgePauses(s.Ctx))
+	_, err = k.GetBridgePause(s.Ctx, rollappId)
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+	all, err := k.GetAllBridgePauses(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(all, 1)
+}
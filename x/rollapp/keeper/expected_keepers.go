@@ -6,6 +6,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
 
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -28,6 +29,7 @@ type BankKeeper interface {
 
 type CanonicalLightClientKeeper interface {
 	GetRollappForClientID(ctx sdk.Context, clientID string) (string, bool)
+	GetClientHealth(ctx sdk.Context, rollappID string) (rollapptypes.ClientHealth, bool)
 }
 
 type TransferKeeper interface {
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) PausedBridges(c context.Context, req *types.QueryPausedBridgesRequest) (*types.QueryPausedBridgesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pauses, err := k.GetPausedBridges(sdk.UnwrapSDKContext(c))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausedBridgesResponse{PausedBridges: pauses}, nil
+}
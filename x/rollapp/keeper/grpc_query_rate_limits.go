@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil || req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limits, err := k.GetRateLimits(sdk.UnwrapSDKContext(c), req.RollappId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: limits}, nil
+}
@@ -84,6 +84,12 @@ func getSummaryResponse(ctx sdk.Context, k Keeper, rollapp types.Rollapp, ok, wi
 		}
 	}
 
+	if k.canonicalClientKeeper != nil {
+		if health, ok := k.canonicalClientKeeper.GetClientHealth(ctx, rollapp.RollappId); ok {
+			s.ClientHealth = &health
+		}
+	}
+
 	resp := &types.QueryGetRollappResponse{
 		Rollapp: rollapp,
 		Summary: s,
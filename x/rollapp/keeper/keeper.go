@@ -42,6 +42,12 @@ type Keeper struct {
 
 	obsoleteDRSVersions     collections.KeySet[uint32]
 	registeredRollappDenoms collections.KeySet[collections.Pair[string, string]] // [ rollappID, denom ]
+	// rateLimits are the IBC transfer rate limits. Key: (rollappID, denom).
+	rateLimits collections.Map[collections.Pair[string, string], types.RateLimit]
+	// rateLimitFlows are the amounts transferred in the current window of the rate limits. Key: (rollappID, denom).
+	rateLimitFlows collections.Map[collections.Pair[string, string], types.RateLimitFlow]
+	// bridgePauses are the emergency pauses of the rollapp bridges. Key: rollappID.
+	bridgePauses collections.Map[string, types.BridgePause]
 	// finalizationQueue is a map from creation height and rollapp to the finalization queue.
 	// Key: (creation height, rollappID), Value: state indexes to finalize.
 	// Contains a special index that helps reverse lookup: finalization queue (all available heights) by rollapp.
@@ -97,6 +103,27 @@ func NewKeeper(
 			"registered_rollapp_denoms",
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 		),
+		rateLimits: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RateLimitKeyPrefix),
+			"rate_limits",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.RateLimit](cdc),
+		),
+		rateLimitFlows: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.RateLimitFlowKeyPrefix),
+			"rate_limit_flows",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.RateLimitFlow](cdc),
+		),
+		bridgePauses: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.BridgePauseKeyPrefix),
+			"bridge_pauses",
+			collections.StringKey,
+			collcompat.ProtoValue[types.BridgePause](cdc),
+		),
 		finalizationQueue: collections.NewIndexedMap(
 			sb,
 			collections.NewPrefix(types.HeightRollappToFinalizationQueueKeyPrefix),
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) PauseBridge(goCtx context.Context, msg *types.MsgPauseBridge) (*types.MsgPauseBridgeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCircuitBreaker(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if _, ok := k.GetRollapp(ctx, msg.RollappId); !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if maxPause := k.MaxBridgePause(ctx); msg.Duration > maxPause {
+		return nil, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duration exceeds max bridge pause: %s", maxPause)
+	}
+
+	pause := types.NewBridgePause(msg.RollappId, msg.Authority, ctx.BlockTime().Add(msg.Duration))
+	if err := k.SetBridgePause(ctx, pause); err != nil {
+		return nil, fmt.Errorf("set bridge pause: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventBridgePaused{Pause: pause}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgPauseBridgeResponse{}, nil
+}
+
+func (k msgServer) UnpauseBridge(goCtx context.Context, msg *types.MsgUnpauseBridge) (*types.MsgUnpauseBridgeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkCircuitBreaker(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if !k.IsBridgePaused(ctx, msg.RollappId) {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "bridge not paused: rollapp: %s", msg.RollappId)
+	}
+
+	if err := k.RemoveBridgePause(ctx, msg.RollappId); err != nil {
+		return nil, fmt.Errorf("remove bridge pause: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventBridgeUnpaused{
+		RollappId:  msg.RollappId,
+		UnpausedBy: msg.Authority,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgUnpauseBridgeResponse{}, nil
+}
+
+// checkCircuitBreaker checks that the signer is a circuit breaker or the gov module.
+func (k msgServer) checkCircuitBreaker(ctx sdk.Context, signer string) error {
+	if signer != k.authority && !k.GetParams(ctx).IsCircuitBreaker(signer) {
+		return errorsmod.Wrap(types.ErrUnauthorizedSigner, "only a circuit breaker or the gov module can pause bridges")
+	}
+	return nil
+}
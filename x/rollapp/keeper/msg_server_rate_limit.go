@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit := msg.RateLimit
+	if err := k.checkRateLimitAuthority(ctx, msg.Authority, limit.RollappId, limit.Denom); err != nil {
+		return nil, err
+	}
+	limit.GovSet = msg.Authority == k.authority
+
+	if err := k.Keeper.SetRateLimit(ctx, limit); err != nil {
+		return nil, fmt.Errorf("set rate limit: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRateLimitSet{RateLimit: limit}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+func (k msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetRateLimit(ctx, msg.RollappId, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkRateLimitAuthority(ctx, msg.Authority, msg.RollappId, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveRateLimit(ctx, msg.RollappId, msg.Denom); err != nil {
+		return nil, fmt.Errorf("remove rate limit: %w", err)
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRateLimitRemoved{
+		RollappId: msg.RollappId,
+		Denom:     msg.Denom,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// checkRateLimitAuthority checks that the signer is the gov module or the rollapp owner.
+// The owner cannot change a limit set by the gov module.
+func (k msgServer) checkRateLimitAuthority(ctx sdk.Context, signer, rollappID, denom string) error {
+	rollapp, ok := k.GetRollapp(ctx, rollappID)
+	if !ok {
+		return types.ErrUnknownRollappID
+	}
+	if signer == k.authority {
+		return nil
+	}
+	if signer != rollapp.Owner {
+		return errorsmod.Wrap(types.ErrUnauthorizedSigner, "only the rollapp owner or the gov module can set rate limits")
+	}
+	existing, err := k.GetRateLimit(ctx, rollappID, denom)
+	if err != nil && !errors.Is(err, gerrc.ErrNotFound) {
+		return err
+	}
+	if err == nil && existing.GovSet {
+		return errorsmod.Wrap(types.ErrUnauthorizedSigner, "rate limit is set by the gov module")
+	}
+	return nil
+}
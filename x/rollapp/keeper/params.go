@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
@@ -14,6 +16,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.LivenessSlashInterval(ctx),
 		k.AppRegistrationFee(ctx),
 		k.MinSequencerBondGlobal(ctx),
+		k.CircuitBreakers(ctx),
+		k.MaxBridgePause(ctx),
 	)
 }
 
@@ -48,3 +52,16 @@ func (k Keeper) MinSequencerBondGlobal(ctx sdk.Context) (res sdk.Coin) {
 	k.paramstore.Get(ctx, types.KeyMinSequencerBondGlobal, &res)
 	return
 }
+
+// CircuitBreakers returns the addresses allowed to pause rollapp bridges
+func (k Keeper) CircuitBreakers(ctx sdk.Context) (res []string) {
+	k.paramstore.GetIfExists(ctx, types.KeyCircuitBreakers, &res)
+	return
+}
+
+// MaxBridgePause returns the longest duration a circuit breaker can pause a bridge for
+func (k Keeper) MaxBridgePause(ctx sdk.Context) (res time.Duration) {
+	res = types.DefaultMaxBridgePause
+	k.paramstore.GetIfExists(ctx, types.KeyMaxBridgePause, &res)
+	return
+}
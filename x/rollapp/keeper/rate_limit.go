@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// SetRateLimit creates or updates the rate limit of the rollapp denom. The flow of the current
+// window is kept.
+func (k Keeper) SetRateLimit(ctx sdk.Context, limit types.RateLimit) error {
+	return k.rateLimits.Set(ctx, collections.Join(limit.RollappId, limit.Denom), limit)
+}
+
+// GetRateLimit returns the rate limit of the rollapp denom.
+func (k Keeper) GetRateLimit(ctx sdk.Context, rollappID, denom string) (types.RateLimit, error) {
+	limit, err := k.rateLimits.Get(ctx, collections.Join(rollappID, denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimit{}, errorsmod.Wrapf(gerrc.ErrNotFound, "rate limit: rollapp: %s: denom: %s", rollappID, denom)
+	}
+	return limit, err
+}
+
+// RemoveRateLimit removes the rate limit of the rollapp denom and its flow.
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, rollappID, denom string) error {
+	key := collections.Join(rollappID, denom)
+	if err := k.rateLimits.Remove(ctx, key); err != nil {
+		return err
+	}
+	return k.rateLimitFlows.Remove(ctx, key)
+}
+
+// GetRateLimitFlow returns the flow of the rate limit in the current window.
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, limit types.RateLimit) (types.RateLimitFlow, error) {
+	flow, err := k.rateLimitFlows.Get(ctx, collections.Join(limit.RollappId, limit.Denom))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimitFlow{}, err
+	}
+	return flow.Current(limit.Window, ctx.BlockTime()), nil
+}
+
+func (k Keeper) SetRateLimitFlow(ctx sdk.Context, rollappID, denom string, flow types.RateLimitFlow) error {
+	return k.rateLimitFlows.Set(ctx, collections.Join(rollappID, denom), flow)
+}
+
+// GetRateLimits returns the rate limits of the rollapp with the flows of their current windows.
+func (k Keeper) GetRateLimits(ctx sdk.Context, rollappID string) ([]types.RateLimitWithFlow, error) {
+	var limits []types.RateLimitWithFlow
+	rng := collections.NewPrefixedPairRange[string, string](rollappID)
+	err := k.rateLimits.Walk(ctx, rng, func(_ collections.Pair[string, string], limit types.RateLimit) (bool, error) {
+		flow, err := k.GetRateLimitFlow(ctx, limit)
+		if err != nil {
+			return true, err
+		}
+		limits = append(limits, types.RateLimitWithFlow{RateLimit: limit, Flow: flow})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get rate limits: %w", err)
+	}
+	return limits, nil
+}
+
+// GetAllRateLimits returns all the rate limits with their stored flows.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) ([]types.RateLimitWithFlow, error) {
+	var limits []types.RateLimitWithFlow
+	err := k.rateLimits.Walk(ctx, nil, func(key collections.Pair[string, string], limit types.RateLimit) (bool, error) {
+		flow, err := k.rateLimitFlows.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			flow = types.NewRateLimitFlow(ctx.BlockTime())
+		} else if err != nil {
+			return true, err
+		}
+		limits = append(limits, types.RateLimitWithFlow{RateLimit: limit, Flow: flow})
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get all rate limits: %w", err)
+	}
+	return limits, nil
+}
+
+// ConsumeRateLimit adds the transferred amount to the flow of the rollapp denom rate limit.
+// Returns ErrRateLimitExceeded if the amount exceeds the quota of the sliding window ending now.
+// No-op if the denom has no rate limit.
+func (k Keeper) ConsumeRateLimit(ctx sdk.Context, rollappID, denom string, amount math.Int, inflow bool) error {
+	limit, err := k.GetRateLimit(ctx, rollappID, denom)
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	flow, err := k.GetRateLimitFlow(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	if inflow {
+		if err := limit.CheckInflow(flow, amount, ctx.BlockTime()); err != nil {
+			return err
+		}
+		flow.Inflow = flow.Inflow.Add(amount)
+	} else {
+		if err := limit.CheckOutflow(flow, amount, ctx.BlockTime()); err != nil {
+			return err
+		}
+		flow.Outflow = flow.Outflow.Add(amount)
+	}
+
+	return k.SetRateLimitFlow(ctx, rollappID, denom, flow)
+}
+
+// RevertOutflow gives back the quota of a transfer to the rollapp which was refunded on
+// timeout or error acknowledgement. No-op if the denom has no rate limit.
+func (k Keeper) RevertOutflow(ctx sdk.Context, rollappID, denom string, amount math.Int) error {
+	limit, err := k.GetRateLimit(ctx, rollappID, denom)
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	flow, err := k.GetRateLimitFlow(ctx, limit)
+	if err != nil {
+		return err
+	}
+
+	// the transfer may have been sent in the previous window, or before
+	fromCurrent := math.MinInt(flow.Outflow, amount)
+	flow.Outflow = flow.Outflow.Sub(fromCurrent)
+	flow.PrevOutflow = math.MaxInt(flow.PrevOutflow.Sub(amount.Sub(fromCurrent)), math.ZeroInt())
+
+	return k.SetRateLimitFlow(ctx, rollappID, denom, flow)
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestSetRateLimit() {
+	const (
+		rollappId = "rollapp_1234-1"
+		denom     = "ibc/9A1EACD53A6A197ADC81DF9A49F0C4A26F7FF685ACF415EE726D7D59796E71A7"
+	)
+	govModule := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	s.k().SetRollapp(s.Ctx, types.Rollapp{
+		RollappId:   rollappId,
+		Owner:       alice,
+		GenesisInfo: *mockGenesisInfo,
+	})
+	limit := types.NewRateLimit(rollappId, denom, math.NewInt(100), math.ZeroInt(), time.Hour)
+
+	tests := []struct {
+		name     string
+		request  *types.MsgSetRateLimit
+		expError error
+	}{
+		{
+			name:    "owner: success",
+			request: &types.MsgSetRateLimit{Authority: alice, RateLimit: limit},
+		},
+		{
+			name:    "gov: success",
+			request: &types.MsgSetRateLimit{Authority: govModule, RateLimit: limit},
+		},
+		{
+			name:     "unauthorized signer",
+			request:  &types.MsgSetRateLimit{Authority: bob, RateLimit: limit},
+			expError: types.ErrUnauthorizedSigner,
+		},
+		{
+			name: "unknown rollapp",
+			request: &types.MsgSetRateLimit{
+				Authority: alice,
+				RateLimit: types.NewRateLimit("rollapp_1235-2", denom, math.NewInt(100), math.ZeroInt(), time.Hour),
+			},
+			expError: types.ErrUnknownRollappID,
+		},
+		{
+			name: "no quota",
+			request: &types.MsgSetRateLimit{
+				Authority: alice,
+				RateLimit: types.NewRateLimit(rollappId, denom, math.ZeroInt(), math.ZeroInt(), time.Hour),
+			},
+			expError: gerrc.ErrInvalidArgument,
+		},
+		{
+			name: "no window",
+			request: &types.MsgSetRateLimit{
+				Authority: alice,
+				RateLimit: types.NewRateLimit(rollappId, denom, math.NewInt(100), math.ZeroInt(), 0),
+			},
+			expError: gerrc.ErrInvalidArgument,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetRateLimit(sdk.WrapSDKContext(s.Ctx), tc.request)
+			if tc.expError != nil {
+				s.Require().ErrorIs(err, tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+
+			got, err := s.k().GetRateLimit(s.Ctx, rollappId, denom)
+			s.Require().NoError(err)
+			expected := tc.request.RateLimit
+			expected.GovSet = tc.request.Authority == govModule
+			s.Require().Equal(expected, got)
+		})
+	}
+
+	// the owner cannot override a limit set by the gov
+	looser := types.NewRateLimit(rollappId, denom, math.NewInt(1_000), math.ZeroInt(), time.Hour)
+	_, err := s.msgServer.SetRateLimit(sdk.WrapSDKContext(s.Ctx), &types.MsgSetRateLimit{Authority: alice, RateLimit: looser})
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// removal
+	_, err = s.msgServer.RemoveRateLimit(sdk.WrapSDKContext(s.Ctx), &types.MsgRemoveRateLimit{Authority: bob, RollappId: rollappId, Denom: denom})
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	_, err = s.msgServer.RemoveRateLimit(sdk.WrapSDKContext(s.Ctx), &types.MsgRemoveRateLimit{Authority: alice, RollappId: rollappId, Denom: denom})
+	s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+	_, err = s.msgServer.RemoveRateLimit(sdk.WrapSDKContext(s.Ctx), &types.MsgRemoveRateLimit{Authority: govModule, RollappId: rollappId, Denom: denom})
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveRateLimit(sdk.WrapSDKContext(s.Ctx), &types.MsgRemoveRateLimit{Authority: alice, RollappId: rollappId, Denom: denom})
+	s.Require().ErrorIs(err, gerrc.ErrNotFound)
+}
+
+func (s *RollappTestSuite) TestConsumeRateLimit() {
+	const (
+		rollappId = "rollapp_1234-1"
+		denom     = "adym"
+	)
+	k := s.k()
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_000_000, 0))
+
+	// no limit
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(1_000), true))
+
+	err := k.SetRateLimit(s.Ctx, types.NewRateLimit(rollappId, denom, math.NewInt(100), math.NewInt(50), time.Hour))
+	s.Require().NoError(err)
+
+	// inflow and outflow are limited separately
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(60), true))
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(40), true))
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(1), true), types.ErrRateLimitExceeded)
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(50), false))
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(1), false), types.ErrRateLimitExceeded)
+
+	// refunded transfers give back their quota
+	s.Require().NoError(k.RevertOutflow(s.Ctx, rollappId, denom, math.NewInt(20)))
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(20), false))
+
+	res, err := s.queryClient.RateLimits(sdk.WrapSDKContext(s.Ctx), &types.QueryRateLimitsRequest{RollappId: rollappId})
+	s.Require().NoError(err)
+	s.Require().Len(res.RateLimits, 1)
+	s.Require().Equal(math.NewInt(100), res.RateLimits[0].Flow.Inflow)
+	s.Require().Equal(math.NewInt(50), res.RateLimits[0].Flow.Outflow)
+
+	// the flow of a window still counts at the start of the next one
+	start := s.Ctx.BlockTime()
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(time.Hour))
+	limits, err := k.GetRateLimits(s.Ctx, rollappId)
+	s.Require().NoError(err)
+	s.Require().True(limits[0].Flow.Inflow.IsZero())
+	s.Require().Equal(math.NewInt(100), limits[0].Flow.PrevInflow)
+	s.Require().Equal(s.Ctx.BlockTime(), limits[0].Flow.WindowStart)
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(1), true), types.ErrRateLimitExceeded)
+}
+
+func (s *RollappTestSuite) TestConsumeRateLimitAcrossWindows() {
+	const (
+		rollappId = "rollapp_1234-1"
+		denom     = "adym"
+	)
+	k := s.k()
+	start := time.Unix(1_000_000, 0)
+	s.Ctx = s.Ctx.WithBlockTime(start)
+
+	err := k.SetRateLimit(s.Ctx, types.NewRateLimit(rollappId, denom, math.NewInt(100), math.NewInt(100), time.Hour))
+	s.Require().NoError(err)
+
+	// the first transfer starts the window, and the rest of the quota is sent at its end
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(1), true))
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(59 * time.Minute))
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(99), true))
+
+	// the quota is not available again right after the window boundary
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(61 * time.Minute))
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(100), true), types.ErrRateLimitExceeded)
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(3), true), types.ErrRateLimitExceeded)
+
+	// half way through the next window, half of the previous flow still counts
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(90 * time.Minute))
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(51), true), types.ErrRateLimitExceeded)
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(50), true))
+
+	// once a whole window elapsed, only the flow of the previous window counts
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(150 * time.Minute))
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(76), true), types.ErrRateLimitExceeded)
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(75), true))
+
+	// after two idle windows, the whole quota is available
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(300 * time.Minute))
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(100), true))
+
+	// a refund of an outflow of the previous window gives back its quota
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(100), false))
+	s.Ctx = s.Ctx.WithBlockTime(start.Add(360 * time.Minute))
+	s.Require().ErrorIs(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(1), false), types.ErrRateLimitExceeded)
+	s.Require().NoError(k.RevertOutflow(s.Ctx, rollappId, denom, math.NewInt(100)))
+	s.Require().NoError(k.ConsumeRateLimit(s.Ctx, rollappId, denom, math.NewInt(100), false))
+}
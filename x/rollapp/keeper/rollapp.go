@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	transferTypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	udenom "github.com/dymensionxyz/dymension/v3/utils/denom"
 	irotypes "github.com/dymensionxyz/dymension/v3/x/iro/types"
@@ -119,6 +120,7 @@ func (k Keeper) SetRollappAsLaunched(ctx sdk.Context, rollapp *types.Rollapp) er
 // - set the pre launch time according to the iro plan end time
 // Validations:
 // - rollapp must not be launched
+// - the IRO of the rollapp must not have failed
 // - genesis info must be set
 // NOTE: we already validated that a genesis account exists for the IRO plan
 func (k Keeper) SetIROPlanToRollapp(ctx sdk.Context, rollapp *types.Rollapp, iro irotypes.Plan) error {
@@ -126,6 +128,10 @@ func (k Keeper) SetIROPlanToRollapp(ctx sdk.Context, rollapp *types.Rollapp, iro
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp already launched")
 	}
 
+	if rollapp.IroFailed {
+		return types.ErrIROFailed
+	}
+
 	if rollapp.GenesisInfo.Sealed {
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "genesis info already sealed")
 	}
@@ -144,6 +150,19 @@ func (k Keeper) SetIROPlanToRollapp(ctx sdk.Context, rollapp *types.Rollapp, iro
 	return nil
 }
 
+// MarkIROFailed marks the IRO of the rollapp as failed, as its plan was cancelled before settlement
+func (k Keeper) MarkIROFailed(ctx sdk.Context, rollappID string) error {
+	rollapp, found := k.GetRollapp(ctx, rollappID)
+	if !found {
+		return types.ErrRollappNotFound
+	}
+
+	rollapp.IroFailed = true
+	k.SetRollapp(ctx, rollapp)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventIROFailed{RollappId: rollappID})
+}
+
 // GetRollappByEIP155 returns a rollapp from its EIP155 id (https://github.com/ethereum/EIPs/blob/master/EIPS/eip-155.md)
 func (k Keeper) GetRollappByEIP155(ctx sdk.Context, eip155 uint64) (val types.Rollapp, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappByEIP155KeyPrefix))
@@ -158,9 +158,12 @@ func (am AppModule) GetHooks() []types.RollappHooks {
 }
 
 // EndBlock finalizes states from rollapps (after dispute period) and corresponding packets. It slashes and jails
-// sequencers of inactive rollapps.
+// sequencers of inactive rollapps. It removes expired bridge pauses.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
 	am.keeper.FinalizeRollappStates(ctx)
 	am.keeper.CheckLiveness(ctx)
+	if err := am.keeper.PruneExpiredBridgePauses(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("Prune expired bridge pauses.", "err", err)
+	}
 	return []abci.ValidatorUpdate{}
 }
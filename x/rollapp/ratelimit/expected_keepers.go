@@ -0,0 +1,14 @@
+package ratelimit
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+type RollappKeeper interface {
+	GetValidTransfer(ctx sdk.Context, packetData []byte, raPortOnHub, raChanOnHub string) (rollapptypes.TransferData, error)
+	ConsumeRateLimit(ctx sdk.Context, rollappID, denom string, amount math.Int, inflow bool) error
+	RevertOutflow(ctx sdk.Context, rollappID, denom string, amount math.Int) error
+}
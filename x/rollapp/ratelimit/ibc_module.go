@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	denomutils "github.com/dymensionxyz/dymension/v3/utils/denom"
+)
+
+// IBCModule enforces the rate limits of the rollapps on the transfers received over their canonical channels.
+// Transfers exceeding the quota of the current window are rejected with an error acknowledgement.
+//
+// It must wrap x/delayedack, so transfers are counted when they are received and not when they are finalized:
+// eIBC makes the funds available before finalization.
+//
+// Transfers sent to the rollapps are limited by the ICS4Wrapper. Their quota is given back when they are refunded.
+type IBCModule struct {
+	porttypes.IBCModule // next one
+	rollappKeeper       RollappKeeper
+}
+
+func NewIBCModule(next porttypes.IBCModule, rollappKeeper RollappKeeper) IBCModule {
+	return IBCModule{
+		IBCModule:     next,
+		rollappKeeper: rollappKeeper,
+	}
+}
+
+func (w IBCModule) logger(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+) log.Logger {
+	return ctx.Logger().With(
+		"module", "ratelimit",
+		"packet_source_port", packet.SourcePort,
+		"packet_destination_port", packet.DestinationPort,
+		"packet_sequence", packet.Sequence,
+	)
+}
+
+// OnRecvPacket consumes the inflow quota of the rollapp denom.
+// No-op for non-rollapp chains and denoms without a rate limit.
+//
+// If the packet is rejected later in the stack, the error acknowledgement reverts the consumption.
+func (w IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	transfer, err := w.rollappKeeper.GetValidTransfer(ctx, packet.GetData(), packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil || !transfer.IsRollapp() {
+		// invalid transfers are rejected by the next modules
+		return w.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	denom := denomutils.GetIncomingTransferDenom(packet, transfer.FungibleTokenPacketData)
+	err = w.rollappKeeper.ConsumeRateLimit(ctx, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt(), true)
+	if err != nil {
+		w.logger(ctx, packet).Info("Reject transfer.", "rollapp_id", transfer.Rollapp.RollappId, "err", err)
+		return uevent.NewErrorAcknowledgement(ctx, errorsmod.Wrap(err, "rate limit"))
+	}
+
+	return w.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket gives back the outflow quota of a transfer refunded on error acknowledgement.
+func (w IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err == nil && !ack.Success() {
+		w.revertOutflow(ctx, packet)
+	}
+	return w.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket gives back the outflow quota of a transfer refunded on timeout.
+func (w IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	w.revertOutflow(ctx, packet)
+	return w.IBCModule.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+func (w IBCModule) revertOutflow(ctx sdk.Context, packet channeltypes.Packet) {
+	transfer, err := w.rollappKeeper.GetValidTransfer(ctx, packet.GetData(), packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil || !transfer.IsRollapp() {
+		return
+	}
+
+	denom := transfertypes.ParseDenomTrace(transfer.Denom).IBCDenom()
+	err = w.rollappKeeper.RevertOutflow(ctx, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt())
+	if err != nil {
+		w.logger(ctx, packet).Error("Revert rate limit outflow.", "rollapp_id", transfer.Rollapp.RollappId, "err", err)
+	}
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+)
+
+// ICS4Wrapper enforces the rate limits of the rollapps on the transfers sent over their canonical channels.
+// Transfers exceeding the quota of the current window are rejected.
+type ICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	rollappKeeper RollappKeeper
+}
+
+func NewICS4Wrapper(next porttypes.ICS4Wrapper, rollappKeeper RollappKeeper) *ICS4Wrapper {
+	return &ICS4Wrapper{
+		ICS4Wrapper:   next,
+		rollappKeeper: rollappKeeper,
+	}
+}
+
+func (w *ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (sequence uint64, err error) {
+	transfer, err := w.rollappKeeper.GetValidTransfer(ctx, data, sourcePort, sourceChannel)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "get valid transfer")
+	}
+
+	if transfer.IsRollapp() {
+		// the packet denom is the trace of the Hub denom
+		denom := transfertypes.ParseDenomTrace(transfer.Denom).IBCDenom()
+		err = w.rollappKeeper.ConsumeRateLimit(ctx, transfer.Rollapp.RollappId, denom, transfer.MustAmountInt(), false)
+		if err != nil {
+			return 0, errorsmod.Wrap(err, "rate limit")
+		}
+	}
+
+	return w.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewBridgePause(rollappID, pausedBy string, pausedUntil time.Time) BridgePause {
+	return BridgePause{
+		RollappId:   rollappID,
+		PausedBy:    pausedBy,
+		PausedUntil: pausedUntil,
+	}
+}
+
+func (p BridgePause) ValidateBasic() error {
+	if p.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id cannot be empty")
+	}
+	if p.PausedBy == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "paused by cannot be empty")
+	}
+	if p.PausedUntil.IsZero() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "paused until cannot be zero")
+	}
+	return nil
+}
+
+// Active returns true if the pause has not expired at the given time.
+func (p BridgePause) Active(now time.Time) bool {
+	return now.Before(p.PausedUntil)
+}
@@ -19,6 +19,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRollappFraudProposal{}, "rollapp/RollappFraudProposal", nil)
 	cdc.RegisterConcrete(&MsgMarkObsoleteRollapps{}, "rollapp/MarkObsoleteRollapps", nil)
 	cdc.RegisterConcrete(&MsgForceGenesisInfoChange{}, "rollapp/ForceGenesisInfoChange", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "rollapp/SetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "rollapp/RemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgPauseBridge{}, "rollapp/PauseBridge", nil)
+	cdc.RegisterConcrete(&MsgUnpauseBridge{}, "rollapp/UnpauseBridge", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -33,6 +37,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRollappFraudProposal{},
 		&MsgMarkObsoleteRollapps{},
 		&MsgForceGenesisInfoChange{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgPauseBridge{},
+		&MsgUnpauseBridge{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -55,6 +55,9 @@ var (
 	ErrTooManyGenesisAccounts            = errorsmod.Wrap(gerrc.ErrInvalidArgument, "too many genesis accounts")
 	ErrInvalidBech32Prefix               = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid bech32 prefix")
 	ErrRollappNotFound                   = errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	ErrRateLimitExceeded                 = errorsmod.Wrap(gerrc.ErrResourceExhausted, "rate limit exceeded")
+	ErrBridgePaused                      = errorsmod.Wrap(gerrc.ErrUnavailable, "rollapp bridge paused")
+	ErrIROFailed                         = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "rollapp IRO failed")
 
 	/* ------------------------------ fraud related ----------------------------- */
 	ErrDisputeAlreadyFinalized = errorsmod.Register(ModuleName, 2000, "disputed height already finalized")
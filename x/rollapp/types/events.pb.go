@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -208,11 +209,254 @@ func (m *EventMarkObsoleteRollapps) GetDrsVersions() []uint32 {
 	return nil
 }
 
+type EventRateLimitSet struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *EventRateLimitSet) Reset()         { *m = EventRateLimitSet{} }
+func (m *EventRateLimitSet) String() string { return proto.CompactTextString(m) }
+func (*EventRateLimitSet) ProtoMessage()    {}
+func (*EventRateLimitSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{4}
+}
+func (m *EventRateLimitSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimitSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateLimitSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRateLimitSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimitSet.Merge(m, src)
+}
+func (m *EventRateLimitSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimitSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimitSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimitSet proto.InternalMessageInfo
+
+func (m *EventRateLimitSet) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+type EventRateLimitRemoved struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventRateLimitRemoved) Reset()         { *m = EventRateLimitRemoved{} }
+func (m *EventRateLimitRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventRateLimitRemoved) ProtoMessage()    {}
+func (*EventRateLimitRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{5}
+}
+func (m *EventRateLimitRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRateLimitRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRateLimitRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRateLimitRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRateLimitRemoved.Merge(m, src)
+}
+func (m *EventRateLimitRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRateLimitRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRateLimitRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRateLimitRemoved proto.InternalMessageInfo
+
+func (m *EventRateLimitRemoved) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRateLimitRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type EventBridgePaused struct {
+	Pause BridgePause `protobuf:"bytes,1,opt,name=pause,proto3" json:"pause"`
+}
+
+func (m *EventBridgePaused) Reset()         { *m = EventBridgePaused{} }
+func (m *EventBridgePaused) String() string { return proto.CompactTextString(m) }
+func (*EventBridgePaused) ProtoMessage()    {}
+func (*EventBridgePaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{6}
+}
+func (m *EventBridgePaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgePaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgePaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgePaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgePaused.Merge(m, src)
+}
+func (m *EventBridgePaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgePaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgePaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgePaused proto.InternalMessageInfo
+
+func (m *EventBridgePaused) GetPause() BridgePause {
+	if m != nil {
+		return m.Pause
+	}
+	return BridgePause{}
+}
+
+type EventBridgeUnpaused struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// UnpausedBy is the circuit breaker which lifted the pause, or empty if the
+	// pause expired.
+	UnpausedBy string `protobuf:"bytes,2,opt,name=unpaused_by,json=unpausedBy,proto3" json:"unpaused_by,omitempty"`
+}
+
+func (m *EventBridgeUnpaused) Reset()         { *m = EventBridgeUnpaused{} }
+func (m *EventBridgeUnpaused) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeUnpaused) ProtoMessage()    {}
+func (*EventBridgeUnpaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{7}
+}
+func (m *EventBridgeUnpaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeUnpaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeUnpaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeUnpaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeUnpaused.Merge(m, src)
+}
+func (m *EventBridgeUnpaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeUnpaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeUnpaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeUnpaused proto.InternalMessageInfo
+
+func (m *EventBridgeUnpaused) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventBridgeUnpaused) GetUnpausedBy() string {
+	if m != nil {
+		return m.UnpausedBy
+	}
+	return ""
+}
+
+type EventIROFailed struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *EventIROFailed) Reset()         { *m = EventIROFailed{} }
+func (m *EventIROFailed) String() string { return proto.CompactTextString(m) }
+func (*EventIROFailed) ProtoMessage()    {}
+func (*EventIROFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{8}
+}
+func (m *EventIROFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIROFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIROFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIROFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIROFailed.Merge(m, src)
+}
+func (m *EventIROFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIROFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIROFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIROFailed proto.InternalMessageInfo
+
+func (m *EventIROFailed) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventAppAdded)(nil), "dymensionxyz.dymension.rollapp.EventAppAdded")
 	proto.RegisterType((*EventAppUpdated)(nil), "dymensionxyz.dymension.rollapp.EventAppUpdated")
 	proto.RegisterType((*EventAppRemoved)(nil), "dymensionxyz.dymension.rollapp.EventAppRemoved")
 	proto.RegisterType((*EventMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.EventMarkObsoleteRollapps")
+	proto.RegisterType((*EventRateLimitSet)(nil), "dymensionxyz.dymension.rollapp.EventRateLimitSet")
+	proto.RegisterType((*EventRateLimitRemoved)(nil), "dymensionxyz.dymension.rollapp.EventRateLimitRemoved")
+	proto.RegisterType((*EventBridgePaused)(nil), "dymensionxyz.dymension.rollapp.EventBridgePaused")
+	proto.RegisterType((*EventBridgeUnpaused)(nil), "dymensionxyz.dymension.rollapp.EventBridgeUnpaused")
+	proto.RegisterType((*EventIROFailed)(nil), "dymensionxyz.dymension.rollapp.EventIROFailed")
 }
 
 func init() {
@@ -220,25 +464,36 @@ func init() {
 }
 
 var fileDescriptor_e0f74405c12dec3c = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xe4, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xc5, 0x52, 0x1a, 0x04, 0x0c, 0x4b, 0x2c,
-	0x28, 0x80, 0x98, 0xa4, 0xe4, 0xc6, 0xc5, 0xeb, 0x0a, 0x32, 0xd9, 0xb1, 0xa0, 0xc0, 0x31, 0x25,
-	0x25, 0x35, 0x45, 0xc8, 0x94, 0x8b, 0x39, 0xb1, 0xa0, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x59, 0x0f, 0xbf, 0x45, 0x7a, 0x8e, 0x05, 0x05, 0x41, 0x20, 0xf5, 0x4a, 0x1e, 0x5c, 0xfc,
-	0x30, 0x73, 0x42, 0x0b, 0x52, 0x12, 0x4b, 0xa8, 0x62, 0x52, 0x50, 0x6a, 0x6e, 0x7e, 0x19, 0xf9,
-	0x26, 0x15, 0x70, 0x49, 0x82, 0x4d, 0xf2, 0x4d, 0x2c, 0xca, 0xf6, 0x4f, 0x2a, 0xce, 0xcf, 0x49,
-	0x2d, 0x49, 0x0d, 0x82, 0x28, 0x2a, 0x16, 0x32, 0xe0, 0x12, 0xc9, 0x87, 0x8a, 0xc5, 0x43, 0x75,
-	0xc6, 0xe7, 0x95, 0xe6, 0x82, 0x2d, 0x61, 0x09, 0x12, 0xca, 0x47, 0x55, 0xef, 0x57, 0x9a, 0x2b,
-	0xa4, 0xc8, 0xc5, 0x93, 0x52, 0x54, 0x1c, 0x5f, 0x96, 0x5a, 0x04, 0xb2, 0xae, 0x58, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0x37, 0x88, 0x3b, 0xa5, 0xa8, 0x38, 0x0c, 0x2a, 0xe4, 0xe4, 0x77, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0x22, 0xa7, 0xcc, 0x58, 0xbf, 0x02, 0x1e, 0x43, 0x25, 0x95, 0x05,
-	0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x48, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x93, 0xbd,
-	0x81, 0x1d, 0x02, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
+	0x14, 0x6f, 0xf6, 0x8f, 0xd0, 0x57, 0x57, 0x31, 0x56, 0xa8, 0x0b, 0x66, 0xd7, 0x78, 0xa9, 0x2c,
+	0x24, 0xea, 0xea, 0x07, 0x68, 0xc1, 0xd5, 0x85, 0xb5, 0x2b, 0x23, 0xf5, 0x20, 0x42, 0x98, 0xec,
+	0x0c, 0x35, 0x98, 0x64, 0x1e, 0x33, 0x93, 0xb2, 0xf1, 0x53, 0xf8, 0xb1, 0xf6, 0xb8, 0x47, 0x4f,
+	0x22, 0xed, 0x17, 0x91, 0x4c, 0x26, 0xb5, 0x3d, 0x68, 0x40, 0xf6, 0xd6, 0xf7, 0xfa, 0xfb, 0xf7,
+	0x32, 0xef, 0xc1, 0x11, 0x2b, 0x33, 0x9e, 0xab, 0x44, 0xe4, 0x97, 0xe5, 0xb7, 0x70, 0x55, 0x84,
+	0x52, 0xa4, 0x29, 0x45, 0x0c, 0xf9, 0x9c, 0xe7, 0x5a, 0x05, 0x28, 0x85, 0x16, 0xae, 0xb7, 0x0e,
+	0x0e, 0x56, 0x45, 0x60, 0xc1, 0xfb, 0xfd, 0x99, 0x98, 0x09, 0x03, 0x0d, 0xab, 0x5f, 0x35, 0x6b,
+	0x7f, 0xd8, 0x62, 0x41, 0x11, 0x2d, 0x32, 0x6c, 0x41, 0x4a, 0xaa, 0x79, 0x94, 0x26, 0x59, 0xa2,
+	0x2d, 0xe1, 0x79, 0x0b, 0x21, 0x96, 0x09, 0x9b, 0xf1, 0x08, 0x69, 0xa1, 0x78, 0x4d, 0xf1, 0x4f,
+	0x60, 0xef, 0x75, 0x35, 0xd3, 0x08, 0x71, 0xc4, 0x18, 0x67, 0xee, 0x2b, 0xd8, 0xa6, 0x88, 0x03,
+	0xe7, 0xd0, 0x19, 0xf6, 0x5e, 0x3c, 0x09, 0xfe, 0x3d, 0x62, 0x30, 0x42, 0x24, 0x15, 0xde, 0x7f,
+	0x0b, 0x77, 0x1b, 0x9d, 0x29, 0x32, 0xaa, 0x6f, 0x44, 0x89, 0xf0, 0x4c, 0xcc, 0xff, 0x5f, 0x09,
+	0xe1, 0xa1, 0x51, 0x7a, 0x47, 0xe5, 0xd7, 0xf3, 0x58, 0x89, 0x94, 0x6b, 0x4e, 0x6a, 0x90, 0x72,
+	0x9f, 0x41, 0x5f, 0xd8, 0x5e, 0x64, 0x99, 0x51, 0x5e, 0x64, 0xc6, 0x64, 0x87, 0xb8, 0x62, 0x13,
+	0x3f, 0x29, 0x32, 0xf7, 0x31, 0xdc, 0x66, 0x52, 0x45, 0x73, 0x2e, 0x2b, 0x3b, 0x35, 0xd8, 0x3a,
+	0xdc, 0x1e, 0xee, 0x91, 0x1e, 0x93, 0xea, 0xa3, 0x6d, 0xf9, 0x17, 0x70, 0xcf, 0x38, 0x12, 0xaa,
+	0xf9, 0x59, 0xf5, 0x30, 0x1f, 0xb8, 0x76, 0x27, 0x00, 0x7f, 0x5e, 0xca, 0x0e, 0xf1, 0xb4, 0x6d,
+	0x88, 0x95, 0xc2, 0x78, 0xe7, 0xea, 0xe7, 0x41, 0x87, 0x74, 0x65, 0xd3, 0xf0, 0xcf, 0xe0, 0xc1,
+	0xa6, 0x49, 0xf3, 0x99, 0x1e, 0x01, 0x34, 0x93, 0x24, 0xcc, 0x18, 0x75, 0x49, 0xd7, 0x76, 0x4e,
+	0x99, 0xdb, 0x87, 0x5d, 0xc6, 0x73, 0x91, 0x0d, 0xb6, 0xcc, 0x3f, 0x75, 0xe1, 0x7f, 0xb6, 0x91,
+	0xc7, 0x66, 0x37, 0xde, 0x57, 0xab, 0xc1, 0xdc, 0x37, 0xb0, 0x6b, 0x96, 0xc4, 0xa6, 0x3d, 0x6a,
+	0x4b, 0xbb, 0x46, 0xb6, 0x79, 0x6b, 0xbe, 0x3f, 0x85, 0xfb, 0x6b, 0xea, 0xd3, 0x1c, 0x6b, 0xfd,
+	0x96, 0xa4, 0x07, 0xd0, 0x2b, 0x2c, 0x34, 0x8a, 0x4b, 0x9b, 0x17, 0x9a, 0xd6, 0xb8, 0xf4, 0x43,
+	0xb8, 0x63, 0x64, 0x4f, 0xc9, 0xf9, 0x09, 0x4d, 0xd2, 0x56, 0xc5, 0xf1, 0xe4, 0x6a, 0xe1, 0x39,
+	0xd7, 0x0b, 0xcf, 0xf9, 0xb5, 0xf0, 0x9c, 0xef, 0x4b, 0xaf, 0x73, 0xbd, 0xf4, 0x3a, 0x3f, 0x96,
+	0x5e, 0xe7, 0xd3, 0xcb, 0x59, 0xa2, 0xbf, 0x14, 0x71, 0x70, 0x21, 0xb2, 0xbf, 0xdd, 0xdb, 0xfc,
+	0x38, 0xbc, 0x5c, 0xdd, 0x90, 0x2e, 0x91, 0xab, 0xf8, 0x96, 0xb9, 0x9e, 0xe3, 0xdf, 0x03, 0x00,
+	0x1f, 0x80, 0xff, 0xc6, 0x30, 0x04, 0x00, 0x00,
 }
 
 func (m *EventAppAdded) Marshal() (dAtA []byte, err error) {
@@ -392,6 +647,176 @@ func (m *EventMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRateLimitSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimitSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimitSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRateLimitRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRateLimitRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRateLimitRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBridgePaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgePaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgePaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBridgeUnpaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeUnpaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeUnpaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnpausedBy) > 0 {
+		i -= len(m.UnpausedBy)
+		copy(dAtA[i:], m.UnpausedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UnpausedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIROFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIROFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIROFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -456,18 +881,490 @@ func (m *EventMarkObsoleteRollapps) Size() (n int) {
 		for _, e := range m.DrsVersions {
 			l += sovEvents(uint64(e))
 		}
-		n += 1 + sovEvents(uint64(l)) + l
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventRateLimitSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRateLimitRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBridgePaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pause.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventBridgeUnpaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.UnpausedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIROFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAppRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAppRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &App{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarkObsoleteRollapps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarkObsoleteRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteRollappNum", wireType)
+			}
+			m.ObsoleteRollappNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObsoleteRollappNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DrsVersions = append(m.DrsVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DrsVersions) == 0 {
+					m.DrsVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DrsVersions = append(m.DrsVersions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
+func (m *EventRateLimitSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -490,15 +1387,15 @@ func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAppAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRateLimitSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAppAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRateLimitSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -525,10 +1422,7 @@ func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &App{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -553,7 +1447,7 @@ func (m *EventAppAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventRateLimitRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -576,17 +1470,17 @@ func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAppUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRateLimitRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAppUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRateLimitRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -596,27 +1490,55 @@ func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &App{}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -639,7 +1561,7 @@ func (m *EventAppUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
+func (m *EventBridgePaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -662,15 +1584,15 @@ func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAppRemoved: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBridgePaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAppRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBridgePaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -697,10 +1619,7 @@ func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &App{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -725,7 +1644,7 @@ func (m *EventAppRemoved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
+func (m *EventBridgeUnpaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -748,17 +1667,17 @@ func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMarkObsoleteRollapps: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBridgeUnpaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMarkObsoleteRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBridgeUnpaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteRollappNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			m.ObsoleteRollappNum = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -768,87 +1687,138 @@ func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObsoleteRollappNum |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				m.DrsVersions = append(m.DrsVersions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnpausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIROFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIROFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIROFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DrsVersions) == 0 {
-					m.DrsVersions = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DrsVersions = append(m.DrsVersions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
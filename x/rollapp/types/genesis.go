@@ -95,5 +95,35 @@ func (gs GenesisState) Validate() error {
 		obsoleteDRSVersionIndexMap[elem] = struct{}{}
 	}
 
+	// Check for duplicated index in rate limits
+	rateLimitIndexMap := make(map[[2]string]struct{})
+
+	for _, elem := range gs.RateLimits {
+		index := [2]string{elem.RateLimit.RollappId, elem.RateLimit.Denom}
+		if _, ok := rateLimitIndexMap[index]; ok {
+			return errors.New("duplicated index for rateLimits")
+		}
+		rateLimitIndexMap[index] = struct{}{}
+		if err := elem.RateLimit.ValidateBasic(); err != nil {
+			return err
+		}
+		if err := elem.Flow.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in bridge pauses
+	bridgePauseIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BridgePauses {
+		if _, ok := bridgePauseIndexMap[elem.RollappId]; ok {
+			return errors.New("duplicated index for bridgePauses")
+		}
+		bridgePauseIndexMap[elem.RollappId] = struct{}{}
+		if err := elem.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -38,6 +38,10 @@ type GenesisState struct {
 	SequencerHeightPairs []SequencerHeightPair     `protobuf:"bytes,10,rep,name=sequencerHeightPairs,proto3" json:"sequencerHeightPairs"`
 	// ObsoleteDrsVersions is a list of DRS versions that are marked obsolete
 	ObsoleteDrsVersions []uint32 `protobuf:"varint,11,rep,packed,name=obsolete_drs_versions,json=obsoleteDrsVersions,proto3" json:"obsolete_drs_versions,omitempty"`
+	// RateLimits are the rollapp rate limits with their current flows
+	RateLimits []RateLimitWithFlow `protobuf:"bytes,12,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// BridgePauses are the paused rollapp bridges
+	BridgePauses []BridgePause `protobuf:"bytes,13,rep,name=bridge_pauses,json=bridgePauses,proto3" json:"bridge_pauses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +154,20 @@ func (m *GenesisState) GetObsoleteDrsVersions() []uint32 {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimitWithFlow {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBridgePauses() []BridgePause {
+	if m != nil {
+		return m.BridgePauses
+	}
+	return nil
+}
+
 type SequencerHeightPair struct {
 	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -265,44 +283,49 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xda, 0x4c, 0xbe, 0x22, 0x34, 0x2d, 0x60, 0x45, 0xd4, 0x44, 0x41,
-	0x82, 0x20, 0xa8, 0x2d, 0x25, 0x48, 0xec, 0x90, 0x08, 0xe1, 0x4f, 0x44, 0x05, 0xc1, 0x05, 0x16,
-	0xb0, 0x88, 0x9c, 0xf8, 0xd6, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x25, 0x79, 0x0a, 0x16, 0xbc,
-	0x08, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x36, 0xa1, 0x6d, 0x32,
-	0x91, 0x58, 0x39, 0xe3, 0x39, 0xf7, 0x77, 0x8e, 0x6f, 0xae, 0x2e, 0x7a, 0xe0, 0x4e, 0x06, 0x10,
-	0x30, 0x42, 0x83, 0xf1, 0x64, 0x6a, 0x65, 0x07, 0x2b, 0xa2, 0xbe, 0xef, 0x84, 0xa1, 0xe5, 0x41,
-	0x00, 0x8c, 0x30, 0x33, 0x8c, 0x28, 0xa7, 0xd8, 0x58, 0x54, 0x9b, 0xd9, 0xc1, 0x94, 0xea, 0xd2,
-	0xbe, 0x47, 0x3d, 0x2a, 0xa4, 0x56, 0xfc, 0x2b, 0xa9, 0x2a, 0xdd, 0x57, 0x78, 0x84, 0x4e, 0xe4,
-	0x0c, 0xa4, 0x45, 0x49, 0x15, 0x48, 0x3e, 0xa5, 0xda, 0x52, 0xa8, 0x19, 0x77, 0x38, 0x74, 0x48,
-	0x70, 0x92, 0x66, 0x39, 0x54, 0x14, 0xf8, 0x64, 0x14, 0x7f, 0x71, 0x9a, 0xa6, 0xaa, 0x90, 0x67,
-	0x49, 0x2a, 0xdf, 0x77, 0xd0, 0xff, 0x2f, 0x92, 0x66, 0x1d, 0xc7, 0xa6, 0xb8, 0x89, 0xf2, 0xc9,
-	0x87, 0xe9, 0x5a, 0x59, 0xab, 0x16, 0x6b, 0x77, 0xcc, 0xd5, 0xcd, 0x33, 0xdb, 0x42, 0xdd, 0xd8,
-	0x3a, 0xfd, 0x79, 0x2b, 0x67, 0xcb, 0x5a, 0xfc, 0x06, 0x15, 0xe5, 0xfd, 0x11, 0x61, 0x5c, 0xdf,
-	0x28, 0x6f, 0x56, 0x8b, 0xb5, 0xbb, 0x2a, 0x94, 0x9d, 0x3c, 0x25, 0x6b, 0x91, 0x80, 0xdf, 0xa3,
-	0x5d, 0xd1, 0x94, 0x56, 0x70, 0x42, 0x05, 0x72, 0x53, 0x20, 0xef, 0xa9, 0x90, 0xc7, 0x69, 0x91,
-	0x84, 0xfe, 0x4d, 0xc1, 0x21, 0xd2, 0x7d, 0x87, 0x03, 0xe3, 0x99, 0xae, 0x15, 0xb8, 0x30, 0x16,
-	0x0e, 0x5b, 0xc2, 0xc1, 0x5c, 0xdb, 0x41, 0x54, 0x4a, 0x9b, 0xa5, 0x54, 0x3c, 0x45, 0x07, 0xc9,
-	0xdd, 0x73, 0x12, 0x38, 0x3e, 0x99, 0x82, 0x2b, 0x45, 0xa9, 0xed, 0x7f, 0xff, 0x60, 0xbb, 0x1a,
-	0x8d, 0xbf, 0x69, 0xa8, 0xd2, 0xf5, 0x69, 0xef, 0xf3, 0x4b, 0x20, 0x5e, 0x9f, 0xbf, 0xa3, 0x52,
-	0xe8, 0x70, 0x42, 0x83, 0xb7, 0x43, 0x18, 0x82, 0x48, 0x90, 0x17, 0x09, 0x1e, 0xab, 0x12, 0x34,
-	0x56, 0x92, 0x64, 0xa2, 0x35, 0xfc, 0xf0, 0x27, 0x74, 0x25, 0x9d, 0xdf, 0x67, 0x23, 0x08, 0x38,
-	0xd3, 0xb7, 0x45, 0x82, 0x43, 0x55, 0x82, 0xa3, 0xc5, 0x2a, 0x69, 0x78, 0x0e, 0x85, 0x9f, 0xa2,
-	0xed, 0x74, 0x0a, 0x77, 0x04, 0xf5, 0xb6, 0x8a, 0xfa, 0x24, 0x9b, 0xc0, 0xb4, 0x12, 0x13, 0x74,
-	0x35, 0x02, 0x8f, 0x30, 0x0e, 0x11, 0xb8, 0x4d, 0x08, 0xe8, 0x80, 0xe9, 0x05, 0x41, 0x7b, 0xb4,
-	0xe6, 0x4c, 0xdb, 0xe7, 0xca, 0xa5, 0xc3, 0x05, 0x2c, 0x1e, 0xa0, 0x7d, 0x06, 0x5f, 0x86, 0x10,
-	0xf4, 0x20, 0x4a, 0xda, 0xd6, 0x76, 0x48, 0xc4, 0x74, 0x24, 0xec, 0xea, 0xca, 0xb1, 0xb8, 0x58,
-	0x2b, 0xad, 0x2e, 0xc5, 0xe2, 0x1a, 0xba, 0x46, 0xbb, 0x8c, 0xfa, 0xc0, 0xa1, 0xe3, 0x46, 0xac,
-	0x33, 0x82, 0x28, 0xe6, 0x31, 0xbd, 0x58, 0xde, 0xac, 0xee, 0xda, 0x7b, 0xe9, 0x65, 0x33, 0x62,
-	0x1f, 0xe4, 0x55, 0xe5, 0x15, 0xda, 0xbb, 0xc4, 0x06, 0xdf, 0x44, 0x85, 0xcc, 0x42, 0x2c, 0x8f,
-	0x82, 0xfd, 0xe7, 0x05, 0xbe, 0x8e, 0xf2, 0x7d, 0xa1, 0xd5, 0x37, 0xca, 0x5a, 0x75, 0xcb, 0x96,
-	0xa7, 0x4a, 0x1b, 0xdd, 0x58, 0xd2, 0x22, 0x7c, 0x80, 0x90, 0xfc, 0xac, 0x0e, 0x71, 0x53, 0xa2,
-	0x7c, 0xd3, 0x72, 0x63, 0xa2, 0x9b, 0xfc, 0x15, 0xf1, 0x7a, 0x29, 0xd8, 0xf2, 0xd4, 0x78, 0x7d,
-	0x3a, 0x33, 0xb4, 0xb3, 0x99, 0xa1, 0xfd, 0x9a, 0x19, 0xda, 0xd7, 0xb9, 0x91, 0x3b, 0x9b, 0x1b,
-	0xb9, 0x1f, 0x73, 0x23, 0xf7, 0xf1, 0xa1, 0x47, 0x78, 0x7f, 0xd8, 0x35, 0x7b, 0x74, 0xb0, 0x6c,
-	0x03, 0x8f, 0xea, 0xd6, 0x38, 0x5b, 0x93, 0x7c, 0x12, 0x02, 0xeb, 0xe6, 0xc5, 0xa6, 0xac, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x21, 0x55, 0x13, 0x74, 0x06, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6f, 0xb6, 0xfd, 0xbb, 0x7f, 0xdd, 0x15, 0x21, 0x6f, 0x40, 0x34, 0xb1, 0x50, 0x15, 0x09,
+	0x8a, 0xc6, 0x12, 0x6d, 0x43, 0xe2, 0x86, 0xc4, 0x18, 0x83, 0x89, 0x09, 0x4a, 0x06, 0x03, 0xc1,
+	0xa1, 0x4a, 0x96, 0x67, 0xa9, 0x45, 0x12, 0x07, 0xdb, 0x2d, 0xdb, 0x3e, 0x05, 0x07, 0x3e, 0xd4,
+	0x8e, 0x3b, 0x72, 0x42, 0x68, 0xfd, 0x22, 0x28, 0x8e, 0x93, 0x95, 0xbd, 0xb9, 0x12, 0xa7, 0xd4,
+	0xf6, 0xef, 0x2d, 0x8f, 0x9f, 0x3e, 0x41, 0x0f, 0x83, 0x83, 0x18, 0x12, 0x4e, 0x68, 0xb2, 0x7f,
+	0x70, 0xe8, 0x94, 0x0b, 0x87, 0xd1, 0x28, 0xf2, 0xd2, 0xd4, 0x09, 0x21, 0x01, 0x4e, 0xb8, 0x9d,
+	0x32, 0x2a, 0x28, 0xb6, 0x46, 0xd1, 0x76, 0xb9, 0xb0, 0x15, 0x7a, 0x7e, 0x2e, 0xa4, 0x21, 0x95,
+	0x50, 0x27, 0xfb, 0x95, 0xb3, 0xe6, 0x17, 0x35, 0x1e, 0xa9, 0xc7, 0xbc, 0x58, 0x59, 0xcc, 0xeb,
+	0x02, 0xa9, 0xa7, 0x42, 0x3b, 0x1a, 0x34, 0x17, 0x9e, 0x80, 0x2e, 0x49, 0xf6, 0x8a, 0x2c, 0x4b,
+	0x1a, 0x42, 0x44, 0x06, 0xd9, 0x1b, 0x17, 0x69, 0xda, 0x1a, 0xf8, 0xf8, 0x49, 0x58, 0x16, 0x24,
+	0x22, 0x31, 0x11, 0x8a, 0xb0, 0xac, 0x21, 0xf8, 0x8c, 0x04, 0x21, 0x74, 0x53, 0xaf, 0xcf, 0x21,
+	0xa7, 0xb4, 0x86, 0x35, 0x34, 0xf3, 0x22, 0xbf, 0x90, 0xed, 0xec, 0xc5, 0xf0, 0x3a, 0xaa, 0xe6,
+	0xc5, 0x33, 0x8d, 0xa6, 0xd1, 0xae, 0xaf, 0xdc, 0xb3, 0xaf, 0xbe, 0x20, 0xbb, 0x23, 0xd1, 0x6b,
+	0x53, 0x47, 0xbf, 0xee, 0x54, 0x5c, 0xc5, 0xc5, 0x6f, 0x50, 0x5d, 0x9d, 0x6f, 0x11, 0x2e, 0xcc,
+	0x89, 0xe6, 0x64, 0xbb, 0xbe, 0x72, 0x5f, 0x27, 0xe5, 0xe6, 0x4f, 0xa5, 0x35, 0xaa, 0x80, 0xdf,
+	0xa3, 0x86, 0x2c, 0xfc, 0x66, 0xb2, 0x47, 0xa5, 0xe4, 0xa4, 0x94, 0x7c, 0xa0, 0x93, 0xdc, 0x2e,
+	0x48, 0x4a, 0xf4, 0x6f, 0x15, 0x9c, 0x22, 0x33, 0xf2, 0x04, 0x70, 0x51, 0xe2, 0x36, 0x93, 0x00,
+	0xf6, 0xa5, 0xc3, 0x94, 0x74, 0xb0, 0xc7, 0x76, 0x90, 0x4c, 0x65, 0x73, 0xa9, 0x2a, 0x3e, 0x44,
+	0x0b, 0xf9, 0xd9, 0x06, 0x49, 0xbc, 0x88, 0x1c, 0x42, 0xa0, 0x40, 0x85, 0xed, 0x7f, 0xff, 0x60,
+	0x7b, 0xb5, 0x34, 0xfe, 0x61, 0xa0, 0x96, 0x1f, 0xd1, 0xdd, 0x2f, 0x2f, 0x81, 0x84, 0x3d, 0xf1,
+	0x8e, 0x2a, 0xa0, 0x27, 0x08, 0x4d, 0xde, 0xf6, 0xa1, 0x0f, 0x32, 0x41, 0x55, 0x26, 0x78, 0xa2,
+	0x4b, 0xb0, 0x76, 0xa5, 0x92, 0x4a, 0x34, 0x86, 0x1f, 0xfe, 0x8c, 0xae, 0x15, 0xff, 0x91, 0xe7,
+	0x03, 0x48, 0x04, 0x37, 0xa7, 0x65, 0x82, 0x25, 0x5d, 0x82, 0xad, 0x51, 0x96, 0x32, 0x3c, 0x23,
+	0x85, 0x9f, 0xa1, 0xe9, 0xa2, 0x0b, 0xff, 0x97, 0xaa, 0x77, 0x75, 0xaa, 0x4f, 0xcb, 0x0e, 0x2c,
+	0x98, 0x98, 0xa0, 0xeb, 0x0c, 0x42, 0xc2, 0x05, 0x30, 0x08, 0xd6, 0x21, 0xa1, 0x31, 0x37, 0x6b,
+	0x52, 0xed, 0xf1, 0x98, 0x3d, 0xed, 0x9e, 0xa1, 0x2b, 0x87, 0x73, 0xb2, 0x38, 0x46, 0x73, 0x1c,
+	0xbe, 0xf6, 0x21, 0xd9, 0x05, 0x96, 0x97, 0xad, 0xe3, 0x11, 0xc6, 0x4d, 0x24, 0xed, 0x56, 0xb5,
+	0x6d, 0x71, 0x9e, 0xab, 0xac, 0x2e, 0x94, 0xc5, 0x2b, 0xe8, 0x06, 0xf5, 0x39, 0x8d, 0x40, 0x40,
+	0x37, 0x60, 0xbc, 0x3b, 0x00, 0x96, 0xe9, 0x71, 0xb3, 0xde, 0x9c, 0x6c, 0x37, 0xdc, 0xd9, 0xe2,
+	0x70, 0x9d, 0xf1, 0x1d, 0x75, 0x84, 0x3f, 0xa2, 0xfa, 0xe9, 0xe8, 0xe1, 0xe6, 0x8c, 0x4c, 0xb6,
+	0xac, 0x2d, 0x84, 0x27, 0x60, 0x2b, 0x63, 0x7c, 0x20, 0xa2, 0xb7, 0x11, 0xd1, 0x6f, 0x2a, 0x17,
+	0x62, 0xc5, 0x01, 0xc7, 0x3b, 0xa8, 0x31, 0x3a, 0xa3, 0xb8, 0xd9, 0x90, 0xda, 0x8b, 0xda, 0x56,
+	0x94, 0xa4, 0x4e, 0xc6, 0x51, 0xaa, 0x33, 0xfe, 0xe9, 0x16, 0x6f, 0xbd, 0x42, 0xb3, 0x17, 0x14,
+	0x06, 0xdf, 0x46, 0xb5, 0xb2, 0x28, 0x72, 0xdc, 0xd5, 0xdc, 0xd3, 0x0d, 0x7c, 0x13, 0x55, 0x7b,
+	0x12, 0x6b, 0x4e, 0x34, 0x8d, 0xf6, 0x94, 0xab, 0x56, 0xad, 0x0e, 0xba, 0x75, 0xc9, 0xa5, 0xe2,
+	0x05, 0x84, 0x54, 0xa4, 0x2e, 0x09, 0x0a, 0x45, 0xb5, 0xb3, 0x19, 0x64, 0x8a, 0x41, 0xde, 0x3c,
+	0xd9, 0x40, 0xac, 0xb9, 0x6a, 0xb5, 0xf6, 0xfa, 0xe8, 0xc4, 0x32, 0x8e, 0x4f, 0x2c, 0xe3, 0xf7,
+	0x89, 0x65, 0x7c, 0x1f, 0x5a, 0x95, 0xe3, 0xa1, 0x55, 0xf9, 0x39, 0xb4, 0x2a, 0x9f, 0x1e, 0x85,
+	0x44, 0xf4, 0xfa, 0xbe, 0xbd, 0x4b, 0xe3, 0xcb, 0xbe, 0x06, 0x83, 0x55, 0x67, 0xbf, 0x9c, 0xf0,
+	0xe2, 0x20, 0x05, 0xee, 0x57, 0xe5, 0x6c, 0x5f, 0xfd, 0x33, 0x00, 0x04, 0x5d, 0x40, 0x86, 0x8a,
+	0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +348,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BridgePauses) > 0 {
+		for iNdEx := len(m.BridgePauses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgePauses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ObsoleteDrsVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ObsoleteDrsVersions)*10)
 		var j1 int
@@ -636,6 +687,18 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgePauses) > 0 {
+		for _, e := range m.BridgePauses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1124,6 +1187,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteDrsVersions", wireType)
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimitWithFlow{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgePauses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgePauses = append(m.BridgePauses, BridgePause{})
+			if err := m.BridgePauses[len(m.BridgePauses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
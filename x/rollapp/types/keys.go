@@ -27,6 +27,12 @@ const (
 	ObsoleteDRSVersionsKeyPrefix = "obsoleteDRSVersions/value/"
 	// KeyRegisteredDenomPrefix is the prefix to retrieve all RegisteredDenom
 	KeyRegisteredDenomPrefix = "RegisteredDenom/value/"
+	// RateLimitKeyPrefix is the prefix to retrieve all RateLimit
+	RateLimitKeyPrefix = "RateLimit/value/"
+	// RateLimitFlowKeyPrefix is the prefix to retrieve all RateLimitFlow
+	RateLimitFlowKeyPrefix = "RateLimitFlow/value/"
+	// BridgePauseKeyPrefix is the prefix to retrieve all BridgePause
+	BridgePauseKeyPrefix = "BridgePause/value/"
 )
 
 var SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
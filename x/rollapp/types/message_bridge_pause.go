@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+const (
+	TypeMsgPauseBridge   = "pause_bridge"
+	TypeMsgUnpauseBridge = "unpause_bridge"
+)
+
+var (
+	_ sdk.Msg            = &MsgPauseBridge{}
+	_ legacytx.LegacyMsg = &MsgPauseBridge{}
+	_ sdk.Msg            = &MsgUnpauseBridge{}
+	_ legacytx.LegacyMsg = &MsgUnpauseBridge{}
+)
+
+func (m *MsgPauseBridge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id cannot be empty")
+	}
+	if m.Duration <= 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "duration must be positive")
+	}
+	return nil
+}
+
+func (m *MsgPauseBridge) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgPauseBridge) Route() string {
+	return RouterKey
+}
+
+func (m *MsgPauseBridge) Type() string {
+	return TypeMsgPauseBridge
+}
+
+func (m *MsgPauseBridge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUnpauseBridge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id cannot be empty")
+	}
+	return nil
+}
+
+func (m *MsgUnpauseBridge) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgUnpauseBridge) Route() string {
+	return RouterKey
+}
+
+func (m *MsgUnpauseBridge) Type() string {
+	return TypeMsgUnpauseBridge
+}
+
+func (m *MsgUnpauseBridge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
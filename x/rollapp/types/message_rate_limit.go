@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+const (
+	TypeMsgSetRateLimit    = "set_rate_limit"
+	TypeMsgRemoveRateLimit = "remove_rate_limit"
+)
+
+var (
+	_ sdk.Msg            = &MsgSetRateLimit{}
+	_ legacytx.LegacyMsg = &MsgSetRateLimit{}
+	_ sdk.Msg            = &MsgRemoveRateLimit{}
+	_ legacytx.LegacyMsg = &MsgRemoveRateLimit{}
+)
+
+func (m *MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+	if err := m.RateLimit.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "rate limit")
+	}
+	return nil
+}
+
+func (m *MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgSetRateLimit) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetRateLimit) Type() string {
+	return TypeMsgSetRateLimit
+}
+
+func (m *MsgSetRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority must be a valid bech32 address"))
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id cannot be empty")
+	}
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func (m *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+func (m *MsgRemoveRateLimit) Type() string {
+	return TypeMsgRemoveRateLimit
+}
+
+func (m *MsgRemoveRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
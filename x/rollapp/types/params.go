@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,6 +27,11 @@ var (
 
 	KeyMinSequencerBondGlobal = []byte("KeyMinSequencerBondGlobal")
 
+	// KeyCircuitBreakers defines the key to store the addresses allowed to pause rollapp bridges
+	KeyCircuitBreakers = []byte("CircuitBreakers")
+	// KeyMaxBridgePause defines the key to store the longest duration a bridge can be paused for
+	KeyMaxBridgePause = []byte("MaxBridgePause")
+
 	DefaultAppRegistrationFee         = commontypes.Dym(sdk.NewInt(1))
 	DefaultMinSequencerBondGlobalCoin = commontypes.Dym(sdk.NewInt(100))
 )
@@ -37,6 +43,8 @@ const (
 
 	DefaultLivenessSlashBlocks   = uint64(7200) // 12 hours worth of blocks at 1 block per 6 seconds
 	DefaultLivenessSlashInterval = uint64(600)  // 1 hour worth of blocks at 1 block per 6 seconds
+
+	DefaultMaxBridgePause = 72 * time.Hour
 )
 
 // ParamKeyTable the param key table for launch module
@@ -51,6 +59,8 @@ func NewParams(
 	livenessSlashInterval uint64,
 	appRegistrationFee sdk.Coin,
 	minSequencerBondGlobal sdk.Coin,
+	circuitBreakers []string,
+	maxBridgePause time.Duration,
 ) Params {
 	return Params{
 		DisputePeriodInBlocks:  disputePeriodInBlocks,
@@ -58,6 +68,8 @@ func NewParams(
 		LivenessSlashInterval:  livenessSlashInterval,
 		AppRegistrationFee:     appRegistrationFee,
 		MinSequencerBondGlobal: minSequencerBondGlobal,
+		CircuitBreakers:        circuitBreakers,
+		MaxBridgePause:         maxBridgePause,
 	}
 }
 
@@ -69,6 +81,8 @@ func DefaultParams() Params {
 		DefaultLivenessSlashInterval,
 		DefaultAppRegistrationFee,
 		DefaultMinSequencerBondGlobalCoin,
+		[]string{},
+		DefaultMaxBridgePause,
 	)
 }
 
@@ -80,6 +94,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyLivenessSlashInterval, &p.LivenessSlashInterval, validateLivenessSlashInterval),
 		paramtypes.NewParamSetPair(KeyAppRegistrationFee, &p.AppRegistrationFee, validateAppRegistrationFee),
 		paramtypes.NewParamSetPair(KeyMinSequencerBondGlobal, &p.MinSequencerBondGlobal, uparam.ValidateCoin),
+		paramtypes.NewParamSetPair(KeyCircuitBreakers, &p.CircuitBreakers, validateCircuitBreakers),
+		paramtypes.NewParamSetPair(KeyMaxBridgePause, &p.MaxBridgePause, validateMaxBridgePause),
 	}
 }
 
@@ -117,9 +133,25 @@ func (p Params) Validate() error {
 	if err := uparam.ValidateCoin(p.MinSequencerBondGlobal); err != nil {
 		return errorsmod.Wrap(err, "min sequencer bond")
 	}
+	if err := validateCircuitBreakers(p.CircuitBreakers); err != nil {
+		return errorsmod.Wrap(err, "circuit breakers")
+	}
+	if err := validateMaxBridgePause(p.MaxBridgePause); err != nil {
+		return errorsmod.Wrap(err, "max bridge pause")
+	}
 	return nil
 }
 
+// IsCircuitBreaker returns true if the address is allowed to pause rollapp bridges.
+func (p Params) IsCircuitBreaker(addr string) bool {
+	for _, cb := range p.CircuitBreakers {
+		if cb == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -159,3 +191,32 @@ func validateAppRegistrationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateCircuitBreakers(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(v))
+	for _, addr := range v {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid circuit breaker address: %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicated circuit breaker: %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+func validateMaxBridgePause(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return errors.New("max bridge pause must be positive")
+	}
+	return nil
+}
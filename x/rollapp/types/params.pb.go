@@ -8,15 +8,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +42,11 @@ type Params struct {
 	AppRegistrationFee types.Coin `protobuf:"bytes,7,opt,name=app_registration_fee,json=appRegistrationFee,proto3" json:"app_registration_fee" yaml:"app_registration_fee"`
 	// no rollapp can have a minimum less than this (in dym)
 	MinSequencerBondGlobal types.Coin `protobuf:"bytes,8,opt,name=min_sequencer_bond_global,json=minSequencerBondGlobal,proto3" json:"min_sequencer_bond_global" yaml:"min_sequencer_bond_global"`
+	// circuit_breakers are the addresses allowed to pause the bridge of any
+	// rollapp in an emergency
+	CircuitBreakers []string `protobuf:"bytes,9,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers,omitempty" yaml:"circuit_breakers"`
+	// max_bridge_pause is the longest a circuit breaker can pause a bridge for
+	MaxBridgePause time.Duration `protobuf:"bytes,10,opt,name=max_bridge_pause,json=maxBridgePause,proto3,stdduration" json:"max_bridge_pause" yaml:"max_bridge_pause"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -107,6 +116,20 @@ func (m *Params) GetMinSequencerBondGlobal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetCircuitBreakers() []string {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBridgePause() time.Duration {
+	if m != nil {
+		return m.MaxBridgePause
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 }
@@ -116,36 +139,42 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x6d, 0x6a, 0xd2, 0xc8, 0x6c, 0x22, 0xd3, 0x80, 0x5b, 0xd0, 0x38, 0x72, 0x37, 0x91,
-	0x90, 0x3c, 0x2a, 0x65, 0xd5, 0xa5, 0x91, 0x40, 0xcd, 0x02, 0x55, 0x2e, 0xab, 0x0a, 0x69, 0x34,
-	0xb6, 0x07, 0x77, 0xc4, 0x78, 0xee, 0xe0, 0x71, 0xa2, 0x86, 0x05, 0xcf, 0xc0, 0x92, 0x25, 0xe2,
-	0x69, 0xba, 0xec, 0x92, 0x95, 0x85, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0xf8, 0x27, 0x2a, 0x28, 0x11,
-	0xbb, 0xb9, 0xe7, 0x9c, 0xf9, 0x8e, 0x74, 0x75, 0xed, 0x17, 0xe9, 0x3c, 0x67, 0x52, 0x73, 0x90,
-	0x37, 0xf3, 0x2f, 0x78, 0x33, 0xe0, 0x02, 0x84, 0xa0, 0x4a, 0x61, 0x45, 0x0b, 0x9a, 0xeb, 0x40,
-	0x15, 0x50, 0x82, 0x83, 0xee, 0x87, 0x83, 0xcd, 0x10, 0xb4, 0xe1, 0xa3, 0x83, 0x0c, 0x32, 0xa8,
-	0xa3, 0x78, 0xfd, 0x6a, 0x7e, 0x1d, 0xa1, 0x04, 0x74, 0x0e, 0x1a, 0xc7, 0x54, 0x33, 0x3c, 0x3b,
-	0x89, 0x59, 0x49, 0x4f, 0x70, 0x02, 0x5c, 0x36, 0xbe, 0xff, 0xd3, 0xb2, 0x7b, 0x17, 0x75, 0x8d,
-	0xf3, 0xc1, 0x76, 0x53, 0xae, 0xd5, 0xb4, 0x64, 0x44, 0xb1, 0x82, 0x43, 0x4a, 0xb8, 0x24, 0xb1,
-	0x80, 0xe4, 0x93, 0x76, 0xcd, 0x91, 0x39, 0xb6, 0xc2, 0xe3, 0x55, 0xe5, 0x79, 0x73, 0x9a, 0x8b,
-	0x33, 0x7f, 0x57, 0xd2, 0x8f, 0x86, 0xad, 0x75, 0x51, 0x3b, 0xe7, 0x32, 0xac, 0x75, 0xe7, 0xbd,
-	0x3d, 0x14, 0x7c, 0xc6, 0x24, 0xd3, 0x9a, 0x68, 0x41, 0xf5, 0x75, 0x87, 0xb6, 0x6a, 0xf4, 0x68,
-	0x55, 0x79, 0xcf, 0x1b, 0xf4, 0xd6, 0x98, 0x1f, 0x3d, 0xee, 0xf4, 0xcb, 0xb5, 0xdc, 0x52, 0xaf,
-	0xec, 0xa7, 0xff, 0xc4, 0xb9, 0x2c, 0x59, 0x31, 0xa3, 0xc2, 0x7d, 0x58, 0x73, 0xfd, 0x55, 0xe5,
-	0xa1, 0xad, 0xdc, 0x2e, 0xe8, 0x47, 0xc3, 0xbf, 0xc8, 0xe7, 0xad, 0xee, 0x28, 0xfb, 0x80, 0x2a,
-	0x45, 0x0a, 0x96, 0x71, 0x5d, 0x16, 0xb4, 0xe4, 0x20, 0xc9, 0x47, 0xc6, 0xdc, 0xfd, 0x91, 0x39,
-	0x7e, 0xf4, 0xf2, 0x30, 0x68, 0x36, 0x1b, 0xac, 0x37, 0x1b, 0xb4, 0x9b, 0x0d, 0x5e, 0x03, 0x97,
-	0xe1, 0xf1, 0x6d, 0xe5, 0x19, 0xab, 0xca, 0x7b, 0xd6, 0xf4, 0x6e, 0x83, 0xf8, 0x91, 0x43, 0x95,
-	0x8a, 0xee, 0xa9, 0x6f, 0x18, 0x73, 0xbe, 0xda, 0x87, 0x39, 0x97, 0x44, 0xb3, 0xcf, 0x53, 0x26,
-	0x13, 0x56, 0x90, 0x18, 0x64, 0x4a, 0x32, 0x01, 0x31, 0x15, 0x6e, 0xff, 0x7f, 0xb5, 0xe3, 0xb6,
-	0x76, 0xd4, 0xd4, 0xee, 0x24, 0xf9, 0xd1, 0x93, 0x9c, 0xcb, 0xcb, 0xce, 0x0a, 0x41, 0xa6, 0x6f,
-	0x6b, 0xe3, 0xcc, 0xfa, 0xfe, 0xc3, 0x33, 0x26, 0x56, 0xff, 0xc1, 0x60, 0x6f, 0x62, 0xf5, 0xf7,
-	0x06, 0xd6, 0xc4, 0xea, 0xf7, 0x06, 0xfb, 0xe1, 0xbb, 0xdb, 0x05, 0x32, 0xef, 0x16, 0xc8, 0xfc,
-	0xbd, 0x40, 0xe6, 0xb7, 0x25, 0x32, 0xee, 0x96, 0xc8, 0xf8, 0xb5, 0x44, 0xc6, 0xd5, 0xab, 0x8c,
-	0x97, 0xd7, 0xd3, 0x38, 0x48, 0x20, 0xc7, 0x3b, 0x8e, 0x79, 0x76, 0x8a, 0x6f, 0x36, 0x17, 0x5d,
-	0xce, 0x15, 0xd3, 0x71, 0xaf, 0xbe, 0xbd, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0xbb,
-	0xec, 0xbf, 0x00, 0x03, 0x00, 0x00,
+	// 551 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0xe2, 0xa6, 0xa9, 0x91, 0x20, 0x32, 0x0d, 0x75, 0x5b, 0x64, 0x47, 0xee, 0x12,
+	0x09, 0xc9, 0x56, 0x29, 0x53, 0x47, 0x83, 0x8a, 0x9a, 0x01, 0x45, 0x2e, 0x53, 0x85, 0x74, 0x3a,
+	0xdb, 0x57, 0xe7, 0x54, 0xfb, 0xee, 0xb8, 0xb3, 0xa3, 0x84, 0x81, 0xcf, 0xc0, 0xd8, 0x91, 0x8f,
+	0xd3, 0xb1, 0x03, 0x03, 0x93, 0x41, 0xc9, 0x37, 0xc8, 0x27, 0x40, 0xb1, 0xcf, 0x51, 0x89, 0x12,
+	0xb1, 0xf9, 0xfd, 0xdf, 0xdf, 0xbf, 0xf7, 0xf4, 0xd7, 0x3b, 0xed, 0x75, 0x34, 0x4d, 0x11, 0x11,
+	0x98, 0x92, 0xc9, 0xf4, 0xab, 0xbb, 0x2a, 0x5c, 0x4e, 0x93, 0x04, 0x32, 0xe6, 0x32, 0xc8, 0x61,
+	0x2a, 0x1c, 0xc6, 0x69, 0x46, 0x75, 0xf3, 0xb1, 0xd9, 0x59, 0x15, 0x8e, 0x34, 0x1f, 0xed, 0xc7,
+	0x34, 0xa6, 0xa5, 0xd5, 0x5d, 0x7e, 0x55, 0x7f, 0x1d, 0x99, 0x21, 0x15, 0x29, 0x15, 0x6e, 0x00,
+	0x05, 0x72, 0xc7, 0xa7, 0x01, 0xca, 0xe0, 0xa9, 0x1b, 0x52, 0x4c, 0xea, 0x7e, 0x4c, 0x69, 0x9c,
+	0x20, 0xb7, 0xac, 0x82, 0xfc, 0xc6, 0x8d, 0x72, 0x0e, 0xb3, 0x25, 0xb7, 0x54, 0xec, 0x9f, 0x3b,
+	0x5a, 0x6b, 0x58, 0xae, 0xa1, 0x7f, 0xd6, 0x8c, 0x08, 0x0b, 0x96, 0x67, 0x08, 0x30, 0xc4, 0x31,
+	0x8d, 0x00, 0x26, 0x20, 0x48, 0x68, 0x78, 0x2b, 0x0c, 0xa5, 0xa7, 0xf4, 0x55, 0xef, 0x64, 0x51,
+	0x58, 0xd6, 0x14, 0xa6, 0xc9, 0xb9, 0xbd, 0xcd, 0x69, 0xfb, 0x5d, 0xd9, 0x1a, 0x96, 0x9d, 0x4b,
+	0xe2, 0x95, 0xba, 0xfe, 0x49, 0xeb, 0x26, 0x78, 0x8c, 0x08, 0x12, 0x02, 0x88, 0x04, 0x8a, 0x51,
+	0x8d, 0x56, 0x4b, 0x74, 0x6f, 0x51, 0x58, 0xaf, 0x2a, 0xf4, 0x46, 0x9b, 0xed, 0xbf, 0xa8, 0xf5,
+	0xab, 0xa5, 0x2c, 0xa9, 0xd7, 0xda, 0xc1, 0x9a, 0x1d, 0x93, 0x0c, 0xf1, 0x31, 0x4c, 0x8c, 0x9d,
+	0x92, 0x6b, 0x2f, 0x0a, 0xcb, 0xdc, 0xc8, 0xad, 0x8d, 0xb6, 0xdf, 0xfd, 0x87, 0x7c, 0x29, 0x75,
+	0x9d, 0x69, 0xfb, 0x90, 0x31, 0xc0, 0x51, 0x8c, 0x45, 0x56, 0x85, 0x06, 0x6e, 0x10, 0x32, 0x76,
+	0x7b, 0x4a, 0xff, 0xe9, 0x9b, 0x43, 0xa7, 0x4a, 0xde, 0x59, 0x26, 0xef, 0xc8, 0xe4, 0x9d, 0x77,
+	0x14, 0x13, 0xef, 0xe4, 0xbe, 0xb0, 0x1a, 0x8b, 0xc2, 0x3a, 0xae, 0xe6, 0x6e, 0x82, 0xd8, 0xbe,
+	0x0e, 0x19, 0xf3, 0x1f, 0xa9, 0x17, 0x08, 0xe9, 0xdf, 0xb4, 0xc3, 0x14, 0x13, 0x20, 0xd0, 0x97,
+	0x1c, 0x91, 0x10, 0x71, 0x10, 0x50, 0x12, 0x81, 0x38, 0xa1, 0x01, 0x4c, 0x8c, 0xf6, 0xff, 0xc6,
+	0xf6, 0xe5, 0xd8, 0x5e, 0x35, 0x76, 0x2b, 0xc9, 0xf6, 0x5f, 0xa6, 0x98, 0x5c, 0xd5, 0x2d, 0x8f,
+	0x92, 0xe8, 0x43, 0xd9, 0xd0, 0x2f, 0xb4, 0x4e, 0x88, 0x79, 0x98, 0xe3, 0x0c, 0x04, 0x1c, 0xc1,
+	0x5b, 0xc4, 0x85, 0xb1, 0xd7, 0x6b, 0xf6, 0xf7, 0xbc, 0xe3, 0x45, 0x61, 0x1d, 0x54, 0xdc, 0x75,
+	0x87, 0xed, 0x3f, 0x97, 0x92, 0x27, 0x15, 0x7d, 0xa4, 0x75, 0x52, 0x38, 0x01, 0x01, 0xc7, 0x51,
+	0x8c, 0x00, 0x83, 0xb9, 0x40, 0x86, 0x26, 0xd7, 0xaf, 0xee, 0xd1, 0xa9, 0xef, 0xd1, 0x79, 0x2f,
+	0xef, 0x71, 0x95, 0x9a, 0x1c, 0xb3, 0x0e, 0xb0, 0xef, 0x7e, 0x5b, 0x8a, 0xff, 0x2c, 0x85, 0x13,
+	0xaf, 0x54, 0x87, 0x4b, 0xf1, 0x5c, 0xbd, 0xfb, 0x61, 0x35, 0x06, 0x6a, 0xfb, 0x49, 0xa7, 0x39,
+	0x50, 0xdb, 0xcd, 0x8e, 0x3a, 0x50, 0xdb, 0xad, 0xce, 0xae, 0xf7, 0xf1, 0x7e, 0x66, 0x2a, 0x0f,
+	0x33, 0x53, 0xf9, 0x33, 0x33, 0x95, 0xef, 0x73, 0xb3, 0xf1, 0x30, 0x37, 0x1b, 0xbf, 0xe6, 0x66,
+	0xe3, 0xfa, 0x6d, 0x8c, 0xb3, 0x51, 0x1e, 0x38, 0x21, 0x4d, 0xdd, 0x2d, 0xcf, 0x73, 0x7c, 0xe6,
+	0x4e, 0x56, 0x6f, 0x34, 0x9b, 0x32, 0x24, 0x82, 0x56, 0xb9, 0xef, 0xd9, 0xdf, 0x01, 0x00, 0x0e,
+	0xae, 0x3b, 0x8b, 0xd2, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -168,6 +197,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxBridgePause, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBridgePause):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CircuitBreakers[iNdEx])
+			copy(dAtA[i:], m.CircuitBreakers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CircuitBreakers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.MinSequencerBondGlobal.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -236,6 +282,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinSequencerBondGlobal.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.CircuitBreakers) > 0 {
+		for _, s := range m.CircuitBreakers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxBridgePause)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -397,6 +451,71 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBridgePause", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxBridgePause, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
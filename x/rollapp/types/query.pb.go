@@ -977,6 +977,174 @@ func (m *QueryValidateGenesisBridgeResponse) GetErr() string {
 	return ""
 }
 
+type QueryRateLimitsRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+}
+
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{19}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimitWithFlow `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{20}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimitWithFlow {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+type QueryPausedBridgesRequest struct {
+}
+
+func (m *QueryPausedBridgesRequest) Reset()         { *m = QueryPausedBridgesRequest{} }
+func (m *QueryPausedBridgesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedBridgesRequest) ProtoMessage()    {}
+func (*QueryPausedBridgesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{21}
+}
+func (m *QueryPausedBridgesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedBridgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedBridgesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedBridgesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedBridgesRequest.Merge(m, src)
+}
+func (m *QueryPausedBridgesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedBridgesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedBridgesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedBridgesRequest proto.InternalMessageInfo
+
+type QueryPausedBridgesResponse struct {
+	PausedBridges []BridgePause `protobuf:"bytes,1,rep,name=paused_bridges,json=pausedBridges,proto3" json:"paused_bridges"`
+}
+
+func (m *QueryPausedBridgesResponse) Reset()         { *m = QueryPausedBridgesResponse{} }
+func (m *QueryPausedBridgesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedBridgesResponse) ProtoMessage()    {}
+func (*QueryPausedBridgesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00a0238fb38306fa, []int{22}
+}
+func (m *QueryPausedBridgesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedBridgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedBridgesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedBridgesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedBridgesResponse.Merge(m, src)
+}
+func (m *QueryPausedBridgesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedBridgesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedBridgesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedBridgesResponse proto.InternalMessageInfo
+
+func (m *QueryPausedBridgesResponse) GetPausedBridges() []BridgePause {
+	if m != nil {
+		return m.PausedBridges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.rollapp.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryParamsResponse")
@@ -997,6 +1165,10 @@ func init() {
 	proto.RegisterType((*QueryObsoleteDRSVersionsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryObsoleteDRSVersionsResponse")
 	proto.RegisterType((*QueryValidateGenesisBridgeRequest)(nil), "dymensionxyz.dymension.rollapp.QueryValidateGenesisBridgeRequest")
 	proto.RegisterType((*QueryValidateGenesisBridgeResponse)(nil), "dymensionxyz.dymension.rollapp.QueryValidateGenesisBridgeResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "dymensionxyz.dymension.rollapp.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryPausedBridgesRequest)(nil), "dymensionxyz.dymension.rollapp.QueryPausedBridgesRequest")
+	proto.RegisterType((*QueryPausedBridgesResponse)(nil), "dymensionxyz.dymension.rollapp.QueryPausedBridgesResponse")
 }
 
 func init() {
@@ -1004,82 +1176,93 @@ func init() {
 }
 
 var fileDescriptor_00a0238fb38306fa = []byte{
-	// 1199 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0x8e, 0x13, 0xbf, 0x14, 0x35, 0x9a, 0x86, 0x10, 0xdc, 0xe0, 0x26, 0x8b, 0xd4,
-	0xba, 0x05, 0x79, 0xe5, 0x04, 0x37, 0x8d, 0x42, 0x4a, 0x1d, 0xa5, 0x09, 0x29, 0xa5, 0x84, 0x0d,
-	0x14, 0x01, 0x42, 0xd6, 0xba, 0x3b, 0x71, 0x16, 0xad, 0x77, 0xb7, 0x3b, 0x9b, 0x28, 0x6e, 0x14,
-	0x09, 0x21, 0xce, 0x08, 0x89, 0x7b, 0x25, 0xfe, 0x01, 0xae, 0x9c, 0x11, 0x97, 0x08, 0x71, 0xa8,
-	0xc4, 0x01, 0x2e, 0x20, 0x94, 0xf0, 0x3f, 0x70, 0x45, 0x9e, 0x79, 0xbb, 0xfe, 0x11, 0xdb, 0xbb,
-	0x71, 0x7b, 0xb2, 0x67, 0xf2, 0xde, 0x37, 0xdf, 0x37, 0xf3, 0x7e, 0x39, 0x70, 0xc3, 0xa8, 0xd7,
-	0x98, 0xcd, 0x4d, 0xc7, 0x3e, 0xa8, 0x3f, 0x51, 0xc3, 0x85, 0xea, 0x39, 0x96, 0xa5, 0xbb, 0xae,
-	0xfa, 0x78, 0x8f, 0x79, 0xf5, 0xbc, 0xeb, 0x39, 0xbe, 0x43, 0xb3, 0xad, 0xb6, 0xf9, 0x70, 0x91,
-	0x47, 0xdb, 0xcc, 0x64, 0xd5, 0xa9, 0x3a, 0xc2, 0x54, 0x6d, 0x7c, 0x93, 0x5e, 0x99, 0x99, 0xaa,
-	0xe3, 0x54, 0x2d, 0xa6, 0xea, 0xae, 0xa9, 0xea, 0xb6, 0xed, 0xf8, 0xba, 0x6f, 0x3a, 0x36, 0xc7,
-	0xbf, 0xde, 0x78, 0xe4, 0xf0, 0x9a, 0xc3, 0xd5, 0x8a, 0xce, 0x99, 0x3c, 0x4c, 0xdd, 0x2f, 0x54,
-	0x98, 0xaf, 0x17, 0x54, 0x57, 0xaf, 0x9a, 0xb6, 0x30, 0x46, 0xdb, 0x37, 0x22, 0xb8, 0xba, 0xba,
-	0xa7, 0xd7, 0x02, 0xe0, 0x37, 0x23, 0x8c, 0xf1, 0x13, 0xad, 0xd5, 0x08, 0x6b, 0xee, 0xeb, 0x3e,
-	0x2b, 0x9b, 0xf6, 0x4e, 0xa0, 0x2a, 0x17, 0xe1, 0xd0, 0x84, 0xbe, 0x15, 0x61, 0x59, 0x65, 0x36,
-	0xe3, 0x26, 0x2f, 0x57, 0x3c, 0xd3, 0xa8, 0xb2, 0xb2, 0xa1, 0xfb, 0xba, 0xf4, 0x54, 0x26, 0x81,
-	0x7e, 0xd8, 0xb8, 0x91, 0x2d, 0xa1, 0x4b, 0x63, 0x8f, 0xf7, 0x18, 0xf7, 0x95, 0xcf, 0xe1, 0x52,
-	0xdb, 0x2e, 0x77, 0x1d, 0x9b, 0x33, 0xba, 0x06, 0x29, 0xa9, 0x7f, 0x9a, 0xcc, 0x92, 0xdc, 0xf8,
-	0xfc, 0xd5, 0x7c, 0xff, 0xd7, 0xca, 0x4b, 0xff, 0xd5, 0xe4, 0xf1, 0xdf, 0x57, 0x86, 0x34, 0xf4,
-	0x55, 0xb6, 0x61, 0x4a, 0x80, 0x6f, 0x30, 0x5f, 0x93, 0x76, 0x78, 0x2c, 0x9d, 0x81, 0x34, 0x7a,
-	0x6e, 0x1a, 0xe2, 0x88, 0xb4, 0xd6, 0xdc, 0xa0, 0x97, 0x21, 0xed, 0xd4, 0x4c, 0xbf, 0xac, 0xbb,
-	0x2e, 0x9f, 0x4e, 0xcc, 0x92, 0xdc, 0x98, 0x36, 0xd6, 0xd8, 0x28, 0xb9, 0x2e, 0x57, 0x3e, 0x86,
-	0x6c, 0x07, 0xe8, 0x6a, 0xfd, 0xee, 0xe6, 0x56, 0xa1, 0x58, 0x0c, 0xc0, 0xa7, 0x20, 0xc5, 0x4c,
-	0xb7, 0x50, 0x2c, 0x0a, 0xe4, 0xa4, 0x86, 0xab, 0xfe, 0xb0, 0x9f, 0xc2, 0xe5, 0x00, 0xf6, 0xbe,
-	0xee, 0x33, 0xee, 0xbf, 0xcb, 0xcc, 0xea, 0xae, 0x1f, 0x8f, 0xf0, 0x0c, 0xa4, 0x77, 0x4c, 0x5b,
-	0xb7, 0xcc, 0x27, 0xcc, 0x40, 0xe4, 0xe6, 0x86, 0x72, 0x13, 0x66, 0xba, 0x43, 0xe3, 0x65, 0x4f,
-	0x41, 0x6a, 0x57, 0xec, 0x04, 0x7c, 0xe5, 0x4a, 0xf9, 0x02, 0xae, 0xb4, 0xfb, 0x6d, 0x37, 0xe2,
-	0x66, 0xd3, 0x36, 0xd8, 0xc1, 0x8b, 0xa0, 0x75, 0x00, 0xb3, 0xbd, 0xe1, 0x91, 0xda, 0x47, 0x00,
-	0x3c, 0xdc, 0xc5, 0x58, 0xc8, 0x47, 0xc5, 0x02, 0xe2, 0xec, 0x38, 0xc2, 0x0b, 0x63, 0xa2, 0x05,
-	0x47, 0xf9, 0x8f, 0xc0, 0x2b, 0x67, 0x02, 0x03, 0x4f, 0xdc, 0x80, 0x51, 0xc4, 0xc1, 0xe3, 0xae,
-	0x45, 0x1d, 0x17, 0x44, 0x81, 0x3c, 0x27, 0xf0, 0xa6, 0x0f, 0x60, 0x94, 0xef, 0xd5, 0x6a, 0xba,
-	0x57, 0x9f, 0x4e, 0xc5, 0xe3, 0x8d, 0x40, 0xdb, 0xd2, 0x2b, 0xc0, 0x43, 0x10, 0xba, 0x02, 0x49,
-	0x11, 0x38, 0xa3, 0xb3, 0xc3, 0xb9, 0xf1, 0xf9, 0xd7, 0xa3, 0xc0, 0x4a, 0xc8, 0x88, 0x68, 0xc2,
-	0xed, 0x5e, 0x72, 0x2c, 0x31, 0x91, 0x52, 0x8e, 0x30, 0x23, 0x4a, 0x96, 0xd5, 0x91, 0x11, 0xeb,
-	0x00, 0xcd, 0x12, 0x15, 0x66, 0x9d, 0xac, 0x67, 0xf9, 0x46, 0x3d, 0xcb, 0xcb, 0xe2, 0x89, 0xf5,
-	0x2c, 0xbf, 0xa5, 0x57, 0x19, 0xfa, 0x6a, 0x2d, 0x9e, 0xfd, 0x83, 0xfc, 0xe7, 0xe0, 0xe2, 0x5b,
-	0xcf, 0xc7, 0x8b, 0xff, 0xa4, 0x79, 0xf1, 0xc3, 0x42, 0xe2, 0x62, 0x94, 0xc4, 0x1e, 0x4f, 0xd8,
-	0xf9, 0x10, 0x1b, 0x6d, 0xca, 0x12, 0xf8, 0xa8, 0x51, 0xca, 0x24, 0x56, 0xab, 0xb4, 0x7b, 0xc9,
-	0x31, 0x32, 0x91, 0x50, 0xbe, 0x21, 0x30, 0x1d, 0x9c, 0x1c, 0x46, 0x5a, 0xbc, 0x7c, 0x98, 0x84,
-	0x11, 0x53, 0x04, 0x72, 0x42, 0xe4, 0x99, 0x5c, 0xb4, 0xa4, 0xdf, 0x70, 0x6b, 0xfa, 0xb5, 0x67,
-	0x4f, 0xb2, 0x33, 0x7b, 0xbe, 0x84, 0x57, 0xbb, 0xb0, 0xc0, 0xbb, 0x7c, 0x1f, 0xd2, 0x3c, 0xd8,
-	0xc4, 0xb7, 0xbc, 0x1e, 0x3b, 0x6b, 0xf0, 0xfe, 0x9a, 0x08, 0x0d, 0xc9, 0xb2, 0x82, 0x68, 0xac,
-	0x6a, 0x72, 0x9f, 0x79, 0xcc, 0x58, 0x63, 0xb6, 0x13, 0x56, 0xf1, 0x08, 0xd9, 0xeb, 0x5d, 0x1e,
-	0x60, 0x80, 0xd0, 0x52, 0xbe, 0x22, 0xf0, 0x5a, 0x0f, 0x1a, 0xcd, 0x4a, 0x66, 0x88, 0x9d, 0x69,
-	0x32, 0x3b, 0x9c, 0x4b, 0x6b, 0xb8, 0x7a, 0x61, 0x21, 0xa0, 0xcc, 0x61, 0x49, 0xfc, 0xa0, 0xc2,
-	0x1d, 0x8b, 0xf9, 0x6c, 0x4d, 0xdb, 0x7e, 0xc8, 0xbc, 0xc6, 0x3d, 0x86, 0x1d, 0xed, 0x2e, 0x96,
-	0xb5, 0xae, 0x26, 0xc8, 0x73, 0x0e, 0x2e, 0x18, 0x1e, 0x2f, 0xef, 0xe3, 0xbe, 0x60, 0xfb, 0x92,
-	0x36, 0x6e, 0x78, 0x3c, 0x30, 0x55, 0xbe, 0x25, 0x30, 0x27, 0x70, 0x1e, 0xea, 0x96, 0x69, 0xe8,
-	0x3e, 0xdb, 0x90, 0x9d, 0x75, 0x55, 0x34, 0xd6, 0x78, 0x17, 0xff, 0x1e, 0x24, 0x1b, 0x0d, 0x18,
-	0x05, 0x17, 0xa2, 0x22, 0xa0, 0xed, 0x84, 0x35, 0xdd, 0xd7, 0x31, 0x12, 0x04, 0x88, 0x72, 0x1f,
-	0x94, 0x7e, 0x7c, 0x50, 0xd9, 0x24, 0x8c, 0xec, 0x37, 0x0c, 0x04, 0x99, 0x31, 0x4d, 0x2e, 0xe8,
-	0x04, 0x0c, 0x33, 0xcf, 0x13, 0x3c, 0xd2, 0x5a, 0xe3, 0xeb, 0xfc, 0xd3, 0x8b, 0x30, 0x22, 0xe0,
-	0xe8, 0x0f, 0x04, 0x52, 0xb2, 0x7b, 0xd3, 0xf9, 0x58, 0x19, 0xdf, 0x36, 0x40, 0x64, 0x16, 0xce,
-	0xe5, 0x23, 0x59, 0x2a, 0xf9, 0xaf, 0x7f, 0xff, 0xf7, 0xfb, 0x44, 0x8e, 0x5e, 0x55, 0x63, 0x0d,
-	0x61, 0xf4, 0x27, 0x02, 0xa3, 0x58, 0x65, 0xe8, 0xcd, 0x73, 0x97, 0x25, 0x49, 0x74, 0xd0, 0x72,
-	0xa6, 0x2c, 0x0b, 0xb2, 0x45, 0xba, 0xa0, 0xc6, 0x1b, 0x02, 0xd5, 0xc3, 0x30, 0x02, 0x8e, 0xe8,
-	0x2f, 0x04, 0x2e, 0x76, 0x8c, 0x29, 0xf4, 0xf6, 0x39, 0x99, 0x74, 0xcc, 0x37, 0x83, 0x2b, 0x59,
-	0x14, 0x4a, 0x0a, 0x54, 0x8d, 0x52, 0x22, 0x07, 0x26, 0xf5, 0x50, 0x7e, 0x1e, 0xd1, 0x1f, 0x09,
-	0x00, 0x82, 0x95, 0x2c, 0x2b, 0xe6, 0x13, 0x9c, 0xe9, 0x71, 0x31, 0x89, 0x9f, 0xed, 0x4d, 0x8a,
-	0x2a, 0x88, 0x5f, 0xa7, 0xd7, 0x62, 0x3e, 0x01, 0xfd, 0x8d, 0xc0, 0x85, 0xd6, 0x59, 0x8b, 0x2e,
-	0xc7, 0xbd, 0xb3, 0x2e, 0xc3, 0x5f, 0xe6, 0xed, 0xc1, 0x9c, 0x91, 0x7c, 0x49, 0x90, 0x5f, 0xa6,
-	0x4b, 0x51, 0xe4, 0x2d, 0xe1, 0x5d, 0x96, 0xed, 0xa7, 0x2d, 0x8a, 0xfe, 0x22, 0x30, 0xd1, 0x39,
-	0xa3, 0xd1, 0x77, 0xce, 0xc7, 0xea, 0xcc, 0xf0, 0x98, 0xb9, 0x33, 0x38, 0x00, 0x4a, 0x5b, 0x17,
-	0xd2, 0xee, 0xd0, 0xdb, 0x31, 0xa5, 0x05, 0x3f, 0x7c, 0x0c, 0x76, 0xd0, 0xa6, 0xef, 0x98, 0x40,
-	0x3a, 0xec, 0x7f, 0xf4, 0x56, 0x5c, 0x5e, 0x9d, 0xed, 0x3f, 0xb3, 0x34, 0x80, 0xe7, 0x79, 0xa5,
-	0x34, 0x7f, 0xbc, 0xb5, 0x4a, 0x50, 0x0f, 0x85, 0xaa, 0x23, 0xfa, 0x2b, 0x81, 0x89, 0xce, 0xfe,
-	0x48, 0xe3, 0x05, 0x50, 0x8f, 0xee, 0x9e, 0x59, 0x19, 0xd0, 0x1b, 0x95, 0x2d, 0x09, 0x65, 0x0b,
-	0xb4, 0x10, 0x99, 0x3c, 0x21, 0x42, 0x19, 0xfb, 0xf6, 0x1f, 0x04, 0x2e, 0x75, 0xe9, 0xa3, 0x31,
-	0x43, 0xaf, 0x77, 0x93, 0x8e, 0x19, 0x7a, 0x7d, 0x5a, 0xb8, 0xb2, 0x22, 0x54, 0x2d, 0xd2, 0x62,
-	0x94, 0x2a, 0x07, 0x41, 0xca, 0xad, 0x1d, 0x9f, 0x3e, 0x25, 0xf0, 0x72, 0xd7, 0x4e, 0x4a, 0x4b,
-	0xb1, 0xa8, 0xf5, 0x9b, 0x0a, 0x32, 0xab, 0xcf, 0x03, 0x81, 0x43, 0xf4, 0x83, 0xe3, 0x93, 0x2c,
-	0x79, 0x76, 0x92, 0x25, 0xff, 0x9c, 0x64, 0xc9, 0x77, 0xa7, 0xd9, 0xa1, 0x67, 0xa7, 0xd9, 0xa1,
-	0x3f, 0x4f, 0xb3, 0x43, 0x9f, 0xbd, 0x55, 0x35, 0xfd, 0xdd, 0xbd, 0x4a, 0xfe, 0x91, 0x53, 0xeb,
-	0xa5, 0x7d, 0x7f, 0x41, 0x3d, 0x08, 0x2f, 0xc0, 0xaf, 0xbb, 0x8c, 0x57, 0x52, 0xe2, 0xbf, 0x00,
-	0x0b, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x0a, 0xfa, 0xcf, 0xa3, 0x11, 0x00, 0x00,
+	// 1363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x24, 0xdb, 0x4d, 0xf6, 0xa5, 0x85, 0x68, 0x1a, 0xda, 0xe0, 0x86, 0x6d, 0x6a, 0xa4,
+	0x36, 0x6d, 0x91, 0xcd, 0x26, 0x24, 0x69, 0x28, 0xfd, 0x91, 0x28, 0x4d, 0x68, 0x29, 0x25, 0x38,
+	0xd0, 0xf2, 0x43, 0x68, 0xe5, 0xd4, 0xd3, 0x8d, 0x91, 0xd7, 0x76, 0x3d, 0x4e, 0x48, 0x5a, 0x45,
+	0x42, 0x88, 0x33, 0x42, 0xe2, 0x8e, 0xe0, 0x1f, 0xe0, 0xca, 0xad, 0x12, 0xe2, 0x52, 0x21, 0x0e,
+	0x95, 0x38, 0xc0, 0x05, 0x84, 0x1a, 0xfe, 0x07, 0xae, 0xc8, 0x33, 0xcf, 0x5e, 0xef, 0x66, 0x37,
+	0xf6, 0x2e, 0x3d, 0x25, 0x9e, 0x9d, 0xf7, 0xbd, 0xef, 0x7b, 0x7e, 0xf3, 0xe6, 0xdb, 0x85, 0x73,
+	0xd6, 0x4e, 0x9d, 0xb9, 0xdc, 0xf6, 0xdc, 0xed, 0x9d, 0x07, 0x7a, 0xf2, 0xa0, 0x07, 0x9e, 0xe3,
+	0x98, 0xbe, 0xaf, 0xdf, 0xdf, 0x64, 0xc1, 0x8e, 0xe6, 0x07, 0x5e, 0xe8, 0xd1, 0x72, 0x7a, 0xaf,
+	0x96, 0x3c, 0x68, 0xb8, 0x57, 0x19, 0xad, 0x79, 0x35, 0x4f, 0x6c, 0xd5, 0xa3, 0xff, 0x64, 0x94,
+	0x32, 0x5e, 0xf3, 0xbc, 0x9a, 0xc3, 0x74, 0xd3, 0xb7, 0x75, 0xd3, 0x75, 0xbd, 0xd0, 0x0c, 0x6d,
+	0xcf, 0xe5, 0xf8, 0xe9, 0xb9, 0xbb, 0x1e, 0xaf, 0x7b, 0x5c, 0x5f, 0x37, 0x39, 0x93, 0xc9, 0xf4,
+	0xad, 0xca, 0x3a, 0x0b, 0xcd, 0x8a, 0xee, 0x9b, 0x35, 0xdb, 0x15, 0x9b, 0x71, 0xef, 0xf9, 0x0c,
+	0xae, 0xbe, 0x19, 0x98, 0xf5, 0x18, 0xf8, 0x95, 0x8c, 0xcd, 0xf8, 0x17, 0x77, 0xeb, 0x19, 0xbb,
+	0x79, 0x68, 0x86, 0xac, 0x6a, 0xbb, 0xf7, 0x62, 0x55, 0x93, 0x19, 0x01, 0x0d, 0xe8, 0x0b, 0x19,
+	0x3b, 0x6b, 0xcc, 0x65, 0xdc, 0xe6, 0xd5, 0xf5, 0xc0, 0xb6, 0x6a, 0xac, 0x6a, 0x99, 0xa1, 0x99,
+	0x93, 0x54, 0x10, 0x71, 0x72, 0xec, 0xba, 0x1d, 0x62, 0x40, 0x25, 0x23, 0x00, 0x53, 0xf8, 0xe6,
+	0x26, 0x67, 0x32, 0x44, 0x1d, 0x05, 0xfa, 0x6e, 0x54, 0xf5, 0x55, 0x51, 0x3b, 0x83, 0xdd, 0xdf,
+	0x64, 0x3c, 0x54, 0x3f, 0x86, 0xa3, 0x4d, 0xab, 0xdc, 0xf7, 0x5c, 0xce, 0xe8, 0x12, 0x14, 0x65,
+	0x8d, 0xc7, 0xc8, 0x04, 0x99, 0x1c, 0x9e, 0x3a, 0xad, 0x1d, 0xdc, 0x11, 0x9a, 0x8c, 0x5f, 0x2c,
+	0x3c, 0xfe, 0xeb, 0x64, 0x9f, 0x81, 0xb1, 0xea, 0x1a, 0x1c, 0x13, 0xe0, 0x2b, 0x2c, 0x34, 0xe4,
+	0x3e, 0x4c, 0x4b, 0xc7, 0xa1, 0x84, 0x91, 0xd7, 0x2d, 0x91, 0xa2, 0x64, 0x34, 0x16, 0xe8, 0x09,
+	0x28, 0x79, 0x75, 0x3b, 0xac, 0x9a, 0xbe, 0xcf, 0xc7, 0xfa, 0x27, 0xc8, 0xe4, 0x90, 0x31, 0x14,
+	0x2d, 0x2c, 0xf8, 0x3e, 0x57, 0xdf, 0x87, 0x72, 0x0b, 0xe8, 0xe2, 0xce, 0xb5, 0xeb, 0xab, 0x95,
+	0x99, 0x99, 0x18, 0xfc, 0x18, 0x14, 0x99, 0xed, 0x57, 0x66, 0x66, 0x04, 0x72, 0xc1, 0xc0, 0xa7,
+	0x83, 0x61, 0x3f, 0x84, 0x13, 0x31, 0xec, 0x4d, 0x33, 0x64, 0x3c, 0x7c, 0x93, 0xd9, 0xb5, 0x8d,
+	0x30, 0x1f, 0xe1, 0x71, 0x28, 0xdd, 0xb3, 0x5d, 0xd3, 0xb1, 0x1f, 0x30, 0x0b, 0x91, 0x1b, 0x0b,
+	0xea, 0x2c, 0x8c, 0xb7, 0x87, 0xc6, 0x62, 0x1f, 0x83, 0xe2, 0x86, 0x58, 0x89, 0xf9, 0xca, 0x27,
+	0xf5, 0x13, 0x38, 0xd9, 0x1c, 0xb7, 0x16, 0xf5, 0xe6, 0x75, 0xd7, 0x62, 0xdb, 0xcf, 0x82, 0xd6,
+	0x36, 0x4c, 0x74, 0x86, 0x47, 0x6a, 0xef, 0x01, 0xf0, 0x64, 0x15, 0x7b, 0x41, 0xcb, 0xea, 0x05,
+	0xc4, 0xb9, 0xe7, 0x89, 0x28, 0xec, 0x89, 0x14, 0x8e, 0xfa, 0x2f, 0x81, 0xe3, 0xfb, 0x1a, 0x03,
+	0x33, 0xae, 0xc0, 0x20, 0xe2, 0x60, 0xba, 0x33, 0x59, 0xe9, 0xe2, 0x2e, 0x90, 0x79, 0xe2, 0x68,
+	0x7a, 0x0b, 0x06, 0xf9, 0x66, 0xbd, 0x6e, 0x06, 0x3b, 0x63, 0xc5, 0x7c, 0xbc, 0x11, 0x68, 0x4d,
+	0x46, 0xc5, 0x78, 0x08, 0x42, 0x2f, 0x41, 0x41, 0x34, 0xce, 0xe0, 0xc4, 0xc0, 0xe4, 0xf0, 0xd4,
+	0xcb, 0x59, 0x60, 0x0b, 0xc8, 0x88, 0x18, 0x22, 0xec, 0x46, 0x61, 0xa8, 0x7f, 0xa4, 0xa8, 0xee,
+	0xe2, 0x89, 0x58, 0x70, 0x9c, 0x96, 0x13, 0xb1, 0x0c, 0xd0, 0x18, 0x83, 0xc9, 0xa9, 0x93, 0x33,
+	0x53, 0x8b, 0x66, 0xa6, 0x26, 0x07, 0x34, 0xce, 0x4c, 0x6d, 0xd5, 0xac, 0x31, 0x8c, 0x35, 0x52,
+	0x91, 0x07, 0x37, 0xf9, 0x4f, 0x71, 0xe1, 0xd3, 0xf9, 0xb1, 0xf0, 0x77, 0x1a, 0x85, 0x1f, 0x10,
+	0x12, 0xe7, 0xb2, 0x24, 0x76, 0x78, 0x85, 0xad, 0x2f, 0x62, 0xa5, 0x49, 0x59, 0x3f, 0xbe, 0xd4,
+	0x2c, 0x65, 0x12, 0x2b, 0x2d, 0xed, 0x46, 0x61, 0x88, 0x8c, 0xf4, 0xab, 0x5f, 0x12, 0x18, 0x8b,
+	0x33, 0x27, 0x9d, 0x96, 0xef, 0x3c, 0x8c, 0xc2, 0x21, 0x5b, 0x34, 0x72, 0xbf, 0x38, 0x67, 0xf2,
+	0x21, 0x75, 0xfc, 0x06, 0xd2, 0xc7, 0xaf, 0xf9, 0xf4, 0x14, 0x5a, 0x4f, 0xcf, 0xa7, 0xf0, 0x62,
+	0x1b, 0x16, 0x58, 0xcb, 0xb7, 0xa1, 0xc4, 0xe3, 0x45, 0x7c, 0x97, 0x67, 0x73, 0x9f, 0x1a, 0xac,
+	0x5f, 0x03, 0x21, 0x92, 0x2c, 0x27, 0x88, 0xc1, 0x6a, 0x36, 0x0f, 0x59, 0xc0, 0xac, 0x25, 0xe6,
+	0x7a, 0xc9, 0x14, 0xcf, 0x90, 0xbd, 0xdc, 0xe6, 0x05, 0xf4, 0xd0, 0x5a, 0xea, 0xe7, 0x04, 0x5e,
+	0xea, 0x40, 0xa3, 0x31, 0xc9, 0x2c, 0xb1, 0x32, 0x46, 0x26, 0x06, 0x26, 0x4b, 0x06, 0x3e, 0x3d,
+	0xb3, 0x16, 0x50, 0x4f, 0xe1, 0x48, 0x7c, 0x67, 0x9d, 0x7b, 0x0e, 0x0b, 0xd9, 0x92, 0xb1, 0x76,
+	0x9b, 0x05, 0x51, 0x1d, 0x93, 0x1b, 0xed, 0x1a, 0x4c, 0x74, 0xde, 0x82, 0x3c, 0x4f, 0xc1, 0x61,
+	0x2b, 0xe0, 0xd5, 0x2d, 0x5c, 0x17, 0x6c, 0x8f, 0x18, 0xc3, 0x56, 0xc0, 0xe3, 0xad, 0xea, 0x57,
+	0x04, 0x4e, 0x09, 0x9c, 0xdb, 0xa6, 0x63, 0x5b, 0x66, 0xc8, 0x56, 0xe4, 0xed, 0xbd, 0x28, 0x6e,
+	0xd6, 0x7c, 0x85, 0x7f, 0x0b, 0x0a, 0xd1, 0x25, 0x8f, 0x82, 0x2b, 0x59, 0x1d, 0xd0, 0x94, 0x61,
+	0xc9, 0x0c, 0x4d, 0xec, 0x04, 0x01, 0xa2, 0xde, 0x04, 0xf5, 0x20, 0x3e, 0xa8, 0x6c, 0x14, 0x0e,
+	0x6d, 0x45, 0x1b, 0x04, 0x99, 0x21, 0x43, 0x3e, 0xd0, 0x11, 0x18, 0x60, 0x41, 0x20, 0x78, 0x94,
+	0x8c, 0xe8, 0x5f, 0x75, 0x16, 0x07, 0x91, 0x61, 0x86, 0xec, 0x66, 0x64, 0x2c, 0xf2, 0xf5, 0x92,
+	0xca, 0xe1, 0xf8, 0xbe, 0x38, 0x4c, 0xfd, 0x01, 0x0c, 0x37, 0x7c, 0x8a, 0xac, 0x69, 0x0e, 0xd1,
+	0x09, 0xd0, 0x1d, 0x3b, 0xdc, 0x58, 0x76, 0xbc, 0xcf, 0xe2, 0xfb, 0x22, 0x48, 0x32, 0xa8, 0x27,
+	0xf0, 0xac, 0xad, 0x46, 0x76, 0xc6, 0x92, 0x8a, 0x93, 0xf7, 0xbd, 0x05, 0x4a, 0xbb, 0x0f, 0x13,
+	0x52, 0xcf, 0x09, 0x13, 0x64, 0xa1, 0xeb, 0x8a, 0x79, 0x9d, 0xcf, 0xe2, 0x25, 0x81, 0x04, 0x28,
+	0x32, 0x3a, 0xe2, 0xa7, 0x33, 0x4c, 0x7d, 0x47, 0xe1, 0x90, 0x48, 0x4c, 0xbf, 0x27, 0x50, 0x94,
+	0xfe, 0x87, 0x4e, 0xe5, 0x9a, 0x99, 0x4d, 0x16, 0x4c, 0x99, 0xee, 0x2a, 0x46, 0xea, 0x52, 0xb5,
+	0x2f, 0x7e, 0xfb, 0xe7, 0x9b, 0xfe, 0x49, 0x7a, 0x5a, 0xcf, 0x65, 0x95, 0xe9, 0x8f, 0x04, 0x06,
+	0x71, 0x4e, 0xd3, 0xd9, 0xae, 0x07, 0xbb, 0x24, 0xda, 0xeb, 0x85, 0xa0, 0x5e, 0x14, 0x64, 0x67,
+	0xe8, 0xb4, 0x9e, 0xcf, 0xaa, 0xeb, 0x0f, 0x93, 0x86, 0xdb, 0xa5, 0x3f, 0x13, 0x78, 0xbe, 0xc5,
+	0xe8, 0xd1, 0xcb, 0x5d, 0x32, 0x69, 0x71, 0x88, 0xbd, 0x2b, 0x99, 0x13, 0x4a, 0x2a, 0x54, 0xcf,
+	0x52, 0x22, 0x2d, 0xa7, 0xfe, 0x50, 0xfe, 0xdd, 0xa5, 0x3f, 0x10, 0x00, 0x04, 0x5b, 0x70, 0x9c,
+	0x9c, 0xaf, 0x60, 0x9f, 0x4b, 0x50, 0xe6, 0xba, 0x8e, 0x43, 0xe2, 0xba, 0x20, 0x7e, 0x96, 0x9e,
+	0xc9, 0xf9, 0x0a, 0xe8, 0xaf, 0x04, 0x0e, 0xa7, 0xdd, 0x2a, 0xbd, 0x98, 0xb7, 0x66, 0x6d, 0xec,
+	0xb3, 0xf2, 0x46, 0x6f, 0xc1, 0x48, 0x7e, 0x41, 0x90, 0xbf, 0x48, 0xe7, 0xb3, 0xc8, 0x3b, 0x22,
+	0xba, 0x2a, 0x2f, 0xf0, 0xa6, 0x2e, 0xfa, 0x93, 0xc0, 0x48, 0xab, 0xcb, 0xa5, 0x57, 0xba, 0x63,
+	0xb5, 0xcf, 0x7e, 0x2b, 0x57, 0x7b, 0x07, 0x40, 0x69, 0xcb, 0x42, 0xda, 0x55, 0x7a, 0x39, 0xa7,
+	0xb4, 0xf8, 0xeb, 0xa9, 0xc5, 0xb6, 0x9b, 0xf4, 0x3d, 0x26, 0x50, 0x4a, 0x1c, 0x04, 0xbd, 0x90,
+	0x97, 0x57, 0xab, 0x81, 0x52, 0xe6, 0x7b, 0x88, 0xec, 0x56, 0x4a, 0xe3, 0x2b, 0x76, 0x5a, 0x82,
+	0xfe, 0x50, 0xa8, 0xda, 0xa5, 0xbf, 0x10, 0x18, 0x69, 0x75, 0x18, 0x34, 0x5f, 0x03, 0x75, 0xf0,
+	0x47, 0xca, 0xa5, 0x1e, 0xa3, 0x51, 0xd9, 0xbc, 0x50, 0x36, 0x4d, 0x2b, 0x99, 0x87, 0x27, 0x41,
+	0xa8, 0xa2, 0xf3, 0xf9, 0x9d, 0xc0, 0xd1, 0x36, 0x4e, 0x24, 0x67, 0xeb, 0x75, 0xb6, 0x39, 0xca,
+	0xd5, 0xde, 0x01, 0x50, 0xd5, 0x25, 0xa1, 0x6a, 0x8e, 0xce, 0x64, 0xa9, 0xf2, 0x10, 0xa4, 0x9a,
+	0xf6, 0x4c, 0xf4, 0x5b, 0x02, 0x2f, 0xb4, 0xf5, 0x22, 0x74, 0x21, 0x17, 0xb5, 0x83, 0x7c, 0x95,
+	0xb2, 0xf8, 0x7f, 0x20, 0xf0, 0xea, 0x7f, 0x14, 0x8d, 0xdc, 0xc4, 0x44, 0xe4, 0x1c, 0xb9, 0xfb,
+	0xfc, 0x90, 0x32, 0xd7, 0x75, 0x1c, 0xd6, 0xf7, 0x8a, 0xa8, 0xef, 0x3c, 0x9d, 0xcb, 0xff, 0xeb,
+	0x0e, 0x6f, 0x3a, 0xd3, 0x8f, 0x08, 0x1c, 0x69, 0x72, 0x35, 0x74, 0x3e, 0xa7, 0x55, 0xd8, 0x6f,
+	0x93, 0x94, 0xd7, 0x7b, 0x09, 0x45, 0x25, 0xb3, 0x42, 0xc9, 0xab, 0x54, 0xcb, 0x36, 0x1b, 0x69,
+	0xab, 0xb5, 0x78, 0xeb, 0xf1, 0xd3, 0x32, 0x79, 0xf2, 0xb4, 0x4c, 0xfe, 0x7e, 0x5a, 0x26, 0x5f,
+	0xef, 0x95, 0xfb, 0x9e, 0xec, 0x95, 0xfb, 0xfe, 0xd8, 0x2b, 0xf7, 0x7d, 0xf4, 0x5a, 0xcd, 0x0e,
+	0x37, 0x36, 0xd7, 0xb5, 0xbb, 0x5e, 0xbd, 0x13, 0xe6, 0xd6, 0xb4, 0xbe, 0x9d, 0x00, 0x87, 0x3b,
+	0x3e, 0xe3, 0xeb, 0x45, 0xf1, 0x4b, 0xd6, 0xf4, 0x7f, 0x03, 0x00, 0xa6, 0x63, 0x39, 0xbf, 0xcb,
+	0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1114,6 +1297,10 @@ type QueryClient interface {
 	ObsoleteDRSVersions(ctx context.Context, in *QueryObsoleteDRSVersionsRequest, opts ...grpc.CallOption) (*QueryObsoleteDRSVersionsResponse, error)
 	// Validates provided genesis bridge data against the hub.
 	ValidateGenesisBridge(ctx context.Context, in *QueryValidateGenesisBridgeRequest, opts ...grpc.CallOption) (*QueryValidateGenesisBridgeResponse, error)
+	// Queries the rate limits of the rollapp with their current utilisation.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// Queries the rollapps whose bridge is paused.
+	PausedBridges(ctx context.Context, in *QueryPausedBridgesRequest, opts ...grpc.CallOption) (*QueryPausedBridgesResponse, error)
 }
 
 type queryClient struct {
@@ -1214,6 +1401,24 @@ func (c *queryClient) ValidateGenesisBridge(ctx context.Context, in *QueryValida
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedBridges(ctx context.Context, in *QueryPausedBridgesRequest, opts ...grpc.CallOption) (*QueryPausedBridgesResponse, error) {
+	out := new(QueryPausedBridgesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/PausedBridges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -1236,6 +1441,10 @@ type QueryServer interface {
 	ObsoleteDRSVersions(context.Context, *QueryObsoleteDRSVersionsRequest) (*QueryObsoleteDRSVersionsResponse, error)
 	// Validates provided genesis bridge data against the hub.
 	ValidateGenesisBridge(context.Context, *QueryValidateGenesisBridgeRequest) (*QueryValidateGenesisBridgeResponse, error)
+	// Queries the rate limits of the rollapp with their current utilisation.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// Queries the rollapps whose bridge is paused.
+	PausedBridges(context.Context, *QueryPausedBridgesRequest) (*QueryPausedBridgesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1272,6 +1481,12 @@ func (*UnimplementedQueryServer) ObsoleteDRSVersions(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) ValidateGenesisBridge(ctx context.Context, req *QueryValidateGenesisBridgeRequest) (*QueryValidateGenesisBridgeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateGenesisBridge not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) PausedBridges(ctx context.Context, req *QueryPausedBridgesRequest) (*QueryPausedBridgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedBridges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1457,6 +1672,42 @@ func _Query_ValidateGenesisBridge_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedBridges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedBridgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedBridges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/PausedBridges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedBridges(ctx, req.(*QueryPausedBridgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.rollapp.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1501,6 +1752,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateGenesisBridge",
 			Handler:    _Query_ValidateGenesisBridge_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "PausedBridges",
+			Handler:    _Query_PausedBridges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/rollapp/query.proto",
@@ -2245,6 +2504,133 @@ func (m *QueryValidateGenesisBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedBridgesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedBridgesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedBridgesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedBridgesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedBridgesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedBridgesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedBridges) > 0 {
+		for iNdEx := len(m.PausedBridges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedBridges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2541,8 +2927,60 @@ func (m *QueryValidateGenesisBridgeResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPausedBridgesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedBridgesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedBridges) > 0 {
+		for _, e := range m.PausedBridges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -4455,6 +4893,306 @@ func (m *QueryValidateGenesisBridgeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimitWithFlow{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedBridgesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedBridgesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedBridgesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedBridgesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedBridgesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedBridgesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBridges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBridges = append(m.PausedBridges, BridgePause{})
+			if err := m.PausedBridges[len(m.PausedBridges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewRateLimit(rollappID, denom string, maxInflow, maxOutflow math.Int, window time.Duration) RateLimit {
+	return RateLimit{
+		RollappId:  rollappID,
+		Denom:      denom,
+		MaxInflow:  maxInflow,
+		MaxOutflow: maxOutflow,
+		Window:     window,
+	}
+}
+
+func (r RateLimit) ValidateBasic() error {
+	if r.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id cannot be empty")
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	if r.MaxInflow.IsNil() || r.MaxInflow.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max inflow must not be negative")
+	}
+	if r.MaxOutflow.IsNil() || r.MaxOutflow.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max outflow must not be negative")
+	}
+	if r.MaxInflow.IsZero() && r.MaxOutflow.IsZero() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "at least one of max inflow and max outflow must be set")
+	}
+	if r.Window <= 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "window must be positive")
+	}
+	return nil
+}
+
+// CheckInflow returns an error if receiving the amount from the rollapp now exceeds the limit.
+func (r RateLimit) CheckInflow(flow RateLimitFlow, amount math.Int, now time.Time) error {
+	used := flow.SlidingInflow(r.Window, now)
+	if r.MaxInflow.IsPositive() && used.Add(amount).GT(r.MaxInflow) {
+		return errorsmod.Wrapf(ErrRateLimitExceeded, "inflow: rollapp: %s: denom: %s: used: %s: amount: %s: max: %s",
+			r.RollappId, r.Denom, used, amount, r.MaxInflow)
+	}
+	return nil
+}
+
+// CheckOutflow returns an error if sending the amount to the rollapp now exceeds the limit.
+func (r RateLimit) CheckOutflow(flow RateLimitFlow, amount math.Int, now time.Time) error {
+	used := flow.SlidingOutflow(r.Window, now)
+	if r.MaxOutflow.IsPositive() && used.Add(amount).GT(r.MaxOutflow) {
+		return errorsmod.Wrapf(ErrRateLimitExceeded, "outflow: rollapp: %s: denom: %s: used: %s: amount: %s: max: %s",
+			r.RollappId, r.Denom, used, amount, r.MaxOutflow)
+	}
+	return nil
+}
+
+// NewRateLimitFlow returns an empty flow for a window starting now.
+func NewRateLimitFlow(now time.Time) RateLimitFlow {
+	return RateLimitFlow{
+		Inflow:      math.ZeroInt(),
+		Outflow:     math.ZeroInt(),
+		WindowStart: now,
+		PrevInflow:  math.ZeroInt(),
+		PrevOutflow: math.ZeroInt(),
+	}
+}
+
+// Current returns the flow of the window containing now. The first window starts with the first
+// transfer, and the next ones follow each other from it. The flow of the window before the current one is kept, as it still counts
+// towards the sliding window.
+func (f RateLimitFlow) Current(window time.Duration, now time.Time) RateLimitFlow {
+	if f.WindowStart.IsZero() {
+		return NewRateLimitFlow(now)
+	}
+	if now.Before(f.WindowStart) {
+		return f
+	}
+	switch elapsed := now.Sub(f.WindowStart) / window; elapsed {
+	case 0:
+		return f
+	case 1:
+		next := NewRateLimitFlow(f.WindowStart.Add(window))
+		next.PrevInflow = f.Inflow
+		next.PrevOutflow = f.Outflow
+		return next
+	default:
+		return NewRateLimitFlow(f.WindowStart.Add(elapsed * window))
+	}
+}
+
+// SlidingInflow returns the inflow within the window ending now.
+func (f RateLimitFlow) SlidingInflow(window time.Duration, now time.Time) math.Int {
+	return f.sliding(f.Inflow, f.PrevInflow, window, now)
+}
+
+// SlidingOutflow returns the outflow within the window ending now.
+func (f RateLimitFlow) SlidingOutflow(window time.Duration, now time.Time) math.Int {
+	return f.sliding(f.Outflow, f.PrevOutflow, window, now)
+}
+
+// sliding returns the flow of the current window, and the flow of the previous window weighted by
+// its overlap with the window ending now, assuming transfers were spread evenly over it.
+func (f RateLimitFlow) sliding(cur, prev math.Int, window time.Duration, now time.Time) math.Int {
+	overlap := window - now.Sub(f.WindowStart)
+	if prev.IsNil() || overlap <= 0 {
+		return cur
+	}
+	return cur.Add(prev.Mul(math.NewInt(int64(overlap))).Quo(math.NewInt(int64(window))))
+}
+
+func (f RateLimitFlow) ValidateBasic() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() || f.Outflow.IsNil() || f.Outflow.IsNegative() ||
+		f.PrevInflow.IsNil() || f.PrevInflow.IsNegative() || f.PrevOutflow.IsNil() || f.PrevOutflow.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "flow must not be negative")
+	}
+	return nil
+}
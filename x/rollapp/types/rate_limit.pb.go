@@ -0,0 +1,1185 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/rate_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateLimit caps the amount of a denom transferred between the Hub and a
+// rollapp over its canonical channel within a time window.
+type RateLimit struct {
+	// RollappId is the rollapp the limit applies to.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// Denom is the Hub denom of the transferred token, i.e. the IBC denom of
+	// the rollapp tokens.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// MaxInflow is the max amount received from the rollapp within a window.
+	// Zero means no limit.
+	MaxInflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_inflow,json=maxInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_inflow"`
+	// MaxOutflow is the max amount sent to the rollapp within a window.
+	// Zero means no limit.
+	MaxOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_outflow,json=maxOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_outflow"`
+	// Window is the duration after which the flow is reset.
+	Window time.Duration `protobuf:"bytes,5,opt,name=window,proto3,stdduration" json:"window"`
+	// GovSet is true if the limit was set by the governance. The rollapp owner
+	// cannot update or remove such a limit.
+	GovSet bool `protobuf:"varint,6,opt,name=gov_set,json=govSet,proto3" json:"gov_set,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f0487af1d2e24a, []int{0}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RateLimit) GetGovSet() bool {
+	if m != nil {
+		return m.GovSet
+	}
+	return false
+}
+
+// RateLimitFlow is the amount transferred in the current window of a rate
+// limit, and in the window before it. The limit applies to a sliding window,
+// which overlaps both.
+type RateLimitFlow struct {
+	Inflow  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=inflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inflow"`
+	Outflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outflow"`
+	// WindowStart is the time the current window started.
+	WindowStart time.Time `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// PrevInflow is the inflow of the window before the current one.
+	PrevInflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=prev_inflow,json=prevInflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"prev_inflow"`
+	// PrevOutflow is the outflow of the window before the current one.
+	PrevOutflow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=prev_outflow,json=prevOutflow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"prev_outflow"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f0487af1d2e24a, []int{1}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// RateLimitWithFlow is a rate limit with its utilisation in the current
+// window.
+type RateLimitWithFlow struct {
+	RateLimit RateLimit     `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	Flow      RateLimitFlow `protobuf:"bytes,2,opt,name=flow,proto3" json:"flow"`
+}
+
+func (m *RateLimitWithFlow) Reset()         { *m = RateLimitWithFlow{} }
+func (m *RateLimitWithFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitWithFlow) ProtoMessage()    {}
+func (*RateLimitWithFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36f0487af1d2e24a, []int{2}
+}
+func (m *RateLimitWithFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitWithFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitWithFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitWithFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitWithFlow.Merge(m, src)
+}
+func (m *RateLimitWithFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitWithFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitWithFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitWithFlow proto.InternalMessageInfo
+
+func (m *RateLimitWithFlow) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+func (m *RateLimitWithFlow) GetFlow() RateLimitFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return RateLimitFlow{}
+}
+
+func init() {
+	proto.RegisterType((*RateLimit)(nil), "dymensionxyz.dymension.rollapp.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "dymensionxyz.dymension.rollapp.RateLimitFlow")
+	proto.RegisterType((*RateLimitWithFlow)(nil), "dymensionxyz.dymension.rollapp.RateLimitWithFlow")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/rate_limit.proto", fileDescriptor_36f0487af1d2e24a)
+}
+
+var fileDescriptor_36f0487af1d2e24a = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xcd, 0xa4, 0x49, 0x5a, 0x5f, 0x97, 0x05, 0x56, 0x25, 0x4c, 0x24, 0x9c, 0x28, 0x0b, 0x14,
+	0x16, 0x1d, 0x4b, 0x29, 0x3b, 0x76, 0x11, 0x6a, 0x89, 0x04, 0xad, 0x70, 0x91, 0x90, 0xd8, 0x58,
+	0x4e, 0x3d, 0x75, 0x2d, 0x3c, 0x1e, 0xcb, 0x9e, 0xbc, 0xf8, 0x08, 0xd4, 0x25, 0x1f, 0xc1, 0x9a,
+	0x6f, 0xe8, 0xb2, 0x4b, 0xc4, 0xa2, 0xa0, 0xe4, 0x47, 0xd0, 0x3c, 0xec, 0x56, 0x20, 0x50, 0xe5,
+	0x95, 0x7d, 0x7d, 0xe7, 0x9c, 0x39, 0xf7, 0x1c, 0xcf, 0x80, 0x1b, 0xae, 0x28, 0x49, 0x8b, 0x98,
+	0xa5, 0xcb, 0xd5, 0xa7, 0xdb, 0xc2, 0xcd, 0x59, 0x92, 0x04, 0x59, 0xe6, 0xe6, 0x01, 0x27, 0x7e,
+	0x12, 0xd3, 0x98, 0xe3, 0x2c, 0x67, 0x9c, 0x59, 0xce, 0x5d, 0x00, 0xae, 0x0a, 0xac, 0x01, 0xdd,
+	0xbd, 0x88, 0x45, 0x4c, 0x2e, 0x75, 0xc5, 0x9b, 0x42, 0x75, 0x9d, 0x88, 0xb1, 0x28, 0x21, 0xae,
+	0xac, 0xa6, 0xb3, 0x73, 0x37, 0x9c, 0xe5, 0x01, 0x17, 0x38, 0xd5, 0xef, 0xfd, 0xd9, 0xe7, 0x31,
+	0x25, 0x05, 0x0f, 0x68, 0xa6, 0x16, 0x0c, 0xbe, 0x35, 0xc1, 0xf0, 0x02, 0x4e, 0x5e, 0x0b, 0x29,
+	0xd6, 0x13, 0x00, 0xbd, 0x9f, 0x1f, 0x87, 0x36, 0xea, 0xa3, 0xa1, 0xe1, 0x19, 0xfa, 0xcb, 0x24,
+	0xb4, 0xf6, 0xa0, 0x1d, 0x92, 0x94, 0x51, 0xbb, 0x29, 0x3b, 0xaa, 0xb0, 0xde, 0x00, 0xd0, 0x60,
+	0xe9, 0xc7, 0xe9, 0x79, 0xc2, 0x16, 0xf6, 0x96, 0x68, 0x8d, 0xf1, 0xd5, 0x4d, 0xaf, 0xf1, 0xe3,
+	0xa6, 0xf7, 0x34, 0x8a, 0xf9, 0xc5, 0x6c, 0x8a, 0xcf, 0x18, 0x75, 0xcf, 0x58, 0x41, 0x59, 0xa1,
+	0x1f, 0xfb, 0x45, 0xf8, 0xd1, 0xe5, 0xab, 0x8c, 0x14, 0x78, 0x92, 0x72, 0xcf, 0xa0, 0xc1, 0x72,
+	0x22, 0x09, 0xac, 0x13, 0x30, 0x05, 0x1d, 0x9b, 0x71, 0xc9, 0xd7, 0xaa, 0xc5, 0x27, 0x14, 0x9d,
+	0x28, 0x06, 0xeb, 0x05, 0x74, 0x16, 0x71, 0x1a, 0xb2, 0x85, 0xdd, 0xee, 0xa3, 0xa1, 0x39, 0x7a,
+	0x8c, 0x95, 0x29, 0xb8, 0x34, 0x05, 0xbf, 0xd4, 0xa6, 0x8d, 0x77, 0xc4, 0x36, 0x5f, 0x7e, 0xf6,
+	0x90, 0xa7, 0x21, 0xd6, 0x23, 0xd8, 0x8e, 0xd8, 0xdc, 0x2f, 0x08, 0xb7, 0x3b, 0x7d, 0x34, 0xdc,
+	0xf1, 0x3a, 0x11, 0x9b, 0x9f, 0x12, 0x3e, 0xf8, 0xbc, 0x05, 0x0f, 0x2a, 0xe3, 0x0e, 0xc5, 0x3e,
+	0x87, 0xd0, 0xd1, 0x1e, 0xa0, 0x5a, 0x9a, 0x35, 0xda, 0x7a, 0x05, 0xdb, 0xe5, 0xf0, 0xcd, 0x5a,
+	0x44, 0x25, 0xdc, 0x3a, 0x82, 0x5d, 0x35, 0x86, 0x5f, 0xf0, 0x20, 0xe7, 0x32, 0x1b, 0x73, 0xd4,
+	0xfd, 0x6b, 0xfe, 0x77, 0xe5, 0x4f, 0xa1, 0x0c, 0xb8, 0x14, 0x06, 0x98, 0x0a, 0x79, 0x2a, 0x80,
+	0x22, 0x93, 0x2c, 0x27, 0xf3, 0x32, 0xe3, 0x9a, 0x99, 0x08, 0x0a, 0x1d, 0xf2, 0x5b, 0xd8, 0x95,
+	0x84, 0xe5, 0xa0, 0xed, 0x5a, 0x8c, 0x52, 0x94, 0x8e, 0x79, 0xf0, 0x15, 0xc1, 0xc3, 0x2a, 0x90,
+	0xf7, 0x31, 0xbf, 0x90, 0xa1, 0x1c, 0x03, 0xdc, 0x1e, 0x35, 0x19, 0x8c, 0x39, 0x7a, 0x86, 0xff,
+	0x7f, 0xd6, 0x70, 0x45, 0x33, 0x6e, 0x09, 0x45, 0x9e, 0x91, 0x57, 0x27, 0xe4, 0x08, 0x5a, 0x55,
+	0x32, 0xe6, 0x68, 0xff, 0xde, 0x4c, 0x42, 0x8c, 0x66, 0x93, 0x04, 0xe3, 0xe3, 0xab, 0xb5, 0x83,
+	0xae, 0xd7, 0x0e, 0xfa, 0xb5, 0x76, 0xd0, 0xe5, 0xc6, 0x69, 0x5c, 0x6f, 0x9c, 0xc6, 0xf7, 0x8d,
+	0xd3, 0xf8, 0xf0, 0xfc, 0xce, 0xf4, 0xff, 0xb8, 0x45, 0xe6, 0x07, 0xee, 0xb2, 0xba, 0x4a, 0xa4,
+	0x1f, 0xd3, 0x8e, 0x4c, 0xf3, 0xe0, 0xf7, 0x00, 0x31, 0x93, 0x39, 0x38, 0x79, 0x04, 0x00, 0x00,
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GovSet {
+		i--
+		if m.GovSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRateLimit(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxInflow.Size()
+		i -= size
+		if _, err := m.MaxInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PrevOutflow.Size()
+		i -= size
+		if _, err := m.PrevOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PrevInflow.Size()
+		i -= size
+		if _, err := m.PrevInflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintRateLimit(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Outflow.Size()
+		i -= size
+		if _, err := m.Outflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflow.Size()
+		i -= size
+		if _, err := m.Inflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitWithFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitWithFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitWithFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = m.MaxInflow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovRateLimit(uint64(l))
+	if m.GovSet {
+		n += 2
+	}
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.Outflow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.PrevInflow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.PrevOutflow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func (m *RateLimitWithFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	return n
+}
+
+func sovRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimit(x uint64) (n int) {
+	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GovSet = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevInflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevInflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitWithFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitWithFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitWithFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
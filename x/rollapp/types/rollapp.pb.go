@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -152,6 +153,9 @@ type Rollapp struct {
 	LivenessCountdownStartHeight int64 `protobuf:"varint,18,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
 	// Revisions is a list of all the rollapp revisions.
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
+	// iro_failed indicates the IRO plan of the rollapp was cancelled, as it was
+	// not settled in time.
+	IroFailed bool `protobuf:"varint,21,opt,name=iro_failed,json=iroFailed,proto3" json:"iro_failed,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +289,13 @@ func (m *Rollapp) GetRevisions() []Revision {
 	return nil
 }
 
+func (m *Rollapp) GetIroFailed() bool {
+	if m != nil {
+		return m.IroFailed
+	}
+	return false
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -351,6 +362,8 @@ type RollappSummary struct {
 	LatestFinalizedStateIndex *StateInfoIndex `protobuf:"bytes,3,opt,name=latestFinalizedStateIndex,proto3" json:"latestFinalizedStateIndex,omitempty"`
 	LatestHeight              uint64          `protobuf:"varint,4,opt,name=latestHeight,proto3" json:"latestHeight,omitempty"`
 	LatestFinalizedHeight     uint64          `protobuf:"varint,5,opt,name=latestFinalizedHeight,proto3" json:"latestFinalizedHeight,omitempty"`
+	// Health of the canonical light client, if the rollapp has one.
+	ClientHealth *ClientHealth `protobuf:"bytes,6,opt,name=clientHealth,proto3" json:"clientHealth,omitempty"`
 }
 
 func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
@@ -421,12 +434,93 @@ func (m *RollappSummary) GetLatestFinalizedHeight() uint64 {
 	return 0
 }
 
+func (m *RollappSummary) GetClientHealth() *ClientHealth {
+	if m != nil {
+		return m.ClientHealth
+	}
+	return nil
+}
+
+// ClientHealth describes how close the canonical light client of a rollapp is
+// to expiry.
+type ClientHealth struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// ibc client status: Active, Expired or Frozen
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// the client expires at this time unless it is updated
+	Expiry time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+	// time left before the client expires, zero if already expired
+	TimeLeft time.Duration `protobuf:"bytes,4,opt,name=time_left,json=timeLeft,proto3,stdduration" json:"time_left"`
+}
+
+func (m *ClientHealth) Reset()         { *m = ClientHealth{} }
+func (m *ClientHealth) String() string { return proto.CompactTextString(m) }
+func (*ClientHealth) ProtoMessage()    {}
+func (*ClientHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{4}
+}
+func (m *ClientHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientHealth.Merge(m, src)
+}
+func (m *ClientHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientHealth proto.InternalMessageInfo
+
+func (m *ClientHealth) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientHealth) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ClientHealth) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func (m *ClientHealth) GetTimeLeft() time.Duration {
+	if m != nil {
+		return m.TimeLeft
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
 	proto.RegisterType((*Revision)(nil), "dymensionxyz.dymension.rollapp.Revision")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
+	proto.RegisterType((*ClientHealth)(nil), "dymensionxyz.dymension.rollapp.ClientHealth")
 }
 
 func init() {
@@ -434,63 +528,71 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x2d, 0xc6, 0xa2, 0x47, 0xb2, 0xcd, 0xac, 0x9c, 0x96, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x15, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x29, 0xc5, 0xca, 0x53, 0xe4, 0xd4,
-	0x87, 0xe8, 0x93, 0xe4, 0x18, 0xf4, 0xd4, 0x93, 0x53, 0xd8, 0x6f, 0xd0, 0x27, 0x28, 0x76, 0xb9,
-	0x94, 0x94, 0x3a, 0xa9, 0x82, 0x9c, 0x96, 0x33, 0xdf, 0xcc, 0x37, 0x3f, 0x3b, 0xb3, 0x84, 0x07,
-	0xe1, 0x3c, 0xc1, 0x94, 0x13, 0x46, 0x2f, 0xe6, 0xaf, 0xdd, 0x85, 0xe0, 0x66, 0x2c, 0x8e, 0x83,
-	0x34, 0x2d, 0x4f, 0x27, 0xcd, 0x58, 0xce, 0x50, 0x6b, 0xd5, 0xda, 0x59, 0x08, 0x8e, 0xb2, 0x6a,
-	0xee, 0x47, 0x2c, 0x62, 0xd2, 0xd4, 0x15, 0x5f, 0x85, 0x57, 0xb3, 0x1d, 0x31, 0x16, 0xc5, 0xd8,
-	0x95, 0xd2, 0x70, 0x3a, 0x76, 0x73, 0x92, 0x60, 0x9e, 0x07, 0x89, 0xa2, 0x6d, 0x7e, 0x3d, 0x62,
-	0x3c, 0x61, 0xdc, 0x4d, 0x78, 0xe4, 0xce, 0x0e, 0xc5, 0xa1, 0x00, 0x77, 0x4d, 0x76, 0x3c, 0x0f,
-	0x72, 0xec, 0x13, 0x3a, 0x2e, 0x43, 0x3d, 0x5c, 0xe3, 0x90, 0xe0, 0x3c, 0x08, 0x83, 0x3c, 0x50,
-	0xe6, 0x2d, 0x15, 0x78, 0x18, 0x70, 0xec, 0xce, 0x0e, 0x87, 0x38, 0x0f, 0x0e, 0xdd, 0x11, 0x23,
-	0x54, 0xe1, 0x87, 0x6b, 0xe8, 0x22, 0x4c, 0x31, 0x27, 0x7c, 0x25, 0x83, 0xee, 0x73, 0x68, 0x78,
-	0x05, 0xfa, 0xa4, 0x00, 0x07, 0x22, 0x47, 0x74, 0x04, 0x77, 0xf2, 0x2c, 0xa0, 0x7c, 0x8c, 0x33,
-	0x3f, 0xcd, 0x18, 0x1b, 0xfb, 0x13, 0x4c, 0xa2, 0x49, 0x6e, 0x55, 0x3a, 0x9a, 0xad, 0x7b, 0x8d,
-	0x12, 0xfc, 0x49, 0x60, 0x4f, 0x25, 0x74, 0xa6, 0x1b, 0x9a, 0xb9, 0x79, 0xa6, 0x1b, 0x9b, 0x66,
-	0xa5, 0x7b, 0x59, 0x85, 0xaa, 0xe2, 0x45, 0xf7, 0x00, 0x54, 0x02, 0x3e, 0x09, 0x2d, 0xad, 0xa3,
-	0xd9, 0xdb, 0xde, 0xb6, 0xd2, 0x9c, 0x86, 0x68, 0x1f, 0x6e, 0xb1, 0x57, 0x14, 0x67, 0xd6, 0xa6,
-	0x44, 0x0a, 0x01, 0xfd, 0x02, 0x3b, 0x65, 0xb6, 0xb2, 0x6b, 0x56, 0xb5, 0xa3, 0xd9, 0xb5, 0xa3,
-	0x63, 0xe7, 0xff, 0xaf, 0xd4, 0xf9, 0x48, 0x31, 0x3d, 0xfd, 0xed, 0x65, 0x7b, 0xc3, 0xab, 0x47,
-	0xab, 0x05, 0xde, 0x03, 0x18, 0x4d, 0x02, 0x4a, 0x71, 0x2c, 0x92, 0x32, 0x8a, 0xa4, 0x94, 0xe6,
-	0x34, 0x44, 0x3f, 0x80, 0x51, 0xf6, 0xde, 0xaa, 0xc9, 0xc8, 0xee, 0x67, 0x46, 0xee, 0x2b, 0x37,
-	0x6f, 0x41, 0x80, 0xce, 0xa1, 0xbe, 0xda, 0x79, 0xab, 0x2e, 0x09, 0xef, 0xaf, 0x23, 0x54, 0x35,
-	0x9c, 0xd2, 0x31, 0x53, 0x25, 0xd4, 0xa2, 0xa5, 0x0a, 0xdd, 0x87, 0xdb, 0x84, 0x92, 0x9c, 0x04,
-	0xb1, 0xcf, 0xf1, 0x6f, 0x53, 0x4c, 0x47, 0x38, 0xb3, 0x76, 0x64, 0x21, 0xa6, 0x02, 0x06, 0xa5,
-	0x1e, 0xfd, 0xae, 0x01, 0x4a, 0x08, 0x5d, 0x5a, 0xfa, 0x43, 0x46, 0x43, 0x6b, 0xbf, 0x53, 0xb1,
-	0x6b, 0x47, 0x07, 0x4e, 0x31, 0x57, 0x8e, 0x98, 0x2b, 0x47, 0xcd, 0x95, 0xf3, 0x98, 0x11, 0xda,
-	0xeb, 0x8b, 0xb8, 0xff, 0x5c, 0xb6, 0x0f, 0xe6, 0x41, 0x12, 0x7f, 0xdb, 0xbd, 0x49, 0xd1, 0xfd,
-	0xe3, 0x7d, 0xdb, 0x8e, 0x48, 0x3e, 0x99, 0x0e, 0x9d, 0x11, 0x4b, 0x5c, 0x35, 0xa1, 0xc5, 0xf1,
-	0x90, 0x87, 0xbf, 0xba, 0xf9, 0x3c, 0xc5, 0x5c, 0xb2, 0x71, 0xcf, 0x4c, 0x08, 0x5d, 0x24, 0xd5,
-	0x63, 0x34, 0x44, 0x4f, 0xa0, 0x3a, 0x4b, 0x7c, 0x61, 0x63, 0xed, 0x76, 0x34, 0x7b, 0xf7, 0xc8,
-	0xf9, 0xcc, 0x3e, 0x3b, 0x2f, 0xfa, 0xe7, 0xf3, 0x14, 0x7b, 0x5b, 0xb3, 0x44, 0x9c, 0xa8, 0x09,
-	0x46, 0x1c, 0x4c, 0xe9, 0x68, 0x82, 0x43, 0x6b, 0xaf, 0xa3, 0xd9, 0x86, 0xb7, 0x90, 0xd1, 0x53,
-	0xd8, 0x4b, 0x33, 0xec, 0x17, 0xb2, 0x2f, 0xd6, 0xd9, 0x32, 0xe5, 0x1d, 0x34, 0x9d, 0x62, 0xd7,
-	0x9d, 0x72, 0xd7, 0x9d, 0xf3, 0x72, 0xd7, 0x7b, 0xfa, 0x9b, 0xf7, 0x6d, 0xcd, 0xdb, 0x49, 0x33,
-	0xfc, 0x4c, 0xfa, 0x09, 0x44, 0xec, 0x45, 0x4c, 0x66, 0xe2, 0x16, 0xb8, 0x8f, 0x67, 0x98, 0xe6,
-	0xe5, 0x5e, 0xdc, 0xee, 0x68, 0x76, 0xc5, 0x6b, 0x94, 0xe0, 0x89, 0xc0, 0x8a, 0xbd, 0x40, 0x27,
-	0xd0, 0x5e, 0xf8, 0x8c, 0xd8, 0x94, 0xe6, 0x21, 0x7b, 0x45, 0xc5, 0x54, 0x67, 0x0b, 0x6f, 0x24,
-	0xbd, 0xef, 0x96, 0x66, 0x8f, 0x4b, 0xab, 0x81, 0x30, 0x52, 0x34, 0xcf, 0x60, 0x3b, 0xc3, 0x33,
-	0x22, 0x7a, 0xc1, 0xad, 0x86, 0xbc, 0x38, 0x7b, 0x6d, 0xaf, 0x94, 0x83, 0x9a, 0x9f, 0x25, 0x41,
-	0xf7, 0x01, 0x6c, 0x15, 0x0d, 0x44, 0x7b, 0x50, 0x7b, 0x4e, 0x79, 0x8a, 0x47, 0x64, 0x4c, 0x70,
-	0x68, 0x6e, 0xa0, 0x2a, 0x54, 0x4e, 0x5e, 0xf4, 0x4d, 0x0d, 0x19, 0xa0, 0xff, 0xfc, 0xdd, 0xa0,
-	0x2f, 0x97, 0xba, 0x62, 0x56, 0xcf, 0x74, 0x63, 0xdb, 0x84, 0x33, 0xdd, 0x00, 0xb3, 0xd6, 0x3d,
-	0x01, 0xa3, 0x24, 0x47, 0x5f, 0xc1, 0x16, 0x9d, 0x26, 0x43, 0x9c, 0x59, 0x0d, 0xf9, 0x3a, 0x28,
-	0x09, 0x7d, 0x03, 0xf5, 0x0f, 0xaa, 0xdc, 0x97, 0x68, 0x8d, 0x2f, 0x8b, 0xea, 0xfe, 0xb9, 0x09,
-	0xbb, 0xea, 0x42, 0x07, 0xd3, 0x24, 0x09, 0xb2, 0x39, 0xba, 0x0b, 0xcb, 0xc7, 0xe1, 0xe6, 0x6b,
-	0xf1, 0x12, 0xcc, 0x38, 0xc8, 0x31, 0xcf, 0xe5, 0x1a, 0x9f, 0xd2, 0x10, 0x5f, 0xc8, 0x87, 0xa3,
-	0xb6, 0x7e, 0x70, 0x94, 0xc7, 0x98, 0x49, 0x2f, 0xef, 0x06, 0x0f, 0x8a, 0xe1, 0xa0, 0xd0, 0x7d,
-	0x4f, 0x68, 0x10, 0x93, 0xd7, 0x38, 0x5c, 0x09, 0x52, 0xf9, 0xa2, 0x20, 0x9f, 0x26, 0x44, 0x5d,
-	0xa8, 0x17, 0x60, 0xd1, 0x0a, 0x4b, 0x97, 0xdd, 0xf9, 0x40, 0x87, 0x1e, 0xc1, 0x9d, 0xff, 0x10,
-	0x28, 0xe3, 0x5b, 0xd2, 0xf8, 0xe3, 0x60, 0xef, 0xc7, 0xb7, 0x57, 0x2d, 0xed, 0xdd, 0x55, 0x4b,
-	0xfb, 0xfb, 0xaa, 0xa5, 0xbd, 0xb9, 0x6e, 0x6d, 0xbc, 0xbb, 0x6e, 0x6d, 0xfc, 0x75, 0xdd, 0xda,
-	0x78, 0xf9, 0x68, 0x65, 0x53, 0x3f, 0xf1, 0xaf, 0x98, 0x1d, 0xbb, 0x17, 0x8b, 0x1f, 0x86, 0xdc,
-	0xdd, 0xe1, 0x96, 0xdc, 0x8e, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x30, 0x0a, 0x28, 0x2f,
-	0x7d, 0x07, 0x00, 0x00,
+	// 1014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
+	0x10, 0x35, 0x2d, 0x45, 0xa2, 0x56, 0xb2, 0xcd, 0xac, 0xed, 0x96, 0x76, 0x13, 0x49, 0xd5, 0x49,
+	0x40, 0x1c, 0x12, 0xb6, 0x73, 0x2a, 0x7a, 0x68, 0xe5, 0x3a, 0x89, 0x5c, 0xab, 0x08, 0x28, 0x27,
+	0x05, 0x72, 0x28, 0x41, 0x89, 0x4b, 0x6a, 0x51, 0x72, 0x97, 0xe5, 0xae, 0x14, 0x2b, 0x5f, 0x91,
+	0x53, 0xd1, 0x0f, 0xe8, 0xa9, 0xbf, 0xd1, 0x4b, 0x8e, 0x39, 0xf6, 0x94, 0x14, 0xf6, 0x1f, 0xf4,
+	0x5e, 0xa0, 0xd8, 0xe5, 0x52, 0x92, 0xed, 0xa4, 0x0a, 0x72, 0x5a, 0xce, 0xbc, 0x99, 0x37, 0xb3,
+	0xb3, 0x33, 0x43, 0xb0, 0xe7, 0x4f, 0x63, 0x44, 0x18, 0xa6, 0xe4, 0x7c, 0xfa, 0xd2, 0x9e, 0x09,
+	0x76, 0x4a, 0xa3, 0xc8, 0x4b, 0x92, 0xfc, 0xb4, 0x92, 0x94, 0x72, 0x0a, 0xeb, 0x8b, 0xd6, 0xd6,
+	0x4c, 0xb0, 0x94, 0xd5, 0xee, 0x56, 0x48, 0x43, 0x2a, 0x4d, 0x6d, 0xf1, 0x95, 0x79, 0xed, 0x36,
+	0x42, 0x4a, 0xc3, 0x08, 0xd9, 0x52, 0x1a, 0x8c, 0x03, 0x9b, 0xe3, 0x18, 0x31, 0xee, 0xc5, 0x8a,
+	0x76, 0xb7, 0x7e, 0xdd, 0xc0, 0x1f, 0xa7, 0x1e, 0x17, 0xc4, 0x19, 0xfe, 0xf9, 0x90, 0xb2, 0x98,
+	0x32, 0x3b, 0x66, 0xa1, 0x3d, 0xd9, 0x17, 0x87, 0x02, 0xec, 0x25, 0xd9, 0x33, 0xee, 0x71, 0xe4,
+	0x62, 0x12, 0xe4, 0xa9, 0xdc, 0x5f, 0xe2, 0x10, 0x23, 0xee, 0xf9, 0x1e, 0xf7, 0xf2, 0xc4, 0x54,
+	0xe0, 0x81, 0xc7, 0x90, 0x3d, 0xd9, 0x1f, 0x20, 0xee, 0xed, 0xdb, 0x43, 0x8a, 0xf3, 0xc4, 0xf6,
+	0x97, 0xd0, 0x85, 0x88, 0x20, 0x86, 0xd9, 0x42, 0x06, 0xad, 0xa7, 0x60, 0xd3, 0xc9, 0xd0, 0x47,
+	0x19, 0xd8, 0x17, 0x39, 0xc2, 0x03, 0xb0, 0xcd, 0x53, 0x8f, 0xb0, 0x00, 0xa5, 0x6e, 0x92, 0x52,
+	0x1a, 0xb8, 0x23, 0x84, 0xc3, 0x11, 0x37, 0x0b, 0x4d, 0xad, 0x5d, 0x74, 0x36, 0x73, 0xf0, 0x89,
+	0xc0, 0x1e, 0x4b, 0xe8, 0xa4, 0xa8, 0x6b, 0xc6, 0xea, 0x49, 0x51, 0x5f, 0x35, 0x0a, 0xad, 0x7f,
+	0xcb, 0xa0, 0xac, 0x78, 0xe1, 0x5d, 0x00, 0x54, 0x02, 0x2e, 0xf6, 0x4d, 0xad, 0xa9, 0xb5, 0x2b,
+	0x4e, 0x45, 0x69, 0xba, 0x3e, 0xdc, 0x02, 0xb7, 0xe8, 0x0b, 0x82, 0x52, 0x73, 0x55, 0x22, 0x99,
+	0x00, 0x7f, 0x02, 0x6b, 0x79, 0xb6, 0xb2, 0x6a, 0x66, 0xb9, 0xa9, 0xb5, 0xab, 0x07, 0x87, 0xd6,
+	0xff, 0x3f, 0xb9, 0xf5, 0x9e, 0xcb, 0x74, 0x8a, 0xaf, 0xdf, 0x36, 0x56, 0x9c, 0x5a, 0xb8, 0x78,
+	0xc1, 0xbb, 0x00, 0x0c, 0x47, 0x1e, 0x21, 0x28, 0x12, 0x49, 0xe9, 0x59, 0x52, 0x4a, 0xd3, 0xf5,
+	0xe1, 0xf7, 0x40, 0xcf, 0x6b, 0x6f, 0x56, 0x65, 0x64, 0xfb, 0x23, 0x23, 0xf7, 0x94, 0x9b, 0x33,
+	0x23, 0x80, 0x67, 0xa0, 0xb6, 0x58, 0x79, 0xb3, 0x26, 0x09, 0xef, 0x2d, 0x23, 0x54, 0x77, 0xe8,
+	0x92, 0x80, 0xaa, 0x2b, 0x54, 0xc3, 0xb9, 0x0a, 0xde, 0x03, 0xb7, 0x31, 0xc1, 0x1c, 0x7b, 0x91,
+	0xcb, 0xd0, 0x2f, 0x63, 0x44, 0x86, 0x28, 0x35, 0xd7, 0xe4, 0x45, 0x0c, 0x05, 0xf4, 0x73, 0x3d,
+	0xfc, 0x55, 0x03, 0x30, 0xc6, 0x64, 0x6e, 0xe9, 0x0e, 0x28, 0xf1, 0xcd, 0xad, 0x66, 0xa1, 0x5d,
+	0x3d, 0xd8, 0xb1, 0xb2, 0xbe, 0xb2, 0x44, 0x5f, 0x59, 0xaa, 0xaf, 0xac, 0x23, 0x8a, 0x49, 0xa7,
+	0x27, 0xe2, 0xfe, 0xf3, 0xb6, 0xb1, 0x33, 0xf5, 0xe2, 0xe8, 0xab, 0xd6, 0x4d, 0x8a, 0xd6, 0x1f,
+	0xef, 0x1a, 0xed, 0x10, 0xf3, 0xd1, 0x78, 0x60, 0x0d, 0x69, 0x6c, 0xab, 0x0e, 0xcd, 0x8e, 0xfb,
+	0xcc, 0xff, 0xd9, 0xe6, 0xd3, 0x04, 0x31, 0xc9, 0xc6, 0x1c, 0x23, 0xc6, 0x64, 0x96, 0x54, 0x87,
+	0x12, 0x1f, 0x3e, 0x02, 0xe5, 0x49, 0xec, 0x0a, 0x1b, 0x73, 0xbd, 0xa9, 0xb5, 0xd7, 0x0f, 0xac,
+	0x8f, 0xac, 0xb3, 0xf5, 0xac, 0x77, 0x36, 0x4d, 0x90, 0x53, 0x9a, 0xc4, 0xe2, 0x84, 0xbb, 0x40,
+	0x8f, 0xbc, 0x31, 0x19, 0x8e, 0x90, 0x6f, 0x6e, 0x34, 0xb5, 0xb6, 0xee, 0xcc, 0x64, 0xf8, 0x18,
+	0x6c, 0x24, 0x29, 0x72, 0x33, 0xd9, 0x15, 0xe3, 0x6e, 0x1a, 0xf2, 0x0d, 0x76, 0xad, 0x6c, 0xd4,
+	0xad, 0x7c, 0xd4, 0xad, 0xb3, 0x7c, 0x17, 0x74, 0x8a, 0xaf, 0xde, 0x35, 0x34, 0x67, 0x2d, 0x49,
+	0xd1, 0xa9, 0xf4, 0x13, 0x88, 0x98, 0x8b, 0x08, 0x4f, 0xc4, 0x2b, 0x30, 0x17, 0x4d, 0x10, 0xe1,
+	0xf9, 0x5c, 0xdc, 0x6e, 0x6a, 0xed, 0x82, 0xb3, 0x99, 0x83, 0xc7, 0x02, 0xcb, 0xe6, 0x02, 0x1e,
+	0x83, 0xc6, 0xcc, 0x67, 0x48, 0xc7, 0x84, 0xfb, 0xf4, 0x05, 0x11, 0x5d, 0x9d, 0xce, 0xbc, 0xa1,
+	0xf4, 0xbe, 0x93, 0x9b, 0x1d, 0xe5, 0x56, 0x7d, 0x61, 0xa4, 0x68, 0x4e, 0x41, 0x25, 0x45, 0x13,
+	0x2c, 0x6a, 0xc1, 0xcc, 0x4d, 0xf9, 0x70, 0xed, 0xa5, 0xb5, 0x52, 0x0e, 0xaa, 0x7f, 0xe6, 0x04,
+	0xa2, 0xff, 0x71, 0x4a, 0xdd, 0xc0, 0xc3, 0x11, 0xf2, 0xcd, 0x6d, 0x59, 0xb0, 0x0a, 0x4e, 0xe9,
+	0x43, 0xa9, 0x68, 0xed, 0x81, 0x52, 0x56, 0x5f, 0xb8, 0x01, 0xaa, 0x4f, 0x09, 0x4b, 0xd0, 0x10,
+	0x07, 0x18, 0xf9, 0xc6, 0x0a, 0x2c, 0x83, 0xc2, 0xf1, 0xb3, 0x9e, 0xa1, 0x41, 0x1d, 0x14, 0x7f,
+	0xfc, 0xb6, 0xdf, 0x93, 0x33, 0x5f, 0x30, 0xca, 0x27, 0x45, 0xbd, 0x62, 0x80, 0x93, 0xa2, 0x0e,
+	0x8c, 0x6a, 0xeb, 0x18, 0xe8, 0x79, 0x6c, 0xf8, 0x19, 0x28, 0x91, 0x71, 0x3c, 0x40, 0xa9, 0xb9,
+	0x29, 0x97, 0x87, 0x92, 0xe0, 0x97, 0xa0, 0x76, 0xa5, 0x08, 0x5b, 0x12, 0xad, 0xb2, 0xf9, 0x9d,
+	0x5b, 0xbf, 0x17, 0xc0, 0xba, 0x7a, 0xef, 0xfe, 0x38, 0x8e, 0xbd, 0x74, 0x0a, 0xef, 0x80, 0xf9,
+	0xee, 0xb8, 0xb9, 0x4c, 0x9e, 0x03, 0x23, 0xf2, 0x38, 0x62, 0x5c, 0x4e, 0x79, 0x97, 0xf8, 0xe8,
+	0x5c, 0xee, 0x95, 0xea, 0xf2, 0xbe, 0x52, 0x1e, 0x01, 0x95, 0x5e, 0xce, 0x0d, 0x1e, 0x18, 0x81,
+	0x9d, 0x4c, 0xf7, 0x10, 0x13, 0x2f, 0xc2, 0x2f, 0x91, 0xbf, 0x10, 0xa4, 0xf0, 0x49, 0x41, 0x3e,
+	0x4c, 0x08, 0x5b, 0xa0, 0x96, 0x81, 0x59, 0x29, 0xcc, 0xa2, 0xac, 0xce, 0x15, 0x1d, 0x7c, 0x00,
+	0xb6, 0xaf, 0x11, 0x28, 0xe3, 0x5b, 0xd2, 0xf8, 0xfd, 0x20, 0x7c, 0x02, 0x6a, 0xc3, 0x08, 0xcb,
+	0xfe, 0xf4, 0x22, 0x3e, 0x32, 0x4b, 0x32, 0xf5, 0xbd, 0x65, 0xa9, 0x1f, 0x2d, 0xf8, 0x38, 0x57,
+	0x18, 0x5a, 0x7f, 0x6a, 0xa0, 0xb6, 0x08, 0xc3, 0x2f, 0x40, 0x25, 0x33, 0x98, 0x6f, 0x7c, 0x3d,
+	0x53, 0x74, 0x7d, 0xd1, 0x0f, 0x62, 0xa5, 0x8f, 0x99, 0xda, 0xf8, 0x4a, 0x82, 0x5f, 0x83, 0x12,
+	0x3a, 0x4f, 0x70, 0x3a, 0x35, 0x0b, 0x4b, 0x87, 0x53, 0x17, 0xfd, 0x2c, 0x07, 0x54, 0xf9, 0xc0,
+	0x6f, 0x40, 0x45, 0x0c, 0xb6, 0x1b, 0xa1, 0x20, 0x2b, 0x96, 0xd8, 0x6b, 0xd7, 0x09, 0xbe, 0x53,
+	0x3f, 0xf2, 0xcc, 0xff, 0x37, 0xe1, 0xaf, 0x0b, 0xaf, 0x53, 0x14, 0xf0, 0xce, 0x0f, 0xaf, 0x2f,
+	0xea, 0xda, 0x9b, 0x8b, 0xba, 0xf6, 0xf7, 0x45, 0x5d, 0x7b, 0x75, 0x59, 0x5f, 0x79, 0x73, 0x59,
+	0x5f, 0xf9, 0xeb, 0xb2, 0xbe, 0xf2, 0xfc, 0xc1, 0xc2, 0x82, 0xfb, 0xc0, 0x2f, 0x76, 0x72, 0x68,
+	0x9f, 0xcf, 0xfe, 0xb3, 0x72, 0xe5, 0x0d, 0x4a, 0x32, 0xec, 0xe1, 0x7f, 0x03, 0x00, 0x95, 0x01,
+	0xbd, 0x18, 0xd4, 0x08, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -541,6 +643,18 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IroFailed {
+		i--
+		if m.IroFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.MinSequencerBond) > 0 {
 		for iNdEx := len(m.MinSequencerBond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -734,6 +848,18 @@ func (m *RollappSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClientHealth != nil {
+		{
+			size, err := m.ClientHealth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollapp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LatestFinalizedHeight != 0 {
 		i = encodeVarintRollapp(dAtA, i, uint64(m.LatestFinalizedHeight))
 		i--
@@ -778,6 +904,59 @@ func (m *RollappSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeLeft, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeLeft):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintRollapp(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintRollapp(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRollapp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRollapp(v)
 	base := offset
@@ -859,6 +1038,9 @@ func (m *Rollapp) Size() (n int) {
 			n += 2 + l + sovRollapp(uint64(l))
 		}
 	}
+	if m.IroFailed {
+		n += 3
+	}
 	return n
 }
 
@@ -901,6 +1083,31 @@ func (m *RollappSummary) Size() (n int) {
 	if m.LatestFinalizedHeight != 0 {
 		n += 1 + sovRollapp(uint64(m.LatestFinalizedHeight))
 	}
+	if m.ClientHealth != nil {
+		l = m.ClientHealth.Size()
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	return n
+}
+
+func (m *ClientHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovRollapp(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeLeft)
+	n += 1 + l + sovRollapp(uint64(l))
 	return n
 }
 
@@ -1419,6 +1626,26 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IroFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IroFailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -1699,6 +1926,222 @@ func (m *RollappSummary) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientHealth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientHealth == nil {
+				m.ClientHealth = &ClientHealth{}
+			}
+			if err := m.ClientHealth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeLeft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeLeft, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -11,18 +11,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1027,6 +1031,394 @@ func (m *MsgMarkObsoleteRollappsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarkObsoleteRollappsResponse proto.InternalMessageInfo
 
+// MsgSetRateLimit creates or updates the rate limit of a rollapp denom.
+// Must be signed by the rollapp owner or the governance.
+type MsgSetRateLimit struct {
+	// Authority is the bech32-encoded address of the rollapp owner or the
+	// x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RateLimit is the new rate limit. The flow of an existing limit is kept.
+	RateLimit RateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *MsgSetRateLimit) Reset()         { *m = MsgSetRateLimit{} }
+func (m *MsgSetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimit) ProtoMessage()    {}
+func (*MsgSetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{16}
+}
+func (m *MsgSetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimit.Merge(m, src)
+}
+func (m *MsgSetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetRateLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRateLimit) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+type MsgSetRateLimitResponse struct {
+}
+
+func (m *MsgSetRateLimitResponse) Reset()         { *m = MsgSetRateLimitResponse{} }
+func (m *MsgSetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{17}
+}
+func (m *MsgSetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitResponse.Merge(m, src)
+}
+func (m *MsgSetRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimitResponse proto.InternalMessageInfo
+
+// MsgRemoveRateLimit removes the rate limit of a rollapp denom.
+// Must be signed by the rollapp owner or the governance.
+type MsgRemoveRateLimit struct {
+	// Authority is the bech32-encoded address of the rollapp owner or the
+	// x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveRateLimit) Reset()         { *m = MsgRemoveRateLimit{} }
+func (m *MsgRemoveRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimit) ProtoMessage()    {}
+func (*MsgRemoveRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{18}
+}
+func (m *MsgRemoveRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveRateLimit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveRateLimit) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgRemoveRateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgRemoveRateLimitResponse struct {
+}
+
+func (m *MsgRemoveRateLimitResponse) Reset()         { *m = MsgRemoveRateLimitResponse{} }
+func (m *MsgRemoveRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{19}
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimitResponse proto.InternalMessageInfo
+
+// MsgPauseBridge pauses IBC transfers and eIBC fulfillment for a rollapp until
+// the pause expires. Pausing a paused bridge sets the new expiry.
+// Must be signed by a circuit breaker or the governance.
+type MsgPauseBridge struct {
+	// Authority is the bech32-encoded address of a circuit breaker or the x/gov
+	// module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// Duration is how long the bridge is paused for. It cannot be longer than
+	// the max_bridge_pause param.
+	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *MsgPauseBridge) Reset()         { *m = MsgPauseBridge{} }
+func (m *MsgPauseBridge) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseBridge) ProtoMessage()    {}
+func (*MsgPauseBridge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{20}
+}
+func (m *MsgPauseBridge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseBridge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseBridge.Merge(m, src)
+}
+func (m *MsgPauseBridge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseBridge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseBridge proto.InternalMessageInfo
+
+func (m *MsgPauseBridge) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgPauseBridge) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgPauseBridge) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgPauseBridgeResponse struct {
+}
+
+func (m *MsgPauseBridgeResponse) Reset()         { *m = MsgPauseBridgeResponse{} }
+func (m *MsgPauseBridgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseBridgeResponse) ProtoMessage()    {}
+func (*MsgPauseBridgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{21}
+}
+func (m *MsgPauseBridgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseBridgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseBridgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseBridgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseBridgeResponse.Merge(m, src)
+}
+func (m *MsgPauseBridgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseBridgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseBridgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseBridgeResponse proto.InternalMessageInfo
+
+// MsgUnpauseBridge lifts the pause of the bridge of a rollapp before it
+// expires. Must be signed by a circuit breaker or the governance.
+type MsgUnpauseBridge struct {
+	// Authority is the bech32-encoded address of a circuit breaker or the x/gov
+	// module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *MsgUnpauseBridge) Reset()         { *m = MsgUnpauseBridge{} }
+func (m *MsgUnpauseBridge) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseBridge) ProtoMessage()    {}
+func (*MsgUnpauseBridge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{22}
+}
+func (m *MsgUnpauseBridge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseBridge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseBridge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseBridge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseBridge.Merge(m, src)
+}
+func (m *MsgUnpauseBridge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseBridge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseBridge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseBridge proto.InternalMessageInfo
+
+func (m *MsgUnpauseBridge) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnpauseBridge) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type MsgUnpauseBridgeResponse struct {
+}
+
+func (m *MsgUnpauseBridgeResponse) Reset()         { *m = MsgUnpauseBridgeResponse{} }
+func (m *MsgUnpauseBridgeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseBridgeResponse) ProtoMessage()    {}
+func (*MsgUnpauseBridgeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{23}
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseBridgeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseBridgeResponse.Merge(m, src)
+}
+func (m *MsgUnpauseBridgeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseBridgeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseBridgeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseBridgeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
 	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
@@ -1044,6 +1436,14 @@ func init() {
 	proto.RegisterType((*MsgRemoveAppResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveAppResponse")
 	proto.RegisterType((*MsgMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.MsgMarkObsoleteRollapps")
 	proto.RegisterType((*MsgMarkObsoleteRollappsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgMarkObsoleteRollappsResponse")
+	proto.RegisterType((*MsgSetRateLimit)(nil), "dymensionxyz.dymension.rollapp.MsgSetRateLimit")
+	proto.RegisterType((*MsgSetRateLimitResponse)(nil), "dymensionxyz.dymension.rollapp.MsgSetRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "dymensionxyz.dymension.rollapp.MsgRemoveRateLimitResponse")
+	proto.RegisterType((*MsgPauseBridge)(nil), "dymensionxyz.dymension.rollapp.MsgPauseBridge")
+	proto.RegisterType((*MsgPauseBridgeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgPauseBridgeResponse")
+	proto.RegisterType((*MsgUnpauseBridge)(nil), "dymensionxyz.dymension.rollapp.MsgUnpauseBridge")
+	proto.RegisterType((*MsgUnpauseBridgeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUnpauseBridgeResponse")
 }
 
 func init() {
@@ -1051,79 +1451,94 @@ func init() {
 }
 
 var fileDescriptor_1a86300fb8647ecb = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x73, 0x1b, 0xc5,
-	0x13, 0xf7, 0x5a, 0xb2, 0x1e, 0x2d, 0xd9, 0x91, 0xe7, 0xef, 0x72, 0x36, 0x4a, 0xfe, 0xb2, 0xac,
-	0x14, 0x85, 0x43, 0xc2, 0x0a, 0x3b, 0xe6, 0x51, 0x86, 0x2a, 0xca, 0xb2, 0xab, 0x92, 0x40, 0x09,
-	0xc3, 0xc6, 0xe4, 0xc0, 0x45, 0xb5, 0xd2, 0x8e, 0xd7, 0x9b, 0x68, 0x77, 0xc4, 0xcc, 0x4a, 0xb6,
-	0xe0, 0xc6, 0x85, 0x03, 0x97, 0x7c, 0x00, 0x0e, 0x7c, 0x04, 0xbe, 0x00, 0x57, 0xca, 0xc7, 0x1c,
-	0xe1, 0xe2, 0xa2, 0xec, 0x03, 0x77, 0x8e, 0x5c, 0xa0, 0x66, 0x76, 0x76, 0x24, 0x5b, 0x4f, 0x1b,
-	0x38, 0x69, 0xa6, 0xe7, 0xd7, 0xdd, 0xbf, 0x7e, 0x4c, 0x8f, 0x16, 0x5e, 0xb7, 0xbb, 0x1e, 0xf6,
-	0x99, 0x4b, 0xfc, 0xe3, 0xee, 0x57, 0x65, 0xb5, 0x29, 0x53, 0xd2, 0x6c, 0x5a, 0xad, 0x56, 0x39,
-	0x38, 0x36, 0x5a, 0x94, 0x04, 0x04, 0x15, 0xfa, 0x81, 0x86, 0xda, 0x18, 0x12, 0x98, 0xbf, 0xd9,
-	0x20, 0xcc, 0x23, 0xac, 0xec, 0x31, 0xa7, 0xdc, 0x59, 0xe7, 0x3f, 0xa1, 0x62, 0xfe, 0xed, 0x09,
-	0x1e, 0xea, 0x4d, 0xd2, 0x78, 0x51, 0xb3, 0x31, 0x6b, 0x50, 0xb7, 0x15, 0x10, 0x2a, 0xd5, 0x1e,
-	0x4c, 0x50, 0x93, 0xbf, 0x12, 0xfd, 0xe6, 0x04, 0xb4, 0x87, 0x03, 0xcb, 0xb6, 0x02, 0x4b, 0xc2,
-	0xd7, 0x27, 0xc0, 0x1d, 0xec, 0x63, 0xe6, 0xb2, 0x9a, 0xeb, 0x1f, 0x10, 0xa9, 0xb2, 0xe4, 0x10,
-	0x87, 0x88, 0x65, 0x99, 0xaf, 0xa4, 0xb4, 0x20, 0xa3, 0xae, 0x5b, 0x0c, 0x97, 0x3b, 0xeb, 0x75,
-	0x1c, 0x58, 0xeb, 0xe5, 0x06, 0x71, 0xfd, 0xf0, 0xbc, 0xf4, 0x67, 0x0c, 0x72, 0x55, 0xe6, 0xec,
-	0x50, 0x6c, 0x05, 0xd8, 0x0c, 0xad, 0x23, 0x1d, 0x92, 0x0d, 0x2e, 0x20, 0x54, 0xd7, 0x8a, 0xda,
-	0x5a, 0xda, 0x8c, 0xb6, 0xe8, 0xff, 0x00, 0x92, 0x42, 0xcd, 0xb5, 0xf5, 0x59, 0x71, 0x98, 0x96,
-	0x92, 0x27, 0x36, 0xba, 0x0f, 0x8b, 0xae, 0xef, 0x06, 0xae, 0xd5, 0xac, 0x31, 0xfc, 0x65, 0x1b,
-	0xfb, 0x0d, 0x4c, 0xf5, 0x8c, 0x40, 0xe5, 0xe4, 0xc1, 0xd3, 0x48, 0x8e, 0x9e, 0x03, 0xf2, 0x5c,
-	0xbf, 0x07, 0xac, 0xd5, 0x89, 0x6f, 0xeb, 0xb9, 0xa2, 0xb6, 0x96, 0xd9, 0xb8, 0x65, 0x84, 0xbc,
-	0x0d, 0xce, 0xdb, 0x90, 0xbc, 0x8d, 0x1d, 0xe2, 0xfa, 0x95, 0xd5, 0x93, 0xd3, 0x95, 0x99, 0x3f,
-	0x4e, 0x57, 0x6e, 0x75, 0x2d, 0xaf, 0xb9, 0x55, 0x1a, 0x34, 0x51, 0x32, 0x73, 0x9e, 0xeb, 0x2b,
-	0x3f, 0x15, 0xe2, 0xdb, 0x68, 0x09, 0xe6, 0xac, 0xa6, 0x6b, 0x31, 0x3d, 0x2b, 0xc8, 0x84, 0x1b,
-	0xf4, 0x31, 0xa4, 0xa2, 0xbc, 0xeb, 0xf3, 0xc2, 0x6f, 0xd9, 0x18, 0xdf, 0x45, 0x86, 0x4c, 0x51,
-	0x55, 0xaa, 0x99, 0xca, 0x00, 0xda, 0x87, 0x6c, 0x7f, 0x55, 0xf4, 0x05, 0x61, 0xf0, 0xfe, 0x24,
-	0x83, 0x8f, 0x42, 0x9d, 0x27, 0xfe, 0x01, 0xa9, 0xc4, 0x4f, 0x4e, 0x57, 0x34, 0x33, 0xe3, 0xf4,
-	0x44, 0xe8, 0x11, 0x24, 0x3b, 0x5e, 0x2d, 0xe8, 0xb6, 0xb0, 0x7e, 0xa3, 0xa8, 0xad, 0x2d, 0x6c,
-	0x18, 0x53, 0x32, 0x34, 0x9e, 0x55, 0xf7, 0xbb, 0x2d, 0x6c, 0x26, 0x3a, 0x1e, 0xff, 0xdd, 0xca,
-	0x7e, 0xf3, 0xfb, 0x8f, 0x6f, 0x44, 0x75, 0xfc, 0x28, 0x9e, 0x8a, 0xe5, 0x32, 0xa5, 0x3c, 0xe8,
-	0x97, 0x6b, 0x6f, 0x62, 0xd6, 0x22, 0x3e, 0xc3, 0xa5, 0x1f, 0x62, 0x70, 0xbb, 0xca, 0x9c, 0xcf,
-	0x5b, 0x76, 0xef, 0x90, 0x33, 0xa2, 0x9e, 0x15, 0xb8, 0xc4, 0xe7, 0x19, 0x25, 0x47, 0x3e, 0x8e,
-	0x3a, 0x24, 0xdc, 0x5c, 0xab, 0x3f, 0x62, 0x57, 0xea, 0x8f, 0xe4, 0x7f, 0xd2, 0x1f, 0x9f, 0xf5,
-	0x75, 0xc2, 0xdc, 0xb5, 0x3a, 0x41, 0x16, 0x6f, 0x74, 0x3f, 0x24, 0xfe, 0x8d, 0x7e, 0xd8, 0x02,
-	0x5e, 0xc6, 0x30, 0xd9, 0xa5, 0xd7, 0xe0, 0xee, 0x98, 0x0a, 0xa9, 0x4a, 0xfe, 0x34, 0x0b, 0x0b,
-	0x0a, 0xf7, 0x34, 0xb0, 0x02, 0x3c, 0xe6, 0x82, 0xdf, 0x81, 0x5e, 0xb9, 0x06, 0xeb, 0x57, 0x84,
-	0x0c, 0x0b, 0x2c, 0x1a, 0x3c, 0xc6, 0xae, 0x73, 0x18, 0x88, 0xca, 0xc5, 0xcd, 0x7e, 0x11, 0xd7,
-	0xf7, 0xdb, 0x5e, 0x85, 0x8f, 0x4c, 0xa6, 0xc7, 0xc5, 0x79, 0x4f, 0x80, 0x96, 0x21, 0xb1, 0xbb,
-	0xfd, 0xa9, 0x15, 0x1c, 0x8a, 0x24, 0xa7, 0x4d, 0xb9, 0x43, 0x8f, 0x21, 0x56, 0xd9, 0x65, 0xb2,
-	0xb6, 0x6f, 0x4d, 0x4a, 0x91, 0x30, 0xb6, 0xab, 0xe6, 0x31, 0x13, 0x79, 0x9a, 0x31, 0xb9, 0x09,
-	0x84, 0x20, 0xde, 0xb4, 0x58, 0xa0, 0xa7, 0x8a, 0xda, 0x5a, 0xca, 0x14, 0x6b, 0x74, 0x0f, 0x72,
-	0x51, 0x53, 0x52, 0xdc, 0x71, 0xb9, 0x2d, 0x3d, 0x2d, 0xa8, 0xdd, 0xa0, 0x51, 0xd7, 0x87, 0xe2,
-	0x81, 0x5b, 0x92, 0xc8, 0x25, 0x4b, 0x3a, 0x2c, 0x5f, 0x4c, 0x9f, 0xca, 0xec, 0x77, 0x1a, 0x2c,
-	0x55, 0x99, 0xb3, 0x4f, 0x2d, 0x9f, 0x1d, 0x60, 0xba, 0xc7, 0xab, 0xc2, 0x0e, 0xdd, 0x16, 0xba,
-	0x0b, 0xf3, 0x8d, 0x36, 0xa5, 0xd8, 0x0f, 0x6a, 0xfd, 0x97, 0x24, 0x2b, 0x85, 0x02, 0x88, 0x6e,
-	0x43, 0xda, 0xc7, 0x47, 0x12, 0x10, 0xa6, 0x3a, 0xe5, 0xe3, 0xa3, 0xbd, 0x21, 0x17, 0x29, 0x76,
-	0xa9, 0x10, 0x5b, 0x88, 0xf3, 0xbc, 0xe8, 0xa3, 0x54, 0x80, 0x3b, 0xc3, 0xc8, 0x28, 0xb6, 0x3f,
-	0x6b, 0x90, 0xae, 0x32, 0x67, 0xdb, 0xb6, 0xb7, 0xc7, 0xce, 0x78, 0x04, 0x71, 0xdf, 0xf2, 0xb0,
-	0xa4, 0x24, 0xd6, 0x13, 0xe8, 0xf0, 0xbe, 0x88, 0xde, 0x47, 0x9e, 0xdc, 0xb8, 0x38, 0xef, 0x17,
-	0xf1, 0x71, 0xe1, 0x7a, 0x96, 0x83, 0x65, 0xe1, 0xc3, 0x0d, 0xca, 0x41, 0xac, 0x4d, 0x9b, 0xe2,
-	0x6a, 0xa4, 0x4d, 0xbe, 0x14, 0x63, 0x85, 0xda, 0x98, 0x8a, 0x5e, 0x98, 0x33, 0xc3, 0xcd, 0xc5,
-	0xb2, 0x94, 0xfe, 0x07, 0x8b, 0x2a, 0x0e, 0x15, 0xdd, 0xaf, 0x1a, 0x64, 0x55, 0x99, 0xc6, 0x07,
-	0xb8, 0x00, 0xb3, 0x72, 0x38, 0xc5, 0xcd, 0x59, 0xd7, 0x56, 0x01, 0xc7, 0x46, 0x06, 0x1c, 0x9f,
-	0x10, 0xf0, 0xdc, 0x98, 0x80, 0x13, 0x43, 0x02, 0x4e, 0x0e, 0x09, 0x38, 0x35, 0x3a, 0xe0, 0x65,
-	0xd1, 0x66, 0x2a, 0x34, 0x15, 0x33, 0x16, 0x21, 0x9b, 0xd8, 0x23, 0x9d, 0x2b, 0x86, 0x3c, 0xa1,
-	0xbd, 0x86, 0xb9, 0x57, 0x6e, 0x94, 0xfb, 0xe7, 0x70, 0xb3, 0xca, 0x9c, 0xaa, 0x45, 0x5f, 0xec,
-	0xd5, 0x19, 0x69, 0x62, 0x35, 0x85, 0x18, 0x1f, 0x03, 0x56, 0x3b, 0x38, 0x24, 0xd4, 0x0d, 0xba,
-	0x92, 0x4b, 0x4f, 0x80, 0x56, 0x21, 0x6b, 0x53, 0x56, 0xeb, 0x60, 0xca, 0x2f, 0x1d, 0xd3, 0x67,
-	0x8b, 0xb1, 0xb5, 0x79, 0x33, 0x63, 0x53, 0xf6, 0x4c, 0x8a, 0xb6, 0x16, 0x38, 0x83, 0x9e, 0x4a,
-	0x69, 0x15, 0x56, 0x46, 0xf8, 0x8a, 0xe8, 0x6c, 0xfc, 0x95, 0x84, 0x58, 0x95, 0x39, 0xe8, 0x6b,
-	0x98, 0xbf, 0xf8, 0x77, 0x66, 0xe2, 0x40, 0xb9, 0xfc, 0x08, 0xe6, 0xdf, 0xbb, 0xaa, 0x46, 0x44,
-	0x02, 0x7d, 0xaf, 0x81, 0x3e, 0xf2, 0xcd, 0x7c, 0x7f, 0x0a, 0xb3, 0xa3, 0x94, 0xf3, 0x3b, 0xff,
-	0x40, 0x59, 0xd1, 0x6b, 0x43, 0xa6, 0xff, 0x1d, 0x30, 0xa6, 0xb6, 0x29, 0xf0, 0xf9, 0x77, 0xae,
-	0x86, 0x57, 0x6e, 0xbf, 0xd5, 0x60, 0x71, 0x70, 0x4a, 0x6e, 0x4e, 0x61, 0x6d, 0x40, 0x2b, 0xff,
-	0xc1, 0x75, 0xb4, 0x14, 0x93, 0x03, 0x48, 0xc8, 0x01, 0x78, 0x6f, 0x0a, 0x3b, 0x21, 0x34, 0xbf,
-	0x3e, 0x35, 0x54, 0xf9, 0x21, 0x90, 0xee, 0x8d, 0xa2, 0x07, 0x53, 0xa7, 0x8d, 0x7b, 0xdb, 0xbc,
-	0x0a, 0xba, 0xdf, 0x61, 0x6f, 0x10, 0x4c, 0xe3, 0x50, 0xa1, 0xa7, 0x72, 0x38, 0x70, 0xfb, 0xd1,
-	0x4b, 0xfe, 0xf8, 0x0d, 0xbb, 0xfb, 0xef, 0x4e, 0x61, 0x6e, 0x98, 0x62, 0xfe, 0xc3, 0x6b, 0x2a,
-	0x46, 0x94, 0x2a, 0x9f, 0x9c, 0x9c, 0x15, 0xb4, 0x57, 0x67, 0x05, 0xed, 0xb7, 0xb3, 0x82, 0xf6,
-	0xf2, 0xbc, 0x30, 0xf3, 0xea, 0xbc, 0x30, 0xf3, 0xcb, 0x79, 0x61, 0xe6, 0x8b, 0x4d, 0xc7, 0x0d,
-	0x0e, 0xdb, 0x75, 0xa3, 0x41, 0xbc, 0xf2, 0x88, 0x4f, 0xab, 0xce, 0xc3, 0xf2, 0x71, 0xef, 0xab,
-	0xb2, 0xdb, 0xc2, 0xac, 0x9e, 0x10, 0xdf, 0x48, 0x0f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x5a,
-	0x44, 0x5b, 0x50, 0x84, 0x0e, 0x00, 0x00,
+	// 1379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x73, 0xdb, 0xc4,
+	0x17, 0x8f, 0x62, 0xc7, 0xb1, 0x9f, 0x9d, 0xc4, 0xd5, 0x37, 0xd3, 0xaa, 0x6a, 0xbf, 0x4e, 0xea,
+	0x0e, 0x43, 0x4a, 0x8b, 0x44, 0xd2, 0xd0, 0x76, 0x02, 0x33, 0x9d, 0xba, 0x99, 0x69, 0x0b, 0xb8,
+	0x2d, 0xea, 0x8f, 0x03, 0x17, 0x8f, 0x6c, 0x6d, 0x14, 0xb5, 0x96, 0xd6, 0x68, 0x65, 0x27, 0xa6,
+	0x27, 0xb8, 0x70, 0xe0, 0x40, 0x8f, 0x1c, 0x18, 0x86, 0x3f, 0x81, 0x7f, 0x80, 0x2b, 0xd3, 0x63,
+	0x8f, 0x70, 0x29, 0x4c, 0x7b, 0xe0, 0x0c, 0x47, 0x4e, 0xcc, 0xae, 0x56, 0x6b, 0x39, 0xb6, 0x23,
+	0x39, 0x2d, 0x27, 0x6b, 0xdf, 0xbe, 0x1f, 0x9f, 0xf7, 0x63, 0xdf, 0x7b, 0x63, 0x78, 0xdb, 0xea,
+	0xbb, 0xc8, 0x23, 0x0e, 0xf6, 0xf6, 0xfb, 0x5f, 0xe8, 0xe2, 0xa0, 0xfb, 0xb8, 0xdd, 0x36, 0x3b,
+	0x1d, 0x3d, 0xd8, 0xd7, 0x3a, 0x3e, 0x0e, 0xb0, 0x5c, 0x89, 0x33, 0x6a, 0xe2, 0xa0, 0x71, 0x46,
+	0xf5, 0x44, 0x0b, 0x13, 0x17, 0x13, 0xdd, 0x25, 0xb6, 0xde, 0x5b, 0xa7, 0x3f, 0xa1, 0xa0, 0xfa,
+	0x7e, 0x82, 0x85, 0x66, 0x1b, 0xb7, 0x1e, 0x37, 0x2c, 0x44, 0x5a, 0xbe, 0xd3, 0x09, 0xb0, 0xcf,
+	0xc5, 0x2e, 0x24, 0x88, 0xf1, 0x5f, 0xce, 0xfd, 0x6e, 0x02, 0xb7, 0x8b, 0x02, 0xd3, 0x32, 0x03,
+	0x93, 0xb3, 0xaf, 0x27, 0xb0, 0xdb, 0xc8, 0x43, 0xc4, 0x21, 0x0d, 0xc7, 0xdb, 0xc1, 0x5c, 0x64,
+	0xd9, 0xc6, 0x36, 0x66, 0x9f, 0x3a, 0xfd, 0xe2, 0xd4, 0x0a, 0xf7, 0xba, 0x69, 0x12, 0xa4, 0xf7,
+	0xd6, 0x9b, 0x28, 0x30, 0xd7, 0xf5, 0x16, 0x76, 0x3c, 0x7e, 0xaf, 0x27, 0x79, 0x61, 0x06, 0xa8,
+	0xd1, 0x76, 0x5c, 0x27, 0x88, 0x14, 0xda, 0x18, 0xdb, 0x6d, 0xa4, 0xb3, 0x53, 0xb3, 0xbb, 0xa3,
+	0x5b, 0x5d, 0xdf, 0x0c, 0x68, 0xa0, 0x19, 0xa5, 0xfa, 0x4f, 0x06, 0xca, 0x75, 0x62, 0x5f, 0xf7,
+	0x91, 0x19, 0x20, 0x23, 0xd4, 0x22, 0x2b, 0x30, 0xdf, 0xa2, 0x04, 0xec, 0x2b, 0xd2, 0xaa, 0xb4,
+	0x56, 0x30, 0xa2, 0xa3, 0xfc, 0x7f, 0x00, 0x6e, 0xaa, 0xe1, 0x58, 0xca, 0x2c, 0xbb, 0x2c, 0x70,
+	0xca, 0x2d, 0x4b, 0x3e, 0x0f, 0xc7, 0x1c, 0xcf, 0x09, 0x1c, 0xb3, 0xdd, 0x20, 0xe8, 0xf3, 0x2e,
+	0xf2, 0x5a, 0xc8, 0x57, 0x8a, 0x8c, 0xab, 0xcc, 0x2f, 0xee, 0x45, 0x74, 0xf9, 0x11, 0xc8, 0xae,
+	0xe3, 0x0d, 0x18, 0x1b, 0x4d, 0xec, 0x59, 0x4a, 0x79, 0x55, 0x5a, 0x2b, 0x6e, 0x9c, 0xd4, 0xc2,
+	0x40, 0x68, 0x34, 0x10, 0x1a, 0x0f, 0x84, 0x76, 0x1d, 0x3b, 0x5e, 0xed, 0xcc, 0xb3, 0x17, 0x2b,
+	0x33, 0x7f, 0xbf, 0x58, 0x39, 0xd9, 0x37, 0xdd, 0xf6, 0x56, 0x75, 0x54, 0x45, 0xd5, 0x28, 0xbb,
+	0x8e, 0x27, 0xec, 0xd4, 0xb0, 0x67, 0xc9, 0xcb, 0x30, 0x67, 0xb6, 0x1d, 0x93, 0x28, 0x25, 0x06,
+	0x26, 0x3c, 0xc8, 0x1f, 0x43, 0x3e, 0x4a, 0xa4, 0xb2, 0xc0, 0xec, 0xea, 0xda, 0xe1, 0x65, 0xa9,
+	0xf1, 0x10, 0xd5, 0xb9, 0x98, 0x21, 0x14, 0xc8, 0xf7, 0xa1, 0x14, 0x4f, 0xb3, 0xb2, 0xc8, 0x14,
+	0x9e, 0x4f, 0x52, 0x78, 0x23, 0x94, 0xb9, 0xe5, 0xed, 0xe0, 0x5a, 0xf6, 0xd9, 0x8b, 0x15, 0xc9,
+	0x28, 0xda, 0x03, 0x92, 0x7c, 0x03, 0xe6, 0x7b, 0x6e, 0x23, 0xe8, 0x77, 0x90, 0xb2, 0xb4, 0x2a,
+	0xad, 0x2d, 0x6e, 0x68, 0x29, 0x11, 0x6a, 0x0f, 0xeb, 0xf7, 0xfb, 0x1d, 0x64, 0xe4, 0x7a, 0x2e,
+	0xfd, 0xdd, 0x2a, 0x7d, 0xf5, 0xe7, 0x4f, 0xef, 0x44, 0x79, 0xfc, 0x28, 0x9b, 0xcf, 0x94, 0x8b,
+	0x55, 0x15, 0x94, 0x83, 0xb9, 0x37, 0x10, 0xe9, 0x60, 0x8f, 0xa0, 0xea, 0x8f, 0x19, 0x38, 0x55,
+	0x27, 0xf6, 0x83, 0x8e, 0x35, 0xb8, 0xa4, 0x88, 0x7c, 0x97, 0x95, 0x0f, 0x8d, 0x28, 0xde, 0xf3,
+	0x50, 0x54, 0x21, 0xe1, 0xe1, 0x48, 0xf5, 0x91, 0x99, 0xaa, 0x3e, 0xe6, 0xff, 0x93, 0xfa, 0xf8,
+	0x34, 0x56, 0x09, 0x73, 0x47, 0xaa, 0x04, 0x9e, 0xbc, 0xc9, 0xf5, 0x90, 0x7b, 0x13, 0xf5, 0xb0,
+	0x05, 0x34, 0x8d, 0x61, 0xb0, 0xab, 0x6f, 0xc1, 0xd9, 0x43, 0x32, 0x24, 0x32, 0xf9, 0xf3, 0x2c,
+	0x2c, 0x0a, 0xbe, 0x7b, 0x81, 0x19, 0xa0, 0x43, 0x1e, 0xf8, 0x69, 0x18, 0xa4, 0x6b, 0x34, 0x7f,
+	0xab, 0x50, 0x24, 0x81, 0xe9, 0x07, 0x37, 0x91, 0x63, 0xef, 0x06, 0x2c, 0x73, 0x59, 0x23, 0x4e,
+	0xa2, 0xf2, 0x5e, 0xd7, 0xad, 0xd1, 0x1e, 0x4c, 0x94, 0x2c, 0xbb, 0x1f, 0x10, 0xe4, 0xe3, 0x90,
+	0xdb, 0xbe, 0x76, 0xd7, 0x0c, 0x76, 0x59, 0x90, 0x0b, 0x06, 0x3f, 0xc9, 0x37, 0x21, 0x53, 0xdb,
+	0x26, 0x3c, 0xb7, 0xef, 0x25, 0x85, 0x88, 0x29, 0xdb, 0x16, 0x0d, 0x9e, 0xb0, 0x38, 0xcd, 0x18,
+	0x54, 0x85, 0x2c, 0x43, 0xb6, 0x6d, 0x92, 0x40, 0xc9, 0xaf, 0x4a, 0x6b, 0x79, 0x83, 0x7d, 0xcb,
+	0xe7, 0xa0, 0x1c, 0x15, 0xa5, 0x8f, 0x7a, 0x0e, 0xd5, 0xa5, 0x14, 0x18, 0xb4, 0x25, 0x3f, 0xaa,
+	0xfa, 0x90, 0x3c, 0xf2, 0x4a, 0x72, 0xe5, 0xf9, 0xaa, 0x02, 0xc7, 0x87, 0xc3, 0x27, 0x22, 0xfb,
+	0x8d, 0x04, 0xcb, 0x75, 0x62, 0xdf, 0xf7, 0x4d, 0x8f, 0xec, 0x20, 0xff, 0x0e, 0xcd, 0x0a, 0xd9,
+	0x75, 0x3a, 0xf2, 0x59, 0x58, 0x68, 0x75, 0x7d, 0x1f, 0x79, 0x41, 0x23, 0xfe, 0x48, 0x4a, 0x9c,
+	0xc8, 0x18, 0xe5, 0x53, 0x50, 0xf0, 0xd0, 0x1e, 0x67, 0x08, 0x43, 0x9d, 0xf7, 0xd0, 0xde, 0x9d,
+	0x31, 0x0f, 0x29, 0x73, 0x20, 0x11, 0x5b, 0x32, 0xc5, 0x39, 0x6c, 0xa3, 0x5a, 0x81, 0xd3, 0xe3,
+	0xc0, 0x08, 0xb4, 0xbf, 0x48, 0x50, 0xa8, 0x13, 0xfb, 0x9a, 0x65, 0x5d, 0x3b, 0xb4, 0xc7, 0xcb,
+	0x90, 0xf5, 0x4c, 0x17, 0x71, 0x48, 0xec, 0x3b, 0x01, 0x0e, 0xad, 0x8b, 0x68, 0xe0, 0xd2, 0xe0,
+	0x66, 0xd9, 0x7d, 0x9c, 0x44, 0xdb, 0x85, 0xe3, 0x9a, 0x36, 0xe2, 0x89, 0x0f, 0x0f, 0x72, 0x19,
+	0x32, 0x5d, 0xbf, 0xcd, 0x9e, 0x46, 0xc1, 0xa0, 0x9f, 0x94, 0x0f, 0xfb, 0x16, 0xf2, 0x59, 0x2d,
+	0xcc, 0x19, 0xe1, 0x61, 0x38, 0x2d, 0xd5, 0xff, 0xc1, 0x31, 0xe1, 0x87, 0xf0, 0xee, 0x37, 0x09,
+	0x4a, 0x22, 0x4d, 0x87, 0x3b, 0xb8, 0x08, 0xb3, 0xbc, 0x39, 0x65, 0x8d, 0x59, 0xc7, 0x12, 0x0e,
+	0x67, 0x26, 0x3a, 0x9c, 0x4d, 0x70, 0x78, 0xee, 0x10, 0x87, 0x73, 0x63, 0x1c, 0x9e, 0x1f, 0xe3,
+	0x70, 0x7e, 0xb2, 0xc3, 0xc7, 0x61, 0x39, 0xee, 0x9a, 0xf0, 0x19, 0x31, 0x97, 0x0d, 0xe4, 0xe2,
+	0xde, 0x94, 0x2e, 0x27, 0x94, 0xd7, 0x38, 0xf3, 0xc2, 0x8c, 0x30, 0xff, 0x08, 0x4e, 0xd4, 0x89,
+	0x5d, 0x37, 0xfd, 0xc7, 0x77, 0x9a, 0x04, 0xb7, 0x91, 0xe8, 0x42, 0x84, 0xb6, 0x01, 0xb3, 0x1b,
+	0xec, 0x62, 0xdf, 0x09, 0xfa, 0x1c, 0xcb, 0x80, 0x20, 0x9f, 0x81, 0x92, 0xe5, 0x93, 0x46, 0x0f,
+	0xf9, 0xf4, 0xd1, 0x11, 0x65, 0x76, 0x35, 0xb3, 0xb6, 0x60, 0x14, 0x2d, 0x9f, 0x3c, 0xe4, 0xa4,
+	0xad, 0x45, 0x8a, 0x60, 0x20, 0x52, 0x3d, 0x03, 0x2b, 0x13, 0x6c, 0x09, 0x38, 0xdf, 0x4a, 0xb0,
+	0x54, 0x27, 0xf6, 0x3d, 0x14, 0x18, 0x66, 0x80, 0x3e, 0xa1, 0x4b, 0x50, 0x02, 0x8e, 0xdb, 0x00,
+	0x83, 0x85, 0x89, 0x45, 0xa7, 0xb8, 0x71, 0x2e, 0xb1, 0xef, 0x47, 0xca, 0x79, 0xdb, 0x29, 0xf8,
+	0x11, 0x61, 0x04, 0xf4, 0x49, 0x38, 0x71, 0x00, 0x90, 0x00, 0xbb, 0x07, 0xb2, 0x88, 0x69, 0x5a,
+	0xb8, 0x09, 0xc3, 0x75, 0x19, 0xe6, 0x2c, 0xe4, 0x61, 0x97, 0xa7, 0x33, 0x3c, 0x8c, 0x60, 0x3a,
+	0x0d, 0xea, 0xa8, 0x61, 0x01, 0xeb, 0x07, 0x89, 0xcd, 0x8a, 0xbb, 0x66, 0x97, 0xa0, 0x9a, 0xef,
+	0x58, 0x36, 0x7a, 0x3d, 0x4c, 0x57, 0x21, 0x1f, 0x2d, 0x9c, 0x4a, 0x86, 0x4f, 0xee, 0x70, 0x23,
+	0xd5, 0xa2, 0x8d, 0x54, 0xdb, 0xe6, 0x0c, 0xb5, 0x3c, 0x8d, 0xe7, 0x77, 0xbf, 0xd3, 0x29, 0x1a,
+	0x09, 0x8d, 0xc0, 0x0f, 0x9b, 0x71, 0x0c, 0x9f, 0x80, 0xde, 0x60, 0x8b, 0xec, 0x03, 0xaf, 0xf3,
+	0x86, 0xb0, 0x8f, 0x98, 0x0e, 0xb7, 0xa5, 0x21, 0x03, 0x91, 0xf1, 0x8d, 0xbf, 0x8a, 0x90, 0xa9,
+	0x13, 0x5b, 0x7e, 0x02, 0x0b, 0xc3, 0xab, 0x74, 0xe2, 0x30, 0x3b, 0xb8, 0x80, 0xa9, 0x57, 0xa6,
+	0x95, 0x88, 0x40, 0xc8, 0xdf, 0x4b, 0xa0, 0x4c, 0xdc, 0xd7, 0x3e, 0x48, 0xa1, 0x76, 0x92, 0xb0,
+	0x7a, 0xfd, 0x35, 0x84, 0x05, 0xbc, 0x2e, 0x14, 0xe3, 0x3b, 0x88, 0x96, 0x5a, 0x27, 0xe3, 0x57,
+	0x2f, 0x4d, 0xc7, 0x2f, 0xcc, 0x7e, 0x2d, 0xc1, 0xb1, 0xd1, 0x09, 0xbd, 0x99, 0x42, 0xdb, 0x88,
+	0x94, 0xfa, 0xe1, 0x51, 0xa4, 0x04, 0x92, 0x1d, 0xc8, 0xf1, 0xe1, 0x7b, 0x2e, 0x85, 0x9e, 0x90,
+	0x55, 0x5d, 0x4f, 0xcd, 0x2a, 0xec, 0x60, 0x28, 0x0c, 0xc6, 0xe0, 0x85, 0xd4, 0x61, 0xa3, 0xd6,
+	0x36, 0xa7, 0xe1, 0x8e, 0x1b, 0x1c, 0x0c, 0xa1, 0x34, 0x06, 0x05, 0xb7, 0xba, 0x39, 0x0d, 0xb7,
+	0x30, 0xf8, 0x94, 0x2e, 0x5e, 0xe3, 0xe6, 0xce, 0xe5, 0x14, 0xea, 0xc6, 0x09, 0xaa, 0x57, 0x8f,
+	0x28, 0x28, 0x20, 0xed, 0x43, 0x69, 0x68, 0xf2, 0xe8, 0x29, 0x14, 0xc6, 0x05, 0xd4, 0xcb, 0x53,
+	0x0a, 0x08, 0xcb, 0x5f, 0x4a, 0xb0, 0x74, 0x70, 0x90, 0x6c, 0xa4, 0x0e, 0xeb, 0x00, 0xc0, 0xd6,
+	0xf4, 0x32, 0xf1, 0xb7, 0x1d, 0x9f, 0x19, 0x69, 0xde, 0x76, 0x8c, 0x5f, 0xbd, 0x34, 0x1d, 0xbf,
+	0x30, 0xfb, 0x04, 0x16, 0x86, 0x1b, 0x7e, 0x9a, 0x76, 0x3b, 0x24, 0xa1, 0x5e, 0x99, 0x56, 0x22,
+	0x32, 0x5e, 0xbb, 0xfd, 0xec, 0x65, 0x45, 0x7a, 0xfe, 0xb2, 0x22, 0xfd, 0xf1, 0xb2, 0x22, 0x3d,
+	0x7d, 0x55, 0x99, 0x79, 0xfe, 0xaa, 0x32, 0xf3, 0xeb, 0xab, 0xca, 0xcc, 0x67, 0x9b, 0xb6, 0x13,
+	0xec, 0x76, 0x9b, 0x5a, 0x0b, 0xbb, 0x93, 0xfe, 0xb0, 0xe9, 0x5d, 0xd4, 0xf7, 0x07, 0x7f, 0x8a,
+	0xf5, 0x3b, 0x88, 0x34, 0x73, 0x6c, 0x22, 0x5e, 0xfc, 0x77, 0x00, 0x17, 0xb8, 0xf7, 0x7b, 0x43,
+	0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1146,6 +1561,10 @@ type MsgClient interface {
 	UpdateApp(ctx context.Context, in *MsgUpdateApp, opts ...grpc.CallOption) (*MsgUpdateAppResponse, error)
 	RemoveApp(ctx context.Context, in *MsgRemoveApp, opts ...grpc.CallOption) (*MsgRemoveAppResponse, error)
 	MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsoleteRollapps, opts ...grpc.CallOption) (*MsgMarkObsoleteRollappsResponse, error)
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	PauseBridge(ctx context.Context, in *MsgPauseBridge, opts ...grpc.CallOption) (*MsgPauseBridgeResponse, error)
+	UnpauseBridge(ctx context.Context, in *MsgUnpauseBridge, opts ...grpc.CallOption) (*MsgUnpauseBridgeResponse, error)
 }
 
 type msgClient struct {
@@ -1228,6 +1647,42 @@ func (c *msgClient) MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsolet
 	return out, nil
 }
 
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/RemoveRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseBridge(ctx context.Context, in *MsgPauseBridge, opts ...grpc.CallOption) (*MsgPauseBridgeResponse, error) {
+	out := new(MsgPauseBridgeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/PauseBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseBridge(ctx context.Context, in *MsgUnpauseBridge, opts ...grpc.CallOption) (*MsgUnpauseBridgeResponse, error) {
+	out := new(MsgUnpauseBridgeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UnpauseBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
@@ -1238,6 +1693,10 @@ type MsgServer interface {
 	UpdateApp(context.Context, *MsgUpdateApp) (*MsgUpdateAppResponse, error)
 	RemoveApp(context.Context, *MsgRemoveApp) (*MsgRemoveAppResponse, error)
 	MarkObsoleteRollapps(context.Context, *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error)
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	PauseBridge(context.Context, *MsgPauseBridge) (*MsgPauseBridgeResponse, error)
+	UnpauseBridge(context.Context, *MsgUnpauseBridge) (*MsgUnpauseBridgeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1268,7 +1727,19 @@ func (*UnimplementedMsgServer) RemoveApp(ctx context.Context, req *MsgRemoveApp)
 func (*UnimplementedMsgServer) MarkObsoleteRollapps(ctx context.Context, req *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkObsoleteRollapps not implemented")
 }
-
+func (*UnimplementedMsgServer) SetRateLimit(ctx context.Context, req *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) PauseBridge(ctx context.Context, req *MsgPauseBridge) (*MsgPauseBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseBridge not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseBridge(ctx context.Context, req *MsgUnpauseBridge) (*MsgUnpauseBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseBridge not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -1417,6 +1888,78 @@ func _Msg_MarkObsoleteRollapps_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/RemoveRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/PauseBridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseBridge(ctx, req.(*MsgPauseBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseBridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UnpauseBridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseBridge(ctx, req.(*MsgUnpauseBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1453,6 +1996,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarkObsoleteRollapps",
 			Handler:    _Msg_MarkObsoleteRollapps_Handler,
 		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "PauseBridge",
+			Handler:    _Msg_PauseBridge_Handler,
+		},
+		{
+			MethodName: "UnpauseBridge",
+			Handler:    _Msg_UnpauseBridge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/rollapp/tx.proto",
@@ -2170,120 +2729,378 @@ func (m *MsgMarkObsoleteRollappsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateRollapp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InitialSequencer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Alias)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GenesisInfo != nil {
-		l = m.GenesisInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.VmType != 0 {
-		n += 1 + sovTx(uint64(m.VmType))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinSequencerBond.Size()
-	n += 2 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRollappInformation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InitialSequencer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.GenesisInfo != nil {
-		l = m.GenesisInfo.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinSequencerBond.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRollappInformationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPauseBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err11 != nil {
+		return 0, err11
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTx(uint64(m.StartHeight))
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseBridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseBridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseBridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseBridgeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseBridgeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseBridgeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GenesisInfo != nil {
+		l = m.GenesisInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VmType != 0 {
+		n += 1 + sovTx(uint64(m.VmType))
+	}
+	l = m.MinSequencerBond.Size()
+	n += 2 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRollappInformation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitialSequencer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GenesisInfo != nil {
+		l = m.GenesisInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinSequencerBond.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRollappInformationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
 	}
 	if m.NumBlocks != 0 {
 		n += 1 + sovTx(uint64(m.NumBlocks))
@@ -2493,47 +3310,155 @@ func (m *MsgMarkObsoleteRollappsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgSetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPauseBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseBridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseBridgeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
 				}
@@ -2658,9 +3583,840 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RollappMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisInfo == nil {
+				m.GenesisInfo = &GenesisInfo{}
+			}
+			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
+			}
+			m.VmType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VmType |= Rollapp_VMType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RollappMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisInfo == nil {
+				m.GenesisInfo = &GenesisInfo{}
+			}
+			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DAPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Last = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappRevision", wireType)
+			}
+			m.RollappRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollappRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2670,33 +4426,29 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &RollappMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2706,52 +4458,29 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GenesisInfo == nil {
-				m.GenesisInfo = &GenesisInfo{}
-			}
-			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmType", wireType)
-			}
-			m.VmType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VmType |= Rollapp_VMType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2761,24 +4490,23 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2801,7 +4529,7 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2824,10 +4552,10 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2851,7 +4579,7 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
+func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2874,15 +4602,15 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddApp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddApp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2910,11 +4638,11 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2942,11 +4670,11 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSequencer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2974,13 +4702,13 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialSequencer = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2990,33 +4718,29 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &RollappMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3026,33 +4750,29 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GenesisInfo == nil {
-				m.GenesisInfo = &GenesisInfo{}
-			}
-			if err := m.GenesisInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSequencerBond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3062,25 +4782,43 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSequencerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3102,7 +4840,7 @@ func (m *MsgUpdateRollappInformation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3125,10 +4863,10 @@ func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRollappInformationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3152,7 +4890,7 @@ func (m *MsgUpdateRollappInformationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3175,10 +4913,10 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateApp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateApp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3214,8 +4952,27 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3243,13 +5000,13 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3259,16 +5016,29 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.NumBlocks = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3278,14 +5048,27 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3313,13 +5096,13 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DAPath = string(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3329,50 +5112,29 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Last = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
-			m.RollappRevision = 0
+			m.Order = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3382,7 +5144,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RollappRevision |= uint64(b&0x7F) << shift
+				m.Order |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3408,7 +5170,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3431,10 +5193,10 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3458,7 +5220,7 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3481,15 +5243,15 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveApp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveApp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3517,13 +5279,13 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentOwner = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3533,24 +5295,11 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
@@ -3604,7 +5353,7 @@ func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3627,10 +5376,10 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAppResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3642,210 +5391,50 @@ func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddApp: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddApp: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarkObsoleteRollapps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarkObsoleteRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3873,26 +5462,83 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			m.Order = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.DrsVersions = append(m.DrsVersions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Order |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DrsVersions) == 0 {
+					m.DrsVersions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DrsVersions = append(m.DrsVersions, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3915,7 +5561,7 @@ func (m *MsgAddApp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarkObsoleteRollappsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3938,10 +5584,10 @@ func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarkObsoleteRollappsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarkObsoleteRollappsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3965,7 +5611,7 @@ func (m *MsgAddAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3988,15 +5634,15 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateApp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateApp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4024,13 +5670,13 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4040,78 +5686,128 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4139,11 +5835,11 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4171,11 +5867,11 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4203,27 +5899,8 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
-			}
-			m.Order = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Order |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4245,7 +5922,7 @@ func (m *MsgUpdateApp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4268,10 +5945,10 @@ func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4295,7 +5972,7 @@ func (m *MsgUpdateAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseBridge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4318,15 +5995,15 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveApp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseBridge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveApp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseBridge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4354,13 +6031,13 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4370,16 +6047,29 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4389,23 +6079,24 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4428,7 +6119,7 @@ func (m *MsgRemoveApp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseBridgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4451,10 +6142,10 @@ func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveAppResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseBridgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveAppResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4478,7 +6169,7 @@ func (m *MsgRemoveAppResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseBridge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4501,10 +6192,10 @@ func (m *MsgMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarkObsoleteRollapps: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseBridge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarkObsoleteRollapps: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseBridge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4540,81 +6231,37 @@ func (m *MsgMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.DrsVersions = append(m.DrsVersions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.DrsVersions) == 0 {
-					m.DrsVersions = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.DrsVersions = append(m.DrsVersions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrsVersions", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4636,7 +6283,7 @@ func (m *MsgMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMarkObsoleteRollappsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseBridgeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4659,10 +6306,10 @@ func (m *MsgMarkObsoleteRollappsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMarkObsoleteRollappsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseBridgeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMarkObsoleteRollappsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseBridgeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: